@@ -35,11 +35,13 @@ import (
 	"github.com/milvus-io/milvus/cmd/components"
 	"github.com/milvus-io/milvus/internal/http"
 	"github.com/milvus-io/milvus/internal/http/healthz"
+	"github.com/milvus-io/milvus/internal/util/auditlog"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	kvfactory "github.com/milvus-io/milvus/internal/util/dependency/kv"
 	"github.com/milvus-io/milvus/internal/util/initcore"
 	internalmetrics "github.com/milvus-io/milvus/internal/util/metrics"
 	"github.com/milvus-io/milvus/pkg/config"
+	"github.com/milvus-io/milvus/pkg/eventlog"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	rocksmqimpl "github.com/milvus-io/milvus/pkg/mq/mqimpl/rocksmq/server"
@@ -350,6 +352,10 @@ func (mr *MilvusRoles) Run() {
 		paramtable.SetRole(mr.ServerType)
 	}
 
+	setupDiskEventLogger()
+	if mr.EnableRootCoord || mr.EnableDataCoord {
+		setupAuditLogger()
+	}
 	expr.Init()
 	expr.Register("param", paramtable.Get())
 	http.ServeHTTP()
@@ -484,9 +490,46 @@ func (mr *MilvusRoles) Run() {
 	// close reused etcd client
 	kvfactory.CloseEtcdClient()
 
+	if err := eventlog.Flush(); err != nil {
+		log.Warn("failed to flush event log", zap.Error(err))
+	}
+	if err := auditlog.Close(); err != nil {
+		log.Warn("failed to close audit log", zap.Error(err))
+	}
+
 	log.Info("Milvus components graceful stop done")
 }
 
+// setupDiskEventLogger persists the event logs of the component on local disk if enabled.
+func setupDiskEventLogger() {
+	params := paramtable.Get()
+	if !params.CommonCfg.EventLogEnabled.GetAsBool() {
+		return
+	}
+	dir := filepath.Join(params.CommonCfg.EventLogRootPath.GetValue(), paramtable.GetRole())
+	err := eventlog.InitDiskLogger(dir,
+		params.CommonCfg.EventLogMaxFileSize.GetAsInt64()*1024*1024,
+		params.CommonCfg.EventLogMaxFiles.GetAsInt())
+	if err != nil {
+		log.Warn("failed to init disk event logger", zap.String("dir", dir), zap.Error(err))
+	}
+}
+
+// setupAuditLogger records the DDL, RBAC and credential changes handled by the coordinators if enabled.
+func setupAuditLogger() {
+	params := paramtable.Get()
+	if !params.CommonCfg.AuditLogEnabled.GetAsBool() {
+		return
+	}
+	dir := params.CommonCfg.AuditLogRootPath.GetValue()
+	err := auditlog.Init(dir,
+		params.CommonCfg.AuditLogMaxFileSize.GetAsInt64()*1024*1024,
+		params.CommonCfg.AuditLogMaxFiles.GetAsInt())
+	if err != nil {
+		log.Warn("failed to init audit logger", zap.String("dir", dir), zap.Error(err))
+	}
+}
+
 func (mr *MilvusRoles) GetRoles() []string {
 	roles := make([]string, 0)
 	if mr.EnableRootCoord {
@@ -0,0 +1,225 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// binlogexport reads the binlogs and deltalogs of segments, applies the deletes,
+// and writes the remaining rows to Parquet or JSON Lines files on local disk, one file per segment.
+// The milvus.yaml is used to connect to etcd and object storage.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
+	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
+	"github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/kv"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+var (
+	collectionID = flag.Int64("collection", 0, "Collection ID to export")
+	segmentID    = flag.Int64("segment", 0, "Segment ID to export, export all flushed segments of the collection if zero")
+	format       = flag.String("format", string(storage.ExportFormatParquet), "Output format, parquet or jsonl")
+	outputDir    = flag.String("output", ".", "Directory to write the exported files")
+)
+
+func main() {
+	flag.Parse()
+	if *collectionID == 0 {
+		fmt.Println("usage: binlogexport -collection id [-segment id] [-format parquet|jsonl] [-output dir]")
+		os.Exit(1)
+	}
+
+	paramtable.Init()
+	params := paramtable.Get()
+	etcdCli, err := etcd.CreateEtcdClient(
+		params.EtcdCfg.UseEmbedEtcd.GetAsBool(),
+		params.EtcdCfg.EtcdEnableAuth.GetAsBool(),
+		params.EtcdCfg.EtcdAuthUserName.GetValue(),
+		params.EtcdCfg.EtcdAuthPassword.GetValue(),
+		params.EtcdCfg.EtcdUseSSL.GetAsBool(),
+		params.EtcdCfg.Endpoints.GetAsStrings(),
+		params.EtcdCfg.EtcdTLSCert.GetValue(),
+		params.EtcdCfg.EtcdTLSKey.GetValue(),
+		params.EtcdCfg.EtcdTLSCACert.GetValue(),
+		params.EtcdCfg.EtcdTLSMinVersion.GetValue())
+	if err != nil {
+		log.Fatal("failed to connect to etcd", zap.Error(err))
+	}
+	defer etcdCli.Close()
+	metaRootPath := params.EtcdCfg.MetaRootPath.GetValue()
+	metaKV := etcdkv.NewEtcdKV(etcdCli, metaRootPath)
+
+	ctx := context.Background()
+	schema, err := getCollectionSchema(ctx, metaKV, metaRootPath, *collectionID)
+	if err != nil {
+		log.Fatal("failed to get collection schema", zap.Int64("collectionID", *collectionID), zap.Error(err))
+	}
+
+	cli, err := storage.NewChunkManagerFactoryWithParam(params).NewPersistentStorageChunkManager(ctx)
+	if err != nil {
+		log.Fatal("failed to connect to object storage", zap.Error(err))
+	}
+	segments, err := datacoord.NewCatalog(metaKV, cli.RootPath(), metaRootPath).ListSegments(ctx)
+	if err != nil {
+		log.Fatal("failed to list segments", zap.Error(err))
+	}
+
+	if err := os.MkdirAll(*outputDir, 0o755); err != nil {
+		log.Fatal("failed to create output directory", zap.Error(err))
+	}
+	segments = lo.Filter(segments, func(segment *datapb.SegmentInfo, _ int) bool {
+		return segment.GetCollectionID() == *collectionID
+	})
+	for _, segment := range segments {
+		if err := binlog.DecompressBinLogs(segment); err != nil {
+			log.Fatal("failed to decompress binlogs", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+		}
+	}
+	// the L0 segments hold the deletes not compacted into the segments yet, they are not exported but applied
+	levelZeroSegments := lo.Filter(segments, func(segment *datapb.SegmentInfo, _ int) bool {
+		return segment.GetLevel() == datapb.SegmentLevel_L0 &&
+			(segment.GetState() == commonpb.SegmentState_Flushed || segment.GetState() == commonpb.SegmentState_Flushing)
+	})
+
+	var exportedSegments int
+	for _, segment := range segments {
+		if *segmentID != 0 && segment.GetID() != *segmentID {
+			continue
+		}
+		if *segmentID == 0 && segment.GetState() != commonpb.SegmentState_Flushed {
+			continue
+		}
+		if segment.GetLevel() == datapb.SegmentLevel_L0 {
+			fmt.Printf("segment %d skipped, L0 segment holds deletes only\n", segment.GetID())
+			continue
+		}
+		output := filepath.Join(*outputDir, fmt.Sprintf("%d.%s", segment.GetID(), *format))
+		exported, deleted, err := exportSegment(ctx, cli, schema, segment, levelZeroSegments, storage.ExportFormat(*format), output)
+		if err != nil {
+			log.Fatal("failed to export segment", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+		}
+		fmt.Printf("segment %d exported to %s, rows: %d, deleted: %d\n", segment.GetID(), output, exported, deleted)
+		exportedSegments++
+	}
+	if exportedSegments == 0 {
+		fmt.Println("no segment found")
+		os.Exit(1)
+	}
+}
+
+// getCollectionSchema loads the collection schema from rootcoord meta, the collection may belong to any database.
+func getCollectionSchema(ctx context.Context, metaKV kv.MetaKv, metaRootPath string, collectionID int64) (*schemapb.CollectionSchema, error) {
+	ss, err := rootcoord.NewSuffixSnapshot(metaKV, rootcoord.SnapshotsSep, metaRootPath, rootcoord.SnapshotPrefix)
+	if err != nil {
+		return nil, err
+	}
+	catalog := &rootcoord.Catalog{Txn: metaKV, Snapshot: ss}
+	dbs, err := catalog.ListDatabases(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	var coll *model.Collection
+	for _, db := range dbs {
+		coll, err = catalog.GetCollectionByID(ctx, db.ID, 0, collectionID)
+		if err == nil {
+			break
+		}
+	}
+	if coll == nil {
+		// the collections created before database introduced are not under any database
+		if coll, err = catalog.GetCollectionByID(ctx, 0, 0, collectionID); err != nil {
+			return nil, err
+		}
+	}
+	return &schemapb.CollectionSchema{
+		Name:   coll.Name,
+		Fields: model.MarshalFieldModels(coll.Fields),
+	}, nil
+}
+
+func exportSegment(ctx context.Context, cli storage.ChunkManager, schema *schemapb.CollectionSchema,
+	segment *datapb.SegmentInfo, levelZeroSegments []*datapb.SegmentInfo, format storage.ExportFormat, output string,
+) (int64, int64, error) {
+	f, err := os.Create(output)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer f.Close()
+	writer, err := storage.NewExportWriter(format, schema, f)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	exported, deleted, err := storage.ExportSegment(ctx, cli, schema, groupBinlogPaths(segment), deltalogPaths(segment, levelZeroSegments), writer)
+	if err != nil {
+		writer.Close()
+		return 0, 0, err
+	}
+	return exported, deleted, writer.Close()
+}
+
+// deltalogPaths returns the deltalogs of the segment, and the ones of the L0 segments of the same channel
+// and partition, or of the whole collection.
+func deltalogPaths(segment *datapb.SegmentInfo, levelZeroSegments []*datapb.SegmentInfo) []string {
+	var paths []string
+	appendPaths := func(s *datapb.SegmentInfo) {
+		for _, fieldBinlog := range s.GetDeltalogs() {
+			for _, l := range fieldBinlog.GetBinlogs() {
+				paths = append(paths, l.GetLogPath())
+			}
+		}
+	}
+	appendPaths(segment)
+	for _, l0 := range levelZeroSegments {
+		if l0.GetInsertChannel() == segment.GetInsertChannel() &&
+			(l0.GetPartitionID() == segment.GetPartitionID() || l0.GetPartitionID() == common.AllPartitionsID) {
+			appendPaths(l0)
+		}
+	}
+	return paths
+}
+
+// groupBinlogPaths groups the insert binlogs by batch, each batch contains the binlogs of all fields.
+func groupBinlogPaths(segment *datapb.SegmentInfo) [][]string {
+	var batchCount int
+	for _, fieldBinlog := range segment.GetBinlogs() {
+		batchCount = max(batchCount, len(fieldBinlog.GetBinlogs()))
+	}
+	batches := make([][]string, batchCount)
+	for _, fieldBinlog := range segment.GetBinlogs() {
+		for idx, l := range fieldBinlog.GetBinlogs() {
+			batches[idx] = append(batches[idx], l.GetLogPath())
+		}
+	}
+	return batches
+}
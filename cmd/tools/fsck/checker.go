@@ -0,0 +1,373 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
+	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/kv"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
+)
+
+type issueType string
+
+const (
+	issueMissingBinlog        issueType = "MissingBinlog"
+	issueSizeMismatch         issueType = "SizeMismatch"
+	issueOrphanFile           issueType = "OrphanFile"
+	issueMissingIndexFile     issueType = "MissingIndexFile"
+	issueIndexFileWithoutMeta issueType = "IndexFileWithoutMeta"
+)
+
+// issue is an inconsistency between the meta and the object storage.
+type issue struct {
+	Type         issueType `json:"type"`
+	CollectionID int64     `json:"collectionID,omitempty"`
+	PartitionID  int64     `json:"partitionID,omitempty"`
+	SegmentID    int64     `json:"segmentID,omitempty"`
+	BuildID      int64     `json:"buildID,omitempty"`
+	Path         string    `json:"path"`
+	Detail       string    `json:"detail,omitempty"`
+}
+
+// breaksSegment returns true if the segment can't be loaded because of the issue.
+func (i *issue) breaksSegment() bool {
+	switch i.Type {
+	case issueMissingBinlog, issueSizeMismatch, issueMissingIndexFile:
+		return true
+	default:
+		return false
+	}
+}
+
+type report struct {
+	CheckedSegments int      `json:"checkedSegments"`
+	CheckedFiles    int      `json:"checkedFiles"`
+	Issues          []*issue `json:"issues"`
+	// BrokenSegments are the segments with missing or mismatched files, keyed by segment ID.
+	BrokenSegments map[int64][]*issue `json:"brokenSegments"`
+}
+
+func (r *report) add(i *issue) {
+	r.Issues = append(r.Issues, i)
+	if i.breaksSegment() {
+		r.BrokenSegments[i.SegmentID] = append(r.BrokenSegments[i.SegmentID], i)
+	}
+}
+
+// checker compares the datacoord meta with the files in object storage, it never modifies anything.
+type checker struct {
+	catalog metastore.DataCoordCatalog
+	cli     storage.ChunkManager
+
+	// collectionID limits the check to one collection if it's not zero
+	collectionID int64
+	// files modified within tolerance are not reported as orphan, since they may be written but not yet saved in meta
+	tolerance time.Duration
+	checkSize bool
+}
+
+func (c *checker) check(ctx context.Context) (*report, error) {
+	r := &report{
+		BrokenSegments: make(map[int64][]*issue),
+	}
+	segments, err := c.catalog.ListSegments(ctx)
+	if err != nil {
+		return nil, err
+	}
+	segments = lo.Filter(segments, func(segment *datapb.SegmentInfo, _ int) bool {
+		return c.collectionID == 0 || segment.GetCollectionID() == c.collectionID
+	})
+	segmentMap := make(map[int64]*datapb.SegmentInfo, len(segments))
+	// all binlogs in meta, including the ones of dropped segments which are waiting for gc
+	binlogFiles := make(map[string]struct{})
+	for _, segment := range segments {
+		if err := binlog.DecompressBinLogs(segment); err != nil {
+			return nil, err
+		}
+		segmentMap[segment.GetID()] = segment
+		for _, fieldBinlog := range allFieldBinlogs(segment) {
+			for _, l := range fieldBinlog.GetBinlogs() {
+				binlogFiles[l.GetLogPath()] = struct{}{}
+			}
+		}
+	}
+
+	for _, segment := range segments {
+		if segment.GetState() == commonpb.SegmentState_Dropped {
+			continue
+		}
+		r.CheckedSegments++
+		if err := c.checkSegmentBinlogs(ctx, segment, r); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, prefix := range []string{common.SegmentInsertLogPath, common.SegmentDeltaLogPath, common.SegmentStatslogPath} {
+		if err := c.checkOrphanBinlogs(ctx, prefix, binlogFiles, r); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := c.checkIndexFiles(ctx, segmentMap, r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func allFieldBinlogs(segment *datapb.SegmentInfo) []*datapb.FieldBinlog {
+	fieldBinlogs := make([]*datapb.FieldBinlog, 0, len(segment.GetBinlogs())+len(segment.GetDeltalogs())+len(segment.GetStatslogs()))
+	fieldBinlogs = append(fieldBinlogs, segment.GetBinlogs()...)
+	fieldBinlogs = append(fieldBinlogs, segment.GetDeltalogs()...)
+	fieldBinlogs = append(fieldBinlogs, segment.GetStatslogs()...)
+	return fieldBinlogs
+}
+
+// checkSegmentBinlogs reports the binlogs in meta which are missing or with unexpected size in storage.
+func (c *checker) checkSegmentBinlogs(ctx context.Context, segment *datapb.SegmentInfo, r *report) error {
+	newIssue := func(typ issueType, logPath string, detail string) *issue {
+		return &issue{
+			Type:         typ,
+			CollectionID: segment.GetCollectionID(),
+			PartitionID:  segment.GetPartitionID(),
+			SegmentID:    segment.GetID(),
+			Path:         logPath,
+			Detail:       detail,
+		}
+	}
+	for _, fieldBinlog := range allFieldBinlogs(segment) {
+		for _, l := range fieldBinlog.GetBinlogs() {
+			r.CheckedFiles++
+			exist, err := c.cli.Exist(ctx, l.GetLogPath())
+			if err != nil {
+				return err
+			}
+			if !exist {
+				r.add(newIssue(issueMissingBinlog, l.GetLogPath(), ""))
+				continue
+			}
+			if !c.checkSize || l.GetLogSize() <= 0 {
+				continue
+			}
+			size, err := c.cli.Size(ctx, l.GetLogPath())
+			if err != nil {
+				return err
+			}
+			if size != l.GetLogSize() {
+				r.add(newIssue(issueSizeMismatch, l.GetLogPath(), fmt.Sprintf("expected %d bytes, actual %d bytes", l.GetLogSize(), size)))
+			}
+		}
+	}
+	return nil
+}
+
+// checkOrphanBinlogs reports the binlog files which are not referenced by any segment.
+func (c *checker) checkOrphanBinlogs(ctx context.Context, prefix string, binlogFiles map[string]struct{}, r *report) error {
+	walkPrefix := path.Join(c.cli.RootPath(), prefix) + "/"
+	if c.collectionID != 0 {
+		walkPrefix = path.Join(c.cli.RootPath(), prefix, strconv.FormatInt(c.collectionID, 10)) + "/"
+	}
+	return c.walk(ctx, walkPrefix, func(info *storage.ChunkObjectInfo) bool {
+		if time.Since(info.ModifyTime) <= c.tolerance {
+			return true
+		}
+		if _, ok := binlogFiles[info.FilePath]; !ok {
+			r.add(&issue{
+				Type: issueOrphanFile,
+				Path: info.FilePath,
+			})
+		}
+		return true
+	})
+}
+
+// checkIndexFiles reports the missing index files of segment indexes, and the index files without meta.
+func (c *checker) checkIndexFiles(ctx context.Context, segments map[int64]*datapb.SegmentInfo, r *report) error {
+	segmentIndexes, err := c.catalog.ListSegmentIndexes(ctx)
+	if err != nil {
+		return err
+	}
+	indexes, err := c.catalog.ListIndexes(ctx)
+	if err != nil {
+		return err
+	}
+	droppedIndexes := make(map[int64]bool)
+	for _, index := range indexes {
+		droppedIndexes[index.IndexID] = index.IsDeleted
+	}
+
+	// index files in meta, keyed by build ID
+	indexFiles := make(map[int64]map[string]struct{})
+	for _, segIdx := range segmentIndexes {
+		files := metautil.BuildSegmentIndexFilePaths(c.cli.RootPath(), segIdx.BuildID, segIdx.IndexVersion,
+			segIdx.PartitionID, segIdx.SegmentID, segIdx.IndexFileKeys)
+		indexFiles[segIdx.BuildID] = lo.SliceToMap(files, func(file string) (string, struct{}) {
+			return file, struct{}{}
+		})
+
+		segment, ok := segments[segIdx.SegmentID]
+		if !ok || segment.GetState() == commonpb.SegmentState_Dropped ||
+			!isIndexInUse(segIdx, droppedIndexes) {
+			continue
+		}
+		for _, file := range files {
+			r.CheckedFiles++
+			exist, err := c.cli.Exist(ctx, file)
+			if err != nil {
+				return err
+			}
+			if !exist {
+				r.add(&issue{
+					Type:         issueMissingIndexFile,
+					CollectionID: segIdx.CollectionID,
+					PartitionID:  segIdx.PartitionID,
+					SegmentID:    segIdx.SegmentID,
+					BuildID:      segIdx.BuildID,
+					Path:         file,
+				})
+			}
+		}
+	}
+
+	// the index files can't be attributed to a collection without meta, skip them when checking one collection
+	if c.collectionID != 0 {
+		return nil
+	}
+	prefix := path.Join(c.cli.RootPath(), common.SegmentIndexPath) + "/"
+	return c.walk(ctx, prefix, func(info *storage.ChunkObjectInfo) bool {
+		if time.Since(info.ModifyTime) <= c.tolerance {
+			return true
+		}
+		buildID, err := parseBuildID(prefix, info.FilePath)
+		if err != nil {
+			log.Warn("unexpected index file path", zap.String("path", info.FilePath), zap.Error(err))
+			return true
+		}
+		files, ok := indexFiles[buildID]
+		if !ok {
+			r.add(&issue{
+				Type:    issueIndexFileWithoutMeta,
+				BuildID: buildID,
+				Path:    info.FilePath,
+				Detail:  "build id not found in meta",
+			})
+			return true
+		}
+		if _, ok := files[info.FilePath]; !ok {
+			r.add(&issue{
+				Type:    issueIndexFileWithoutMeta,
+				BuildID: buildID,
+				Path:    info.FilePath,
+			})
+		}
+		return true
+	})
+}
+
+// walk walks through the objects with prefix recursively, the prefix not exists is treated as empty.
+func (c *checker) walk(ctx context.Context, prefix string, walkFunc storage.ChunkObjectWalkFunc) error {
+	err := c.cli.WalkWithPrefix(ctx, prefix, true, walkFunc)
+	// local chunk manager fails to walk a directory not exists
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// isIndexInUse returns true if the segment index is finished and not dropped, so its files are expected to exist.
+func isIndexInUse(segIdx *model.SegmentIndex, droppedIndexes map[int64]bool) bool {
+	dropped, ok := droppedIndexes[segIdx.IndexID]
+	return ok && !dropped && !segIdx.IsDeleted && segIdx.IndexState == commonpb.IndexState_Finished
+}
+
+// parseBuildID parses the build id from index file path `index_files/{buildID}/{version}/{partID}/{segID}/{key}`.
+func parseBuildID(prefix string, filePath string) (int64, error) {
+	key := strings.TrimPrefix(filePath, prefix)
+	buildID, _, _ := strings.Cut(key, "/")
+	return strconv.ParseInt(buildID, 10, 64)
+}
+
+// markBrokenSegments records the issues of broken segments in meta, datacoord excludes the marked segments
+// from loading and compaction after restart. The segment meta and the files are untouched.
+func markBrokenSegments(metaKV kv.MetaKv, r *report) error {
+	kvs := make(map[string]string, len(r.BrokenSegments))
+	for segmentID, issues := range r.BrokenSegments {
+		value, err := json.Marshal(issues)
+		if err != nil {
+			return err
+		}
+		key := datacoord.BuildBrokenSegmentKey(issues[0].CollectionID, segmentID)
+		kvs[key] = string(value)
+	}
+	if len(kvs) == 0 {
+		return nil
+	}
+	return metaKV.MultiSave(kvs)
+}
+
+func printReport(r *report) {
+	sort.Slice(r.Issues, func(i, j int) bool {
+		if r.Issues[i].Type != r.Issues[j].Type {
+			return r.Issues[i].Type < r.Issues[j].Type
+		}
+		return r.Issues[i].Path < r.Issues[j].Path
+	})
+	counts := make(map[issueType]int)
+	for _, i := range r.Issues {
+		counts[i.Type]++
+		fmt.Printf("[%s] %s", i.Type, i.Path)
+		if i.SegmentID != 0 {
+			fmt.Printf(" collection=%d partition=%d segment=%d", i.CollectionID, i.PartitionID, i.SegmentID)
+		}
+		if i.BuildID != 0 {
+			fmt.Printf(" buildID=%d", i.BuildID)
+		}
+		if i.Detail != "" {
+			fmt.Printf(" (%s)", i.Detail)
+		}
+		fmt.Println()
+	}
+	fmt.Println("================================================================================")
+	fmt.Printf("Checked Segments: %d\tChecked Files: %d\n", r.CheckedSegments, r.CheckedFiles)
+	for _, typ := range []issueType{issueMissingBinlog, issueSizeMismatch, issueOrphanFile, issueMissingIndexFile, issueIndexFileWithoutMeta} {
+		fmt.Printf("%s: %d\n", typ, counts[typ])
+	}
+	segmentIDs := lo.Keys(r.BrokenSegments)
+	sort.Slice(segmentIDs, func(i, j int) bool { return segmentIDs[i] < segmentIDs[j] })
+	fmt.Printf("Broken Segments: %v\n", segmentIDs)
+}
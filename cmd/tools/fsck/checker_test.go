@@ -0,0 +1,160 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/kv/mocks"
+	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
+	mocks2 "github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestChecker(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	rootPath := t.TempDir()
+	paramtable.Get().Save(paramtable.Get().CommonCfg.StorageType.Key, "local")
+	paramtable.Get().Save(paramtable.Get().LocalStorageCfg.Path.Key, rootPath)
+	defer paramtable.Get().Reset(paramtable.Get().CommonCfg.StorageType.Key)
+	defer paramtable.Get().Reset(paramtable.Get().LocalStorageCfg.Path.Key)
+	cli := storage.NewLocalChunkManager(storage.RootPath(rootPath))
+
+	write := func(filePath string, size int) {
+		require.NoError(t, cli.Write(ctx, filePath, make([]byte, size)))
+	}
+	// segment 10 is healthy, segment 11 misses a binlog, segment 12 is dropped
+	write(metautil.BuildInsertLogPath(rootPath, 1, 2, 10, 100, 1000), 10)
+	write(metautil.BuildInsertLogPath(rootPath, 1, 2, 11, 100, 1001), 20)
+	write(metautil.BuildDeltaLogPath(rootPath, 1, 2, 10, 1003), 10)
+	write(metautil.BuildStatsLogPath(rootPath, 1, 2, 12, 100, 1004), 10)
+	orphan := metautil.BuildInsertLogPath(rootPath, 1, 2, 13, 100, 1005)
+	write(orphan, 10)
+	write(metautil.BuildSegmentIndexFilePath(rootPath, 500, 1, 2, 10, "index"), 10)
+	indexOrphan := metautil.BuildSegmentIndexFilePath(rootPath, 501, 1, 2, 13, "index")
+	write(indexOrphan, 10)
+
+	segments := []*datapb.SegmentInfo{
+		{
+			ID: 10, CollectionID: 1, PartitionID: 2, State: commonpb.SegmentState_Flushed,
+			Binlogs:   []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []*datapb.Binlog{{LogID: 1000, LogSize: 10}}}},
+			Deltalogs: []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []*datapb.Binlog{{LogID: 1003}}}},
+		},
+		{
+			ID: 11, CollectionID: 1, PartitionID: 2, State: commonpb.SegmentState_Flushed,
+			Binlogs: []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []*datapb.Binlog{{LogID: 1001, LogSize: 10}, {LogID: 1002}}}},
+		},
+		{
+			ID: 12, CollectionID: 1, PartitionID: 2, State: commonpb.SegmentState_Dropped,
+			Statslogs: []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []*datapb.Binlog{{LogID: 1004}}}},
+		},
+	}
+	catalog := mocks2.NewDataCoordCatalog(t)
+	// the binlog paths are decompressed in place, return new segments for each call
+	catalog.EXPECT().ListSegments(mock.Anything).RunAndReturn(func(ctx context.Context) ([]*datapb.SegmentInfo, error) {
+		cloned := make([]*datapb.SegmentInfo, 0, len(segments))
+		for _, segment := range segments {
+			cloned = append(cloned, &datapb.SegmentInfo{
+				ID: segment.ID, CollectionID: segment.CollectionID, PartitionID: segment.PartitionID, State: segment.State,
+				Binlogs: cloneFieldBinlogs(segment.Binlogs), Deltalogs: cloneFieldBinlogs(segment.Deltalogs), Statslogs: cloneFieldBinlogs(segment.Statslogs),
+			})
+		}
+		return cloned, nil
+	})
+	catalog.EXPECT().ListIndexes(mock.Anything).Return([]*model.Index{{IndexID: 50}}, nil)
+	catalog.EXPECT().ListSegmentIndexes(mock.Anything).Return([]*model.SegmentIndex{
+		{SegmentID: 10, CollectionID: 1, PartitionID: 2, IndexID: 50, BuildID: 500, IndexVersion: 1, IndexState: commonpb.IndexState_Finished, IndexFileKeys: []string{"index"}},
+		{SegmentID: 11, CollectionID: 1, PartitionID: 2, IndexID: 50, BuildID: 502, IndexVersion: 1, IndexState: commonpb.IndexState_Finished, IndexFileKeys: []string{"index"}},
+		// in progress index files are not checked
+		{SegmentID: 10, CollectionID: 1, PartitionID: 2, IndexID: 50, BuildID: 503, IndexVersion: 1, IndexState: commonpb.IndexState_InProgress},
+	}, nil)
+
+	c := &checker{
+		catalog:   catalog,
+		cli:       cli,
+		tolerance: -1,
+		checkSize: true,
+	}
+	r, err := c.check(ctx)
+	require.NoError(t, err)
+
+	issues := make(map[issueType][]string)
+	for _, i := range r.Issues {
+		issues[i.Type] = append(issues[i.Type], i.Path)
+	}
+	assert.Equal(t, []string{metautil.BuildInsertLogPath(rootPath, 1, 2, 11, 100, 1002)}, issues[issueMissingBinlog])
+	assert.Equal(t, []string{metautil.BuildInsertLogPath(rootPath, 1, 2, 11, 100, 1001)}, issues[issueSizeMismatch])
+	assert.Equal(t, []string{orphan}, issues[issueOrphanFile])
+	assert.Equal(t, []string{metautil.BuildSegmentIndexFilePath(rootPath, 502, 1, 2, 11, "index")}, issues[issueMissingIndexFile])
+	assert.Equal(t, []string{indexOrphan}, issues[issueIndexFileWithoutMeta])
+	assert.Equal(t, 2, r.CheckedSegments)
+	assert.Len(t, r.BrokenSegments, 1)
+	assert.Len(t, r.BrokenSegments[11], 3)
+
+	t.Run("tolerance", func(t *testing.T) {
+		c := &checker{catalog: catalog, cli: cli, tolerance: time.Hour}
+		r, err := c.check(ctx)
+		require.NoError(t, err)
+		for _, i := range r.Issues {
+			assert.NotEqual(t, issueOrphanFile, i.Type)
+			assert.NotEqual(t, issueIndexFileWithoutMeta, i.Type)
+		}
+	})
+
+	t.Run("collection filter", func(t *testing.T) {
+		c := &checker{catalog: catalog, cli: cli, collectionID: 2, tolerance: -1}
+		r, err := c.check(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, r.Issues)
+		assert.Equal(t, 0, r.CheckedSegments)
+	})
+
+	t.Run("mark broken segments", func(t *testing.T) {
+		metaKV := mocks.NewMetaKv(t)
+		metaKV.EXPECT().MultiSave(mock.Anything).RunAndReturn(func(kvs map[string]string) error {
+			assert.Len(t, kvs, 1)
+			assert.Contains(t, kvs, datacoord.BuildBrokenSegmentKey(1, 11))
+			return nil
+		})
+		assert.NoError(t, markBrokenSegments(metaKV, r))
+		assert.NoError(t, markBrokenSegments(metaKV, &report{}))
+	})
+}
+
+func cloneFieldBinlogs(fieldBinlogs []*datapb.FieldBinlog) []*datapb.FieldBinlog {
+	cloned := make([]*datapb.FieldBinlog, 0, len(fieldBinlogs))
+	for _, fieldBinlog := range fieldBinlogs {
+		binlogs := make([]*datapb.Binlog, 0, len(fieldBinlog.GetBinlogs()))
+		for _, l := range fieldBinlog.GetBinlogs() {
+			binlogs = append(binlogs, &datapb.Binlog{LogID: l.GetLogID(), LogSize: l.GetLogSize()})
+		}
+		cloned = append(cloned, &datapb.FieldBinlog{FieldID: fieldBinlog.GetFieldID(), Binlogs: binlogs})
+	}
+	return cloned
+}
@@ -0,0 +1,124 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// fsck checks the datacoord meta against the object storage, reports the missing binlogs,
+// orphan files, size mismatches and index files without meta.
+// The milvus.yaml is used to connect to etcd and object storage.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+var (
+	etcdAddr     = flag.String("etcd", "", "Etcd Endpoint to connect, use the endpoints in milvus.yaml if empty")
+	metaRootPath = flag.String("metaRootPath", "", "Meta root path, use etcd.rootPath in milvus.yaml if empty")
+
+	collectionID = flag.Int64("collection", 0, "Collection ID to check, check all collections if zero")
+	tolerance    = flag.Duration("tolerance", time.Hour, "Files modified within tolerance are not reported as orphan")
+	checkSize    = flag.Bool("checkSize", false, "Compare the binlog size in meta with the object size")
+	repair       = flag.Bool("repair", false, "Mark the broken segments in meta, datacoord stops loading and compacting them after restart, nothing is deleted")
+	output       = flag.String("output", "", "Write the report as json to the file")
+)
+
+func main() {
+	flag.Parse()
+
+	paramtable.Init()
+	params := paramtable.Get()
+
+	var etcdCli *clientv3.Client
+	var err error
+	if *etcdAddr != "" {
+		etcdCli, err = etcd.GetRemoteEtcdClient([]string{*etcdAddr})
+	} else {
+		etcdCli, err = etcd.CreateEtcdClient(
+			params.EtcdCfg.UseEmbedEtcd.GetAsBool(),
+			params.EtcdCfg.EtcdEnableAuth.GetAsBool(),
+			params.EtcdCfg.EtcdAuthUserName.GetValue(),
+			params.EtcdCfg.EtcdAuthPassword.GetValue(),
+			params.EtcdCfg.EtcdUseSSL.GetAsBool(),
+			params.EtcdCfg.Endpoints.GetAsStrings(),
+			params.EtcdCfg.EtcdTLSCert.GetValue(),
+			params.EtcdCfg.EtcdTLSKey.GetValue(),
+			params.EtcdCfg.EtcdTLSCACert.GetValue(),
+			params.EtcdCfg.EtcdTLSMinVersion.GetValue())
+	}
+	if err != nil {
+		log.Fatal("failed to connect to etcd", zap.Error(err))
+	}
+	defer etcdCli.Close()
+
+	rootPath := *metaRootPath
+	if rootPath == "" {
+		rootPath = params.EtcdCfg.MetaRootPath.GetValue()
+	}
+	metaKV := etcdkv.NewEtcdKV(etcdCli, rootPath)
+
+	ctx := context.Background()
+	cli, err := storage.NewChunkManagerFactoryWithParam(params).NewPersistentStorageChunkManager(ctx)
+	if err != nil {
+		log.Fatal("failed to connect to object storage", zap.Error(err))
+	}
+
+	c := &checker{
+		catalog:      datacoord.NewCatalog(metaKV, cli.RootPath(), rootPath),
+		cli:          cli,
+		collectionID: *collectionID,
+		tolerance:    *tolerance,
+		checkSize:    *checkSize,
+	}
+	r, err := c.check(ctx)
+	if err != nil {
+		log.Fatal("failed to check", zap.Error(err))
+	}
+	printReport(r)
+
+	if *output != "" {
+		data, err := json.MarshalIndent(r, "", "  ")
+		if err != nil {
+			log.Fatal("failed to marshal report", zap.Error(err))
+		}
+		if err := storage.WriteFile(*output, data, 0o644); err != nil {
+			log.Fatal("failed to write report", zap.String("output", *output), zap.Error(err))
+		}
+	}
+
+	if *repair {
+		if err := markBrokenSegments(metaKV, r); err != nil {
+			log.Fatal("failed to mark broken segments", zap.Error(err))
+		}
+		fmt.Printf("Marked %d broken segments under %s, restart datacoord to exclude them\n", len(r.BrokenSegments), datacoord.BrokenSegmentPrefix)
+	}
+	if len(r.Issues) > 0 {
+		os.Exit(1)
+	}
+}
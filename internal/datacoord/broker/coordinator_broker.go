@@ -39,6 +39,10 @@ type Broker interface {
 	ShowCollections(ctx context.Context, dbName string) (*milvuspb.ShowCollectionsResponse, error)
 	ListDatabases(ctx context.Context) (*milvuspb.ListDatabasesResponse, error)
 	HasCollection(ctx context.Context, collectionID int64) (bool, error)
+	DescribeCollectionByName(ctx context.Context, dbName, collectionName string) (*milvuspb.DescribeCollectionResponse, error)
+	ShowPartitions(ctx context.Context, collectionID int64) (*milvuspb.ShowPartitionsResponse, error)
+	CreateCollection(ctx context.Context, req *milvuspb.CreateCollectionRequest) error
+	CreatePartition(ctx context.Context, dbName, collectionName, partitionName string) error
 }
 
 type coordinatorBroker struct {
@@ -52,7 +56,7 @@ func NewCoordinatorBroker(rootCoord types.RootCoordClient) *coordinatorBroker {
 }
 
 func (b *coordinatorBroker) DescribeCollectionInternal(ctx context.Context, collectionID int64) (*milvuspb.DescribeCollectionResponse, error) {
-	ctx, cancel := context.WithTimeout(ctx, paramtable.Get().QueryCoordCfg.BrokerTimeout.GetAsDuration(time.Millisecond))
+	ctx, cancel := context.WithTimeout(ctx, paramtable.Get().DataCoordCfg.BrokerTimeout.GetAsDuration(time.Millisecond))
 	defer cancel()
 	log := log.Ctx(ctx).With(zap.Int64("collectionID", collectionID))
 
@@ -73,7 +77,7 @@ func (b *coordinatorBroker) DescribeCollectionInternal(ctx context.Context, coll
 }
 
 func (b *coordinatorBroker) ShowPartitionsInternal(ctx context.Context, collectionID int64) ([]int64, error) {
-	ctx, cancel := context.WithTimeout(ctx, paramtable.Get().QueryCoordCfg.BrokerTimeout.GetAsDuration(time.Millisecond))
+	ctx, cancel := context.WithTimeout(ctx, paramtable.Get().DataCoordCfg.BrokerTimeout.GetAsDuration(time.Millisecond))
 	defer cancel()
 	log := log.Ctx(ctx).With(zap.Int64("collectionID", collectionID))
 
@@ -96,7 +100,7 @@ func (b *coordinatorBroker) ShowPartitionsInternal(ctx context.Context, collecti
 }
 
 func (b *coordinatorBroker) ShowCollections(ctx context.Context, dbName string) (*milvuspb.ShowCollectionsResponse, error) {
-	ctx, cancel := context.WithTimeout(ctx, paramtable.Get().QueryCoordCfg.BrokerTimeout.GetAsDuration(time.Millisecond))
+	ctx, cancel := context.WithTimeout(ctx, paramtable.Get().DataCoordCfg.BrokerTimeout.GetAsDuration(time.Millisecond))
 	defer cancel()
 	log := log.Ctx(ctx).With(zap.String("dbName", dbName))
 	resp, err := b.rootCoord.ShowCollections(ctx, &milvuspb.ShowCollectionsRequest{
@@ -117,7 +121,7 @@ func (b *coordinatorBroker) ShowCollections(ctx context.Context, dbName string)
 }
 
 func (b *coordinatorBroker) ListDatabases(ctx context.Context) (*milvuspb.ListDatabasesResponse, error) {
-	ctx, cancel := context.WithTimeout(ctx, paramtable.Get().QueryCoordCfg.BrokerTimeout.GetAsDuration(time.Millisecond))
+	ctx, cancel := context.WithTimeout(ctx, paramtable.Get().DataCoordCfg.BrokerTimeout.GetAsDuration(time.Millisecond))
 	defer cancel()
 	log := log.Ctx(ctx)
 	resp, err := b.rootCoord.ListDatabases(ctx, &milvuspb.ListDatabasesRequest{
@@ -132,7 +136,7 @@ func (b *coordinatorBroker) ListDatabases(ctx context.Context) (*milvuspb.ListDa
 
 // HasCollection communicates with RootCoord and check whether this collection exist from the user's perspective.
 func (b *coordinatorBroker) HasCollection(ctx context.Context, collectionID int64) (bool, error) {
-	ctx, cancel := context.WithTimeout(ctx, paramtable.Get().QueryCoordCfg.BrokerTimeout.GetAsDuration(time.Millisecond))
+	ctx, cancel := context.WithTimeout(ctx, paramtable.Get().DataCoordCfg.BrokerTimeout.GetAsDuration(time.Millisecond))
 	defer cancel()
 	resp, err := b.rootCoord.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
 		Base: commonpbutil.NewMsgBase(
@@ -151,3 +155,84 @@ func (b *coordinatorBroker) HasCollection(ctx context.Context, collectionID int6
 	}
 	return err == nil, err
 }
+
+func (b *coordinatorBroker) DescribeCollectionByName(ctx context.Context, dbName, collectionName string) (*milvuspb.DescribeCollectionResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, paramtable.Get().DataCoordCfg.BrokerTimeout.GetAsDuration(time.Millisecond))
+	defer cancel()
+	log := log.Ctx(ctx).With(zap.String("dbName", dbName), zap.String("collectionName", collectionName))
+
+	resp, err := b.rootCoord.DescribeCollectionInternal(ctx, &milvuspb.DescribeCollectionRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_DescribeCollection),
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		DbName:         dbName,
+		CollectionName: collectionName,
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		log.Warn("DescribeCollectionByName failed", zap.Error(err))
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// ShowPartitions returns the partition ids and names of the collection.
+func (b *coordinatorBroker) ShowPartitions(ctx context.Context, collectionID int64) (*milvuspb.ShowPartitionsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, paramtable.Get().DataCoordCfg.BrokerTimeout.GetAsDuration(time.Millisecond))
+	defer cancel()
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", collectionID))
+
+	resp, err := b.rootCoord.ShowPartitionsInternal(ctx, &milvuspb.ShowPartitionsRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_ShowPartitions),
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		CollectionID: collectionID,
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		log.Warn("ShowPartitions failed", zap.Error(err))
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (b *coordinatorBroker) CreateCollection(ctx context.Context, req *milvuspb.CreateCollectionRequest) error {
+	ctx, cancel := context.WithTimeout(ctx, paramtable.Get().DataCoordCfg.BrokerTimeout.GetAsDuration(time.Millisecond))
+	defer cancel()
+	log := log.Ctx(ctx).With(zap.String("dbName", req.GetDbName()), zap.String("collectionName", req.GetCollectionName()))
+
+	req.Base = commonpbutil.NewMsgBase(
+		commonpbutil.WithMsgType(commonpb.MsgType_CreateCollection),
+		commonpbutil.WithSourceID(paramtable.GetNodeID()),
+	)
+	status, err := b.rootCoord.CreateCollection(ctx, req)
+	if err := merr.CheckRPCCall(status, err); err != nil {
+		log.Warn("CreateCollection failed", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (b *coordinatorBroker) CreatePartition(ctx context.Context, dbName, collectionName, partitionName string) error {
+	ctx, cancel := context.WithTimeout(ctx, paramtable.Get().DataCoordCfg.BrokerTimeout.GetAsDuration(time.Millisecond))
+	defer cancel()
+	log := log.Ctx(ctx).With(zap.String("dbName", dbName), zap.String("collectionName", collectionName),
+		zap.String("partitionName", partitionName))
+
+	status, err := b.rootCoord.CreatePartition(ctx, &milvuspb.CreatePartitionRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_CreatePartition),
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		DbName:         dbName,
+		CollectionName: collectionName,
+		PartitionName:  partitionName,
+	})
+	if err := merr.CheckRPCCall(status, err); err != nil {
+		log.Warn("CreatePartition failed", zap.Error(err))
+		return err
+	}
+	return nil
+}
@@ -22,6 +22,150 @@ func (_m *MockBroker) EXPECT() *MockBroker_Expecter {
 	return &MockBroker_Expecter{mock: &_m.Mock}
 }
 
+// CreateCollection provides a mock function with given fields: ctx, req
+func (_m *MockBroker) CreateCollection(ctx context.Context, req *milvuspb.CreateCollectionRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CreateCollectionRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBroker_CreateCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCollection'
+type MockBroker_CreateCollection_Call struct {
+	*mock.Call
+}
+
+// CreateCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *milvuspb.CreateCollectionRequest
+func (_e *MockBroker_Expecter) CreateCollection(ctx interface{}, req interface{}) *MockBroker_CreateCollection_Call {
+	return &MockBroker_CreateCollection_Call{Call: _e.mock.On("CreateCollection", ctx, req)}
+}
+
+func (_c *MockBroker_CreateCollection_Call) Run(run func(ctx context.Context, req *milvuspb.CreateCollectionRequest)) *MockBroker_CreateCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.CreateCollectionRequest))
+	})
+	return _c
+}
+
+func (_c *MockBroker_CreateCollection_Call) Return(_a0 error) *MockBroker_CreateCollection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBroker_CreateCollection_Call) RunAndReturn(run func(context.Context, *milvuspb.CreateCollectionRequest) error) *MockBroker_CreateCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePartition provides a mock function with given fields: ctx, dbName, collectionName, partitionName
+func (_m *MockBroker) CreatePartition(ctx context.Context, dbName string, collectionName string, partitionName string) error {
+	ret := _m.Called(ctx, dbName, collectionName, partitionName)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, dbName, collectionName, partitionName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBroker_CreatePartition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePartition'
+type MockBroker_CreatePartition_Call struct {
+	*mock.Call
+}
+
+// CreatePartition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbName string
+//   - collectionName string
+//   - partitionName string
+func (_e *MockBroker_Expecter) CreatePartition(ctx interface{}, dbName interface{}, collectionName interface{}, partitionName interface{}) *MockBroker_CreatePartition_Call {
+	return &MockBroker_CreatePartition_Call{Call: _e.mock.On("CreatePartition", ctx, dbName, collectionName, partitionName)}
+}
+
+func (_c *MockBroker_CreatePartition_Call) Run(run func(ctx context.Context, dbName string, collectionName string, partitionName string)) *MockBroker_CreatePartition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockBroker_CreatePartition_Call) Return(_a0 error) *MockBroker_CreatePartition_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBroker_CreatePartition_Call) RunAndReturn(run func(context.Context, string, string, string) error) *MockBroker_CreatePartition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DescribeCollectionByName provides a mock function with given fields: ctx, dbName, collectionName
+func (_m *MockBroker) DescribeCollectionByName(ctx context.Context, dbName string, collectionName string) (*milvuspb.DescribeCollectionResponse, error) {
+	ret := _m.Called(ctx, dbName, collectionName)
+
+	var r0 *milvuspb.DescribeCollectionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*milvuspb.DescribeCollectionResponse, error)); ok {
+		return rf(ctx, dbName, collectionName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *milvuspb.DescribeCollectionResponse); ok {
+		r0 = rf(ctx, dbName, collectionName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.DescribeCollectionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, dbName, collectionName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBroker_DescribeCollectionByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeCollectionByName'
+type MockBroker_DescribeCollectionByName_Call struct {
+	*mock.Call
+}
+
+// DescribeCollectionByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbName string
+//   - collectionName string
+func (_e *MockBroker_Expecter) DescribeCollectionByName(ctx interface{}, dbName interface{}, collectionName interface{}) *MockBroker_DescribeCollectionByName_Call {
+	return &MockBroker_DescribeCollectionByName_Call{Call: _e.mock.On("DescribeCollectionByName", ctx, dbName, collectionName)}
+}
+
+func (_c *MockBroker_DescribeCollectionByName_Call) Run(run func(ctx context.Context, dbName string, collectionName string)) *MockBroker_DescribeCollectionByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockBroker_DescribeCollectionByName_Call) Return(_a0 *milvuspb.DescribeCollectionResponse, _a1 error) *MockBroker_DescribeCollectionByName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBroker_DescribeCollectionByName_Call) RunAndReturn(run func(context.Context, string, string) (*milvuspb.DescribeCollectionResponse, error)) *MockBroker_DescribeCollectionByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DescribeCollectionInternal provides a mock function with given fields: ctx, collectionID
 func (_m *MockBroker) DescribeCollectionInternal(ctx context.Context, collectionID int64) (*milvuspb.DescribeCollectionResponse, error) {
 	ret := _m.Called(ctx, collectionID)
@@ -239,6 +383,61 @@ func (_c *MockBroker_ShowCollections_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// ShowPartitions provides a mock function with given fields: ctx, collectionID
+func (_m *MockBroker) ShowPartitions(ctx context.Context, collectionID int64) (*milvuspb.ShowPartitionsResponse, error) {
+	ret := _m.Called(ctx, collectionID)
+
+	var r0 *milvuspb.ShowPartitionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*milvuspb.ShowPartitionsResponse, error)); ok {
+		return rf(ctx, collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *milvuspb.ShowPartitionsResponse); ok {
+		r0 = rf(ctx, collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.ShowPartitionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBroker_ShowPartitions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShowPartitions'
+type MockBroker_ShowPartitions_Call struct {
+	*mock.Call
+}
+
+// ShowPartitions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collectionID int64
+func (_e *MockBroker_Expecter) ShowPartitions(ctx interface{}, collectionID interface{}) *MockBroker_ShowPartitions_Call {
+	return &MockBroker_ShowPartitions_Call{Call: _e.mock.On("ShowPartitions", ctx, collectionID)}
+}
+
+func (_c *MockBroker_ShowPartitions_Call) Run(run func(ctx context.Context, collectionID int64)) *MockBroker_ShowPartitions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockBroker_ShowPartitions_Call) Return(_a0 *milvuspb.ShowPartitionsResponse, _a1 error) *MockBroker_ShowPartitions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBroker_ShowPartitions_Call) RunAndReturn(run func(context.Context, int64) (*milvuspb.ShowPartitionsResponse, error)) *MockBroker_ShowPartitions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ShowPartitionsInternal provides a mock function with given fields: ctx, collectionID
 func (_m *MockBroker) ShowPartitionsInternal(ctx context.Context, collectionID int64) ([]int64, error) {
 	ret := _m.Called(ctx, collectionID)
@@ -699,11 +699,19 @@ func (m *ChannelManagerImpl) fillChannelWatchInfo(op *ChannelOp) error {
 			return err
 		}
 
+		schema := ch.GetSchema()
+		// fields may be added after the channel was created, watch with the latest schema
+		if op.Type == Watch {
+			if coll, err := m.h.GetCollection(context.Background(), ch.GetCollectionID()); err == nil && coll != nil && coll.Schema != nil {
+				schema = coll.Schema
+			}
+		}
+
 		info := &datapb.ChannelWatchInfo{
 			Vchan:   reduceVChanSize(vcInfo),
 			StartTs: startTs,
 			State:   inferStateByOpType(op.Type),
-			Schema:  ch.GetSchema(),
+			Schema:  schema,
 			OpID:    opID,
 		}
 		ch.UpdateWatchInfo(info)
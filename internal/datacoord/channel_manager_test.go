@@ -103,6 +103,7 @@ func (s *ChannelManagerSuite) SetupTest() {
 				ChannelName:  ch.GetName(),
 			}
 		}).Maybe()
+	s.mockHandler.EXPECT().GetCollection(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
 	s.mockAlloc.EXPECT().allocID(mock.Anything).Return(19530, nil).Maybe()
 	s.mockKv.EXPECT().MultiSaveAndRemove(mock.Anything, mock.Anything).RunAndReturn(
 		func(save map[string]string, removals []string, preds ...predicates.Predicate) error {
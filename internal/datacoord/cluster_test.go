@@ -26,6 +26,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/kv/mocks"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
@@ -178,6 +179,32 @@ func (suite *ClusterSuite) TestFlushChannels() {
 	})
 }
 
+func (suite *ClusterSuite) TestUpdateChannelSchema() {
+	suite.Run("empty channel", func() {
+		suite.SetupTest()
+
+		cluster := NewClusterImpl(suite.mockSession, suite.mockChManager)
+		err := cluster.UpdateChannelSchema(context.Background(), 1, 100, nil, nil)
+		suite.NoError(err)
+	})
+
+	suite.Run("normal case", func() {
+		suite.SetupTest()
+
+		schema := &schemapb.CollectionSchema{Name: "test"}
+		suite.mockSession.EXPECT().UpdateChannelSchema(mock.Anything, int64(1), mock.Anything).
+			RunAndReturn(func(ctx context.Context, nodeID int64, req *datapb.UpdateChannelSchemaRequest) error {
+				suite.EqualValues(100, req.GetCollectionID())
+				suite.ElementsMatch([]string{"ch-1", "ch-2"}, req.GetChannels())
+				suite.Equal(schema, req.GetSchema())
+				return nil
+			}).Once()
+		cluster := NewClusterImpl(suite.mockSession, suite.mockChManager)
+		err := cluster.UpdateChannelSchema(context.Background(), 1, 100, []string{"ch-1", "ch-2"}, schema)
+		suite.NoError(err)
+	})
+}
+
 func (suite *ClusterSuite) TestQuerySlot() {
 	suite.Run("query slot failed", func() {
 		suite.SetupTest()
@@ -212,7 +212,7 @@ func (c *compactionPlanHandler) schedule() []CompactionTask {
 		switch t.GetType() {
 		case datapb.CompactionType_Level0DeleteCompaction:
 			l0ChannelExcludes.Insert(t.GetChannel())
-		case datapb.CompactionType_MixCompaction:
+		case datapb.CompactionType_MixCompaction, datapb.CompactionType_RepartitionCompaction:
 			mixChannelExcludes.Insert(t.GetChannel())
 			mixLabelExcludes.Insert(t.GetLabel())
 		case datapb.CompactionType_ClusteringCompaction:
@@ -239,7 +239,7 @@ func (c *compactionPlanHandler) schedule() []CompactionTask {
 			}
 			picked = append(picked, t)
 			l0ChannelExcludes.Insert(t.GetChannel())
-		case datapb.CompactionType_MixCompaction:
+		case datapb.CompactionType_MixCompaction, datapb.CompactionType_RepartitionCompaction:
 			if l0ChannelExcludes.Contain(t.GetChannel()) {
 				continue
 			}
@@ -574,7 +574,7 @@ func (c *compactionPlanHandler) enqueueCompaction(task *datapb.CompactionTask) e
 func (c *compactionPlanHandler) createCompactTask(t *datapb.CompactionTask) (CompactionTask, error) {
 	var task CompactionTask
 	switch t.GetType() {
-	case datapb.CompactionType_MixCompaction:
+	case datapb.CompactionType_MixCompaction, datapb.CompactionType_RepartitionCompaction:
 		task = &mixCompactionTask{
 			CompactionTask: t,
 			meta:           c.meta,
@@ -29,6 +29,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/util/clustering"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
@@ -107,6 +108,11 @@ func (policy *clusteringCompactionPolicy) triggerOneCollection(ctx context.Conte
 	if clusteringKeyField == nil {
 		return nil, 0, nil
 	}
+	// clustering compaction applies all the deletes, which breaks the time travel within history retention
+	if retention, _ := common.CollectionHistoryRetention(collection.Properties); retention > 0 {
+		log.Info("skip clustering compaction for collection with history retention", zap.Int64("collectionID", collectionID))
+		return nil, 0, nil
+	}
 
 	// if not pass, alloc a new one
 	if ts == 0 {
@@ -135,6 +141,7 @@ func (policy *clusteringCompactionPolicy) triggerOneCollection(ctx context.Conte
 			isSegmentHealthy(segment) &&
 			isFlush(segment) &&
 			!segment.isCompacting && // not compacting now
+			!segment.isBroken && // not marked broken by fsck
 			!segment.GetIsImporting() && // not importing now
 			segment.GetLevel() != datapb.SegmentLevel_L0 // ignore level zero segments
 	})
@@ -151,7 +158,7 @@ func (policy *clusteringCompactionPolicy) triggerOneCollection(ctx context.Conte
 			continue
 		}
 
-		ct, err := getCompactTime(ts, collection)
+		ct, err := getCompactTime(ts, collection, group.partitionID)
 		if err != nil {
 			log.Warn("get compact time failed, skip to handle compaction")
 			return make([]CompactionView, 0), 0, err
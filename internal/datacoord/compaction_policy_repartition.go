@@ -0,0 +1,165 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/datacoord/broker"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// repartitionCompactionPolicy triggers the compactions rewriting the rows of the segments into the partitions
+// of partition key, after the number of partitions is increased by altering collection.
+type repartitionCompactionPolicy struct {
+	meta    *meta
+	handler Handler
+	broker  broker.Broker
+}
+
+func newRepartitionCompactionPolicy(meta *meta, handler Handler, broker broker.Broker) *repartitionCompactionPolicy {
+	return &repartitionCompactionPolicy{meta: meta, handler: handler, broker: broker}
+}
+
+func (policy *repartitionCompactionPolicy) Enable() bool {
+	return Params.DataCoordCfg.EnableAutoCompaction.GetAsBool() &&
+		Params.DataCoordCfg.RepartitionCompactionEnable.GetAsBool()
+}
+
+func (policy *repartitionCompactionPolicy) Trigger() (map[CompactionTriggerType][]CompactionView, error) {
+	ctx := context.Background()
+	views := make([]CompactionView, 0)
+	for _, collection := range policy.meta.GetCollections() {
+		// only the collections being repartitioned, the cached properties are refreshed by altering collection
+		if _, ok := collection.Properties[common.PartitionKeyRepartitionFromKey]; !ok {
+			continue
+		}
+		collectionViews, err := policy.triggerOneCollection(ctx, collection.ID)
+		if err != nil {
+			log.Warn("fail to trigger collection repartition compaction", zap.Int64("collectionID", collection.ID), zap.Error(err))
+			continue
+		}
+		views = append(views, collectionViews...)
+	}
+	return map[CompactionTriggerType][]CompactionView{TriggerTypeRepartition: views}, nil
+}
+
+func (policy *repartitionCompactionPolicy) triggerOneCollection(ctx context.Context, collectionID int64) ([]CompactionView, error) {
+	collection, err := policy.handler.GetCollection(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	_, repartitionTs, err := common.PartitionKeyRepartition(collection.Properties)
+	if err != nil || repartitionTs == 0 {
+		return nil, err
+	}
+	partitionIDs, err := getPartitionKeyPartitions(ctx, policy.broker, collectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	buckets := int64(len(partitionIDs))
+	partSegments := policy.meta.GetSegmentsChanPart(func(segment *SegmentInfo) bool {
+		return segment.CollectionID == collectionID &&
+			needRepartition(segment, buckets, repartitionTs) &&
+			isFlush(segment) &&
+			!segment.isCompacting && // not compacting now
+			!segment.isBroken && // not marked broken by fsck
+			!segment.GetIsImporting() // not importing now
+	})
+
+	// the rows of one input segment are spread over the partitions, so the segments are
+	// grouped by the max segment size only
+	maxSize := Params.DataCoordCfg.SegmentMaxSize.GetAsFloat() * 1024 * 1024
+	views := make([]CompactionView, 0)
+	for _, group := range partSegments {
+		var (
+			segments []*SegmentInfo
+			size     float64
+		)
+		for _, segment := range group.segments {
+			segmentSize := float64(segment.getSegmentSize())
+			if len(segments) > 0 && size+segmentSize > maxSize {
+				views = append(views, newRepartitionSegmentsView(segments, partitionIDs))
+				segments, size = nil, 0
+			}
+			segments = append(segments, segment)
+			size += segmentSize
+		}
+		if len(segments) > 0 {
+			views = append(views, newRepartitionSegmentsView(segments, partitionIDs))
+		}
+	}
+	log.Info("trigger collection repartition compaction", zap.Int64("collectionID", collectionID), zap.Int("viewNum", len(views)))
+	return views, nil
+}
+
+var _ CompactionView = (*RepartitionSegmentsView)(nil)
+
+type RepartitionSegmentsView struct {
+	label    *CompactionGroupLabel
+	segments []*SegmentView
+	// the partitions of partition key ordered by the bucket index
+	targetPartitionIDs []int64
+}
+
+func newRepartitionSegmentsView(segments []*SegmentInfo, targetPartitionIDs []int64) *RepartitionSegmentsView {
+	segmentViews := GetViewsByInfo(segments...)
+	return &RepartitionSegmentsView{
+		label:              segmentViews[0].label,
+		segments:           segmentViews,
+		targetPartitionIDs: targetPartitionIDs,
+	}
+}
+
+func (v *RepartitionSegmentsView) GetGroupLabel() *CompactionGroupLabel {
+	if v == nil {
+		return &CompactionGroupLabel{}
+	}
+	return v.label
+}
+
+func (v *RepartitionSegmentsView) GetSegmentsView() []*SegmentView {
+	if v == nil {
+		return nil
+	}
+	return v.segments
+}
+
+func (v *RepartitionSegmentsView) Append(segments ...*SegmentView) {
+	v.segments = append(v.segments, segments...)
+}
+
+func (v *RepartitionSegmentsView) String() string {
+	strs := lo.Map(v.segments, func(segView *SegmentView, _ int) string {
+		return segView.String()
+	})
+	return fmt.Sprintf("label=<%s>, targetPartitions=%v, segments=%v", v.label.String(), v.targetPartitionIDs, strs)
+}
+
+func (v *RepartitionSegmentsView) Trigger() (CompactionView, string) {
+	return v, "partitions of partition key increased"
+}
+
+func (v *RepartitionSegmentsView) ForceTrigger() (CompactionView, string) {
+	return v.Trigger()
+}
@@ -6,10 +6,12 @@ import (
 	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 )
@@ -18,12 +20,13 @@ var _ CompactionTask = (*mixCompactionTask)(nil)
 
 type mixCompactionTask struct {
 	*datapb.CompactionTask
-	plan       *datapb.CompactionPlan
-	result     *datapb.CompactionPlanResult
-	span       trace.Span
-	sessions   SessionManager
-	meta       CompactionMeta
-	newSegment *SegmentInfo
+	plan     *datapb.CompactionPlan
+	result   *datapb.CompactionPlanResult
+	span     trace.Span
+	sessions SessionManager
+	meta     CompactionMeta
+	// the result segments saved, one for mix compaction, or at most one for each target partition for repartition compaction
+	newSegments []*SegmentInfo
 }
 
 func (t *mixCompactionTask) processPipelining() bool {
@@ -86,7 +89,7 @@ func (t *mixCompactionTask) processExecuting() bool {
 		}
 	case datapb.CompactionTaskState_completed:
 		t.result = result
-		if len(result.GetSegments()) == 0 || len(result.GetSegments()) > 1 {
+		if !t.isValidResultNum(len(result.GetSegments())) {
 			log.Info("illegal compaction results")
 			err := t.updateAndSaveTaskMeta(setState(datapb.CompactionTaskState_failed))
 			if err != nil {
@@ -107,7 +110,7 @@ func (t *mixCompactionTask) processExecuting() bool {
 			}
 			return false
 		}
-		segments := []UniqueID{t.newSegment.GetID()}
+		segments := lo.Map(t.newSegments, func(segment *SegmentInfo, _ int) UniqueID { return segment.GetID() })
 		err = t.updateAndSaveTaskMeta(setState(datapb.CompactionTaskState_meta_saved), setResultSegments(segments))
 		if err != nil {
 			log.Warn("mixCompaction failed to setState meta saved", zap.Error(err))
@@ -124,6 +127,15 @@ func (t *mixCompactionTask) processExecuting() bool {
 	return false
 }
 
+// isValidResultNum returns whether the number of result segments is legal, mix compaction generates one and only one segment,
+// repartition compaction generates no more segments than the target partitions, as the rows might be all deleted.
+func (t *mixCompactionTask) isValidResultNum(num int) bool {
+	if t.GetType() == datapb.CompactionType_RepartitionCompaction {
+		return num <= len(t.GetTargetPartitionIDs())
+	}
+	return num == 1
+}
+
 func (t *mixCompactionTask) saveTaskMeta(task *datapb.CompactionTask) error {
 	return t.meta.SaveCompactionTask(task)
 }
@@ -140,7 +152,7 @@ func (t *mixCompactionTask) saveSegmentMeta() error {
 		return err
 	}
 	// Apply metrics after successful meta update.
-	t.newSegment = newSegments[0]
+	t.newSegments = newSegments
 	metricMutation.commit()
 	log.Info("mixCompactionTask success to save segment meta")
 	return nil
@@ -213,25 +225,27 @@ func (t *mixCompactionTask) processTimeout() bool {
 
 func (t *mixCompactionTask) ShadowClone(opts ...compactionTaskOpt) *datapb.CompactionTask {
 	taskClone := &datapb.CompactionTask{
-		PlanID:           t.GetPlanID(),
-		TriggerID:        t.GetTriggerID(),
-		State:            t.GetState(),
-		StartTime:        t.GetStartTime(),
-		EndTime:          t.GetEndTime(),
-		TimeoutInSeconds: t.GetTimeoutInSeconds(),
-		Type:             t.GetType(),
-		CollectionTtl:    t.CollectionTtl,
-		CollectionID:     t.GetCollectionID(),
-		PartitionID:      t.GetPartitionID(),
-		Channel:          t.GetChannel(),
-		InputSegments:    t.GetInputSegments(),
-		ResultSegments:   t.GetResultSegments(),
-		TotalRows:        t.TotalRows,
-		Schema:           t.Schema,
-		NodeID:           t.GetNodeID(),
-		FailReason:       t.GetFailReason(),
-		RetryTimes:       t.GetRetryTimes(),
-		Pos:              t.GetPos(),
+		PlanID:             t.GetPlanID(),
+		TriggerID:          t.GetTriggerID(),
+		State:              t.GetState(),
+		StartTime:          t.GetStartTime(),
+		EndTime:            t.GetEndTime(),
+		TimeoutInSeconds:   t.GetTimeoutInSeconds(),
+		Type:               t.GetType(),
+		CollectionTtl:      t.CollectionTtl,
+		HistoryRetention:   t.GetHistoryRetention(),
+		CollectionID:       t.GetCollectionID(),
+		PartitionID:        t.GetPartitionID(),
+		Channel:            t.GetChannel(),
+		InputSegments:      t.GetInputSegments(),
+		ResultSegments:     t.GetResultSegments(),
+		TotalRows:          t.TotalRows,
+		Schema:             t.Schema,
+		NodeID:             t.GetNodeID(),
+		FailReason:         t.GetFailReason(),
+		RetryTimes:         t.GetRetryTimes(),
+		Pos:                t.GetPos(),
+		TargetPartitionIDs: t.GetTargetPartitionIDs(),
 	}
 	for _, opt := range opts {
 		opt(taskClone)
@@ -329,14 +343,16 @@ func (t *mixCompactionTask) CleanLogPath() {
 
 func (t *mixCompactionTask) BuildCompactionRequest() (*datapb.CompactionPlan, error) {
 	plan := &datapb.CompactionPlan{
-		PlanID:           t.GetPlanID(),
-		StartTime:        t.GetStartTime(),
-		TimeoutInSeconds: t.GetTimeoutInSeconds(),
-		Type:             t.GetType(),
-		Channel:          t.GetChannel(),
-		CollectionTtl:    t.GetCollectionTtl(),
-		TotalRows:        t.GetTotalRows(),
-		Schema:           t.GetSchema(),
+		PlanID:             t.GetPlanID(),
+		StartTime:          t.GetStartTime(),
+		TimeoutInSeconds:   t.GetTimeoutInSeconds(),
+		Type:               t.GetType(),
+		Channel:            t.GetChannel(),
+		CollectionTtl:      t.GetCollectionTtl(),
+		HistoryRetention:   t.GetHistoryRetention(),
+		TotalRows:          t.GetTotalRows(),
+		Schema:             t.GetSchema(),
+		TargetPartitionIDs: t.GetTargetPartitionIDs(),
 	}
 	log := log.With(zap.Int64("taskID", t.GetTriggerID()), zap.Int64("planID", plan.GetPlanID()))
 
@@ -358,6 +374,27 @@ func (t *mixCompactionTask) BuildCompactionRequest() (*datapb.CompactionPlan, er
 		})
 		segIDMap[segID] = segInfo.GetDeltalogs()
 	}
+
+	// the rows moved into the other partitions are out of the reach of the L0 segments of the partition,
+	// so the deletes still held by them are applied by repartition compaction as well
+	if t.GetType() == datapb.CompactionType_RepartitionCompaction {
+		levelZeroSegments := t.meta.SelectSegments(WithCollection(t.GetCollectionID()), WithChannel(t.GetChannel()), SegmentFilterFunc(func(info *SegmentInfo) bool {
+			return (info.GetPartitionID() == t.GetPartitionID() || info.GetPartitionID() == common.AllPartitionsID) &&
+				info.GetLevel() == datapb.SegmentLevel_L0 &&
+				isFlushState(info.GetState())
+		}))
+		for _, segInfo := range levelZeroSegments {
+			plan.SegmentBinlogs = append(plan.SegmentBinlogs, &datapb.CompactionSegmentBinlogs{
+				SegmentID:     segInfo.GetID(),
+				CollectionID:  segInfo.GetCollectionID(),
+				PartitionID:   segInfo.GetPartitionID(),
+				Level:         segInfo.GetLevel(),
+				InsertChannel: segInfo.GetInsertChannel(),
+				Deltalogs:     segInfo.GetDeltalogs(),
+			})
+			segIDMap[segInfo.GetID()] = segInfo.GetDeltalogs()
+		}
+	}
 	log.Info("Compaction handler refreshed mix compaction plan", zap.Any("segID2DeltaLogs", segIDMap))
 	return plan, nil
 }
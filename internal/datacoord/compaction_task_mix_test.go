@@ -45,6 +45,54 @@ func (s *CompactionTaskSuite) TestProcessRefreshPlan_NormalMix() {
 	s.ElementsMatch([]int64{200, 201}, segIDs)
 }
 
+func (s *CompactionTaskSuite) TestProcessRefreshPlan_RepartitionWithL0() {
+	channel := "Ch-1"
+	binLogs := []*datapb.FieldBinlog{getFieldBinlogIDs(101, 3)}
+	deltaLogs := []*datapb.FieldBinlog{getFieldBinlogIDs(0, 4)}
+	s.mockMeta.EXPECT().GetHealthySegment(mock.Anything).RunAndReturn(func(segID int64) *SegmentInfo {
+		return &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{
+			ID:            segID,
+			Level:         datapb.SegmentLevel_L1,
+			InsertChannel: channel,
+			State:         commonpb.SegmentState_Flushed,
+			Binlogs:       binLogs,
+		}}
+	}).Times(2)
+	s.mockMeta.EXPECT().SelectSegments(mock.Anything, mock.Anything).Return([]*SegmentInfo{
+		{SegmentInfo: &datapb.SegmentInfo{
+			ID:            300,
+			Level:         datapb.SegmentLevel_L0,
+			InsertChannel: channel,
+			State:         commonpb.SegmentState_Flushed,
+			Deltalogs:     deltaLogs,
+		}},
+	}).Once()
+	task := &mixCompactionTask{
+		CompactionTask: &datapb.CompactionTask{
+			PlanID:             1,
+			TriggerID:          19530,
+			CollectionID:       1,
+			PartitionID:        10,
+			Channel:            channel,
+			Type:               datapb.CompactionType_RepartitionCompaction,
+			NodeID:             1,
+			State:              datapb.CompactionTaskState_executing,
+			InputSegments:      []int64{200, 201},
+			TargetPartitionIDs: []int64{10, 11},
+		},
+		meta: s.mockMeta,
+	}
+	plan, err := task.BuildCompactionRequest()
+	s.Require().NoError(err)
+
+	s.Equal(3, len(plan.GetSegmentBinlogs()))
+	levelZero := plan.GetSegmentBinlogs()[2]
+	s.EqualValues(300, levelZero.GetSegmentID())
+	s.Equal(datapb.SegmentLevel_L0, levelZero.GetLevel())
+	s.Equal(deltaLogs, levelZero.GetDeltalogs())
+	s.Empty(levelZero.GetFieldBinlogs())
+}
+
 func (s *CompactionTaskSuite) TestProcessRefreshPlan_MixSegmentNotFound() {
 	channel := "Ch-1"
 	s.Run("segment_not_found", func() {
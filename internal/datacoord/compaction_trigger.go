@@ -31,6 +31,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/indexparamcheck"
 	"github.com/milvus-io/milvus/pkg/util/lifetime"
@@ -45,6 +46,17 @@ type compactTime struct {
 	startTime     Timestamp
 	expireTime    Timestamp
 	collectionTTL time.Duration
+	// the deletes after retainTime are kept by compaction for time travel, zero if history retention is disabled
+	retainTime       Timestamp
+	historyRetention time.Duration
+	// the nullable or defaulted fields which may be added after the segments were written,
+	// the segments lacking binlogs of them are compacted to backfill the binlogs
+	backfillFields []int64
+	// the number of segments allowed to compact only for backfill, which is shared by the groups of a collection
+	// in one global compaction round, so the collection is backfilled at a limited pace instead of rewritten at once
+	backfillQuota int
+	// the fields dropped from the schema, the segments holding binlogs of them are compacted to remove the binlogs
+	droppedFields []int64
 }
 
 // todo: migrate to compaction_trigger_v2
@@ -206,22 +218,39 @@ func (t *compactionTrigger) isChannelCheckpointHealthy(vchanName string) bool {
 	return time.Since(cpTime) < paramtable.Get().DataCoordCfg.ChannelCheckpointMaxLag.GetAsDuration(time.Second)
 }
 
-func getCompactTime(ts Timestamp, coll *collectionInfo) (*compactTime, error) {
-	collectionTTL, err := getCollectionTTL(coll.Properties)
+func getCompactTime(ts Timestamp, coll *collectionInfo, partitionID int64) (*compactTime, error) {
+	collectionTTL, err := getPartitionTTL(coll, partitionID)
+	if err != nil {
+		return nil, err
+	}
+
+	historyRetention, err := common.CollectionHistoryRetention(coll.Properties)
 	if err != nil {
 		return nil, err
 	}
 
 	pts, _ := tsoutil.ParseTS(ts)
+	ct := &compactTime{startTime: ts}
 
 	if collectionTTL > 0 {
 		ttexpired := pts.Add(-collectionTTL)
-		ttexpiredLogic := tsoutil.ComposeTS(ttexpired.UnixNano()/int64(time.Millisecond), 0)
-		return &compactTime{ts, ttexpiredLogic, collectionTTL}, nil
+		ct.expireTime = tsoutil.ComposeTS(ttexpired.UnixNano()/int64(time.Millisecond), 0)
+		ct.collectionTTL = collectionTTL
 	}
-
-	// no expiration time
-	return &compactTime{ts, 0, 0}, nil
+	if historyRetention > 0 {
+		ct.retainTime = tsoutil.ComposeTSByTime(pts.Add(-historyRetention), 0)
+		ct.historyRetention = historyRetention
+	}
+	for _, field := range coll.Schema.GetFields() {
+		if field.GetNullable() || field.GetDefaultValue() != nil {
+			ct.backfillFields = append(ct.backfillFields, field.GetFieldID())
+		}
+	}
+	ct.droppedFields, err = common.CollectionDroppedFields(coll.Properties)
+	if err != nil {
+		return nil, err
+	}
+	return ct, nil
 }
 
 // triggerCompaction trigger a compaction if any compaction condition satisfy.
@@ -345,6 +374,7 @@ func (t *compactionTrigger) handleGlobalSignal(signal *compactionSignal) error {
 			isSegmentHealthy(segment) &&
 			isFlush(segment) &&
 			!segment.isCompacting && // not compacting now
+			!segment.isBroken && // not marked broken by fsck
 			!segment.GetIsImporting() && // not importing now
 			segment.GetLevel() != datapb.SegmentLevel_L0 && // ignore level zero segments
 			segment.GetLevel() != datapb.SegmentLevel_L2 // ignore l2 segment
@@ -364,6 +394,7 @@ func (t *compactionTrigger) handleGlobalSignal(signal *compactionSignal) error {
 		return t.isChannelCheckpointHealthy(channelName)
 	}
 
+	backfillQuotas := make(map[int64]int)
 	for _, group := range partSegments {
 		log := log.With(zap.Int64("collectionID", group.collectionID),
 			zap.Int64("partitionID", group.partitionID),
@@ -392,13 +423,19 @@ func (t *compactionTrigger) handleGlobalSignal(signal *compactionSignal) error {
 			return nil
 		}
 
-		ct, err := getCompactTime(tsoutil.ComposeTSByTime(time.Now(), 0), coll)
+		ct, err := getCompactTime(tsoutil.ComposeTSByTime(time.Now(), 0), coll, group.partitionID)
 		if err != nil {
 			log.Warn("get compact time failed, skip to handle compaction")
 			return err
 		}
+		quota, ok := backfillQuotas[group.collectionID]
+		if !ok {
+			quota = Params.DataCoordCfg.BackfillCompactionMaxSegments.GetAsInt()
+		}
+		ct.backfillQuota = quota
 
 		plans := t.generatePlans(group.segments, signal, ct)
+		backfillQuotas[group.collectionID] = ct.backfillQuota
 		currentID, _, err := t.allocator.allocN(int64(len(plans)))
 		if err != nil {
 			return err
@@ -422,6 +459,7 @@ func (t *compactionTrigger) handleGlobalSignal(signal *compactionSignal) error {
 				TimeoutInSeconds: Params.DataCoordCfg.CompactionTimeoutInSeconds.GetAsInt32(),
 				Type:             datapb.CompactionType_MixCompaction,
 				CollectionTtl:    ct.collectionTTL.Nanoseconds(),
+				HistoryRetention: ct.historyRetention.Nanoseconds(),
 				CollectionID:     group.collectionID,
 				PartitionID:      group.partitionID,
 				Channel:          group.channelName,
@@ -495,7 +533,8 @@ func (t *compactionTrigger) handleSignal(signal *compactionSignal) {
 		return
 	}
 	ts := tsoutil.ComposeTSByTime(time.Now(), 0)
-	ct, err := getCompactTime(ts, coll)
+	// the backfill quota is left zero, the added fields are backfilled by the global compaction only
+	ct, err := getCompactTime(ts, coll, partitionID)
 	if err != nil {
 		log.Warn("get compact time failed, skip to handle compaction", zap.Int64("collectionID", segment.GetCollectionID()),
 			zap.Int64("partitionID", partitionID), zap.String("channel", channel))
@@ -527,6 +566,7 @@ func (t *compactionTrigger) handleSignal(signal *compactionSignal) {
 			TimeoutInSeconds: Params.DataCoordCfg.CompactionTimeoutInSeconds.GetAsInt32(),
 			Type:             datapb.CompactionType_MixCompaction,
 			CollectionTtl:    ct.collectionTTL.Nanoseconds(),
+			HistoryRetention: ct.historyRetention.Nanoseconds(),
 			CollectionID:     collectionID,
 			PartitionID:      partitionID,
 			Channel:          channel,
@@ -741,6 +781,7 @@ func (t *compactionTrigger) getCandidateSegments(channel string, partitionID Uni
 			s.GetInsertChannel() != channel ||
 			s.GetPartitionID() != partitionID ||
 			s.isCompacting ||
+			s.isBroken ||
 			s.GetIsImporting() ||
 			s.GetLevel() == datapb.SegmentLevel_L0 ||
 			s.GetLevel() == datapb.SegmentLevel_L2 {
@@ -812,6 +853,10 @@ func (t *compactionTrigger) ShouldDoSingleCompaction(segment *SegmentInfo, compa
 	totalDeleteLogSize := int64(0)
 	for _, deltaLogs := range segment.GetDeltalogs() {
 		for _, l := range deltaLogs.GetBinlogs() {
+			// the deletes within history retention can't be applied by compaction yet
+			if compactTime.retainTime > 0 && l.GetTimestampTo() >= compactTime.retainTime {
+				continue
+			}
 			totalDeletedRows += int(l.GetEntriesNum())
 			totalDeleteLogSize += l.GetMemorySize()
 		}
@@ -827,6 +872,21 @@ func (t *compactionTrigger) ShouldDoSingleCompaction(segment *SegmentInfo, compa
 		return true
 	}
 
+	if missing := missingBackfillFields(segment, compactTime.backfillFields); len(missing) > 0 && compactTime.backfillQuota > 0 {
+		compactTime.backfillQuota--
+		log.Info("segment lacks binlogs of added fields, trigger compaction",
+			zap.Int64("segmentID", segment.ID),
+			zap.Int64s("fieldIDs", missing))
+		return true
+	}
+
+	if dropped := droppedFieldsWithBinlogs(segment, compactTime.droppedFields); len(dropped) > 0 {
+		log.Info("segment holds binlogs of dropped fields, trigger compaction",
+			zap.Int64("segmentID", segment.ID),
+			zap.Int64s("fieldIDs", dropped))
+		return true
+	}
+
 	if Params.DataCoordCfg.AutoUpgradeSegmentIndex.GetAsBool() {
 		// index version of segment lower than current version and IndexFileKeys should have value, trigger compaction
 		indexIDToSegIdxes := t.meta.indexMeta.GetSegmentIndexes(segment.CollectionID, segment.ID)
@@ -847,6 +907,36 @@ func (t *compactionTrigger) ShouldDoSingleCompaction(segment *SegmentInfo, compa
 	return false
 }
 
+// missingBackfillFields returns the fields which have no binlogs in the segment,
+// the empty segments are skipped since there is nothing to backfill.
+func missingBackfillFields(segment *SegmentInfo, fieldIDs []int64) []int64 {
+	if len(fieldIDs) == 0 || segment.GetNumOfRows() == 0 {
+		return nil
+	}
+	written := typeutil.NewSet[int64]()
+	for _, binlog := range segment.GetBinlogs() {
+		if len(binlog.GetBinlogs()) > 0 {
+			written.Insert(binlog.GetFieldID())
+		}
+	}
+	if written.Len() == 0 {
+		return nil
+	}
+	return lo.Filter(fieldIDs, func(fieldID int64, _ int) bool {
+		return !written.Contain(fieldID)
+	})
+}
+
+// droppedFieldsWithBinlogs returns the dropped fields which still have binlogs in the segment.
+func droppedFieldsWithBinlogs(segment *SegmentInfo, fieldIDs []int64) []int64 {
+	if len(fieldIDs) == 0 {
+		return nil
+	}
+	return lo.FilterMap(segment.GetBinlogs(), func(binlog *datapb.FieldBinlog, _ int) (int64, bool) {
+		return binlog.GetFieldID(), len(binlog.GetBinlogs()) > 0 && lo.Contains(fieldIDs, binlog.GetFieldID())
+	})
+}
+
 func isFlush(segment *SegmentInfo) bool {
 	return segment.GetState() == commonpb.SegmentState_Flushed || segment.GetState() == commonpb.SegmentState_Flushing
 }
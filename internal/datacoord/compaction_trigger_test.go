@@ -1976,9 +1976,103 @@ func Test_compactionTrigger_getCompactTime(t *testing.T) {
 		},
 	}
 	now := tsoutil.GetCurrentTime()
-	ct, err := getCompactTime(now, coll)
+	ct, err := getCompactTime(now, coll, 1)
 	assert.NoError(t, err)
 	assert.NotNil(t, ct)
+	assert.EqualValues(t, 0, ct.retainTime)
+
+	coll.Properties[common.CollectionHistoryRetentionKey] = "3600"
+	ct, err = getCompactTime(now, coll, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Hour, ct.historyRetention)
+	pnow, _ := tsoutil.ParseTS(now)
+	assert.Equal(t, tsoutil.ComposeTSByTime(pnow.Add(-time.Hour), 0), ct.retainTime)
+
+	coll.Properties[common.CollectionHistoryRetentionKey] = "invalid"
+	_, err = getCompactTime(now, coll, 1)
+	assert.Error(t, err)
+}
+
+func Test_compactionTrigger_getPartitionCompactTime(t *testing.T) {
+	coll := &collectionInfo{
+		ID:             1,
+		Schema:         newTestSchema(),
+		Partitions:     []UniqueID{1, 2},
+		PartitionNames: getPartitionNames([]int64{1, 2}, []string{"p1", "p2"}),
+		Properties: map[string]string{
+			common.CollectionTTLConfigKey:                                   "10",
+			common.PartitionPropertyKey("p1", common.PartitionTTLConfigKey): "3600",
+		},
+	}
+	ct, err := getCompactTime(tsoutil.GetCurrentTime(), coll, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Hour, ct.collectionTTL)
+
+	// the partitions without ttl follow the collection ttl
+	ct, err = getCompactTime(tsoutil.GetCurrentTime(), coll, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 10*time.Second, ct.collectionTTL)
+
+	coll.Properties[common.PartitionPropertyKey("p1", common.PartitionTTLConfigKey)] = "invalid"
+	_, err = getCompactTime(tsoutil.GetCurrentTime(), coll, 1)
+	assert.Error(t, err)
+
+	assert.Nil(t, getPartitionNames([]int64{1, 2}, []string{"p1"}))
+}
+
+func Test_compactionTrigger_backfillFields(t *testing.T) {
+	coll := &collectionInfo{
+		ID: 1,
+		Schema: &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				{FieldID: 101, DataType: schemapb.DataType_Int32, Nullable: true},
+			},
+		},
+		Properties: map[string]string{},
+	}
+	ct, err := getCompactTime(tsoutil.ComposeTSByTime(time.Now(), 0), coll, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{101}, ct.backfillFields)
+
+	segment := &SegmentInfo{
+		SegmentInfo: &datapb.SegmentInfo{
+			ID:        1,
+			NumOfRows: 100,
+			State:     commonpb.SegmentState_Flushed,
+			Binlogs: []*datapb.FieldBinlog{
+				{FieldID: 100, Binlogs: []*datapb.Binlog{{EntriesNum: 100}}},
+			},
+		},
+	}
+	assert.Equal(t, []int64{101}, missingBackfillFields(segment, ct.backfillFields))
+	trigger := &compactionTrigger{}
+	// no backfill without quota
+	assert.False(t, trigger.ShouldDoSingleCompaction(segment, ct))
+	ct.backfillQuota = 1
+	assert.True(t, trigger.ShouldDoSingleCompaction(segment, ct))
+	assert.Equal(t, 0, ct.backfillQuota)
+	assert.False(t, trigger.ShouldDoSingleCompaction(segment, ct))
+
+	segment.Binlogs = append(segment.Binlogs, &datapb.FieldBinlog{FieldID: 101, Binlogs: []*datapb.Binlog{{EntriesNum: 100}}})
+	assert.Empty(t, missingBackfillFields(segment, ct.backfillFields))
+	assert.Empty(t, missingBackfillFields(&SegmentInfo{SegmentInfo: &datapb.SegmentInfo{}}, ct.backfillFields))
+
+	assert.False(t, trigger.ShouldDoSingleCompaction(segment, ct))
+
+	// binlogs of the dropped field
+	coll.Properties[common.CollectionDroppedFieldsKey] = "102"
+	ct, err = getCompactTime(tsoutil.ComposeTSByTime(time.Now(), 0), coll, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{102}, ct.droppedFields)
+	assert.Empty(t, droppedFieldsWithBinlogs(segment, ct.droppedFields))
+	segment.Binlogs = append(segment.Binlogs, &datapb.FieldBinlog{FieldID: 102, Binlogs: []*datapb.Binlog{{EntriesNum: 100}}})
+	assert.Equal(t, []int64{102}, droppedFieldsWithBinlogs(segment, ct.droppedFields))
+	assert.True(t, trigger.ShouldDoSingleCompaction(segment, ct))
+
+	coll.Properties[common.CollectionDroppedFieldsKey] = "invalid"
+	_, err = getCompactTime(tsoutil.ComposeTSByTime(time.Now(), 0), coll, 1)
+	assert.Error(t, err)
 }
 
 func Test_triggerSingleCompaction(t *testing.T) {
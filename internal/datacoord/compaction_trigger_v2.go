@@ -24,10 +24,12 @@ import (
 	"github.com/samber/lo"
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus/internal/datacoord/broker"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/lock"
 	"github.com/milvus-io/milvus/pkg/util/logutil"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
 )
 
 type CompactionTriggerType int8
@@ -37,6 +39,7 @@ const (
 	TriggerTypeLevelZeroViewIDLE
 	TriggerTypeSegmentSizeViewChange
 	TriggerTypeClustering
+	TriggerTypeRepartition
 )
 
 type TriggerManager interface {
@@ -66,15 +69,16 @@ type CompactionTriggerManager struct {
 	// todo handle this lock
 	viewGuard lock.RWMutex
 
-	meta             *meta
-	l0Policy         *l0CompactionPolicy
-	clusteringPolicy *clusteringCompactionPolicy
+	meta              *meta
+	l0Policy          *l0CompactionPolicy
+	clusteringPolicy  *clusteringCompactionPolicy
+	repartitionPolicy *repartitionCompactionPolicy
 
 	closeSig chan struct{}
 	closeWg  sync.WaitGroup
 }
 
-func NewCompactionTriggerManager(alloc allocator, handler Handler, compactionHandler compactionPlanContext, meta *meta) *CompactionTriggerManager {
+func NewCompactionTriggerManager(alloc allocator, handler Handler, compactionHandler compactionPlanContext, meta *meta, broker broker.Broker) *CompactionTriggerManager {
 	m := &CompactionTriggerManager{
 		allocator:         alloc,
 		handler:           handler,
@@ -87,6 +91,7 @@ func NewCompactionTriggerManager(alloc allocator, handler Handler, compactionHan
 	}
 	m.l0Policy = newL0CompactionPolicy(meta)
 	m.clusteringPolicy = newClusteringCompactionPolicy(meta, m.view, m.allocator, m.compactionHandler, m.handler)
+	m.repartitionPolicy = newRepartitionCompactionPolicy(meta, m.handler, broker)
 	return m
 }
 
@@ -108,6 +113,8 @@ func (m *CompactionTriggerManager) startLoop() {
 	defer l0Ticker.Stop()
 	clusteringTicker := time.NewTicker(Params.DataCoordCfg.ClusteringCompactionTriggerInterval.GetAsDuration(time.Second))
 	defer clusteringTicker.Stop()
+	repartitionTicker := time.NewTicker(Params.DataCoordCfg.GlobalCompactionInterval.GetAsDuration(time.Second))
+	defer repartitionTicker.Stop()
 	log.Info("Compaction trigger manager start")
 	for {
 		select {
@@ -152,6 +159,25 @@ func (m *CompactionTriggerManager) startLoop() {
 					m.notify(ctx, triggerType, views)
 				}
 			}
+		case <-repartitionTicker.C:
+			if !m.repartitionPolicy.Enable() {
+				continue
+			}
+			if m.compactionHandler.isFull() {
+				log.RatedInfo(10, "Skip trigger repartition compaction since compactionHandler is full")
+				continue
+			}
+			events, err := m.repartitionPolicy.Trigger()
+			if err != nil {
+				log.Warn("Fail to trigger repartition policy", zap.Error(err))
+				continue
+			}
+			ctx := context.Background()
+			if len(events) > 0 {
+				for triggerType, views := range events {
+					m.notify(ctx, triggerType, views)
+				}
+			}
 		}
 	}
 }
@@ -207,6 +233,15 @@ func (m *CompactionTriggerManager) notify(ctx context.Context, eventType Compact
 					zap.String("output view", outView.String()))
 				m.SubmitClusteringViewToScheduler(ctx, outView)
 			}
+		case TriggerTypeRepartition:
+			log.Debug("Start to trigger a repartition compaction by TriggerTypeRepartition")
+			outView, reason := view.Trigger()
+			if outView != nil {
+				log.Info("Success to trigger a RepartitionCompaction output view, try to submit",
+					zap.String("reason", reason),
+					zap.String("output view", outView.String()))
+				m.SubmitRepartitionViewToScheduler(ctx, outView)
+			}
 		}
 	}
 }
@@ -307,6 +342,60 @@ func (m *CompactionTriggerManager) SubmitClusteringViewToScheduler(ctx context.C
 	)
 }
 
+func (m *CompactionTriggerManager) SubmitRepartitionViewToScheduler(ctx context.Context, view CompactionView) {
+	taskID, err := m.allocator.allocID(ctx)
+	if err != nil {
+		log.Warn("Failed to submit compaction view to scheduler because allocate id fail", zap.String("view", view.String()))
+		return
+	}
+	collection, err := m.handler.GetCollection(ctx, view.GetGroupLabel().CollectionID)
+	if err != nil {
+		log.Warn("Failed to submit compaction view to scheduler because get collection fail", zap.String("view", view.String()))
+		return
+	}
+	ct, err := getCompactTime(tsoutil.ComposeTSByTime(time.Now(), 0), collection, view.GetGroupLabel().PartitionID)
+	if err != nil {
+		log.Warn("Failed to submit compaction view to scheduler because get compact time fail", zap.String("view", view.String()), zap.Error(err))
+		return
+	}
+	var totalRows int64
+	for _, segView := range view.GetSegmentsView() {
+		totalRows += segView.NumOfRows
+	}
+	task := &datapb.CompactionTask{
+		PlanID:             taskID,
+		TriggerID:          taskID, // inner trigger, use task id as trigger id
+		State:              datapb.CompactionTaskState_pipelining,
+		StartTime:          time.Now().Unix(),
+		TimeoutInSeconds:   Params.DataCoordCfg.CompactionTimeoutInSeconds.GetAsInt32(),
+		Type:               datapb.CompactionType_RepartitionCompaction,
+		CollectionTtl:      ct.collectionTTL.Nanoseconds(),
+		HistoryRetention:   ct.historyRetention.Nanoseconds(),
+		CollectionID:       view.GetGroupLabel().CollectionID,
+		PartitionID:        view.GetGroupLabel().PartitionID,
+		Channel:            view.GetGroupLabel().Channel,
+		InputSegments:      lo.Map(view.GetSegmentsView(), func(segView *SegmentView, _ int) int64 { return segView.ID }),
+		TotalRows:          totalRows,
+		Schema:             collection.Schema,
+		TargetPartitionIDs: view.(*RepartitionSegmentsView).targetPartitionIDs,
+	}
+	err = m.compactionHandler.enqueueCompaction(task)
+	if err != nil {
+		log.Warn("Failed to execute compaction task",
+			zap.Int64("collection", task.CollectionID),
+			zap.Int64("planID", task.GetPlanID()),
+			zap.Int64s("segmentIDs", task.GetInputSegments()),
+			zap.Error(err))
+		return
+	}
+	log.Info("Finish to submit a repartition compaction task",
+		zap.Int64("taskID", taskID),
+		zap.Int64("planID", task.GetPlanID()),
+		zap.String("type", task.GetType().String()),
+		zap.Int64s("targetPartitions", task.GetTargetPartitionIDs()),
+	)
+}
+
 // chanPartSegments is an internal result struct, which is aggregates of SegmentInfos with same collectionID, partitionID and channelName
 type chanPartSegments struct {
 	collectionID UniqueID
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus/internal/datacoord/broker"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/log"
 )
@@ -23,6 +24,7 @@ type CompactionTriggerManagerSuite struct {
 	mockAlloc       *NMockAllocator
 	handler         Handler
 	mockPlanContext *MockCompactionPlanContext
+	mockBroker      *broker.MockBroker
 	testLabel       *CompactionGroupLabel
 	meta            *meta
 
@@ -33,6 +35,7 @@ func (s *CompactionTriggerManagerSuite) SetupTest() {
 	s.mockAlloc = NewNMockAllocator(s.T())
 	s.handler = NewNMockHandler(s.T())
 	s.mockPlanContext = NewMockCompactionPlanContext(s.T())
+	s.mockBroker = broker.NewMockBroker(s.T())
 
 	s.testLabel = &CompactionGroupLabel{
 		CollectionID: 1,
@@ -45,7 +48,7 @@ func (s *CompactionTriggerManagerSuite) SetupTest() {
 		s.meta.segments.SetSegment(id, segment)
 	}
 
-	s.triggerManager = NewCompactionTriggerManager(s.mockAlloc, s.handler, s.mockPlanContext, s.meta)
+	s.triggerManager = NewCompactionTriggerManager(s.mockAlloc, s.handler, s.mockPlanContext, s.meta, s.mockBroker)
 }
 
 func (s *CompactionTriggerManagerSuite) TestNotifyByViewIDLE() {
@@ -78,6 +78,49 @@ type gcCmd struct {
 	done     chan struct{}
 }
 
+// gcPass is a single round of garbage collection. The periodic rounds cover all collections,
+// while a manual round could be restricted to one collection, or only report the garbage in dry-run mode.
+type gcPass struct {
+	collectionID int64 // all collections if zero
+	dryRun       bool
+
+	mu         sync.Mutex
+	candidates []*datapb.GarbageCandidate
+}
+
+func newGcPass(collectionID int64, dryRun bool) *gcPass {
+	return &gcPass{
+		collectionID: collectionID,
+		dryRun:       dryRun,
+	}
+}
+
+// inScope returns whether the collection is covered by the pass.
+func (p *gcPass) inScope(collectionID int64) bool {
+	return p.collectionID == 0 || p.collectionID == collectionID
+}
+
+// scopePrefix narrows the object storage prefix down to the collection of the pass.
+func (p *gcPass) scopePrefix(prefix string) string {
+	if p.collectionID == 0 {
+		return prefix
+	}
+	return path.Join(prefix, fmt.Sprint(p.collectionID)) + "/"
+}
+
+// report records the garbage which would be recycled by a dry-run pass.
+func (p *gcPass) report(candidate *datapb.GarbageCandidate) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.candidates = append(p.candidates, candidate)
+}
+
+func (p *gcPass) getCandidates() []*datapb.GarbageCandidate {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.candidates
+}
+
 // newGarbageCollector create garbage collector with meta and option
 func newGarbageCollector(meta *meta, handler Handler, opt GcOption) *garbageCollector {
 	log.Info("GC with option",
@@ -156,18 +199,20 @@ func (gc *garbageCollector) work(ctx context.Context) {
 	go func() {
 		defer gc.wg.Done()
 		gc.runRecycleTaskWithPauser(ctx, "meta", gc.option.checkInterval, func(ctx context.Context) {
-			gc.recycleDroppedSegments(ctx)
-			gc.recycleChannelCPMeta(ctx)
-			gc.recycleUnusedIndexes(ctx)
-			gc.recycleUnusedSegIndexes(ctx)
-			gc.recycleUnusedAnalyzeFiles()
+			pass := newGcPass(0, false)
+			gc.recycleDroppedSegments(ctx, pass)
+			gc.recycleChannelCPMeta(ctx, pass)
+			gc.recycleUnusedIndexes(ctx, pass)
+			gc.recycleUnusedSegIndexes(ctx, pass)
+			gc.recycleUnusedAnalyzeFiles(ctx, pass)
 		})
 	}()
 	go func() {
 		defer gc.wg.Done()
 		gc.runRecycleTaskWithPauser(ctx, "orphan", gc.option.scanInterval, func(ctx context.Context) {
-			gc.recycleUnusedBinlogFiles(ctx)
-			gc.recycleUnusedIndexFiles(ctx)
+			pass := newGcPass(0, false)
+			gc.recycleUnusedBinlogFiles(ctx, pass)
+			gc.recycleUnusedIndexFiles(ctx, pass)
 		})
 	}()
 	go func() {
@@ -176,6 +221,35 @@ func (gc *garbageCollector) work(ctx context.Context) {
 	}()
 }
 
+// RunOnce runs a manual garbage collection pass synchronously, the pass is not affected by Pause.
+// The pass covers all collections if collectionID is zero, and in dry-run mode nothing is removed,
+// the garbage which would be recycled is returned instead.
+func (gc *garbageCollector) RunOnce(ctx context.Context, collectionID int64, dryRun bool) ([]*datapb.GarbageCandidate, error) {
+	if !gc.option.enabled || gc.option.cli == nil {
+		log.Warn("garbage collection not enabled, cannot run")
+		return nil, merr.WrapErrServiceUnavailable("garbage collection not enabled")
+	}
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", collectionID), zap.Bool("dryRun", dryRun))
+	log.Info("manual garbage collection start...")
+	start := time.Now()
+
+	pass := newGcPass(collectionID, dryRun)
+	gc.recycleDroppedSegments(ctx, pass)
+	gc.recycleChannelCPMeta(ctx, pass)
+	gc.recycleUnusedIndexes(ctx, pass)
+	gc.recycleUnusedSegIndexes(ctx, pass)
+	gc.recycleUnusedAnalyzeFiles(ctx, pass)
+	gc.recycleUnusedBinlogFiles(ctx, pass)
+	gc.recycleUnusedIndexFiles(ctx, pass)
+	if err := ctx.Err(); err != nil {
+		log.Warn("manual garbage collection canceled", zap.Error(err))
+		return nil, err
+	}
+	log.Info("manual garbage collection done", zap.Int("candidates", len(pass.getCandidates())),
+		zap.Duration("timeCost", time.Since(start)))
+	return pass.getCandidates(), nil
+}
+
 // startControlLoop start a control loop for garbageCollector.
 func (gc *garbageCollector) startControlLoop(_ context.Context) {
 	for {
@@ -236,7 +310,7 @@ func (gc *garbageCollector) close() {
 
 // recycleUnusedBinlogFiles load meta file info and compares OSS keys
 // if missing found, performs gc cleanup
-func (gc *garbageCollector) recycleUnusedBinlogFiles(ctx context.Context) {
+func (gc *garbageCollector) recycleUnusedBinlogFiles(ctx context.Context, pass *gcPass) {
 	start := time.Now()
 	log := log.With(zap.String("gcName", "recycleUnusedBinlogFiles"), zap.Time("startAt", start))
 	log.Info("start recycleUnusedBinlogFiles...")
@@ -282,14 +356,14 @@ func (gc *garbageCollector) recycleUnusedBinlogFiles(ctx context.Context) {
 	}
 
 	for _, task := range scanTasks {
-		gc.recycleUnusedBinLogWithChecker(ctx, task.prefix, task.label, task.checker)
+		gc.recycleUnusedBinLogWithChecker(ctx, pass, pass.scopePrefix(task.prefix), task.label, task.checker)
 	}
 	metrics.GarbageCollectorRunCount.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Add(1)
 }
 
 // recycleUnusedBinLogWithChecker scans the prefix and checks the path with checker.
 // GC the file if checker returns false.
-func (gc *garbageCollector) recycleUnusedBinLogWithChecker(ctx context.Context, prefix string, label string, checker func(objectInfo *storage.ChunkObjectInfo, segment *SegmentInfo) bool) {
+func (gc *garbageCollector) recycleUnusedBinLogWithChecker(ctx context.Context, pass *gcPass, prefix string, label string, checker func(objectInfo *storage.ChunkObjectInfo, segment *SegmentInfo) bool) {
 	logger := log.With(zap.String("prefix", prefix))
 	logger.Info("garbageCollector recycleUnusedBinlogFiles start", zap.String("prefix", prefix))
 	lastFilePath := ""
@@ -321,6 +395,13 @@ func (gc *garbageCollector) recycleUnusedBinLogWithChecker(ctx context.Context,
 			return true
 		}
 
+		// the files of snapshot are kept even if they're not referenced by the segment meta anymore
+		if gc.isSegmentPinned(segmentID) {
+			valid++
+			logger.Info("garbageCollector recycleUnusedBinlogFiles skip file since the segment is pinned by snapshot", zap.String("filePath", chunkInfo.FilePath), zap.Int64("segmentID", segmentID))
+			return true
+		}
+
 		segment := gc.meta.GetSegment(segmentID)
 		if checker(chunkInfo, segment) {
 			valid++
@@ -328,6 +409,22 @@ func (gc *garbageCollector) recycleUnusedBinLogWithChecker(ctx context.Context,
 			return true
 		}
 
+		if pass.dryRun {
+			candidate := &datapb.GarbageCandidate{
+				Type:         label,
+				CollectionID: pass.collectionID,
+				SegmentID:    segmentID,
+				Path:         chunkInfo.FilePath,
+				Reason:       "segment not found in meta",
+			}
+			if segment != nil {
+				candidate.CollectionID = segment.GetCollectionID()
+				candidate.Reason = "log not referenced by segment meta"
+			}
+			pass.report(candidate)
+			return true
+		}
+
 		// ignore error since it could be cleaned up next time
 		file := chunkInfo.FilePath
 		future := gc.option.removeObjectPool.Submit(func() (struct{}, error) {
@@ -406,8 +503,18 @@ func (gc *garbageCollector) checkDroppedSegmentGC(segment *SegmentInfo,
 	return true
 }
 
+// isSegmentPinned returns whether the segment is referenced by any snapshot.
+func (gc *garbageCollector) isSegmentPinned(segmentID int64) bool {
+	return gc.meta.snapshotMeta != nil && gc.meta.snapshotMeta.IsSegmentPinned(segmentID)
+}
+
+// isBuildPinned returns whether the index files of the build are referenced by any snapshot.
+func (gc *garbageCollector) isBuildPinned(buildID int64) bool {
+	return gc.meta.snapshotMeta != nil && gc.meta.snapshotMeta.IsBuildPinned(buildID)
+}
+
 // recycleDroppedSegments scans all segments and remove those dropped segments from meta and oss.
-func (gc *garbageCollector) recycleDroppedSegments(ctx context.Context) {
+func (gc *garbageCollector) recycleDroppedSegments(ctx context.Context, pass *gcPass) {
 	start := time.Now()
 	log := log.With(zap.String("gcName", "recycleDroppedSegments"), zap.Time("startAt", start))
 	log.Info("start clear dropped segments...")
@@ -418,6 +525,9 @@ func (gc *garbageCollector) recycleDroppedSegments(ctx context.Context) {
 	compactTo := make(map[int64]*SegmentInfo)
 	channels := typeutil.NewSet[string]()
 	for _, segment := range all {
+		if !pass.inScope(segment.GetCollectionID()) {
+			continue
+		}
 		cloned := segment.Clone()
 		binlog.DecompressBinLogs(cloned.SegmentInfo)
 		if cloned.GetState() == commonpb.SegmentState_Dropped {
@@ -457,11 +567,26 @@ func (gc *garbageCollector) recycleDroppedSegments(ctx context.Context) {
 		}
 
 		log := log.With(zap.Int64("segmentID", segmentID))
+		if gc.isSegmentPinned(segmentID) {
+			log.RatedInfo(60, "segment is pinned by snapshot, skip GC")
+			continue
+		}
 		segInsertChannel := segment.GetInsertChannel()
 		if !gc.checkDroppedSegmentGC(segment, compactTo[segment.GetID()], indexedSet, channelCPs[segInsertChannel]) {
 			continue
 		}
 
+		if pass.dryRun {
+			pass.report(&datapb.GarbageCandidate{
+				Type:         "segment",
+				CollectionID: segment.GetCollectionID(),
+				SegmentID:    segmentID,
+				Size:         segment.getSegmentSize(),
+				Reason:       fmt.Sprintf("segment dropped at %s", time.Unix(0, int64(segment.GetDroppedAt())).Format(time.RFC3339)),
+			})
+			continue
+		}
+
 		logs := getLogs(segment)
 		log.Info("GC segment start...", zap.Int("insert_logs", len(segment.GetBinlogs())),
 			zap.Int("delta_logs", len(segment.GetDeltalogs())),
@@ -479,7 +604,7 @@ func (gc *garbageCollector) recycleDroppedSegments(ctx context.Context) {
 	}
 }
 
-func (gc *garbageCollector) recycleChannelCPMeta(ctx context.Context) {
+func (gc *garbageCollector) recycleChannelCPMeta(ctx context.Context, pass *gcPass) {
 	channelCPs, err := gc.meta.catalog.ListChannelCheckpoint(ctx)
 	if err != nil {
 		log.Warn("list channel cp fail during GC", zap.Error(err))
@@ -499,6 +624,9 @@ func (gc *garbageCollector) recycleChannelCPMeta(ctx context.Context) {
 			log.Warn("parse collection id fail, skip to gc channel cp", zap.String("vchannel", vChannel))
 			continue
 		}
+		if !pass.inScope(collectionID) {
+			continue
+		}
 
 		if _, ok := collectionID2GcStatus[collectionID]; !ok {
 			collectionID2GcStatus[collectionID] = gc.meta.catalog.GcConfirm(ctx, collectionID, -1)
@@ -510,6 +638,15 @@ func (gc *garbageCollector) recycleChannelCPMeta(ctx context.Context) {
 			continue
 		}
 
+		if pass.dryRun {
+			pass.report(&datapb.GarbageCandidate{
+				Type:         "channel_checkpoint",
+				CollectionID: collectionID,
+				Path:         vChannel,
+				Reason:       "all segments of the collection are recycled",
+			})
+			continue
+		}
 		if err := gc.meta.DropChannelCheckpoint(vChannel); err != nil {
 			// Try to GC in the next gc cycle if drop channel cp meta fail.
 			log.Warn("failed to drop channel check point during gc", zap.String("vchannel", vChannel), zap.Error(err))
@@ -568,7 +705,7 @@ func (gc *garbageCollector) removeObjectFiles(ctx context.Context, filePaths map
 }
 
 // recycleUnusedIndexes is used to delete those indexes that is deleted by collection.
-func (gc *garbageCollector) recycleUnusedIndexes(ctx context.Context) {
+func (gc *garbageCollector) recycleUnusedIndexes(ctx context.Context, pass *gcPass) {
 	start := time.Now()
 	log := log.With(zap.String("gcName", "recycleUnusedIndexes"), zap.Time("startAt", start))
 	log.Info("start recycleUnusedIndexes...")
@@ -581,6 +718,19 @@ func (gc *garbageCollector) recycleUnusedIndexes(ctx context.Context) {
 			return
 		}
 
+		if !pass.inScope(index.CollectionID) {
+			continue
+		}
+		if pass.dryRun {
+			pass.report(&datapb.GarbageCandidate{
+				Type:         "index",
+				CollectionID: index.CollectionID,
+				IndexID:      index.IndexID,
+				Reason:       "index dropped",
+			})
+			continue
+		}
+
 		log := log.With(zap.Int64("collectionID", index.CollectionID), zap.Int64("fieldID", index.FieldID), zap.Int64("indexID", index.IndexID))
 		if err := gc.meta.indexMeta.RemoveIndex(index.CollectionID, index.IndexID); err != nil {
 			log.Warn("remove index on collection fail", zap.Error(err))
@@ -591,7 +741,7 @@ func (gc *garbageCollector) recycleUnusedIndexes(ctx context.Context) {
 }
 
 // recycleUnusedSegIndexes remove the index of segment if index is deleted or segment itself is deleted.
-func (gc *garbageCollector) recycleUnusedSegIndexes(ctx context.Context) {
+func (gc *garbageCollector) recycleUnusedSegIndexes(ctx context.Context, pass *gcPass) {
 	start := time.Now()
 	log := log.With(zap.String("gcName", "recycleUnusedSegIndexes"), zap.Time("startAt", start))
 	log.Info("start recycleUnusedSegIndexes...")
@@ -604,9 +754,31 @@ func (gc *garbageCollector) recycleUnusedSegIndexes(ctx context.Context) {
 			return
 		}
 
+		// the index files of snapshot are kept even if the index is dropped
+		if !pass.inScope(segIdx.CollectionID) || gc.isBuildPinned(segIdx.BuildID) {
+			continue
+		}
+
 		// 1. segment belongs to is deleted.
 		// 2. index is deleted.
-		if gc.meta.GetSegment(segIdx.SegmentID) == nil || !gc.meta.indexMeta.IsIndexExist(segIdx.CollectionID, segIdx.IndexID) {
+		segmentDropped := gc.meta.GetSegment(segIdx.SegmentID) == nil
+		if segmentDropped || !gc.meta.indexMeta.IsIndexExist(segIdx.CollectionID, segIdx.IndexID) {
+			if pass.dryRun {
+				reason := "index dropped"
+				if segmentDropped {
+					reason = "segment recycled"
+				}
+				pass.report(&datapb.GarbageCandidate{
+					Type:         "segment_index",
+					CollectionID: segIdx.CollectionID,
+					SegmentID:    segIdx.SegmentID,
+					IndexID:      segIdx.IndexID,
+					BuildID:      segIdx.BuildID,
+					Size:         int64(segIdx.IndexSize),
+					Reason:       reason,
+				})
+				continue
+			}
 			indexFiles := gc.getAllIndexFilesOfIndex(segIdx)
 			log := log.With(zap.Int64("collectionID", segIdx.CollectionID),
 				zap.Int64("partitionID", segIdx.PartitionID),
@@ -634,7 +806,7 @@ func (gc *garbageCollector) recycleUnusedSegIndexes(ctx context.Context) {
 }
 
 // recycleUnusedIndexFiles is used to delete those index files that no longer exist in the meta.
-func (gc *garbageCollector) recycleUnusedIndexFiles(ctx context.Context) {
+func (gc *garbageCollector) recycleUnusedIndexFiles(ctx context.Context, pass *gcPass) {
 	start := time.Now()
 	log := log.With(zap.String("gcName", "recycleUnusedIndexFiles"), zap.Time("startAt", start))
 	log.Info("start recycleUnusedIndexFiles...")
@@ -653,6 +825,10 @@ func (gc *garbageCollector) recycleUnusedIndexFiles(ctx context.Context) {
 			return true
 		}
 		logger = logger.With(zap.Int64("buildID", buildID))
+		if gc.isBuildPinned(buildID) {
+			logger.Info("garbageCollector skip index files since the build is pinned by snapshot")
+			return true
+		}
 		logger.Info("garbageCollector will recycle index files")
 		canRecycle, segIdx := gc.meta.indexMeta.CheckCleanSegmentIndex(buildID)
 		if !canRecycle {
@@ -662,6 +838,19 @@ func (gc *garbageCollector) recycleUnusedIndexFiles(ctx context.Context) {
 			return true
 		}
 		if segIdx == nil {
+			// the collection of the files is unknown without meta, leave them to the unrestricted passes
+			if pass.collectionID != 0 {
+				return true
+			}
+			if pass.dryRun {
+				pass.report(&datapb.GarbageCandidate{
+					Type:    "index_file",
+					BuildID: buildID,
+					Path:    key,
+					Reason:  "build not found in meta",
+				})
+				return true
+			}
 			// buildID no longer exists in meta, remove all index files
 			logger.Info("garbageCollector recycleUnusedIndexFiles find meta has not exist, remove index files")
 			err = gc.option.cli.RemoveWithPrefix(ctx, key)
@@ -672,6 +861,9 @@ func (gc *garbageCollector) recycleUnusedIndexFiles(ctx context.Context) {
 			logger.Info("garbageCollector recycleUnusedIndexFiles remove index files success")
 			return true
 		}
+		if !pass.inScope(segIdx.CollectionID) {
+			return true
+		}
 		filesMap := gc.getAllIndexFilesOfIndex(segIdx)
 
 		logger.Info("recycle index files", zap.Int("meta files num", len(filesMap)))
@@ -683,6 +875,18 @@ func (gc *garbageCollector) recycleUnusedIndexFiles(ctx context.Context) {
 			fileNum++
 			file := indexFile.FilePath
 			if _, ok := filesMap[file]; !ok {
+				if pass.dryRun {
+					pass.report(&datapb.GarbageCandidate{
+						Type:         "index_file",
+						CollectionID: segIdx.CollectionID,
+						SegmentID:    segIdx.SegmentID,
+						IndexID:      segIdx.IndexID,
+						BuildID:      buildID,
+						Path:         file,
+						Reason:       "file not referenced by segment index meta",
+					})
+					return true
+				}
 				future := gc.option.removeObjectPool.Submit(func() (struct{}, error) {
 					logger := logger.With(zap.String("file", file))
 					logger.Info("garbageCollector recycleUnusedIndexFiles remove file...")
@@ -733,10 +937,8 @@ func (gc *garbageCollector) getAllIndexFilesOfIndex(segmentIndex *model.SegmentI
 }
 
 // recycleUnusedAnalyzeFiles is used to delete those analyze stats files that no longer exist in the meta.
-func (gc *garbageCollector) recycleUnusedAnalyzeFiles() {
+func (gc *garbageCollector) recycleUnusedAnalyzeFiles(ctx context.Context, pass *gcPass) {
 	log.Info("start recycleUnusedAnalyzeFiles")
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
 	startTs := time.Now()
 	prefix := path.Join(gc.option.cli.RootPath(), common.AnalyzeStatsPath) + "/"
 	// list dir first
@@ -766,6 +968,19 @@ func (gc *garbageCollector) recycleUnusedAnalyzeFiles() {
 			continue
 		}
 		if task == nil {
+			// the collection of the files is unknown without meta, leave them to the unrestricted passes
+			if pass.collectionID != 0 {
+				continue
+			}
+			if pass.dryRun {
+				pass.report(&datapb.GarbageCandidate{
+					Type:    "analyze_file",
+					BuildID: taskID,
+					Path:    key,
+					Reason:  "analyze task not found in meta",
+				})
+				continue
+			}
 			// taskID no longer exists in meta, remove all analysis files
 			log.Info("garbageCollector recycleUnusedAnalyzeFiles find meta has not exist, remove index files",
 				zap.Int64("taskID", taskID))
@@ -780,6 +995,22 @@ func (gc *garbageCollector) recycleUnusedAnalyzeFiles() {
 			continue
 		}
 
+		if !pass.inScope(task.CollectionID) {
+			continue
+		}
+		if pass.dryRun {
+			if task.Version > 0 {
+				pass.report(&datapb.GarbageCandidate{
+					Type:         "analyze_file",
+					CollectionID: task.CollectionID,
+					BuildID:      taskID,
+					Path:         key,
+					Reason:       fmt.Sprintf("analyze stats files older than version %d", task.Version),
+				})
+			}
+			continue
+		}
+
 		log.Info("remove analyze stats files which version is less than current task",
 			zap.Int64("taskID", taskID), zap.Int64("current version", task.Version))
 		var i int64
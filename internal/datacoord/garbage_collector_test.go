@@ -49,6 +49,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/lock"
 	"github.com/milvus-io/milvus/pkg/util/merr"
@@ -129,7 +130,7 @@ func Test_garbageCollector_scan(t *testing.T) {
 			missingTolerance: time.Hour * 24,
 			dropTolerance:    time.Hour * 24,
 		})
-		gc.recycleUnusedBinlogFiles(context.TODO())
+		gc.recycleUnusedBinlogFiles(context.TODO(), newGcPass(0, false))
 
 		validateMinioPrefixElements(t, cli, bucketName, path.Join(rootPath, common.SegmentInsertLogPath), inserts)
 		validateMinioPrefixElements(t, cli, bucketName, path.Join(rootPath, common.SegmentStatslogPath), stats)
@@ -147,7 +148,7 @@ func Test_garbageCollector_scan(t *testing.T) {
 			missingTolerance: time.Hour * 24,
 			dropTolerance:    time.Hour * 24,
 		})
-		gc.recycleUnusedBinlogFiles(context.TODO())
+		gc.recycleUnusedBinlogFiles(context.TODO(), newGcPass(0, false))
 
 		validateMinioPrefixElements(t, cli, bucketName, path.Join(rootPath, common.SegmentInsertLogPath), inserts)
 		validateMinioPrefixElements(t, cli, bucketName, path.Join(rootPath, common.SegmentStatslogPath), stats)
@@ -174,7 +175,7 @@ func Test_garbageCollector_scan(t *testing.T) {
 			dropTolerance:    time.Hour * 24,
 		})
 		gc.start()
-		gc.recycleUnusedBinlogFiles(context.TODO())
+		gc.recycleUnusedBinlogFiles(context.TODO(), newGcPass(0, false))
 		validateMinioPrefixElements(t, cli, bucketName, path.Join(rootPath, common.SegmentInsertLogPath), inserts)
 		validateMinioPrefixElements(t, cli, bucketName, path.Join(rootPath, common.SegmentStatslogPath), stats)
 		validateMinioPrefixElements(t, cli, bucketName, path.Join(rootPath, common.SegmentDeltaLogPath), delta)
@@ -202,7 +203,7 @@ func Test_garbageCollector_scan(t *testing.T) {
 			missingTolerance: time.Hour * 24,
 			dropTolerance:    0,
 		})
-		gc.recycleDroppedSegments(context.TODO())
+		gc.recycleDroppedSegments(context.TODO(), newGcPass(0, false))
 		validateMinioPrefixElements(t, cli, bucketName, path.Join(rootPath, common.SegmentInsertLogPath), inserts[1:])
 		validateMinioPrefixElements(t, cli, bucketName, path.Join(rootPath, common.SegmentStatslogPath), stats[1:])
 		validateMinioPrefixElements(t, cli, bucketName, path.Join(rootPath, common.SegmentDeltaLogPath), delta[1:])
@@ -220,8 +221,8 @@ func Test_garbageCollector_scan(t *testing.T) {
 			dropTolerance:    0,
 		})
 		gc.start()
-		gc.recycleUnusedBinlogFiles(context.TODO())
-		gc.recycleDroppedSegments(context.TODO())
+		gc.recycleUnusedBinlogFiles(context.TODO(), newGcPass(0, false))
+		gc.recycleDroppedSegments(context.TODO(), newGcPass(0, false))
 
 		// bad path shall remains since datacoord cannot determine file is garbage or not if path is not valid
 		validateMinioPrefixElements(t, cli, bucketName, path.Join(rootPath, common.SegmentInsertLogPath), inserts[1:2])
@@ -242,7 +243,7 @@ func Test_garbageCollector_scan(t *testing.T) {
 			dropTolerance:    0,
 		})
 		gc.start()
-		gc.recycleUnusedBinlogFiles(context.TODO())
+		gc.recycleUnusedBinlogFiles(context.TODO(), newGcPass(0, false))
 
 		// bad path shall remains since datacoord cannot determine file is garbage or not if path is not valid
 		validateMinioPrefixElements(t, cli, bucketName, path.Join(rootPath, common.SegmentInsertLogPath), inserts[1:2])
@@ -429,7 +430,7 @@ func TestGarbageCollector_recycleUnusedIndexes(t *testing.T) {
 			mock.Anything,
 		).Return(nil)
 		gc := newGarbageCollector(createMetaForRecycleUnusedIndexes(catalog), nil, GcOption{})
-		gc.recycleUnusedIndexes(context.TODO())
+		gc.recycleUnusedIndexes(context.TODO(), newGcPass(0, false))
 	})
 
 	t.Run("fail", func(t *testing.T) {
@@ -440,7 +441,7 @@ func TestGarbageCollector_recycleUnusedIndexes(t *testing.T) {
 			mock.Anything,
 		).Return(errors.New("fail"))
 		gc := newGarbageCollector(createMetaForRecycleUnusedIndexes(catalog), nil, GcOption{})
-		gc.recycleUnusedIndexes(context.TODO())
+		gc.recycleUnusedIndexes(context.TODO(), newGcPass(0, false))
 	})
 }
 
@@ -586,7 +587,7 @@ func TestGarbageCollector_recycleUnusedSegIndexes(t *testing.T) {
 		gc := newGarbageCollector(createMetaForRecycleUnusedSegIndexes(catalog), nil, GcOption{
 			cli: mockChunkManager,
 		})
-		gc.recycleUnusedSegIndexes(context.TODO())
+		gc.recycleUnusedSegIndexes(context.TODO(), newGcPass(0, false))
 	})
 
 	t.Run("fail", func(t *testing.T) {
@@ -604,7 +605,46 @@ func TestGarbageCollector_recycleUnusedSegIndexes(t *testing.T) {
 		gc := newGarbageCollector(createMetaForRecycleUnusedSegIndexes(catalog), nil, GcOption{
 			cli: mockChunkManager,
 		})
-		gc.recycleUnusedSegIndexes(context.TODO())
+		gc.recycleUnusedSegIndexes(context.TODO(), newGcPass(0, false))
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		catalog := catalogmocks.NewDataCoordCatalog(t)
+		mockChunkManager := mocks.NewChunkManager(t)
+		gc := newGarbageCollector(createMetaForRecycleUnusedSegIndexes(catalog), nil, GcOption{
+			cli: mockChunkManager,
+		})
+		pass := newGcPass(0, true)
+		gc.recycleUnusedSegIndexes(context.TODO(), pass)
+		assert.NotEmpty(t, pass.getCandidates())
+		for _, candidate := range pass.getCandidates() {
+			assert.Equal(t, "segment_index", candidate.GetType())
+			assert.EqualValues(t, 100, candidate.GetCollectionID())
+		}
+	})
+
+	t.Run("other collection", func(t *testing.T) {
+		catalog := catalogmocks.NewDataCoordCatalog(t)
+		mockChunkManager := mocks.NewChunkManager(t)
+		gc := newGarbageCollector(createMetaForRecycleUnusedSegIndexes(catalog), nil, GcOption{
+			cli: mockChunkManager,
+		})
+		gc.recycleUnusedSegIndexes(context.TODO(), newGcPass(101, false))
+	})
+
+	t.Run("pinned by snapshot", func(t *testing.T) {
+		catalog := catalogmocks.NewDataCoordCatalog(t)
+		mockChunkManager := mocks.NewChunkManager(t)
+		m := createMetaForRecycleUnusedSegIndexes(catalog)
+		m.snapshotMeta = &snapshotMeta{
+			snapshots:      map[int64]*datapb.SnapshotInfo{},
+			pinnedSegments: map[int64]int{},
+			pinnedBuilds:   map[int64]int{buildID: 1, buildID + 1: 1},
+		}
+		gc := newGarbageCollector(m, nil, GcOption{
+			cli: mockChunkManager,
+		})
+		gc.recycleUnusedSegIndexes(context.TODO(), newGcPass(0, false))
 	})
 }
 
@@ -772,7 +812,7 @@ func TestGarbageCollector_recycleUnusedIndexFiles(t *testing.T) {
 				cli: cm,
 			})
 
-		gc.recycleUnusedIndexFiles(context.TODO())
+		gc.recycleUnusedIndexFiles(context.TODO(), newGcPass(0, false))
 	})
 
 	t.Run("list fail", func(t *testing.T) {
@@ -788,7 +828,7 @@ func TestGarbageCollector_recycleUnusedIndexFiles(t *testing.T) {
 			GcOption{
 				cli: cm,
 			})
-		gc.recycleUnusedIndexFiles(context.TODO())
+		gc.recycleUnusedIndexFiles(context.TODO(), newGcPass(0, false))
 	})
 
 	t.Run("remove fail", func(t *testing.T) {
@@ -809,7 +849,7 @@ func TestGarbageCollector_recycleUnusedIndexFiles(t *testing.T) {
 			GcOption{
 				cli: cm,
 			})
-		gc.recycleUnusedIndexFiles(context.TODO())
+		gc.recycleUnusedIndexFiles(context.TODO(), newGcPass(0, false))
 	})
 
 	t.Run("remove with prefix fail", func(t *testing.T) {
@@ -830,7 +870,54 @@ func TestGarbageCollector_recycleUnusedIndexFiles(t *testing.T) {
 			GcOption{
 				cli: cm,
 			})
-		gc.recycleUnusedIndexFiles(context.TODO())
+		gc.recycleUnusedIndexFiles(context.TODO(), newGcPass(0, false))
+	})
+}
+
+func TestGarbageCollector_recycleUnusedFilesPinned(t *testing.T) {
+	newMeta := func() *meta {
+		m := createMetaTableForRecycleUnusedIndexFiles(&datacoord.Catalog{MetaKv: kvmocks.NewMetaKv(t)})
+		m.snapshotMeta = &snapshotMeta{
+			snapshots:      map[int64]*datapb.SnapshotInfo{},
+			pinnedSegments: map[int64]int{1000: 1},
+			pinnedBuilds:   map[int64]int{600: 1, 601: 1, 603: 1},
+		}
+		return m
+	}
+
+	t.Run("binlog files", func(t *testing.T) {
+		cm := mocks.NewChunkManager(t)
+		cm.EXPECT().RootPath().Return("root")
+		cm.EXPECT().WalkWithPrefix(mock.Anything, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, prefix string, recursive bool, walkFunc storage.ChunkObjectWalkFunc) error {
+				// the segment is not in meta, the files are removed without pinning
+				walkFunc(&storage.ChunkObjectInfo{FilePath: "root/insert_log/100/200/1000/101/1", ModifyTime: time.Now().Add(-time.Hour)})
+				return nil
+			})
+		gc := newGarbageCollector(newMeta(), nil, GcOption{
+			cli: cm,
+		})
+		gc.recycleUnusedBinLogWithChecker(context.TODO(), newGcPass(0, false), "root/insert_log", metrics.InsertFileLabel,
+			func(objectInfo *storage.ChunkObjectInfo, segment *SegmentInfo) bool {
+				return segment != nil
+			})
+	})
+
+	t.Run("index files", func(t *testing.T) {
+		cm := mocks.NewChunkManager(t)
+		cm.EXPECT().RootPath().Return("root")
+		cm.EXPECT().WalkWithPrefix(mock.Anything, mock.Anything, false, mock.Anything).RunAndReturn(
+			func(ctx context.Context, prefix string, recursive bool, walkFunc storage.ChunkObjectWalkFunc) error {
+				// the build 603 is not in meta, the files are removed without pinning
+				for _, file := range []string{"a/b/600/", "a/b/601/", "a/b/603/"} {
+					walkFunc(&storage.ChunkObjectInfo{FilePath: file})
+				}
+				return nil
+			})
+		gc := newGarbageCollector(newMeta(), nil, GcOption{
+			cli: cm,
+		})
+		gc.recycleUnusedIndexFiles(context.TODO(), newGcPass(0, false))
 	})
 }
 
@@ -1391,7 +1478,21 @@ func TestGarbageCollector_clearETCD(t *testing.T) {
 			cli:           cm,
 			dropTolerance: 1,
 		})
-	gc.recycleDroppedSegments(context.TODO())
+
+	// dry run only reports the segments would be recycled
+	pass := newGcPass(0, true)
+	gc.recycleDroppedSegments(context.TODO(), pass)
+	candidates := pass.getCandidates()
+	require.Len(t, candidates, 1)
+	assert.Equal(t, "segment", candidates[0].GetType())
+	assert.Equal(t, segID+8, candidates[0].GetSegmentID())
+	assert.NotNil(t, gc.meta.GetSegment(segID+8))
+
+	// the pass restricted to other collection recycles nothing
+	gc.recycleDroppedSegments(context.TODO(), newGcPass(collID+1, false))
+	assert.NotNil(t, gc.meta.GetSegment(segID+8))
+
+	gc.recycleDroppedSegments(context.TODO(), newGcPass(0, false))
 
 	/*
 		A    B
@@ -1449,7 +1550,7 @@ func TestGarbageCollector_clearETCD(t *testing.T) {
 	})
 	assert.NoError(t, err)
 
-	gc.recycleDroppedSegments(context.TODO())
+	gc.recycleDroppedSegments(context.TODO(), newGcPass(0, false))
 	/*
 
 		A: processed prior to C, C is not GCed yet and C is not indexed, A is not GCed in this turn
@@ -1465,7 +1566,7 @@ func TestGarbageCollector_clearETCD(t *testing.T) {
 	segD = gc.meta.GetSegment(segID + 3)
 	assert.Nil(t, segD)
 
-	gc.recycleDroppedSegments(context.TODO())
+	gc.recycleDroppedSegments(context.TODO(), newGcPass(0, false))
 	/*
 		A: compacted became false due to C is GCed already, A should be GCed since dropTolernace is meet
 		B: compacted became false due to C is GCed already, B should be GCed since dropTolerance is meet
@@ -1476,6 +1577,33 @@ func TestGarbageCollector_clearETCD(t *testing.T) {
 	assert.Nil(t, segB)
 }
 
+func TestGarbageCollector_RunOnce(t *testing.T) {
+	t.Run("not enabled", func(t *testing.T) {
+		gc := newGarbageCollector(&meta{}, nil, GcOption{})
+		_, err := gc.RunOnce(context.TODO(), 0, true)
+		assert.ErrorIs(t, err, merr.ErrServiceUnavailable)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		catalog := catalogmocks.NewDataCoordCatalog(t)
+		catalog.EXPECT().ListChannelCheckpoint(mock.Anything).Return(nil, nil).Maybe()
+		cm := mocks.NewChunkManager(t)
+		cm.EXPECT().RootPath().Return("root").Maybe()
+		cm.EXPECT().WalkWithPrefix(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(context.Canceled).Maybe()
+		m := createMetaForRecycleUnusedSegIndexes(catalog)
+		m.catalog = catalog
+		m.analyzeMeta = &analyzeMeta{tasks: map[int64]*indexpb.AnalyzeTask{}}
+		gc := newGarbageCollector(m, newMockHandlerWithMeta(m), GcOption{
+			cli:     cm,
+			enabled: true,
+		})
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := gc.RunOnce(ctx, 100, true)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
 func TestGarbageCollector_recycleChannelMeta(t *testing.T) {
 	catalog := catalogmocks.NewDataCoordCatalog(t)
 
@@ -1493,7 +1621,7 @@ func TestGarbageCollector_recycleChannelMeta(t *testing.T) {
 
 	t.Run("list channel cp fail", func(t *testing.T) {
 		catalog.EXPECT().ListChannelCheckpoint(mock.Anything).Return(nil, errors.New("mock error")).Once()
-		gc.recycleChannelCPMeta(context.TODO())
+		gc.recycleChannelCPMeta(context.TODO(), newGcPass(0, false))
 		assert.Equal(t, 2, len(m.channelCPs.checkpoints))
 	})
 
@@ -1513,13 +1641,13 @@ func TestGarbageCollector_recycleChannelMeta(t *testing.T) {
 
 	t.Run("drop channel cp fail", func(t *testing.T) {
 		catalog.EXPECT().DropChannelCheckpoint(mock.Anything, mock.Anything).Return(errors.New("mock error")).Once()
-		gc.recycleChannelCPMeta(context.TODO())
+		gc.recycleChannelCPMeta(context.TODO(), newGcPass(0, false))
 		assert.Equal(t, 2, len(m.channelCPs.checkpoints))
 	})
 
 	t.Run("gc ok", func(t *testing.T) {
 		catalog.EXPECT().DropChannelCheckpoint(mock.Anything, mock.Anything).Return(nil).Once()
-		gc.recycleChannelCPMeta(context.TODO())
+		gc.recycleChannelCPMeta(context.TODO(), newGcPass(0, false))
 		assert.Equal(t, 1, len(m.channelCPs.checkpoints))
 	})
 }
@@ -146,6 +146,10 @@ func (h *ServerHandler) GetQueryVChanPositions(channel RWChannel, partitionIDs .
 				// Skip bulk insert segments.
 				continue
 			}
+			if s.isBroken && s.GetState() != commonpb.SegmentState_Dropped {
+				// Skip the segments marked broken by fsck, they cannot be loaded.
+				continue
+			}
 			if s.GetLevel() == datapb.SegmentLevel_L2 && s.PartitionStatsVersion != currentPartitionStatsVersion {
 				// in the process of L2 compaction, newly generated segment may be visible before the whole L2 compaction Plan
 				// is finished, we have to skip these fast-finished segment because all segments in one L2 Batch must be
@@ -326,15 +326,26 @@ func (m *indexMeta) AlterIndex(ctx context.Context, indexes ...*model.Index) err
 
 // AddSegmentIndex adds the index meta corresponding the indexBuildID to meta table.
 func (m *indexMeta) AddSegmentIndex(segIndex *model.SegmentIndex) error {
+	segIndex.IndexState = commonpb.IndexState_Unissued
+	return m.addSegmentIndex(segIndex)
+}
+
+// AddFinishedSegmentIndex adds the segment index whose index files already exist, e.g. copied from a snapshot,
+// so that no index task is issued for it.
+func (m *indexMeta) AddFinishedSegmentIndex(segIndex *model.SegmentIndex) error {
+	segIndex.IndexState = commonpb.IndexState_Finished
+	return m.addSegmentIndex(segIndex)
+}
+
+func (m *indexMeta) addSegmentIndex(segIndex *model.SegmentIndex) error {
 	m.Lock()
 	defer m.Unlock()
 
 	buildID := segIndex.BuildID
 	log.Info("meta update: adding segment index", zap.Int64("collectionID", segIndex.CollectionID),
 		zap.Int64("segmentID", segIndex.SegmentID), zap.Int64("indexID", segIndex.IndexID),
-		zap.Int64("buildID", buildID))
+		zap.Int64("buildID", buildID), zap.String("state", segIndex.IndexState.String()))
 
-	segIndex.IndexState = commonpb.IndexState_Unissued
 	if err := m.catalog.CreateSegmentIndex(m.ctx, segIndex); err != nil {
 		log.Warn("meta update: adding segment index failed",
 			zap.Int64("segmentID", segIndex.SegmentID), zap.Int64("indexID", segIndex.IndexID),
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"math"
 	"path"
+	"sort"
 	"strconv"
 	"time"
 
@@ -37,6 +38,7 @@ import (
 	"github.com/milvus-io/milvus/internal/datacoord/broker"
 	"github.com/milvus-io/milvus/internal/metastore"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/segmentutil"
 	"github.com/milvus-io/milvus/pkg/common"
@@ -87,6 +89,7 @@ type meta struct {
 	analyzeMeta        *analyzeMeta
 	partitionStatsMeta *partitionStatsMeta
 	compactionTaskMeta *compactionTaskMeta
+	snapshotMeta       *snapshotMeta
 }
 
 func (m *meta) GetIndexMeta() *indexMeta {
@@ -105,6 +108,10 @@ func (m *meta) GetCompactionTaskMeta() *compactionTaskMeta {
 	return m.compactionTaskMeta
 }
 
+func (m *meta) GetSnapshotMeta() *snapshotMeta {
+	return m.snapshotMeta
+}
+
 type channelCPs struct {
 	lock.RWMutex
 	checkpoints map[string]*msgpb.MsgPosition
@@ -133,6 +140,8 @@ type collectionInfo struct {
 	DatabaseName   string
 	DatabaseID     int64
 	VChannelNames  []string
+	// the names of the partitions, to find the partition properties in the collection properties
+	PartitionNames map[int64]string
 }
 
 // NewMeta creates meta from provided `kv.TxnKV`
@@ -156,6 +165,11 @@ func newMeta(ctx context.Context, catalog metastore.DataCoordCatalog, chunkManag
 	if err != nil {
 		return nil, err
 	}
+
+	sm, err := newSnapshotMeta(ctx, catalog)
+	if err != nil {
+		return nil, err
+	}
 	mt := &meta{
 		ctx:                ctx,
 		catalog:            catalog,
@@ -167,6 +181,7 @@ func newMeta(ctx context.Context, catalog metastore.DataCoordCatalog, chunkManag
 		chunkManager:       chunkManager,
 		partitionStatsMeta: psm,
 		compactionTaskMeta: ctm,
+		snapshotMeta:       sm,
 	}
 	err = mt.reloadFromKV()
 	if err != nil {
@@ -222,6 +237,21 @@ func (m *meta) reloadFromKV() error {
 		m.channelCPs.checkpoints[vChannel] = pos
 	}
 
+	brokenSegmentIDs, err := m.catalog.ListBrokenSegments(m.ctx)
+	if err != nil {
+		return err
+	}
+	for _, segmentID := range brokenSegmentIDs {
+		segment := m.segments.GetSegment(segmentID)
+		if segment == nil {
+			continue
+		}
+		log.Warn("segment is marked broken by fsck, exclude it from loading and compaction",
+			zap.Int64("collectionID", segment.GetCollectionID()),
+			zap.Int64("segmentID", segmentID))
+		m.segments.SetSegment(segmentID, segment.ShadowClone(SetIsBroken(true)))
+	}
+
 	log.Info("DataCoord meta reloadFromKV done", zap.Duration("duration", record.ElapseSpan()))
 	return nil
 }
@@ -241,14 +271,15 @@ func (m *meta) reloadCollectionsFromRootcoord(ctx context.Context, broker broker
 			if err != nil {
 				return err
 			}
-			partitionIDs, err := broker.ShowPartitionsInternal(ctx, collectionID)
+			partitions, err := broker.ShowPartitions(ctx, collectionID)
 			if err != nil {
 				return err
 			}
 			collection := &collectionInfo{
 				ID:             collectionID,
 				Schema:         resp.GetSchema(),
-				Partitions:     partitionIDs,
+				Partitions:     partitions.GetPartitionIDs(),
+				PartitionNames: getPartitionNames(partitions.GetPartitionIDs(), partitions.GetPartitionNames()),
 				StartPositions: resp.GetStartPositions(),
 				Properties:     funcutil.KeyValuePair2Map(resp.GetProperties()),
 				CreatedAt:      resp.GetCreatedTimestamp(),
@@ -326,6 +357,7 @@ func (m *meta) GetClonedCollectionInfo(collectionID UniqueID) *collectionInfo {
 		DatabaseName:   coll.DatabaseName,
 		DatabaseID:     coll.DatabaseID,
 		VChannelNames:  coll.VChannelNames,
+		PartitionNames: maps.Clone(coll.PartitionNames),
 	}
 
 	return cloneColl
@@ -448,6 +480,108 @@ func (m *meta) GetCollectionIndexFilesSize() uint64 {
 	return total
 }
 
+// GetCollectionStorageUsage returns the object storage usage of the collection by file type and segment state.
+// The files of dropped segments and dropped indexes are reclaimable unless they are pinned by snapshots.
+func (m *meta) GetCollectionStorageUsage(collectionID int64) *internalpb.GetCollectionStorageUsageResponse {
+	m.RLock()
+	defer m.RUnlock()
+	usage := &internalpb.GetCollectionStorageUsageResponse{
+		Status:       merr.Success(),
+		CollectionID: collectionID,
+	}
+	logSize := func(fieldBinlogs []*datapb.FieldBinlog) int64 {
+		var size int64
+		for _, fieldBinlog := range fieldBinlogs {
+			for _, l := range fieldBinlog.GetBinlogs() {
+				size += l.GetLogSize()
+			}
+		}
+		return size
+	}
+	isPinned := func(segmentID int64) bool {
+		return m.snapshotMeta != nil && m.snapshotMeta.IsSegmentPinned(segmentID)
+	}
+
+	indexSizes := make(map[int64]int64)
+	for _, segment := range m.segments.GetSegmentsBySelector(WithCollection(collectionID)) {
+		segmentIndexes := m.indexMeta.getSegmentIndexes(segment.GetID())
+		if !isSegmentHealthy(segment) {
+			if isPinned(segment.GetID()) {
+				continue
+			}
+			usage.ReclaimableSize += logSize(segment.GetBinlogs()) + logSize(segment.GetDeltalogs()) + logSize(segment.GetStatslogs())
+			for _, segIdx := range segmentIndexes {
+				usage.ReclaimableSize += int64(segIdx.IndexSize)
+			}
+			continue
+		}
+
+		switch {
+		case segment.GetLevel() == datapb.SegmentLevel_L0:
+			usage.L0SegmentNum++
+		case segment.GetState() == commonpb.SegmentState_Growing:
+			usage.GrowingSegmentNum++
+		default:
+			usage.SealedSegmentNum++
+		}
+		usage.InsertBinlogSize += logSize(segment.GetBinlogs())
+		usage.DeltalogSize += logSize(segment.GetDeltalogs())
+		usage.StatslogSize += logSize(segment.GetStatslogs())
+		for _, segIdx := range segmentIndexes {
+			if segIdx.IsDeleted || !m.indexMeta.IsIndexExist(collectionID, segIdx.IndexID) {
+				usage.ReclaimableSize += int64(segIdx.IndexSize)
+				continue
+			}
+			indexSizes[segIdx.IndexID] += int64(segIdx.IndexSize)
+		}
+	}
+
+	for _, index := range m.indexMeta.GetIndexesForCollection(collectionID, "") {
+		usage.Indexes = append(usage.Indexes, &internalpb.IndexStorageUsage{
+			IndexID:   index.IndexID,
+			IndexName: index.IndexName,
+			FieldID:   index.FieldID,
+			Size:      indexSizes[index.IndexID],
+		})
+	}
+	sort.Slice(usage.Indexes, func(i, j int) bool {
+		return usage.Indexes[i].GetIndexID() < usage.Indexes[j].GetIndexID()
+	})
+	return usage
+}
+
+// GetPartitionKeyStats returns the row stats of the partitions of partition key ordered by the bucket index,
+// and the segments to be rewritten by repartition compaction if the partitions are increased at repartitionTs.
+func (m *meta) GetPartitionKeyStats(collectionID int64, partitionIDs []int64, repartitionTs Timestamp) *datapb.GetPartitionKeyStatsResponse {
+	m.RLock()
+	defer m.RUnlock()
+	resp := &datapb.GetPartitionKeyStatsResponse{
+		Status:     merr.Success(),
+		Partitions: make([]*datapb.PartitionKeyPartitionStats, 0, len(partitionIDs)),
+	}
+	stats := make(map[int64]*datapb.PartitionKeyPartitionStats, len(partitionIDs))
+	for _, partitionID := range partitionIDs {
+		stat := &datapb.PartitionKeyPartitionStats{PartitionID: partitionID}
+		stats[partitionID] = stat
+		resp.Partitions = append(resp.Partitions, stat)
+	}
+
+	for _, segment := range m.segments.GetSegmentsBySelector(WithCollection(collectionID)) {
+		if !isSegmentHealthy(segment) || segment.GetLevel() == datapb.SegmentLevel_L0 {
+			continue
+		}
+		if stat, ok := stats[segment.GetPartitionID()]; ok {
+			stat.NumRows += segment.GetNumOfRows()
+			stat.NumSegments++
+		}
+		if repartitionTs > 0 && needRepartition(segment, int64(len(partitionIDs)), repartitionTs) {
+			resp.RepartitionSegments++
+			resp.RepartitionRows += segment.GetNumOfRows()
+		}
+	}
+	return resp
+}
+
 func (m *meta) GetAllCollectionNumRows() map[int64]int64 {
 	m.RLock()
 	defer m.RUnlock()
@@ -1503,6 +1637,7 @@ func (m *meta) completeMixCompactionMutation(t *datapb.CompactionTask, result *d
 			CompactionFrom:      compactFromSegIDs,
 			LastExpireTime:      tsoutil.ComposeTSByTime(time.Unix(t.GetStartTime(), 0), 0),
 			Level:               datapb.SegmentLevel_L1,
+			PartitionKeyBuckets: getPartitionKeyBuckets(compactFromSegInfos),
 
 			StartPosition: getMinPosition(lo.Map(compactFromSegInfos, func(info *SegmentInfo, _ int) *msgpb.MsgPosition {
 				return info.GetStartPosition()
@@ -1556,6 +1691,125 @@ func (m *meta) completeMixCompactionMutation(t *datapb.CompactionTask, result *d
 	return []*SegmentInfo{compactToSegmentInfo}, metricMutation, nil
 }
 
+func (m *meta) completeRepartitionCompactionMutation(t *datapb.CompactionTask, result *datapb.CompactionPlanResult) ([]*SegmentInfo, *segMetricMutation, error) {
+	log := log.With(zap.Int64("planID", t.GetPlanID()),
+		zap.String("type", t.GetType().String()),
+		zap.Int64("collectionID", t.CollectionID),
+		zap.Int64("partitionID", t.PartitionID),
+		zap.String("channel", t.GetChannel()))
+
+	metricMutation := &segMetricMutation{stateChange: make(map[string]map[string]int)}
+	compactFromSegIDs := make([]int64, 0)
+	compactToSegIDs := make([]int64, 0)
+	compactFromSegInfos := make([]*SegmentInfo, 0)
+	compactToSegInfos := make([]*SegmentInfo, 0)
+
+	for _, segmentID := range t.GetInputSegments() {
+		segment := m.segments.GetSegment(segmentID)
+		if segment == nil {
+			return nil, nil, merr.WrapErrSegmentNotFound(segmentID)
+		}
+
+		cloned := segment.Clone()
+		cloned.DroppedAt = uint64(time.Now().UnixNano())
+		cloned.Compacted = true
+
+		compactFromSegInfos = append(compactFromSegInfos, cloned)
+		compactFromSegIDs = append(compactFromSegIDs, cloned.GetID())
+
+		// metrics mutation for compaction from segments
+		updateSegStateAndPrepareMetrics(cloned, commonpb.SegmentState_Dropped, metricMutation)
+	}
+
+	// RepartitionCompaction generates at most one segment for each of the target partitions
+	for _, seg := range result.GetSegments() {
+		if !lo.Contains(t.GetTargetPartitionIDs(), seg.GetPartitionID()) {
+			return nil, nil, merr.WrapErrIllegalCompactionPlan(fmt.Sprintf("segment %d of unexpected partition %d", seg.GetSegmentID(), seg.GetPartitionID()))
+		}
+		segment := NewSegmentInfo(&datapb.SegmentInfo{
+			ID:                  seg.GetSegmentID(),
+			CollectionID:        compactFromSegInfos[0].CollectionID,
+			PartitionID:         seg.GetPartitionID(),
+			InsertChannel:       t.GetChannel(),
+			NumOfRows:           seg.NumOfRows,
+			State:               commonpb.SegmentState_Flushed,
+			MaxRowNum:           compactFromSegInfos[0].MaxRowNum,
+			Binlogs:             seg.GetInsertLogs(),
+			Statslogs:           seg.GetField2StatslogPaths(),
+			Deltalogs:           seg.GetDeltalogs(),
+			CreatedByCompaction: true,
+			CompactionFrom:      compactFromSegIDs,
+			LastExpireTime:      tsoutil.ComposeTSByTime(time.Unix(t.GetStartTime(), 0), 0),
+			Level:               datapb.SegmentLevel_L1,
+			PartitionKeyBuckets: int64(len(t.GetTargetPartitionIDs())),
+			StartPosition: getMinPosition(lo.Map(compactFromSegInfos, func(info *SegmentInfo, _ int) *msgpb.MsgPosition {
+				return info.GetStartPosition()
+			})),
+			DmlPosition: getMinPosition(lo.Map(compactFromSegInfos, func(info *SegmentInfo, _ int) *msgpb.MsgPosition {
+				return info.GetDmlPosition()
+			})),
+		})
+		// L1 segment with NumRows=0 will be discarded, so no need to change the metric
+		if segment.GetNumOfRows() > 0 {
+			metricMutation.addNewSeg(segment.GetState(), segment.GetLevel(), segment.GetNumOfRows())
+		} else {
+			segment.State = commonpb.SegmentState_Dropped
+		}
+		compactToSegInfos = append(compactToSegInfos, segment)
+		compactToSegIDs = append(compactToSegIDs, segment.GetID())
+	}
+
+	log = log.With(zap.Int64s("compact from", compactFromSegIDs), zap.Int64s("compact to", compactToSegIDs))
+	log.Debug("meta update: prepare for meta mutation - complete")
+
+	compactFromInfos := lo.Map(compactFromSegInfos, func(info *SegmentInfo, _ int) *datapb.SegmentInfo {
+		return info.SegmentInfo
+	})
+
+	compactToInfos := lo.Map(compactToSegInfos, func(info *SegmentInfo, _ int) *datapb.SegmentInfo {
+		return info.SegmentInfo
+	})
+
+	binlogs := make([]metastore.BinlogsIncrement, 0)
+	for _, seg := range compactToInfos {
+		binlogs = append(binlogs, metastore.BinlogsIncrement{Segment: seg})
+	}
+	// alter compactTo before compactFrom segments to avoid data lost if service crash during AlterSegments
+	if len(compactToInfos) > 0 {
+		if err := m.catalog.AlterSegments(m.ctx, compactToInfos, binlogs...); err != nil {
+			log.Warn("fail to alter compactTo segments", zap.Error(err))
+			return nil, nil, err
+		}
+	}
+	if err := m.catalog.AlterSegments(m.ctx, compactFromInfos); err != nil {
+		log.Warn("fail to alter compactFrom segments", zap.Error(err))
+		return nil, nil, err
+	}
+	lo.ForEach(compactFromSegInfos, func(info *SegmentInfo, _ int) {
+		m.segments.SetSegment(info.GetID(), info)
+	})
+	lo.ForEach(compactToSegInfos, func(info *SegmentInfo, _ int) {
+		m.segments.SetSegment(info.GetID(), info)
+	})
+	log.Info("meta update: alter in memory meta after compaction - complete")
+	return compactToSegInfos, metricMutation, nil
+}
+
+// getPartitionKeyBuckets returns the number of partitions of partition key shared by the segments, zero if they differ,
+// so that the segments rewritten by repartition compaction are not rewritten again after compacted together.
+func getPartitionKeyBuckets(segments []*SegmentInfo) int64 {
+	if len(segments) == 0 {
+		return 0
+	}
+	buckets := segments[0].GetPartitionKeyBuckets()
+	for _, segment := range segments[1:] {
+		if segment.GetPartitionKeyBuckets() != buckets {
+			return 0
+		}
+	}
+	return buckets
+}
+
 func (m *meta) CompleteCompactionMutation(t *datapb.CompactionTask, result *datapb.CompactionPlanResult) ([]*SegmentInfo, *segMetricMutation, error) {
 	m.Lock()
 	defer m.Unlock()
@@ -1564,6 +1818,8 @@ func (m *meta) CompleteCompactionMutation(t *datapb.CompactionTask, result *data
 		return m.completeMixCompactionMutation(t, result)
 	case datapb.CompactionType_ClusteringCompaction:
 		return m.completeClusterCompactionMutation(t, result)
+	case datapb.CompactionType_RepartitionCompaction:
+		return m.completeRepartitionCompactionMutation(t, result)
 	}
 	return nil, nil, merr.WrapErrIllegalCompactionPlan("illegal compaction type")
 }
@@ -1729,6 +1985,7 @@ func (m *meta) GetCompactableSegmentGroupByCollection() map[int64][]*SegmentInfo
 		return isSegmentHealthy(segment) &&
 			isFlush(segment) && // sealed segment
 			!segment.isCompacting && // not compacting now
+			!segment.isBroken && // not marked broken by fsck
 			!segment.GetIsImporting() // not importing now
 	}))
 
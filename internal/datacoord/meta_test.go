@@ -25,6 +25,7 @@ import (
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
@@ -73,6 +74,7 @@ func (suite *MetaReloadSuite) TestReloadFromKV() {
 		suite.catalog.EXPECT().ListSegmentIndexes(mock.Anything).Return([]*model.SegmentIndex{}, nil)
 		suite.catalog.EXPECT().ListAnalyzeTasks(mock.Anything).Return(nil, nil)
 		suite.catalog.EXPECT().ListCompactionTask(mock.Anything).Return(nil, nil)
+		suite.catalog.EXPECT().ListSnapshots(mock.Anything).Return(nil, nil)
 		suite.catalog.EXPECT().ListPartitionStatsInfos(mock.Anything).Return(nil, nil)
 
 		_, err := newMeta(ctx, suite.catalog, nil)
@@ -88,6 +90,7 @@ func (suite *MetaReloadSuite) TestReloadFromKV() {
 		suite.catalog.EXPECT().ListSegmentIndexes(mock.Anything).Return([]*model.SegmentIndex{}, nil)
 		suite.catalog.EXPECT().ListAnalyzeTasks(mock.Anything).Return(nil, nil)
 		suite.catalog.EXPECT().ListCompactionTask(mock.Anything).Return(nil, nil)
+		suite.catalog.EXPECT().ListSnapshots(mock.Anything).Return(nil, nil)
 		suite.catalog.EXPECT().ListPartitionStatsInfos(mock.Anything).Return(nil, nil)
 
 		_, err := newMeta(ctx, suite.catalog, nil)
@@ -100,6 +103,7 @@ func (suite *MetaReloadSuite) TestReloadFromKV() {
 		suite.catalog.EXPECT().ListSegmentIndexes(mock.Anything).Return([]*model.SegmentIndex{}, nil)
 		suite.catalog.EXPECT().ListAnalyzeTasks(mock.Anything).Return(nil, nil)
 		suite.catalog.EXPECT().ListCompactionTask(mock.Anything).Return(nil, nil)
+		suite.catalog.EXPECT().ListSnapshots(mock.Anything).Return(nil, nil)
 		suite.catalog.EXPECT().ListPartitionStatsInfos(mock.Anything).Return(nil, nil)
 		suite.catalog.EXPECT().ListSegments(mock.Anything).Return([]*datapb.SegmentInfo{
 			{
@@ -116,9 +120,11 @@ func (suite *MetaReloadSuite) TestReloadFromKV() {
 				Timestamp:   1000,
 			},
 		}, nil)
+		suite.catalog.EXPECT().ListBrokenSegments(mock.Anything).Return([]int64{1, 2}, nil)
 
-		_, err := newMeta(ctx, suite.catalog, nil)
+		meta, err := newMeta(ctx, suite.catalog, nil)
 		suite.NoError(err)
+		suite.True(meta.GetSegment(1).isBroken)
 
 		suite.MetricsEqual(metrics.DataCoordNumSegments.WithLabelValues(metrics.FlushedSegmentLabel, datapb.SegmentLevel_Legacy.String()), 1)
 	})
@@ -277,6 +283,70 @@ func (suite *MetaBasicSuite) TestCompleteCompactionMutation() {
 	suite.EqualValues(2, mutation.rowCountAccChange)
 }
 
+func (suite *MetaBasicSuite) TestCompleteRepartitionCompactionMutation() {
+	latestSegments := NewSegmentsInfo()
+	for segID, segment := range map[UniqueID]*SegmentInfo{
+		1: {SegmentInfo: &datapb.SegmentInfo{
+			ID:           1,
+			CollectionID: 100,
+			PartitionID:  10,
+			State:        commonpb.SegmentState_Flushed,
+			Level:        datapb.SegmentLevel_L1,
+			Binlogs:      []*datapb.FieldBinlog{getFieldBinlogIDs(0, 10000)},
+			NumOfRows:    3,
+		}},
+		2: {SegmentInfo: &datapb.SegmentInfo{
+			ID:           2,
+			CollectionID: 100,
+			PartitionID:  10,
+			State:        commonpb.SegmentState_Flushed,
+			Level:        datapb.SegmentLevel_L1,
+			Binlogs:      []*datapb.FieldBinlog{getFieldBinlogIDs(0, 11000)},
+			NumOfRows:    2,
+		}},
+	} {
+		latestSegments.SetSegment(segID, segment)
+	}
+
+	m := &meta{
+		catalog:      &datacoord.Catalog{MetaKv: NewMetaMemoryKV()},
+		segments:     latestSegments,
+		chunkManager: mocks.NewChunkManager(suite.T()),
+	}
+	task := &datapb.CompactionTask{
+		InputSegments:      []UniqueID{1, 2},
+		Type:               datapb.CompactionType_RepartitionCompaction,
+		TargetPartitionIDs: []int64{10, 11, 12},
+	}
+
+	// unexpected partition
+	_, _, err := m.CompleteCompactionMutation(task, &datapb.CompactionPlanResult{
+		Segments: []*datapb.CompactionSegment{{SegmentID: 3, PartitionID: 13, NumOfRows: 5}},
+	})
+	suite.ErrorIs(err, merr.ErrIllegalCompactionPlan)
+
+	infos, mutation, err := m.CompleteCompactionMutation(task, &datapb.CompactionPlanResult{
+		Segments: []*datapb.CompactionSegment{
+			{SegmentID: 3, PartitionID: 10, NumOfRows: 2, InsertLogs: []*datapb.FieldBinlog{getFieldBinlogIDs(0, 50000)}},
+			{SegmentID: 4, PartitionID: 12, NumOfRows: 3, InsertLogs: []*datapb.FieldBinlog{getFieldBinlogIDs(0, 50001)}},
+		},
+	})
+	suite.NoError(err)
+	suite.Equal(2, len(infos))
+	for i, partitionID := range []int64{10, 12} {
+		suite.Equal(partitionID, infos[i].GetPartitionID())
+		suite.EqualValues(3, infos[i].GetPartitionKeyBuckets())
+		suite.Equal(datapb.SegmentLevel_L1, infos[i].GetLevel())
+		suite.Equal(commonpb.SegmentState_Flushed, infos[i].GetState())
+		suite.ElementsMatch([]int64{1, 2}, infos[i].GetCompactionFrom())
+	}
+	for _, segID := range []int64{1, 2} {
+		suite.Equal(commonpb.SegmentState_Dropped, m.GetSegment(segID).GetState())
+	}
+	suite.EqualValues(0, mutation.rowCountChange)
+	suite.EqualValues(5, mutation.rowCountAccChange)
+}
+
 func (suite *MetaBasicSuite) TestSetSegment() {
 	meta := suite.meta
 	catalog := mocks2.NewDataCoordCatalog(suite.T())
@@ -1063,6 +1133,61 @@ func TestMeta_GetAllSegments(t *testing.T) {
 	assert.NotNil(t, seg2All)
 }
 
+func TestMeta_GetCollectionStorageUsage(t *testing.T) {
+	binlogs := func(sizes ...int64) []*datapb.FieldBinlog {
+		return []*datapb.FieldBinlog{{FieldID: 100, Binlogs: lo.Map(sizes, func(size int64, _ int) *datapb.Binlog {
+			return &datapb.Binlog{LogSize: size}
+		})}}
+	}
+	segments := NewSegmentsInfo()
+	for _, segment := range []*datapb.SegmentInfo{
+		{ID: 1, CollectionID: 1, State: commonpb.SegmentState_Growing, Binlogs: binlogs(1, 2)},
+		{ID: 2, CollectionID: 1, State: commonpb.SegmentState_Flushed, Binlogs: binlogs(10), Deltalogs: binlogs(20), Statslogs: binlogs(30)},
+		{ID: 3, CollectionID: 1, State: commonpb.SegmentState_Flushed, Level: datapb.SegmentLevel_L0, Deltalogs: binlogs(40)},
+		{ID: 4, CollectionID: 1, State: commonpb.SegmentState_Dropped, Binlogs: binlogs(100), Statslogs: binlogs(200)},
+		// pinned by snapshot, not reclaimable
+		{ID: 5, CollectionID: 1, State: commonpb.SegmentState_Dropped, Binlogs: binlogs(1000)},
+		{ID: 6, CollectionID: 2, State: commonpb.SegmentState_Flushed, Binlogs: binlogs(10000)},
+	} {
+		segments.SetSegment(segment.GetID(), NewSegmentInfo(segment))
+	}
+	m := &meta{
+		segments: segments,
+		indexMeta: &indexMeta{
+			indexes: map[UniqueID]map[UniqueID]*model.Index{
+				1: {
+					10: {CollectionID: 1, IndexID: 10, FieldID: 100, IndexName: "idx"},
+					11: {CollectionID: 1, IndexID: 11, FieldID: 101, IndexName: "dropped", IsDeleted: true},
+				},
+			},
+			segmentIndexes: map[UniqueID]map[UniqueID]*model.SegmentIndex{
+				2: {
+					10: {SegmentID: 2, CollectionID: 1, IndexID: 10, IndexSize: 300},
+					11: {SegmentID: 2, CollectionID: 1, IndexID: 11, IndexSize: 400},
+				},
+				4: {10: {SegmentID: 4, CollectionID: 1, IndexID: 10, IndexSize: 500}},
+			},
+		},
+		snapshotMeta: &snapshotMeta{pinnedSegments: map[int64]int{5: 1}},
+	}
+
+	usage := m.GetCollectionStorageUsage(1)
+	assert.EqualValues(t, 13, usage.GetInsertBinlogSize())
+	assert.EqualValues(t, 60, usage.GetDeltalogSize())
+	assert.EqualValues(t, 30, usage.GetStatslogSize())
+	assert.EqualValues(t, 1, usage.GetGrowingSegmentNum())
+	assert.EqualValues(t, 1, usage.GetSealedSegmentNum())
+	assert.EqualValues(t, 1, usage.GetL0SegmentNum())
+	assert.EqualValues(t, 100+200+500+400, usage.GetReclaimableSize())
+	require.Len(t, usage.GetIndexes(), 1)
+	assert.Equal(t, "idx", usage.GetIndexes()[0].GetIndexName())
+	assert.EqualValues(t, 300, usage.GetIndexes()[0].GetSize())
+
+	usage = m.GetCollectionStorageUsage(3)
+	assert.EqualValues(t, 0, usage.GetInsertBinlogSize())
+	assert.Empty(t, usage.GetIndexes())
+}
+
 func TestMeta_isSegmentHealthy_issue17823_panic(t *testing.T) {
 	var seg *SegmentInfo
 
@@ -1215,7 +1340,7 @@ func Test_meta_ReloadCollectionsFromRootcoords(t *testing.T) {
 			CollectionIds:   []int64{1000},
 		}, nil)
 		mockBroker.EXPECT().DescribeCollectionInternal(mock.Anything, mock.Anything).Return(&milvuspb.DescribeCollectionResponse{}, nil)
-		mockBroker.EXPECT().ShowPartitionsInternal(mock.Anything, mock.Anything).Return(nil, errors.New("show partitions failed, mocked"))
+		mockBroker.EXPECT().ShowPartitions(mock.Anything, mock.Anything).Return(nil, errors.New("show partitions failed, mocked"))
 		err := m.reloadCollectionsFromRootcoord(context.TODO(), mockBroker)
 		assert.Error(t, err)
 	})
@@ -1236,10 +1361,14 @@ func Test_meta_ReloadCollectionsFromRootcoords(t *testing.T) {
 		mockBroker.EXPECT().DescribeCollectionInternal(mock.Anything, mock.Anything).Return(&milvuspb.DescribeCollectionResponse{
 			CollectionID: 1000,
 		}, nil)
-		mockBroker.EXPECT().ShowPartitionsInternal(mock.Anything, mock.Anything).Return([]int64{2000}, nil)
+		mockBroker.EXPECT().ShowPartitions(mock.Anything, mock.Anything).Return(&milvuspb.ShowPartitionsResponse{
+			PartitionIDs:   []int64{2000},
+			PartitionNames: []string{"p1"},
+		}, nil)
 		err := m.reloadCollectionsFromRootcoord(context.TODO(), mockBroker)
 		assert.NoError(t, err)
 		c := m.GetCollection(UniqueID(1000))
 		assert.NotNil(t, c)
+		assert.Equal(t, map[int64]string{2000: "p1"}, c.PartitionNames)
 	})
 }
@@ -1014,6 +1014,50 @@ func (_c *MockSessionManager_SyncSegments_Call) RunAndReturn(run func(int64, *da
 	return _c
 }
 
+// UpdateChannelSchema provides a mock function with given fields: ctx, nodeID, req
+func (_m *MockSessionManager) UpdateChannelSchema(ctx context.Context, nodeID int64, req *datapb.UpdateChannelSchemaRequest) error {
+	ret := _m.Called(ctx, nodeID, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *datapb.UpdateChannelSchemaRequest) error); ok {
+		r0 = rf(ctx, nodeID, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSessionManager_UpdateChannelSchema_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateChannelSchema'
+type MockSessionManager_UpdateChannelSchema_Call struct {
+	*mock.Call
+}
+
+// UpdateChannelSchema is a helper method to define mock.On call
+//   - ctx context.Context
+//   - nodeID int64
+//   - req *datapb.UpdateChannelSchemaRequest
+func (_e *MockSessionManager_Expecter) UpdateChannelSchema(ctx interface{}, nodeID interface{}, req interface{}) *MockSessionManager_UpdateChannelSchema_Call {
+	return &MockSessionManager_UpdateChannelSchema_Call{Call: _e.mock.On("UpdateChannelSchema", ctx, nodeID, req)}
+}
+
+func (_c *MockSessionManager_UpdateChannelSchema_Call) Run(run func(ctx context.Context, nodeID int64, req *datapb.UpdateChannelSchemaRequest)) *MockSessionManager_UpdateChannelSchema_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*datapb.UpdateChannelSchemaRequest))
+	})
+	return _c
+}
+
+func (_c *MockSessionManager_UpdateChannelSchema_Call) Return(_a0 error) *MockSessionManager_UpdateChannelSchema_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSessionManager_UpdateChannelSchema_Call) RunAndReturn(run func(context.Context, int64, *datapb.UpdateChannelSchemaRequest) error) *MockSessionManager_UpdateChannelSchema_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockSessionManager creates a new instance of MockSessionManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockSessionManager(t interface {
@@ -327,6 +327,10 @@ func (c *mockDataNodeClient) DropCompactionPlan(ctx context.Context, req *datapb
 	return merr.Success(), nil
 }
 
+func (c *mockDataNodeClient) UpdateChannelSchema(ctx context.Context, req *datapb.UpdateChannelSchemaRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
 func (c *mockDataNodeClient) Stop() error {
 	c.state = commonpb.StateCode_Abnormal
 	return nil
@@ -356,6 +360,18 @@ func (m *mockRootCoordClient) RenameCollection(ctx context.Context, req *milvusp
 	panic("implement me")
 }
 
+func (m *mockRootCoordClient) AddCollectionField(ctx context.Context, req *internalpb.AddCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) DropCollectionField(ctx context.Context, req *internalpb.DropCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) RenameCollectionField(ctx context.Context, req *internalpb.RenameCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
 func (m *mockRootCoordClient) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error) {
 	panic("implement me")
 }
@@ -372,6 +388,26 @@ func (m *mockRootCoordClient) AlterAlias(ctx context.Context, req *milvuspb.Alte
 	panic("implement me")
 }
 
+func (m *mockRootCoordClient) AlterAliases(ctx context.Context, req *internalpb.AlterAliasesRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) DropCollectionAsync(ctx context.Context, req *milvuspb.DropCollectionRequest, opts ...grpc.CallOption) (*internalpb.SubmitDDLJobResponse, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) DropDatabaseAsync(ctx context.Context, req *milvuspb.DropDatabaseRequest, opts ...grpc.CallOption) (*internalpb.SubmitDDLJobResponse, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) ListDDLJobs(ctx context.Context, req *internalpb.ListDDLJobsRequest, opts ...grpc.CallOption) (*internalpb.ListDDLJobsResponse, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) GetDDLJob(ctx context.Context, req *internalpb.GetDDLJobRequest, opts ...grpc.CallOption) (*internalpb.GetDDLJobResponse, error) {
+	panic("implement me")
+}
+
 func (m *mockRootCoordClient) DescribeAlias(ctx context.Context, req *milvuspb.DescribeAliasRequest, opts ...grpc.CallOption) (*milvuspb.DescribeAliasResponse, error) {
 	panic("implement me")
 }
@@ -0,0 +1,262 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/datacoord/broker"
+	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const defaultPartitionKeyHotKeys = 10
+
+// getPartitionKeyPartitions returns the partitions of partition key of the collection ordered by the bucket index.
+func getPartitionKeyPartitions(ctx context.Context, b broker.Broker, collectionID int64) ([]int64, error) {
+	resp, err := b.ShowPartitions(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	partitions := make(map[string]int64, len(resp.GetPartitionNames()))
+	for i, name := range resp.GetPartitionNames() {
+		partitions[name] = resp.GetPartitionIDs()[i]
+	}
+	_, partitionIDs, err := typeutil.RearrangePartitionsForPartitionKey(partitions)
+	if err != nil {
+		return nil, err
+	}
+	return partitionIDs, nil
+}
+
+// needRepartition returns whether the rows of the segment are to be rewritten into the partitions of partition key
+// increased to buckets at repartitionTs. The segments started before the increasing might contain the rows placed
+// by the previous number of partitions, unless they are rewritten by repartition compaction already. The segments
+// started after repartitionTs are routed by the new partitions, as rootcoord allocates it after the proxies refreshed.
+func needRepartition(segment *SegmentInfo, buckets int64, repartitionTs Timestamp) bool {
+	return isSegmentHealthy(segment) &&
+		segment.GetLevel() != datapb.SegmentLevel_L0 &&
+		segment.GetPartitionKeyBuckets() != buckets &&
+		segment.GetStartPosition().GetTimestamp() <= repartitionTs
+}
+
+// sampleKeyStats estimates the key cardinality and the hot keys of the partition by the partition key values
+// of the latest flushed segments.
+func sampleKeyStats(ctx context.Context, cm storage.ChunkManager, field *schemapb.FieldSchema,
+	stat *datapb.PartitionKeyPartitionStats, segments []*SegmentInfo, topK int,
+) error {
+	sort.Slice(segments, func(i, j int) bool {
+		return segments[i].GetID() > segments[j].GetID()
+	})
+	if maxNum := Params.DataCoordCfg.PartitionKeyStatsSampleSegments.GetAsInt(); len(segments) > maxNum {
+		segments = segments[:maxNum]
+	}
+
+	counts := make(map[string]int64)
+	for _, segment := range segments {
+		keys, err := readPartitionKeys(ctx, cm, segment, field)
+		if err != nil {
+			return err
+		}
+		for _, key := range keys {
+			counts[key]++
+		}
+		stat.SampledRows += int64(len(keys))
+	}
+	stat.DistinctKeys = estimateDistinctKeys(counts, stat.GetSampledRows(), stat.GetNumRows())
+	stat.HotKeys = topKeys(counts, topK)
+	return nil
+}
+
+// readPartitionKeys reads the partition key values of the segment from its insert binlogs.
+func readPartitionKeys(ctx context.Context, cm storage.ChunkManager, segment *SegmentInfo, field *schemapb.FieldSchema) ([]string, error) {
+	cloned := segment.Clone()
+	fieldBinlogs := lo.Filter(cloned.GetBinlogs(), func(fieldBinlog *datapb.FieldBinlog, _ int) bool {
+		return fieldBinlog.GetFieldID() == field.GetFieldID()
+	})
+	if err := binlog.DecompressBinLog(storage.InsertBinlog, cloned.GetCollectionID(), cloned.GetPartitionID(), cloned.GetID(), fieldBinlogs); err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, segment.GetNumOfRows())
+	for _, fieldBinlog := range fieldBinlogs {
+		for _, l := range fieldBinlog.GetBinlogs() {
+			data, err := cm.Read(ctx, l.GetLogPath())
+			if err != nil {
+				return nil, err
+			}
+			reader, err := storage.NewBinlogReader(data)
+			if err != nil {
+				return nil, err
+			}
+			keys, err = appendPartitionKeys(keys, reader, field.GetDataType())
+			reader.Close()
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return keys, nil
+}
+
+func appendPartitionKeys(keys []string, reader *storage.BinlogReader, dataType schemapb.DataType) ([]string, error) {
+	for {
+		event, err := reader.NextEventReader()
+		if err != nil {
+			return nil, err
+		}
+		if event == nil {
+			return keys, nil
+		}
+		switch dataType {
+		case schemapb.DataType_Int64:
+			values, _, err := event.GetInt64FromPayload()
+			if err != nil {
+				return nil, err
+			}
+			for _, value := range values {
+				keys = append(keys, strconv.FormatInt(value, 10))
+			}
+		case schemapb.DataType_VarChar:
+			values, _, err := event.GetStringFromPayload()
+			if err != nil {
+				return nil, err
+			}
+			keys = append(keys, values...)
+		default:
+			return nil, merr.WrapErrParameterInvalidMsg("unsupported partition key type %s", dataType.String())
+		}
+	}
+}
+
+// estimateDistinctKeys estimates the number of distinct keys of the total rows by the key counts of the sampled rows,
+// with the GEE estimator: sqrt(total/sampled) * f1 + sum(fj) for j >= 2, where fj is the number of keys sampled j times.
+func estimateDistinctKeys(counts map[string]int64, sampledRows, totalRows int64) int64 {
+	if sampledRows == 0 {
+		return 0
+	}
+	if sampledRows >= totalRows {
+		return int64(len(counts))
+	}
+	var once, more int64
+	for _, count := range counts {
+		if count == 1 {
+			once++
+		} else {
+			more++
+		}
+	}
+	estimated := int64(math.Sqrt(float64(totalRows)/float64(sampledRows))*float64(once)) + more
+	return min(estimated, totalRows)
+}
+
+// topKeys returns the k most frequent keys, ordered by the count descending.
+func topKeys(counts map[string]int64, k int) []*datapb.PartitionKeyHotKey {
+	hotKeys := make([]*datapb.PartitionKeyHotKey, 0, len(counts))
+	for key, count := range counts {
+		hotKeys = append(hotKeys, &datapb.PartitionKeyHotKey{Key: key, Count: count})
+	}
+	sort.Slice(hotKeys, func(i, j int) bool {
+		if hotKeys[i].GetCount() != hotKeys[j].GetCount() {
+			return hotKeys[i].GetCount() > hotKeys[j].GetCount()
+		}
+		return hotKeys[i].GetKey() < hotKeys[j].GetKey()
+	})
+	if len(hotKeys) > k {
+		hotKeys = hotKeys[:k]
+	}
+	return hotKeys
+}
+
+// GetPartitionKeyStats returns the stats of the partitions of partition key. The key cardinality and the hot keys
+// are estimated by sampling the partition key values of the flushed segments if required.
+func (s *Server) GetPartitionKeyStats(ctx context.Context, req *datapb.GetPartitionKeyStatsRequest) (*datapb.GetPartitionKeyStatsResponse, error) {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", req.GetCollectionID()))
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.GetPartitionKeyStatsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	coll, err := s.handler.GetCollection(ctx, req.GetCollectionID())
+	if err != nil {
+		log.Warn("failed to get collection", zap.Error(err))
+		return &datapb.GetPartitionKeyStatsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	if coll == nil {
+		return &datapb.GetPartitionKeyStatsResponse{
+			Status: merr.Status(merr.WrapErrCollectionNotFound(req.GetCollectionID())),
+		}, nil
+	}
+	field, err := typeutil.GetPartitionKeyFieldSchema(coll.Schema)
+	if err != nil {
+		return &datapb.GetPartitionKeyStatsResponse{
+			Status: merr.Status(merr.WrapErrParameterInvalidMsg("collection %d has no partition key", coll.ID)),
+		}, nil
+	}
+	_, repartitionTs, err := common.PartitionKeyRepartition(coll.Properties)
+	if err != nil {
+		return &datapb.GetPartitionKeyStatsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	partitionIDs, err := getPartitionKeyPartitions(ctx, s.broker, coll.ID)
+	if err != nil {
+		log.Warn("failed to get partitions of partition key", zap.Error(err))
+		return &datapb.GetPartitionKeyStatsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	resp := s.meta.GetPartitionKeyStats(coll.ID, partitionIDs, repartitionTs)
+	if !req.GetWithKeyStats() {
+		return resp, nil
+	}
+	topK := int(req.GetTopK())
+	if topK <= 0 {
+		topK = defaultPartitionKeyHotKeys
+	}
+	for _, stat := range resp.GetPartitions() {
+		segments := s.meta.SelectSegments(WithCollection(coll.ID), SegmentFilterFunc(func(segment *SegmentInfo) bool {
+			return segment.GetPartitionID() == stat.GetPartitionID() &&
+				segment.GetState() == commonpb.SegmentState_Flushed &&
+				segment.GetLevel() != datapb.SegmentLevel_L0
+		}))
+		if err := sampleKeyStats(ctx, s.meta.chunkManager, field, stat, segments, topK); err != nil {
+			log.Warn("failed to sample partition key stats", zap.Int64("partitionID", stat.GetPartitionID()), zap.Error(err))
+			return &datapb.GetPartitionKeyStatsResponse{
+				Status: merr.Status(err),
+			}, nil
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,194 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus/internal/datacoord/broker"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func newPartitionKeyTestSegment(id, partitionID int64, numRows int64, startTs Timestamp, buckets int64) *SegmentInfo {
+	return NewSegmentInfo(&datapb.SegmentInfo{
+		ID:                  id,
+		CollectionID:        1,
+		PartitionID:         partitionID,
+		InsertChannel:       "ch-1",
+		NumOfRows:           numRows,
+		State:               commonpb.SegmentState_Flushed,
+		Level:               datapb.SegmentLevel_L1,
+		StartPosition:       &msgpb.MsgPosition{Timestamp: startTs},
+		PartitionKeyBuckets: buckets,
+	})
+}
+
+func newPartitionKeyTestBroker(t *testing.T, num int) *broker.MockBroker {
+	resp := &milvuspb.ShowPartitionsResponse{Status: merr.Success()}
+	// in the reversed order to check the partitions are ordered by bucket index
+	for i := num - 1; i >= 0; i-- {
+		resp.PartitionNames = append(resp.PartitionNames, fmt.Sprintf("_default_%d", i))
+		resp.PartitionIDs = append(resp.PartitionIDs, int64(10+i))
+	}
+	b := broker.NewMockBroker(t)
+	b.EXPECT().ShowPartitions(mock.Anything, int64(1)).Return(resp, nil)
+	return b
+}
+
+func TestNeedRepartition(t *testing.T) {
+	assert.True(t, needRepartition(newPartitionKeyTestSegment(1, 10, 1, 100, 0), 4, 200))
+	assert.True(t, needRepartition(newPartitionKeyTestSegment(1, 10, 1, 100, 2), 4, 200))
+	// rewritten already
+	assert.False(t, needRepartition(newPartitionKeyTestSegment(1, 10, 1, 100, 4), 4, 200))
+	// started after repartition
+	assert.False(t, needRepartition(newPartitionKeyTestSegment(1, 10, 1, 300, 0), 4, 200))
+
+	segment := newPartitionKeyTestSegment(1, 10, 1, 100, 0)
+	segment.Level = datapb.SegmentLevel_L0
+	assert.False(t, needRepartition(segment, 4, 200))
+	segment = newPartitionKeyTestSegment(1, 10, 1, 100, 0)
+	segment.State = commonpb.SegmentState_Dropped
+	assert.False(t, needRepartition(segment, 4, 200))
+}
+
+func TestEstimateDistinctKeys(t *testing.T) {
+	counts := map[string]int64{"a": 1, "b": 1, "c": 3, "d": 5}
+	assert.EqualValues(t, 0, estimateDistinctKeys(counts, 0, 100))
+	// all rows sampled
+	assert.EqualValues(t, 4, estimateDistinctKeys(counts, 10, 10))
+	// sqrt(40/10) * 2 + 2
+	assert.EqualValues(t, 6, estimateDistinctKeys(counts, 10, 40))
+}
+
+func TestTopKeys(t *testing.T) {
+	counts := map[string]int64{"a": 1, "b": 3, "c": 3, "d": 5}
+	hotKeys := topKeys(counts, 3)
+	require.Len(t, hotKeys, 3)
+	assert.Equal(t, "d", hotKeys[0].GetKey())
+	assert.EqualValues(t, 5, hotKeys[0].GetCount())
+	assert.Equal(t, "b", hotKeys[1].GetKey())
+	assert.Equal(t, "c", hotKeys[2].GetKey())
+	assert.Len(t, topKeys(counts, 10), 4)
+}
+
+func TestGetPartitionKeyBuckets(t *testing.T) {
+	assert.EqualValues(t, 0, getPartitionKeyBuckets(nil))
+	assert.EqualValues(t, 4, getPartitionKeyBuckets([]*SegmentInfo{
+		newPartitionKeyTestSegment(1, 10, 1, 100, 4),
+		newPartitionKeyTestSegment(2, 10, 1, 100, 4),
+	}))
+	assert.EqualValues(t, 0, getPartitionKeyBuckets([]*SegmentInfo{
+		newPartitionKeyTestSegment(1, 10, 1, 100, 4),
+		newPartitionKeyTestSegment(2, 10, 1, 100, 0),
+	}))
+}
+
+func TestMeta_GetPartitionKeyStats(t *testing.T) {
+	segments := NewSegmentsInfo()
+	for _, segment := range []*SegmentInfo{
+		newPartitionKeyTestSegment(1, 10, 10, 100, 0),
+		newPartitionKeyTestSegment(2, 10, 20, 100, 3),
+		newPartitionKeyTestSegment(3, 11, 30, 300, 0),
+		newPartitionKeyTestSegment(4, 12, 40, 100, 2),
+	} {
+		segments.SetSegment(segment.GetID(), segment)
+	}
+	m := &meta{segments: segments}
+
+	resp := m.GetPartitionKeyStats(1, []int64{10, 11, 12}, 200)
+	require.Len(t, resp.GetPartitions(), 3)
+	assert.EqualValues(t, 30, resp.GetPartitions()[0].GetNumRows())
+	assert.EqualValues(t, 2, resp.GetPartitions()[0].GetNumSegments())
+	assert.EqualValues(t, 30, resp.GetPartitions()[1].GetNumRows())
+	assert.EqualValues(t, 40, resp.GetPartitions()[2].GetNumRows())
+	assert.EqualValues(t, 2, resp.GetRepartitionSegments())
+	assert.EqualValues(t, 50, resp.GetRepartitionRows())
+
+	// not repartitioning
+	resp = m.GetPartitionKeyStats(1, []int64{10, 11, 12}, 0)
+	assert.EqualValues(t, 0, resp.GetRepartitionSegments())
+}
+
+func TestRepartitionCompactionPolicy_Trigger(t *testing.T) {
+	paramtable.Init()
+	segments := NewSegmentsInfo()
+	for _, segment := range []*SegmentInfo{
+		newPartitionKeyTestSegment(1, 10, 10, 100, 0),
+		newPartitionKeyTestSegment(2, 11, 20, 100, 0),
+		newPartitionKeyTestSegment(3, 10, 30, 100, 3),
+		newPartitionKeyTestSegment(4, 10, 40, 300, 0),
+	} {
+		segments.SetSegment(segment.GetID(), segment)
+	}
+	segments.SetIsCompacting(2, true)
+	coll := &collectionInfo{
+		ID: 1,
+		Properties: map[string]string{
+			common.PartitionKeyRepartitionFromKey: "2",
+			common.PartitionKeyRepartitionTsKey:   "200",
+		},
+	}
+	m := &meta{segments: segments, collections: map[UniqueID]*collectionInfo{
+		1: coll,
+		2: {ID: 2},
+	}}
+	handler := NewNMockHandler(t)
+	handler.EXPECT().GetCollection(mock.Anything, int64(1)).Return(coll, nil)
+
+	policy := newRepartitionCompactionPolicy(m, handler, newPartitionKeyTestBroker(t, 3))
+	events, err := policy.Trigger()
+	require.NoError(t, err)
+	views := events[TriggerTypeRepartition]
+	require.Len(t, views, 1)
+	view := views[0].(*RepartitionSegmentsView)
+	assert.Equal(t, []int64{10, 11, 12}, view.targetPartitionIDs)
+	require.Len(t, view.GetSegmentsView(), 1)
+	assert.EqualValues(t, 1, view.GetSegmentsView()[0].ID)
+	outView, _ := view.Trigger()
+	assert.Equal(t, view, outView)
+}
+
+func TestServer_GetPartitionKeyStats(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+
+	s := &Server{}
+	s.stateCode.Store(commonpb.StateCode_Abnormal)
+	resp, err := s.GetPartitionKeyStats(ctx, &datapb.GetPartitionKeyStatsRequest{CollectionID: 1})
+	assert.NoError(t, err)
+	assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+
+	s.stateCode.Store(commonpb.StateCode_Healthy)
+	handler := NewNMockHandler(t)
+	s.handler = handler
+	handler.EXPECT().GetCollection(mock.Anything, int64(2)).Return(&collectionInfo{ID: 2, Schema: newTestSchema()}, nil)
+	resp, err = s.GetPartitionKeyStats(ctx, &datapb.GetPartitionKeyStatsRequest{CollectionID: 2})
+	assert.NoError(t, err)
+	assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+}
@@ -51,6 +51,8 @@ type SegmentInfo struct {
 	allocations   []*Allocation
 	lastFlushTime time.Time
 	isCompacting  bool
+	// marked broken by the fsck tool, excluded from loading and compaction
+	isBroken bool
 	// a cache to avoid calculate twice
 	size            atomic.Int64
 	lastWrittenTime time.Time
@@ -310,6 +312,7 @@ func (s *SegmentInfo) Clone(opts ...SegmentInfoOption) *SegmentInfo {
 		allocations:   s.allocations,
 		lastFlushTime: s.lastFlushTime,
 		isCompacting:  s.isCompacting,
+		isBroken:      s.isBroken,
 		// cannot copy size, since binlog may be changed
 		lastWrittenTime: s.lastWrittenTime,
 	}
@@ -327,6 +330,7 @@ func (s *SegmentInfo) ShadowClone(opts ...SegmentInfoOption) *SegmentInfo {
 		allocations:     s.allocations,
 		lastFlushTime:   s.lastFlushTime,
 		isCompacting:    s.isCompacting,
+		isBroken:        s.isBroken,
 		lastWrittenTime: s.lastWrittenTime,
 	}
 	cloned.size.Store(s.size.Load())
@@ -458,6 +462,13 @@ func SetIsCompacting(isCompacting bool) SegmentInfoOption {
 	}
 }
 
+// SetIsBroken is the option to set the broken mark for segment info
+func SetIsBroken(isBroken bool) SegmentInfoOption {
+	return func(segment *SegmentInfo) {
+		segment.isBroken = isBroken
+	}
+}
+
 // SetLevel is the option to set level for segment info
 func SetLevel(level datapb.SegmentLevel) SegmentInfoOption {
 	return func(segment *SegmentInfo) {
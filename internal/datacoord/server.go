@@ -651,7 +651,7 @@ func (s *Server) initIndexNodeManager() {
 
 func (s *Server) initCompaction() {
 	s.compactionHandler = newCompactionPlanHandler(s.cluster, s.sessionManager, s.channelManager, s.meta, s.allocator, s.taskScheduler, s.handler)
-	s.compactionTriggerManager = NewCompactionTriggerManager(s.allocator, s.handler, s.compactionHandler, s.meta)
+	s.compactionTriggerManager = NewCompactionTriggerManager(s.allocator, s.handler, s.compactionHandler, s.meta, s.broker)
 	s.compactionTrigger = newCompactionTrigger(s.meta, s.compactionHandler, s.allocator, s.handler, s.indexEngineVersionManager)
 }
 
@@ -1081,7 +1081,7 @@ func (s *Server) loadCollectionFromRootCoord(ctx context.Context, collectionID i
 	if err != nil {
 		return err
 	}
-	partitionIDs, err := s.broker.ShowPartitionsInternal(ctx, collectionID)
+	partitions, err := s.broker.ShowPartitions(ctx, collectionID)
 	if err != nil {
 		return err
 	}
@@ -1094,7 +1094,8 @@ func (s *Server) loadCollectionFromRootCoord(ctx context.Context, collectionID i
 	collInfo := &collectionInfo{
 		ID:             resp.CollectionID,
 		Schema:         resp.Schema,
-		Partitions:     partitionIDs,
+		Partitions:     partitions.GetPartitionIDs(),
+		PartitionNames: getPartitionNames(partitions.GetPartitionIDs(), partitions.GetPartitionNames()),
 		StartPositions: resp.GetStartPositions(),
 		Properties:     properties,
 		CreatedAt:      resp.GetCreatedTimestamp(),
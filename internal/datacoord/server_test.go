@@ -1424,6 +1424,17 @@ func TestGetQueryVChanPositions(t *testing.T) {
 		assert.EqualValues(t, 1, len(infos.GetLevelZeroSegmentIds()))
 	})
 
+	t.Run("skip broken segment", func(t *testing.T) {
+		infos := svr.handler.GetQueryVChanPositions(&channelMeta{Name: "ch1", CollectionID: 0}, 1)
+		assert.Contains(t, infos.GetUnflushedSegmentIds(), int64(3))
+
+		segment := svr.meta.GetSegment(3)
+		svr.meta.segments.SetSegment(3, segment.ShadowClone(SetIsBroken(true)))
+		defer svr.meta.segments.SetSegment(3, segment)
+		infos = svr.handler.GetQueryVChanPositions(&channelMeta{Name: "ch1", CollectionID: 0}, 1)
+		assert.NotContains(t, infos.GetUnflushedSegmentIds(), int64(3))
+	})
+
 	t.Run("empty collection with passed positions", func(t *testing.T) {
 		vchannel := "ch_no_segment_1"
 		pchannel := funcutil.ToPhysicalChannel(vchannel)
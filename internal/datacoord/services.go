@@ -31,6 +31,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
@@ -338,6 +339,22 @@ func (s *Server) GetCollectionStatistics(ctx context.Context, req *datapb.GetCol
 	return resp, nil
 }
 
+// GetCollectionStorageUsage returns the object storage usage of the collection computed from meta
+func (s *Server) GetCollectionStorageUsage(ctx context.Context, req *internalpb.GetCollectionStorageUsageRequest) (*internalpb.GetCollectionStorageUsageResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+	)
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &internalpb.GetCollectionStorageUsageResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	resp := s.meta.GetCollectionStorageUsage(req.GetCollectionID())
+	log.Debug("success to get collection storage usage", zap.Any("response", resp))
+	return resp, nil
+}
+
 // GetPartitionStatistics returns statistics for partition
 // if partID is empty, return statistics for all partitions of the collection
 // for now only row count is returned
@@ -1501,6 +1518,7 @@ func (s *Server) BroadcastAlteredCollection(ctx context.Context, req *datapb.Alt
 			ID:             req.GetCollectionID(),
 			Schema:         req.GetSchema(),
 			Partitions:     req.GetPartitionIDs(),
+			PartitionNames: getPartitionNames(req.GetPartitionIDs(), req.GetPartitionNames()),
 			StartPositions: req.GetStartPositions(),
 			Properties:     properties,
 			DatabaseID:     req.GetDbID(),
@@ -1511,10 +1529,68 @@ func (s *Server) BroadcastAlteredCollection(ctx context.Context, req *datapb.Alt
 	}
 
 	clonedColl.Properties = properties
+	if names := getPartitionNames(req.GetPartitionIDs(), req.GetPartitionNames()); names != nil {
+		clonedColl.PartitionNames = names
+	}
+	// the schema may be changed by adding fields online
+	oldSchema := clonedColl.Schema
+	fieldsAdded := false
+	if len(req.GetSchema().GetFields()) > 0 {
+		currentFields := typeutil.NewSet(lo.Map(oldSchema.GetFields(), func(field *schemapb.FieldSchema, _ int) int64 {
+			return field.GetFieldID()
+		})...)
+		fieldsAdded = lo.ContainsBy(req.GetSchema().GetFields(), func(field *schemapb.FieldSchema) bool {
+			return !currentFields.Contain(field.GetFieldID())
+		})
+		clonedColl.Schema = req.GetSchema()
+	}
 	s.meta.AddCollection(clonedColl)
+
+	if fieldsAdded {
+		if err := s.updateChannelSchema(ctx, req.GetCollectionID(), req.GetSchema()); err != nil {
+			// restore the previous schema, so that the retried request notifies the datanodes again
+			restored := s.meta.GetClonedCollectionInfo(req.GetCollectionID())
+			restored.Schema = oldSchema
+			s.meta.AddCollection(restored)
+			return merr.Status(err), nil
+		}
+	}
 	return merr.Success(), nil
 }
 
+// updateChannelSchema seals the growing segments of the collection, so that rows carrying the added fields
+// are written into new segments, and notifies the datanodes to update the schema of the watched channels.
+func (s *Server) updateChannelSchema(ctx context.Context, collectionID int64, schema *schemapb.CollectionSchema) error {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", collectionID))
+	sealedSegmentIDs, err := s.segmentManager.SealAllSegments(ctx, collectionID, nil)
+	if err != nil {
+		log.Warn("failed to seal segments after fields added", zap.Error(err))
+		return err
+	}
+
+	err = retry.Do(ctx, func() error {
+		nodeChannels := s.channelManager.GetNodeChannelsByCollectionID(collectionID)
+		for nodeID, channelNames := range nodeChannels {
+			err := s.cluster.UpdateChannelSchema(ctx, nodeID, collectionID, channelNames, schema)
+			if err != nil && errors.Is(err, merr.ErrServiceUnimplemented) {
+				// datanode of older version, the schema is updated after the channel is watched again
+				log.Warn("DataNode UpdateChannelSchema unimplemented", zap.Int64("nodeID", nodeID))
+				continue
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}, retry.Attempts(60)) // about 3min
+	if err != nil {
+		log.Warn("failed to update channel schema", zap.Error(err))
+		return err
+	}
+	log.Info("channel schema updated after fields added", zap.Int64s("sealedSegments", sealedSegmentIDs))
+	return nil
+}
+
 func (s *Server) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
 		return &milvuspb.CheckHealthResponse{
@@ -1595,6 +1671,29 @@ func (s *Server) GcControl(ctx context.Context, request *datapb.GcControlRequest
 	return status, nil
 }
 
+// RunGarbageCollection runs a manual garbage collection pass, which could be restricted to one collection,
+// and reports the garbage without removing anything in dry-run mode.
+func (s *Server) RunGarbageCollection(ctx context.Context, request *datapb.RunGarbageCollectionRequest) (*datapb.RunGarbageCollectionResponse, error) {
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.RunGarbageCollectionResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	candidates, err := s.garbageCollector.RunOnce(ctx, request.GetCollectionID(), request.GetDryRun())
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to run garbage collection", zap.Int64("collectionID", request.GetCollectionID()),
+			zap.Bool("dryRun", request.GetDryRun()), zap.Error(err))
+		return &datapb.RunGarbageCollectionResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	return &datapb.RunGarbageCollectionResponse{
+		Status:     merr.Success(),
+		Candidates: candidates,
+	}, nil
+}
+
 func (s *Server) ImportV2(ctx context.Context, in *internalpb.ImportRequestInternal) (*internalpb.ImportResponse, error) {
 	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
 		return &internalpb.ImportResponse{
@@ -1646,11 +1745,23 @@ func (s *Server) ImportV2(ctx context.Context, in *internalpb.ImportRequestInter
 		log.Info("list binlogs prefixes for import", zap.Any("binlog_prefixes", files))
 	}
 
-	idStart, _, err := s.allocator.allocN(int64(len(files)) + 1)
+	job, err := s.addImportJob(in, files, timeoutTs)
 	if err != nil {
-		resp.Status = merr.Status(merr.WrapErrImportFailed(fmt.Sprint("alloc id failed, err=%w", err)))
+		resp.Status = merr.Status(err)
 		return resp, nil
 	}
+
+	resp.JobID = fmt.Sprint(job.GetJobID())
+	log.Info("add import job done", zap.Int64("jobID", job.GetJobID()), zap.Any("files", files))
+	return resp, nil
+}
+
+// addImportJob allocates ids for the import job and its files, and adds the job to import meta.
+func (s *Server) addImportJob(in *internalpb.ImportRequestInternal, files []*internalpb.ImportFile, timeoutTs uint64) (*importJob, error) {
+	idStart, _, err := s.allocator.allocN(int64(len(files)) + 1)
+	if err != nil {
+		return nil, merr.WrapErrImportFailed(fmt.Sprint("alloc id failed, err=%w", err))
+	}
 	files = lo.Map(files, func(importFile *internalpb.ImportFile, i int) *internalpb.ImportFile {
 		importFile.Id = idStart + int64(i) + 1
 		return importFile
@@ -1674,13 +1785,9 @@ func (s *Server) ImportV2(ctx context.Context, in *internalpb.ImportRequestInter
 	}
 	err = s.importMeta.AddJob(job)
 	if err != nil {
-		resp.Status = merr.Status(merr.WrapErrImportFailed(fmt.Sprint("add import job failed, err=%w", err)))
-		return resp, nil
+		return nil, merr.WrapErrImportFailed(fmt.Sprint("add import job failed, err=%w", err))
 	}
-
-	resp.JobID = fmt.Sprint(job.GetJobID())
-	log.Info("add import job done", zap.Int64("jobID", job.GetJobID()), zap.Any("files", files))
-	return resp, nil
+	return job, nil
 }
 
 func (s *Server) GetImportProgress(ctx context.Context, in *internalpb.GetImportProgressRequest) (*internalpb.GetImportProgressResponse, error) {
@@ -720,6 +720,57 @@ func TestBroadcastAlteredCollection(t *testing.T) {
 		assert.NotNil(t, resp)
 		assert.NoError(t, err)
 		assert.NotNil(t, s.meta.collections[1].Properties)
+		assert.Nil(t, s.meta.collections[1].Schema)
+	})
+
+	t.Run("test update schema", func(t *testing.T) {
+		segmentManager := NewMockManager(t)
+		segmentManager.EXPECT().SealAllSegments(mock.Anything, int64(1), mock.Anything).Return([]int64{1000}, nil).Once()
+		channelManager := NewMockChannelManager(t)
+		channelManager.EXPECT().GetNodeChannelsByCollectionID(int64(1)).Return(map[int64][]string{1: {"ch-1"}})
+		cluster := NewMockCluster(t)
+		cluster.EXPECT().UpdateChannelSchema(mock.Anything, int64(1), int64(1), []string{"ch-1"}, mock.Anything).Return(nil).Once()
+		s := &Server{
+			meta: &meta{collections: map[UniqueID]*collectionInfo{
+				1: {ID: 1, Schema: &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{{FieldID: 100}}}},
+			}},
+			segmentManager: segmentManager,
+			channelManager: channelManager,
+			cluster:        cluster,
+		}
+		s.stateCode.Store(commonpb.StateCode_Healthy)
+		req := &datapb.AlterCollectionRequest{
+			CollectionID: 1,
+			Schema:       &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{{FieldID: 100}, {FieldID: 101, Nullable: true}}},
+		}
+
+		resp, err := s.BroadcastAlteredCollection(context.Background(), req)
+		assert.NoError(t, merr.CheckRPCCall(resp, err))
+		assert.Len(t, s.meta.collections[1].Schema.GetFields(), 2)
+
+		// no field added, datanodes are not notified again
+		resp, err = s.BroadcastAlteredCollection(context.Background(), req)
+		assert.NoError(t, merr.CheckRPCCall(resp, err))
+	})
+
+	t.Run("test update schema failed", func(t *testing.T) {
+		segmentManager := NewMockManager(t)
+		segmentManager.EXPECT().SealAllSegments(mock.Anything, int64(1), mock.Anything).Return(nil, errors.New("mock"))
+		s := &Server{
+			meta: &meta{collections: map[UniqueID]*collectionInfo{
+				1: {ID: 1, Schema: &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{{FieldID: 100}}}},
+			}},
+			segmentManager: segmentManager,
+		}
+		s.stateCode.Store(commonpb.StateCode_Healthy)
+		req := &datapb.AlterCollectionRequest{
+			CollectionID: 1,
+			Schema:       &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{{FieldID: 100}, {FieldID: 101, Nullable: true}}},
+		}
+
+		resp, err := s.BroadcastAlteredCollection(context.Background(), req)
+		assert.Error(t, merr.CheckRPCCall(resp, err))
+		assert.Len(t, s.meta.collections[1].Schema.GetFields(), 1)
 	})
 }
 
@@ -58,6 +58,7 @@ type SessionManager interface {
 
 	Flush(ctx context.Context, nodeID int64, req *datapb.FlushSegmentsRequest)
 	FlushChannels(ctx context.Context, nodeID int64, req *datapb.FlushChannelsRequest) error
+	UpdateChannelSchema(ctx context.Context, nodeID int64, req *datapb.UpdateChannelSchemaRequest) error
 	Compaction(ctx context.Context, nodeID int64, plan *datapb.CompactionPlan) error
 	SyncSegments(nodeID int64, req *datapb.SyncSegmentsRequest) error
 	GetCompactionPlanResult(nodeID int64, planID int64) (*datapb.CompactionPlanResult, error)
@@ -373,6 +374,26 @@ func (c *SessionManagerImpl) FlushChannels(ctx context.Context, nodeID int64, re
 	return nil
 }
 
+func (c *SessionManagerImpl) UpdateChannelSchema(ctx context.Context, nodeID int64, req *datapb.UpdateChannelSchemaRequest) error {
+	log := log.Ctx(ctx).With(zap.Int64("nodeID", nodeID),
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Strings("channels", req.GetChannels()))
+	cli, err := c.getClient(ctx, nodeID)
+	if err != nil {
+		log.Warn("failed to get client", zap.Error(err))
+		return err
+	}
+
+	resp, err := cli.UpdateChannelSchema(ctx, req)
+	err = VerifyResponse(resp, err)
+	if err != nil {
+		log.Warn("SessionManagerImpl.UpdateChannelSchema failed", zap.Error(err))
+		return err
+	}
+	log.Info("SessionManagerImpl.UpdateChannelSchema successfully")
+	return nil
+}
+
 func (c *SessionManagerImpl) NotifyChannelOperation(ctx context.Context, nodeID int64, req *datapb.ChannelOperationsRequest) error {
 	log := log.Ctx(ctx).With(zap.Int64("nodeID", nodeID))
 	cli, err := c.getClient(ctx, nodeID)
@@ -0,0 +1,178 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/timerecord"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// snapshotMeta holds the snapshots of collections, the segments and index files
+// referenced by a snapshot are pinned and never recycled by the garbage collector.
+type snapshotMeta struct {
+	sync.RWMutex
+
+	ctx     context.Context
+	catalog metastore.DataCoordCatalog
+
+	// snapshotID -> snapshot
+	snapshots map[int64]*datapb.SnapshotInfo
+	// segmentID -> referenced snapshot count
+	pinnedSegments map[int64]int
+	// buildID -> referenced snapshot count
+	pinnedBuilds map[int64]int
+}
+
+func newSnapshotMeta(ctx context.Context, catalog metastore.DataCoordCatalog) (*snapshotMeta, error) {
+	sm := &snapshotMeta{
+		ctx:            ctx,
+		catalog:        catalog,
+		snapshots:      make(map[int64]*datapb.SnapshotInfo),
+		pinnedSegments: make(map[int64]int),
+		pinnedBuilds:   make(map[int64]int),
+	}
+	if err := sm.reloadFromKV(); err != nil {
+		return nil, err
+	}
+	return sm, nil
+}
+
+func (m *snapshotMeta) reloadFromKV() error {
+	record := timerecord.NewTimeRecorder("snapshotMeta-reloadFromKV")
+	snapshots, err := m.catalog.ListSnapshots(m.ctx)
+	if err != nil {
+		log.Warn("snapshotMeta reloadFromKV load snapshots failed", zap.Error(err))
+		return err
+	}
+	for _, snapshot := range snapshots {
+		m.addSnapshot(snapshot)
+	}
+	log.Info("snapshotMeta reloadFromKV done", zap.Int("snapshotNum", len(snapshots)),
+		zap.Duration("duration", record.ElapseSpan()))
+	return nil
+}
+
+func (m *snapshotMeta) addSnapshot(snapshot *datapb.SnapshotInfo) {
+	m.snapshots[snapshot.GetSnapshotID()] = snapshot
+	for _, segment := range snapshot.GetSegments() {
+		m.pinnedSegments[segment.GetSegmentID()]++
+		for _, buildID := range segment.GetBuildIDs() {
+			m.pinnedBuilds[buildID]++
+		}
+	}
+}
+
+func (m *snapshotMeta) removeSnapshot(snapshot *datapb.SnapshotInfo) {
+	delete(m.snapshots, snapshot.GetSnapshotID())
+	for _, segment := range snapshot.GetSegments() {
+		if m.pinnedSegments[segment.GetSegmentID()]--; m.pinnedSegments[segment.GetSegmentID()] <= 0 {
+			delete(m.pinnedSegments, segment.GetSegmentID())
+		}
+		for _, buildID := range segment.GetBuildIDs() {
+			if m.pinnedBuilds[buildID]--; m.pinnedBuilds[buildID] <= 0 {
+				delete(m.pinnedBuilds, buildID)
+			}
+		}
+	}
+}
+
+// AddSnapshot persists the snapshot and pins its segments and index files.
+func (m *snapshotMeta) AddSnapshot(snapshot *datapb.SnapshotInfo) error {
+	m.Lock()
+	defer m.Unlock()
+
+	if err := m.catalog.SaveSnapshot(m.ctx, snapshot); err != nil {
+		log.Warn("save snapshot failed", zap.Int64("snapshotID", snapshot.GetSnapshotID()), zap.Error(err))
+		return err
+	}
+	m.addSnapshot(snapshot)
+	log.Info("add snapshot", zap.Int64("snapshotID", snapshot.GetSnapshotID()),
+		zap.String("name", snapshot.GetName()), zap.Int64("collectionID", snapshot.GetCollectionID()),
+		zap.Int("segmentNum", len(snapshot.GetSegments())))
+	return nil
+}
+
+// DropSnapshot removes the snapshot, the segments and index files only referenced by it become recyclable.
+func (m *snapshotMeta) DropSnapshot(snapshotID int64) error {
+	m.Lock()
+	defer m.Unlock()
+
+	snapshot, ok := m.snapshots[snapshotID]
+	if !ok {
+		return nil
+	}
+	if err := m.catalog.DropSnapshot(m.ctx, snapshotID); err != nil {
+		log.Warn("drop snapshot failed", zap.Int64("snapshotID", snapshotID), zap.Error(err))
+		return err
+	}
+	m.removeSnapshot(snapshot)
+	log.Info("drop snapshot", zap.Int64("snapshotID", snapshotID), zap.String("name", snapshot.GetName()))
+	return nil
+}
+
+func (m *snapshotMeta) GetSnapshot(snapshotID int64) *datapb.SnapshotInfo {
+	m.RLock()
+	defer m.RUnlock()
+	return m.snapshots[snapshotID]
+}
+
+// GetSnapshotByName returns the snapshot of the collection with the name, nil if not exist.
+func (m *snapshotMeta) GetSnapshotByName(collectionID int64, name string) *datapb.SnapshotInfo {
+	m.RLock()
+	defer m.RUnlock()
+	for _, snapshot := range m.snapshots {
+		if snapshot.GetCollectionID() == collectionID && snapshot.GetName() == name {
+			return snapshot
+		}
+	}
+	return nil
+}
+
+// ListSnapshots returns the snapshots of the collection, all snapshots are returned if collectionID is zero.
+func (m *snapshotMeta) ListSnapshots(collectionID int64) []*datapb.SnapshotInfo {
+	m.RLock()
+	defer m.RUnlock()
+	snapshots := make([]*datapb.SnapshotInfo, 0, len(m.snapshots))
+	for _, snapshot := range m.snapshots {
+		if collectionID == 0 || snapshot.GetCollectionID() == collectionID {
+			snapshots = append(snapshots, snapshot)
+		}
+	}
+	return snapshots
+}
+
+// IsSegmentPinned returns whether the segment is referenced by any snapshot.
+func (m *snapshotMeta) IsSegmentPinned(segmentID typeutil.UniqueID) bool {
+	m.RLock()
+	defer m.RUnlock()
+	return m.pinnedSegments[segmentID] > 0
+}
+
+// IsBuildPinned returns whether the index files of the build are referenced by any snapshot.
+func (m *snapshotMeta) IsBuildPinned(buildID typeutil.UniqueID) bool {
+	m.RLock()
+	defer m.RUnlock()
+	return m.pinnedBuilds[buildID] > 0
+}
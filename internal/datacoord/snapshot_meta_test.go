@@ -0,0 +1,95 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+func TestSnapshotMeta(t *testing.T) {
+	ctx := context.Background()
+	catalog := mocks.NewDataCoordCatalog(t)
+	catalog.EXPECT().ListSnapshots(mock.Anything).Return([]*datapb.SnapshotInfo{
+		{
+			SnapshotID:   1,
+			Name:         "s1",
+			CollectionID: 100,
+			Segments: []*datapb.SnapshotSegment{
+				{SegmentID: 1000, BuildIDs: []int64{2000}},
+				{SegmentID: 1001},
+			},
+		},
+	}, nil)
+	sm, err := newSnapshotMeta(ctx, catalog)
+	require.NoError(t, err)
+	assert.True(t, sm.IsSegmentPinned(1000))
+	assert.True(t, sm.IsBuildPinned(2000))
+	assert.False(t, sm.IsSegmentPinned(1002))
+
+	catalog.EXPECT().SaveSnapshot(mock.Anything, mock.Anything).Return(nil).Once()
+	err = sm.AddSnapshot(&datapb.SnapshotInfo{
+		SnapshotID:   2,
+		Name:         "s2",
+		CollectionID: 100,
+		Segments: []*datapb.SnapshotSegment{
+			{SegmentID: 1001},
+			{SegmentID: 1002, BuildIDs: []int64{2002}},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "s2", sm.GetSnapshotByName(100, "s2").GetName())
+	assert.Nil(t, sm.GetSnapshotByName(101, "s2"))
+	assert.Len(t, sm.ListSnapshots(100), 2)
+	assert.Len(t, sm.ListSnapshots(0), 2)
+	assert.Empty(t, sm.ListSnapshots(101))
+
+	catalog.EXPECT().SaveSnapshot(mock.Anything, mock.Anything).Return(errors.New("mock")).Once()
+	assert.Error(t, sm.AddSnapshot(&datapb.SnapshotInfo{SnapshotID: 3}))
+	assert.Nil(t, sm.GetSnapshot(3))
+
+	catalog.EXPECT().DropSnapshot(mock.Anything, int64(1)).Return(errors.New("mock")).Once()
+	assert.Error(t, sm.DropSnapshot(1))
+	assert.NotNil(t, sm.GetSnapshot(1))
+
+	catalog.EXPECT().DropSnapshot(mock.Anything, int64(1)).Return(nil).Once()
+	assert.NoError(t, sm.DropSnapshot(1))
+	assert.Nil(t, sm.GetSnapshot(1))
+	// segment 1001 is still pinned by snapshot 2
+	assert.False(t, sm.IsSegmentPinned(1000))
+	assert.False(t, sm.IsBuildPinned(2000))
+	assert.True(t, sm.IsSegmentPinned(1001))
+	assert.True(t, sm.IsBuildPinned(2002))
+
+	// drop the snapshot not exist
+	assert.NoError(t, sm.DropSnapshot(1))
+
+	t.Run("reload failed", func(t *testing.T) {
+		catalog := mocks.NewDataCoordCatalog(t)
+		catalog.EXPECT().ListSnapshots(mock.Anything).Return(nil, errors.New("mock"))
+		_, err := newSnapshotMeta(ctx, catalog)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,547 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// CreateSnapshot pins the flushed segments and the index files of a collection at current timestamp,
+// and persists the manifest so that the collection could be restored from it later.
+// The data not flushed yet is not included, flush the collection before creating snapshot if needed.
+func (s *Server) CreateSnapshot(ctx context.Context, req *datapb.CreateSnapshotRequest) (*datapb.CreateSnapshotResponse, error) {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", req.GetCollectionID()), zap.String("name", req.GetName()))
+	log.Info("receive CreateSnapshot request")
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.CreateSnapshotResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	if req.GetName() == "" {
+		return &datapb.CreateSnapshotResponse{
+			Status: merr.Status(merr.WrapErrParameterInvalidMsg("snapshot name should not be empty")),
+		}, nil
+	}
+	if s.meta.GetSnapshotMeta().GetSnapshotByName(req.GetCollectionID(), req.GetName()) != nil {
+		return &datapb.CreateSnapshotResponse{
+			Status: merr.Status(merr.WrapErrParameterInvalidMsg("snapshot %s already exists", req.GetName())),
+		}, nil
+	}
+
+	snapshot, err := s.buildSnapshot(ctx, req.GetCollectionID(), req.GetName())
+	if err != nil {
+		log.Warn("failed to build snapshot", zap.Error(err))
+		return &datapb.CreateSnapshotResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	if err := s.meta.GetSnapshotMeta().AddSnapshot(snapshot); err != nil {
+		log.Warn("failed to add snapshot", zap.Error(err))
+		return &datapb.CreateSnapshotResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	log.Info("CreateSnapshot done", zap.Int64("snapshotID", snapshot.GetSnapshotID()),
+		zap.Uint64("snapshotTs", snapshot.GetSnapshotTs()), zap.Int("segmentNum", len(snapshot.GetSegments())))
+	return &datapb.CreateSnapshotResponse{
+		Status:   merr.Success(),
+		Snapshot: snapshot,
+	}, nil
+}
+
+// buildSnapshot collects the manifest of the collection, the segments flushed before the snapshot ts are included.
+func (s *Server) buildSnapshot(ctx context.Context, collectionID int64, name string) (*datapb.SnapshotInfo, error) {
+	coll, err := s.broker.DescribeCollectionInternal(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	partitions, err := s.broker.ShowPartitions(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	ts, err := s.allocator.allocTimestamp(ctx)
+	if err != nil {
+		return nil, err
+	}
+	snapshotID, err := s.allocator.allocID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	segments := s.meta.SelectSegments(WithCollection(collectionID), SegmentFilterFunc(func(segment *SegmentInfo) bool {
+		return isSegmentHealthy(segment) && segment.GetState() == commonpb.SegmentState_Flushed && !segment.GetIsImporting()
+	}))
+	snapshotSegments := make([]*datapb.SnapshotSegment, 0, len(segments))
+	for _, segment := range segments {
+		buildIDs := make([]int64, 0)
+		for _, segIdx := range s.meta.indexMeta.GetSegmentIndexes(collectionID, segment.GetID()) {
+			if segIdx.IndexState == commonpb.IndexState_Finished {
+				buildIDs = append(buildIDs, segIdx.BuildID)
+			}
+		}
+		snapshotSegments = append(snapshotSegments, &datapb.SnapshotSegment{
+			SegmentID:   segment.GetID(),
+			PartitionID: segment.GetPartitionID(),
+			Level:       segment.GetLevel(),
+			NumOfRows:   segment.GetNumOfRows(),
+			BuildIDs:    buildIDs,
+		})
+	}
+
+	indexes := lo.Map(s.meta.indexMeta.GetIndexesForCollection(collectionID, ""), func(index *model.Index, _ int) *indexpb.IndexInfo {
+		return &indexpb.IndexInfo{
+			CollectionID:    index.CollectionID,
+			FieldID:         index.FieldID,
+			IndexName:       index.IndexName,
+			IndexID:         index.IndexID,
+			TypeParams:      index.TypeParams,
+			IndexParams:     index.IndexParams,
+			IsAutoIndex:     index.IsAutoIndex,
+			UserIndexParams: index.UserIndexParams,
+		}
+	})
+
+	return &datapb.SnapshotInfo{
+		SnapshotID:   snapshotID,
+		Name:         name,
+		CollectionID: collectionID,
+		DbName:       coll.GetDbName(),
+		Schema:       coll.GetSchema(),
+		SnapshotTs:   ts,
+		Partitions: lo.Map(partitions.GetPartitionIDs(), func(partitionID int64, i int) *datapb.SnapshotPartition {
+			return &datapb.SnapshotPartition{
+				PartitionID:   partitionID,
+				PartitionName: partitions.GetPartitionNames()[i],
+			}
+		}),
+		Segments:         snapshotSegments,
+		Indexes:          indexes,
+		Properties:       coll.GetProperties(),
+		ShardsNum:        coll.GetShardsNum(),
+		NumPartitions:    coll.GetNumPartitions(),
+		ConsistencyLevel: coll.GetConsistencyLevel(),
+		CreateTime:       time.Now().Unix(),
+	}, nil
+}
+
+func (s *Server) ListSnapshots(ctx context.Context, req *datapb.ListSnapshotsRequest) (*datapb.ListSnapshotsResponse, error) {
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.ListSnapshotsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	return &datapb.ListSnapshotsResponse{
+		Status:    merr.Success(),
+		Snapshots: s.meta.GetSnapshotMeta().ListSnapshots(req.GetCollectionID()),
+	}, nil
+}
+
+// DropSnapshot removes the snapshot, its segments and index files would be recycled by gc if not used anymore.
+func (s *Server) DropSnapshot(ctx context.Context, req *datapb.DropSnapshotRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.Int64("snapshotID", req.GetSnapshotID()))
+	log.Info("receive DropSnapshot request")
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	if err := s.meta.GetSnapshotMeta().DropSnapshot(req.GetSnapshotID()); err != nil {
+		log.Warn("failed to drop snapshot", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	return merr.Success(), nil
+}
+
+// RestoreSnapshot creates a new collection with the schema, partitions and indexes in the snapshot,
+// then copies the snapshot segments into it along with their index files.
+func (s *Server) RestoreSnapshot(ctx context.Context, req *datapb.RestoreSnapshotRequest) (*datapb.RestoreSnapshotResponse, error) {
+	log := log.Ctx(ctx).With(zap.Int64("snapshotID", req.GetSnapshotID()),
+		zap.String("dbName", req.GetDbName()), zap.String("collectionName", req.GetCollectionName()))
+	log.Info("receive RestoreSnapshot request")
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.RestoreSnapshotResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	snapshot := s.meta.GetSnapshotMeta().GetSnapshot(req.GetSnapshotID())
+	if snapshot == nil {
+		return &datapb.RestoreSnapshotResponse{
+			Status: merr.Status(merr.WrapErrParameterInvalidMsg("snapshot %d not found", req.GetSnapshotID())),
+		}, nil
+	}
+	if req.GetCollectionName() == "" {
+		return &datapb.RestoreSnapshotResponse{
+			Status: merr.Status(merr.WrapErrParameterInvalidMsg("collection name should not be empty")),
+		}, nil
+	}
+
+	coll, err := s.restoreCollection(ctx, snapshot, req.GetDbName(), req.GetCollectionName())
+	if err != nil {
+		log.Warn("failed to restore collection", zap.Error(err))
+		return &datapb.RestoreSnapshotResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	segmentIDs, err := s.restoreSegments(ctx, snapshot, coll)
+	if err != nil {
+		log.Warn("failed to restore segments", zap.Int64("collectionID", coll.GetCollectionID()), zap.Error(err))
+		return &datapb.RestoreSnapshotResponse{
+			Status:       merr.Status(err),
+			CollectionID: coll.GetCollectionID(),
+		}, nil
+	}
+
+	log.Info("RestoreSnapshot done", zap.Int64("collectionID", coll.GetCollectionID()), zap.Int64s("segmentIDs", segmentIDs))
+	return &datapb.RestoreSnapshotResponse{
+		Status:       merr.Success(),
+		CollectionID: coll.GetCollectionID(),
+		SegmentIDs:   segmentIDs,
+	}, nil
+}
+
+// restoreCollection creates the collection, partitions and indexes of the snapshot with a new collection name.
+func (s *Server) restoreCollection(ctx context.Context, snapshot *datapb.SnapshotInfo, dbName, collectionName string) (*milvuspb.DescribeCollectionResponse, error) {
+	schema := proto.Clone(snapshot.GetSchema()).(*schemapb.CollectionSchema)
+	schema.Name = collectionName
+	// the system fields and the dynamic field are appended by rootcoord
+	schema.Fields = lo.Filter(schema.GetFields(), func(field *schemapb.FieldSchema, _ int) bool {
+		return field.GetFieldID() >= common.StartOfUserFieldID && !field.GetIsDynamic()
+	})
+	schemaBytes, err := proto.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+	hasPartitionKey := typeutil.HasPartitionKey(schema)
+	createReq := &milvuspb.CreateCollectionRequest{
+		DbName:           dbName,
+		CollectionName:   collectionName,
+		Schema:           schemaBytes,
+		ShardsNum:        snapshot.GetShardsNum(),
+		ConsistencyLevel: snapshot.GetConsistencyLevel(),
+		Properties:       snapshot.GetProperties(),
+	}
+	if hasPartitionKey {
+		createReq.NumPartitions = snapshot.GetNumPartitions()
+	}
+	if err := s.broker.CreateCollection(ctx, createReq); err != nil {
+		return nil, err
+	}
+
+	// the partitions of partition key are created along with the collection
+	if !hasPartitionKey {
+		for _, partition := range snapshot.GetPartitions() {
+			if partition.GetPartitionName() == Params.CommonCfg.DefaultPartitionName.GetValue() {
+				continue
+			}
+			if err := s.broker.CreatePartition(ctx, dbName, collectionName, partition.GetPartitionName()); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	coll, err := s.broker.DescribeCollectionByName(ctx, dbName, collectionName)
+	if err != nil {
+		return nil, err
+	}
+	// the binlogs are located by field id, so the field ids must not be changed
+	newFields := lo.KeyBy(coll.GetSchema().GetFields(), func(field *schemapb.FieldSchema) string {
+		return field.GetName()
+	})
+	fieldNames := make(map[int64]string, len(snapshot.GetSchema().GetFields()))
+	for _, field := range snapshot.GetSchema().GetFields() {
+		if newFields[field.GetName()].GetFieldID() != field.GetFieldID() {
+			return nil, merr.WrapErrServiceInternal(fmt.Sprintf("field id of %s changed from %d to %d after restore",
+				field.GetName(), field.GetFieldID(), newFields[field.GetName()].GetFieldID()))
+		}
+		fieldNames[field.GetFieldID()] = field.GetName()
+	}
+
+	for _, index := range snapshot.GetIndexes() {
+		status, err := s.CreateIndex(ctx, &indexpb.CreateIndexRequest{
+			CollectionID:    coll.GetCollectionID(),
+			FieldID:         newFields[fieldNames[index.GetFieldID()]].GetFieldID(),
+			IndexName:       index.GetIndexName(),
+			TypeParams:      index.GetTypeParams(),
+			IndexParams:     index.GetIndexParams(),
+			IsAutoIndex:     index.GetIsAutoIndex(),
+			UserIndexParams: index.GetUserIndexParams(),
+		})
+		if err := merr.CheckRPCCall(status, err); err != nil {
+			return nil, err
+		}
+	}
+	return coll, nil
+}
+
+// restoreSegments copies the snapshot segments into the restored collection, the binlogs and the finished index files
+// are copied to the paths of the new segments, so the indexes are reused instead of being built again.
+func (s *Server) restoreSegments(ctx context.Context, snapshot *datapb.SnapshotInfo, coll *milvuspb.DescribeCollectionResponse) ([]int64, error) {
+	partitions, err := s.broker.ShowPartitions(ctx, coll.GetCollectionID())
+	if err != nil {
+		return nil, err
+	}
+	newPartitionIDs := make(map[string]int64, len(partitions.GetPartitionIDs()))
+	for i, partitionID := range partitions.GetPartitionIDs() {
+		newPartitionIDs[partitions.GetPartitionNames()[i]] = partitionID
+	}
+	partitionMapping := map[int64]int64{common.AllPartitionsID: common.AllPartitionsID}
+	for _, partition := range snapshot.GetPartitions() {
+		partitionID, ok := newPartitionIDs[partition.GetPartitionName()]
+		if !ok {
+			return nil, merr.WrapErrPartitionNotFound(partition.GetPartitionName())
+		}
+		partitionMapping[partition.GetPartitionID()] = partitionID
+	}
+
+	// the rows are hashed to the shards by primary key, the restored collection has the same number of shards
+	channels := make(map[int]string, len(coll.GetVirtualChannelNames()))
+	for _, channel := range coll.GetVirtualChannelNames() {
+		shard, err := shardIndexOf(channel)
+		if err != nil {
+			return nil, err
+		}
+		channels[shard] = channel
+	}
+
+	newIndexIDs := lo.SliceToMap(s.meta.indexMeta.GetIndexesForCollection(coll.GetCollectionID(), ""), func(index *model.Index) (string, int64) {
+		return index.IndexName, index.IndexID
+	})
+	indexMapping := make(map[int64]int64, len(snapshot.GetIndexes()))
+	for _, index := range snapshot.GetIndexes() {
+		if indexID, ok := newIndexIDs[index.GetIndexName()]; ok {
+			indexMapping[index.GetIndexID()] = indexID
+		}
+	}
+
+	segmentIDs := make([]int64, 0, len(snapshot.GetSegments()))
+	for _, segment := range snapshot.GetSegments() {
+		partitionID, ok := partitionMapping[segment.GetPartitionID()]
+		if !ok {
+			return nil, merr.WrapErrPartitionNotFound(segment.GetPartitionID())
+		}
+		segmentID, err := s.restoreSegment(ctx, snapshot.GetSnapshotTs(), segment, coll.GetCollectionID(), partitionID, channels, indexMapping)
+		if err != nil {
+			return nil, err
+		}
+		segmentIDs = append(segmentIDs, segmentID)
+	}
+	return segmentIDs, nil
+}
+
+// restoreSegment copies the binlogs and the finished indexes of the snapshot segment to a new segment.
+// The segment indexes are added before the segment, otherwise the indexes may be built for the segment meanwhile.
+func (s *Server) restoreSegment(ctx context.Context, snapshotTs uint64, snapshotSegment *datapb.SnapshotSegment,
+	collectionID, partitionID int64, channels map[int]string, indexMapping map[int64]int64,
+) (int64, error) {
+	source := s.meta.GetSegment(snapshotSegment.GetSegmentID())
+	if source == nil {
+		return 0, merr.WrapErrSegmentNotFound(snapshotSegment.GetSegmentID())
+	}
+	shard, err := shardIndexOf(source.GetInsertChannel())
+	if err != nil {
+		return 0, err
+	}
+	channel, ok := channels[shard]
+	if !ok {
+		return 0, merr.WrapErrChannelNotFound(source.GetInsertChannel(), "no shard to restore the segment into")
+	}
+	segmentID, err := s.allocator.allocID(ctx)
+	if err != nil {
+		return 0, err
+	}
+	log := log.Ctx(ctx).With(zap.Int64("sourceSegmentID", source.GetID()), zap.Int64("segmentID", segmentID),
+		zap.Int64("partitionID", partitionID), zap.String("channel", channel))
+
+	level := source.GetLevel()
+	if level == datapb.SegmentLevel_L2 {
+		// the partition stats are not restored
+		level = datapb.SegmentLevel_L1
+	}
+	cloneBinlogs := func(fieldBinlogs []*datapb.FieldBinlog) []*datapb.FieldBinlog {
+		return lo.Map(fieldBinlogs, func(fieldBinlog *datapb.FieldBinlog, _ int) *datapb.FieldBinlog {
+			return proto.Clone(fieldBinlog).(*datapb.FieldBinlog)
+		})
+	}
+	// the deltalogs appended after the snapshot, e.g. by L0 compaction, are excluded
+	deltalogs := make([]*datapb.FieldBinlog, 0, len(source.GetDeltalogs()))
+	for _, fieldBinlog := range cloneBinlogs(source.GetDeltalogs()) {
+		fieldBinlog.Binlogs = lo.Filter(fieldBinlog.GetBinlogs(), func(l *datapb.Binlog, _ int) bool {
+			return l.GetTimestampTo() <= snapshotTs
+		})
+		if len(fieldBinlog.GetBinlogs()) > 0 {
+			deltalogs = append(deltalogs, fieldBinlog)
+		}
+	}
+	restorePosition := func(pos *msgpb.MsgPosition) *msgpb.MsgPosition {
+		if pos == nil {
+			return nil
+		}
+		pos = proto.Clone(pos).(*msgpb.MsgPosition)
+		pos.ChannelName = channel
+		return pos
+	}
+	segment := &datapb.SegmentInfo{
+		ID:                  segmentID,
+		CollectionID:        collectionID,
+		PartitionID:         partitionID,
+		InsertChannel:       channel,
+		NumOfRows:           source.GetNumOfRows(),
+		State:               commonpb.SegmentState_Flushed,
+		MaxRowNum:           source.GetMaxRowNum(),
+		LastExpireTime:      source.GetLastExpireTime(),
+		StartPosition:       restorePosition(source.GetStartPosition()),
+		DmlPosition:         restorePosition(source.GetDmlPosition()),
+		Binlogs:             cloneBinlogs(source.GetBinlogs()),
+		Statslogs:           cloneBinlogs(source.GetStatslogs()),
+		Deltalogs:           deltalogs,
+		Level:               level,
+		StorageVersion:      source.GetStorageVersion(),
+		PartitionKeyBuckets: source.GetPartitionKeyBuckets(),
+	}
+	// the binlogs are located by the ids, the log ids are kept and the paths are built for the new segment
+	if err := binlog.CompressBinLogs(segment.GetBinlogs(), segment.GetStatslogs(), segment.GetDeltalogs()); err != nil {
+		return 0, err
+	}
+	sourcePaths, err := segmentLogPaths(source.GetCollectionID(), source.GetPartitionID(), source.GetID(), segment)
+	if err != nil {
+		return 0, err
+	}
+	targetPaths, err := segmentLogPaths(collectionID, partitionID, segmentID, segment)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.copyFiles(ctx, sourcePaths, targetPaths); err != nil {
+		return 0, err
+	}
+
+	rootPath := s.meta.chunkManager.RootPath()
+	for _, buildID := range snapshotSegment.GetBuildIDs() {
+		segIdx, ok := s.meta.indexMeta.GetIndexJob(buildID)
+		if !ok || segIdx.IndexState != commonpb.IndexState_Finished {
+			continue
+		}
+		// the index not restored is built on the segment as usual
+		indexID, ok := indexMapping[segIdx.IndexID]
+		if !ok {
+			continue
+		}
+		newBuildID, err := s.allocator.allocID(ctx)
+		if err != nil {
+			return 0, err
+		}
+		sourceFiles := metautil.BuildSegmentIndexFilePaths(rootPath, buildID, segIdx.IndexVersion,
+			segIdx.PartitionID, segIdx.SegmentID, segIdx.IndexFileKeys)
+		targetFiles := metautil.BuildSegmentIndexFilePaths(rootPath, newBuildID, segIdx.IndexVersion,
+			partitionID, segmentID, segIdx.IndexFileKeys)
+		if err := s.copyFiles(ctx, sourceFiles, targetFiles); err != nil {
+			return 0, err
+		}
+		if err := s.meta.indexMeta.AddFinishedSegmentIndex(&model.SegmentIndex{
+			SegmentID:           segmentID,
+			CollectionID:        collectionID,
+			PartitionID:         partitionID,
+			NumRows:             segIdx.NumRows,
+			IndexID:             indexID,
+			BuildID:             newBuildID,
+			IndexVersion:        segIdx.IndexVersion,
+			CreateTime:          segIdx.CreateTime,
+			IndexFileKeys:       segIdx.IndexFileKeys,
+			IndexSize:           segIdx.IndexSize,
+			CurrentIndexVersion: segIdx.CurrentIndexVersion,
+			IndexStoreVersion:   segIdx.IndexStoreVersion,
+		}); err != nil {
+			return 0, err
+		}
+		log.Info("segment index restored", zap.Int64("sourceBuildID", buildID), zap.Int64("buildID", newBuildID))
+	}
+
+	if err := s.meta.AddSegment(ctx, NewSegmentInfo(segment)); err != nil {
+		return 0, err
+	}
+	log.Info("segment restored", zap.Int("files", len(targetPaths)))
+	return segmentID, nil
+}
+
+// copyFiles copies the files one by one, the files copied partially are recycled by gc as they're not in meta.
+func (s *Server) copyFiles(ctx context.Context, sourcePaths, targetPaths []string) error {
+	for i, sourcePath := range sourcePaths {
+		content, err := s.meta.chunkManager.Read(ctx, sourcePath)
+		if err != nil {
+			return err
+		}
+		if err := s.meta.chunkManager.Write(ctx, targetPaths[i], content); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// segmentLogPaths returns the paths of the binlogs, statslogs and deltalogs of the segment located by the ids.
+func segmentLogPaths(collectionID, partitionID, segmentID int64, segment *datapb.SegmentInfo) ([]string, error) {
+	paths := make([]string, 0)
+	binlogTypes := []storage.BinlogType{storage.InsertBinlog, storage.StatsBinlog, storage.DeleteBinlog}
+	for i, fieldBinlogs := range [][]*datapb.FieldBinlog{segment.GetBinlogs(), segment.GetStatslogs(), segment.GetDeltalogs()} {
+		binlogType := binlogTypes[i]
+		for _, fieldBinlog := range fieldBinlogs {
+			for _, l := range fieldBinlog.GetBinlogs() {
+				logPath, err := binlog.BuildLogPath(binlogType, collectionID, partitionID, segmentID, fieldBinlog.GetFieldID(), l.GetLogID())
+				if err != nil {
+					return nil, err
+				}
+				paths = append(paths, logPath)
+			}
+		}
+	}
+	return paths, nil
+}
+
+// shardIndexOf returns the shard index of the virtual channel, which is named as {pchannel}_{collectionID}v{index}.
+func shardIndexOf(vchannel string) (int, error) {
+	i := strings.LastIndex(vchannel, "v")
+	if i < 0 {
+		return 0, merr.WrapErrParameterInvalidMsg("invalid virtual channel %s", vchannel)
+	}
+	shard, err := strconv.Atoi(vchannel[i+1:])
+	if err != nil {
+		return 0, merr.WrapErrParameterInvalidMsg("invalid virtual channel %s", vchannel)
+	}
+	return shard, nil
+}
@@ -0,0 +1,274 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/datacoord/broker"
+	catalogmocks "github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+func TestServer_Snapshot(t *testing.T) {
+	ctx := context.Background()
+	catalog := catalogmocks.NewDataCoordCatalog(t)
+	catalog.EXPECT().ListSnapshots(mock.Anything).Return(nil, nil)
+	sm, err := newSnapshotMeta(ctx, catalog)
+	require.NoError(t, err)
+
+	cm := mocks.NewChunkManager(t)
+	cm.EXPECT().RootPath().Return("files").Maybe()
+	segments := NewSegmentsInfo()
+	for _, segment := range []*datapb.SegmentInfo{
+		{
+			ID: 10, CollectionID: 1, PartitionID: 2, InsertChannel: "ch_1v0", NumOfRows: 100, State: commonpb.SegmentState_Flushed, Level: datapb.SegmentLevel_L1,
+			Binlogs: []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []*datapb.Binlog{{LogID: 1}}}},
+			// the deltalog appended after snapshot is excluded when restoring
+			Deltalogs: []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{LogID: 2, TimestampTo: 1}, {LogID: 3, TimestampTo: math.MaxUint64}}}},
+		},
+		{
+			ID: 11, CollectionID: 1, PartitionID: common.AllPartitionsID, InsertChannel: "ch_1v0", State: commonpb.SegmentState_Flushed, Level: datapb.SegmentLevel_L0,
+			Deltalogs: []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{LogID: 4, TimestampTo: 1}}}},
+		},
+		{ID: 12, CollectionID: 1, PartitionID: 2, State: commonpb.SegmentState_Growing},
+		{ID: 13, CollectionID: 1, PartitionID: 2, State: commonpb.SegmentState_Dropped},
+	} {
+		segments.SetSegment(segment.GetID(), NewSegmentInfo(segment))
+	}
+	s := &Server{
+		meta: &meta{
+			catalog:      catalog,
+			segments:     segments,
+			chunkManager: cm,
+			snapshotMeta: sm,
+			indexMeta: &indexMeta{
+				catalog: catalog,
+				indexes: map[UniqueID]map[UniqueID]*model.Index{},
+				segmentIndexes: map[UniqueID]map[UniqueID]*model.SegmentIndex{
+					10: {100: {SegmentID: 10, CollectionID: 1, IndexID: 100, BuildID: 1000, IndexState: commonpb.IndexState_Finished}},
+				},
+				buildID2SegmentIndex: map[UniqueID]*model.SegmentIndex{},
+			},
+		},
+		allocator: newMockAllocator(),
+	}
+
+	schema := &schemapb.CollectionSchema{
+		Name: "coll",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+		},
+	}
+	b := broker.NewMockBroker(t)
+	b.EXPECT().DescribeCollectionInternal(mock.Anything, int64(1)).Return(&milvuspb.DescribeCollectionResponse{
+		Status: merr.Success(), CollectionID: 1, DbName: "default", Schema: schema, ShardsNum: 1,
+	}, nil).Maybe()
+	b.EXPECT().ShowPartitions(mock.Anything, int64(1)).Return(&milvuspb.ShowPartitionsResponse{
+		Status: merr.Success(), PartitionIDs: []int64{2}, PartitionNames: []string{"p1"},
+	}, nil).Maybe()
+	s.broker = b
+
+	t.Run("server not healthy", func(t *testing.T) {
+		s.stateCode.Store(commonpb.StateCode_Abnormal)
+		resp, err := s.CreateSnapshot(ctx, &datapb.CreateSnapshotRequest{CollectionID: 1, Name: "s1"})
+		assert.ErrorIs(t, merr.CheckRPCCall(resp, err), merr.ErrServiceNotReady)
+		resp2, err := s.ListSnapshots(ctx, &datapb.ListSnapshotsRequest{})
+		assert.ErrorIs(t, merr.CheckRPCCall(resp2, err), merr.ErrServiceNotReady)
+		status, err := s.DropSnapshot(ctx, &datapb.DropSnapshotRequest{SnapshotID: 1})
+		assert.ErrorIs(t, merr.CheckRPCCall(status, err), merr.ErrServiceNotReady)
+		resp3, err := s.RestoreSnapshot(ctx, &datapb.RestoreSnapshotRequest{SnapshotID: 1})
+		assert.ErrorIs(t, merr.CheckRPCCall(resp3, err), merr.ErrServiceNotReady)
+	})
+	s.stateCode.Store(commonpb.StateCode_Healthy)
+
+	var snapshot *datapb.SnapshotInfo
+	t.Run("create", func(t *testing.T) {
+		resp, err := s.CreateSnapshot(ctx, &datapb.CreateSnapshotRequest{CollectionID: 1})
+		assert.ErrorIs(t, merr.CheckRPCCall(resp, err), merr.ErrParameterInvalid)
+
+		catalog.EXPECT().SaveSnapshot(mock.Anything, mock.Anything).Return(nil).Once()
+		resp, err = s.CreateSnapshot(ctx, &datapb.CreateSnapshotRequest{CollectionID: 1, Name: "s1"})
+		require.NoError(t, merr.CheckRPCCall(resp, err))
+		snapshot = resp.GetSnapshot()
+		assert.ElementsMatch(t, []int64{10, 11}, lo.Map(snapshot.GetSegments(), func(segment *datapb.SnapshotSegment, _ int) int64 {
+			return segment.GetSegmentID()
+		}))
+		assert.True(t, sm.IsSegmentPinned(10))
+		assert.True(t, sm.IsBuildPinned(1000))
+		assert.Equal(t, "p1", snapshot.GetPartitions()[0].GetPartitionName())
+
+		resp, err = s.CreateSnapshot(ctx, &datapb.CreateSnapshotRequest{CollectionID: 1, Name: "s1"})
+		assert.ErrorIs(t, merr.CheckRPCCall(resp, err), merr.ErrParameterInvalid)
+
+		listResp, err := s.ListSnapshots(ctx, &datapb.ListSnapshotsRequest{CollectionID: 1})
+		assert.NoError(t, merr.CheckRPCCall(listResp, err))
+		assert.Len(t, listResp.GetSnapshots(), 1)
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		resp, err := s.RestoreSnapshot(ctx, &datapb.RestoreSnapshotRequest{SnapshotID: 999, CollectionName: "restored"})
+		assert.ErrorIs(t, merr.CheckRPCCall(resp, err), merr.ErrParameterInvalid)
+
+		b.EXPECT().CreateCollection(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *milvuspb.CreateCollectionRequest) error {
+			assert.Equal(t, "restored", req.GetCollectionName())
+			return nil
+		}).Once()
+		b.EXPECT().CreatePartition(mock.Anything, "default", "restored", "p1").Return(nil).Once()
+		b.EXPECT().DescribeCollectionByName(mock.Anything, "default", "restored").Return(&milvuspb.DescribeCollectionResponse{
+			Status: merr.Success(), CollectionID: 5, CollectionName: "restored", Schema: schema, VirtualChannelNames: []string{"ch_5v0"},
+		}, nil).Once()
+		b.EXPECT().ShowPartitions(mock.Anything, int64(5)).Return(&milvuspb.ShowPartitionsResponse{
+			Status: merr.Success(), PartitionIDs: []int64{6, 7}, PartitionNames: []string{"_default", "p1"},
+		}, nil).Once()
+		cm.EXPECT().Read(mock.Anything, mock.Anything).Return([]byte("data"), nil)
+		written := make([]string, 0)
+		cm.EXPECT().Write(mock.Anything, mock.Anything, []byte("data")).RunAndReturn(func(ctx context.Context, filePath string, content []byte) error {
+			written = append(written, filePath)
+			return nil
+		})
+		restored := make(map[datapb.SegmentLevel]*datapb.SegmentInfo)
+		catalog.EXPECT().AddSegment(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, segment *datapb.SegmentInfo) error {
+			restored[segment.GetLevel()] = segment
+			return nil
+		})
+		resp, err = s.RestoreSnapshot(ctx, &datapb.RestoreSnapshotRequest{SnapshotID: snapshot.GetSnapshotID(), DbName: "default", CollectionName: "restored"})
+		require.NoError(t, merr.CheckRPCCall(resp, err))
+		assert.EqualValues(t, 5, resp.GetCollectionID())
+		assert.Len(t, resp.GetSegmentIDs(), 2)
+		assert.Len(t, written, 3)
+		require.Len(t, restored, 2)
+		assert.EqualValues(t, 7, restored[datapb.SegmentLevel_L1].GetPartitionID())
+		assert.EqualValues(t, 100, restored[datapb.SegmentLevel_L1].GetNumOfRows())
+		assert.Equal(t, []int64{2}, lo.Map(restored[datapb.SegmentLevel_L1].GetDeltalogs()[0].GetBinlogs(), func(l *datapb.Binlog, _ int) int64 {
+			return l.GetLogID()
+		}))
+		assert.EqualValues(t, common.AllPartitionsID, restored[datapb.SegmentLevel_L0].GetPartitionID())
+		for _, segment := range restored {
+			assert.EqualValues(t, 5, segment.GetCollectionID())
+			assert.Equal(t, "ch_5v0", segment.GetInsertChannel())
+			assert.Equal(t, commonpb.SegmentState_Flushed, segment.GetState())
+			assert.Contains(t, resp.GetSegmentIDs(), segment.GetID())
+		}
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		catalog.EXPECT().DropSnapshot(mock.Anything, snapshot.GetSnapshotID()).Return(nil).Once()
+		status, err := s.DropSnapshot(ctx, &datapb.DropSnapshotRequest{SnapshotID: snapshot.GetSnapshotID()})
+		assert.NoError(t, merr.CheckRPCCall(status, err))
+		assert.False(t, sm.IsSegmentPinned(10))
+	})
+}
+
+func TestServer_restoreSegment(t *testing.T) {
+	ctx := context.Background()
+	catalog := catalogmocks.NewDataCoordCatalog(t)
+	cm := mocks.NewChunkManager(t)
+	cm.EXPECT().RootPath().Return("files")
+	segments := NewSegmentsInfo()
+	segments.SetSegment(10, NewSegmentInfo(&datapb.SegmentInfo{
+		ID: 10, CollectionID: 1, PartitionID: 2, InsertChannel: "ch_1v1", NumOfRows: 100,
+		State: commonpb.SegmentState_Dropped, Level: datapb.SegmentLevel_L2,
+		DmlPosition: &msgpb.MsgPosition{ChannelName: "ch_1v1", Timestamp: 10},
+	}))
+	segIdx := &model.SegmentIndex{
+		SegmentID: 10, CollectionID: 1, PartitionID: 2, NumRows: 100, IndexID: 100, BuildID: 1000, IndexVersion: 2,
+		IndexState: commonpb.IndexState_Finished, IndexFileKeys: []string{"file"}, IndexSize: 1024,
+	}
+	s := &Server{
+		meta: &meta{
+			catalog:      catalog,
+			segments:     segments,
+			chunkManager: cm,
+			indexMeta: &indexMeta{
+				catalog:              catalog,
+				indexes:              map[UniqueID]map[UniqueID]*model.Index{5: {200: {CollectionID: 5, IndexID: 200, IndexName: "idx"}}},
+				segmentIndexes:       map[UniqueID]map[UniqueID]*model.SegmentIndex{10: {100: segIdx}},
+				buildID2SegmentIndex: map[UniqueID]*model.SegmentIndex{1000: segIdx, 1001: {BuildID: 1001, IndexID: 101, IndexState: commonpb.IndexState_InProgress}},
+			},
+		},
+		allocator: newMockAllocator(),
+	}
+	snapshotSegment := &datapb.SnapshotSegment{SegmentID: 10, PartitionID: 2, BuildIDs: []int64{1000, 1001}}
+	channels := map[int]string{0: "ch_5v0", 1: "ch_5v1"}
+
+	t.Run("segment not found", func(t *testing.T) {
+		_, err := s.restoreSegment(ctx, 100, &datapb.SnapshotSegment{SegmentID: 11}, 5, 7, channels, map[int64]int64{100: 200})
+		assert.ErrorIs(t, err, merr.ErrSegmentNotFound)
+	})
+
+	t.Run("no shard", func(t *testing.T) {
+		_, err := s.restoreSegment(ctx, 100, snapshotSegment, 5, 7, map[int]string{0: "ch_5v0"}, map[int64]int64{100: 200})
+		assert.ErrorIs(t, err, merr.ErrChannelNotFound)
+	})
+
+	t.Run("failed to copy index files", func(t *testing.T) {
+		cm.EXPECT().Read(mock.Anything, mock.Anything).Return(nil, errors.New("mock")).Once()
+		_, err := s.restoreSegment(ctx, 100, snapshotSegment, 5, 7, channels, map[int64]int64{100: 200})
+		assert.Error(t, err)
+	})
+
+	t.Run("reuse index files", func(t *testing.T) {
+		cm.EXPECT().Read(mock.Anything, "files/index_files/1000/2/2/10/file").Return([]byte("index"), nil).Once()
+		var indexFile string
+		cm.EXPECT().Write(mock.Anything, mock.Anything, []byte("index")).RunAndReturn(func(ctx context.Context, filePath string, content []byte) error {
+			indexFile = filePath
+			return nil
+		}).Once()
+		var restoredIndex *model.SegmentIndex
+		catalog.EXPECT().CreateSegmentIndex(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, segIndex *model.SegmentIndex) error {
+			restoredIndex = segIndex
+			return nil
+		}).Once()
+		catalog.EXPECT().AddSegment(mock.Anything, mock.Anything).Return(nil).Once()
+
+		segmentID, err := s.restoreSegment(ctx, 100, snapshotSegment, 5, 7, channels, map[int64]int64{100: 200})
+		require.NoError(t, err)
+		segment := s.meta.GetSegment(segmentID)
+		require.NotNil(t, segment)
+		assert.Equal(t, "ch_5v1", segment.GetInsertChannel())
+		assert.Equal(t, "ch_5v1", segment.GetDmlPosition().GetChannelName())
+		assert.Equal(t, datapb.SegmentLevel_L1, segment.GetLevel())
+		assert.Equal(t, commonpb.SegmentState_Flushed, segment.GetState())
+
+		require.NotNil(t, restoredIndex)
+		assert.Equal(t, commonpb.IndexState_Finished, restoredIndex.IndexState)
+		assert.EqualValues(t, 200, restoredIndex.IndexID)
+		assert.Equal(t, segmentID, restoredIndex.SegmentID)
+		assert.Equal(t, fmt.Sprintf("files/index_files/%d/2/7/%d/file", restoredIndex.BuildID, segmentID), indexFile)
+		assert.False(t, s.meta.indexMeta.IsUnIndexedSegment(5, segmentID))
+	})
+}
@@ -139,6 +139,29 @@ func getCollectionTTL(properties map[string]string) (time.Duration, error) {
 	return Params.CommonCfg.EntityExpirationTTL.GetAsDuration(time.Second), nil
 }
 
+// getPartitionTTL returns the ttl of the partition if specified, otherwise the ttl of the collection.
+func getPartitionTTL(coll *collectionInfo, partitionID int64) (time.Duration, error) {
+	if name, ok := coll.PartitionNames[partitionID]; ok {
+		ttl, err := common.PartitionTTL(coll.Properties, name)
+		if err != nil || ttl > 0 {
+			return ttl, err
+		}
+	}
+	return getCollectionTTL(coll.Properties)
+}
+
+// getPartitionNames maps the partition ids to the names, nil if they don't match.
+func getPartitionNames(partitionIDs []int64, partitionNames []string) map[int64]string {
+	if len(partitionNames) == 0 || len(partitionIDs) != len(partitionNames) {
+		return nil
+	}
+	names := make(map[int64]string, len(partitionIDs))
+	for i, partitionID := range partitionIDs {
+		names[partitionID] = partitionNames[i]
+	}
+	return names
+}
+
 func UpdateCompactionSegmentSizeMetrics(segments []*datapb.CompactionSegment) {
 	var totalSize int64
 	for _, seg := range segments {
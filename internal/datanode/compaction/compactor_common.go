@@ -18,6 +18,7 @@ package compaction
 
 import (
 	"context"
+	"math"
 	"strconv"
 	"time"
 
@@ -48,11 +49,19 @@ func isExpiredEntity(ttl int64, now, ts typeutil.Timestamp) bool {
 }
 
 func mergeDeltalogs(ctx context.Context, io io.BinlogIO, dpaths map[typeutil.UniqueID][]string) (map[interface{}]typeutil.Timestamp, error) {
+	pk2ts, _, err := mergeDeltalogsWithRetention(ctx, io, dpaths, math.MaxUint64)
+	return pk2ts, err
+}
+
+// mergeDeltalogsWithRetention merges the deltalogs like mergeDeltalogs, but only the deletes not after retainTs are applied,
+// the later ones are within the history retention and returned to be kept by compaction.
+func mergeDeltalogsWithRetention(ctx context.Context, io io.BinlogIO, dpaths map[typeutil.UniqueID][]string, retainTs typeutil.Timestamp) (map[interface{}]typeutil.Timestamp, *storage.DeleteData, error) {
 	pk2ts := make(map[interface{}]typeutil.Timestamp)
+	retained := &storage.DeleteData{}
 
 	if len(dpaths) == 0 {
 		log.Info("compact with no deltalogs, skip merge deltalogs")
-		return pk2ts, nil
+		return pk2ts, retained, nil
 	}
 
 	allIters := make([]*iter.DeltalogIterator, 0)
@@ -66,7 +75,7 @@ func mergeDeltalogs(ctx context.Context, io io.BinlogIO, dpaths map[typeutil.Uni
 				zap.Int64("segment", segID),
 				zap.Strings("path", paths),
 				zap.Error(err))
-			return nil, err
+			return nil, nil, err
 		}
 
 		allIters = append(allIters, iter.NewDeltalogIterator(blobs, nil))
@@ -76,6 +85,10 @@ func mergeDeltalogs(ctx context.Context, io io.BinlogIO, dpaths map[typeutil.Uni
 		for deltaIter.HasNext() {
 			labeled, _ := deltaIter.Next()
 			ts := labeled.GetTimestamp()
+			if ts > retainTs {
+				retained.Append(labeled.GetPk(), ts)
+				continue
+			}
 			if lastTs, ok := pk2ts[labeled.GetPk().GetValue()]; ok && lastTs > ts {
 				ts = lastTs
 			}
@@ -84,9 +97,10 @@ func mergeDeltalogs(ctx context.Context, io io.BinlogIO, dpaths map[typeutil.Uni
 	}
 
 	log.Info("compact mergeDeltalogs end",
-		zap.Int("deleted pk counts", len(pk2ts)))
+		zap.Int("deleted pk counts", len(pk2ts)),
+		zap.Int64("retained delete counts", retained.RowCount))
 
-	return pk2ts, nil
+	return pk2ts, retained, nil
 }
 
 func loadDeltaMap(segments []*datapb.CompactionSegmentBinlogs) (map[typeutil.UniqueID][]string, [][]string, error) {
@@ -98,6 +112,16 @@ func loadDeltaMap(segments []*datapb.CompactionSegmentBinlogs) (map[typeutil.Uni
 	deltaPaths := make(map[typeutil.UniqueID][]string) // segmentID to deltalog paths
 	allPath := make([][]string, 0)                     // group by binlog batch
 	for _, s := range segments {
+		// L0 segments in the plan only provide the deletes
+		if s.GetLevel() == datapb.SegmentLevel_L0 {
+			for _, d := range s.GetDeltalogs() {
+				for _, l := range d.GetBinlogs() {
+					deltaPaths[s.GetSegmentID()] = append(deltaPaths[s.GetSegmentID()], l.GetLogPath())
+				}
+			}
+			continue
+		}
+
 		// Get the batch count of field binlog files from non-empty segment
 		// each segment might contain different batches
 		var binlogBatchCount int
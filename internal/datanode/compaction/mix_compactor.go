@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	sio "io"
+	"math"
 	"time"
 
 	"github.com/cockroachdb/errors"
@@ -29,6 +30,7 @@ import (
 
 	"github.com/milvus-io/milvus/internal/datanode/allocator"
 	"github.com/milvus-io/milvus/internal/datanode/io"
+	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/log"
@@ -339,7 +341,7 @@ func (t *mixCompactionTask) Compact() (*datapb.CompactionPlanResult, error) {
 		return nil, errors.New("illegal compaction plan")
 	}
 
-	deltaPk2Ts, err := mergeDeltalogs(ctxTimeout, t.binlogIO, deltaPaths)
+	deltaPk2Ts, retainedDeletes, err := mergeDeltalogsWithRetention(ctxTimeout, t.binlogIO, deltaPaths, t.getRetainTs())
 	if err != nil {
 		log.Warn("compact wrong, fail to merge deltalogs", zap.Error(err))
 		return nil, err
@@ -351,6 +353,12 @@ func (t *mixCompactionTask) Compact() (*datapb.CompactionPlanResult, error) {
 		return nil, err
 	}
 
+	compactToSeg.Deltalogs, err = t.uploadRetainedDeletes(ctxTimeout, writer, retainedDeletes)
+	if err != nil {
+		log.Warn("compact wrong, fail to upload retained deletes", zap.Error(err))
+		return nil, err
+	}
+
 	log.Info("compact done",
 		zap.Int64("compact to segment", targetSegID),
 		zap.Int64s("compact from segments", segIDs),
@@ -374,6 +382,49 @@ func (t *mixCompactionTask) Compact() (*datapb.CompactionPlanResult, error) {
 	return planResult, nil
 }
 
+// getRetainTs returns the timestamp after which the deletes are kept for the history retention,
+// all the deletes are applied if the collection doesn't retain history.
+func (t *mixCompactionTask) getRetainTs() typeutil.Timestamp {
+	if t.plan.GetHistoryRetention() <= 0 {
+		return math.MaxUint64
+	}
+	now, _ := tsoutil.ParseTS(t.currentTs)
+	return tsoutil.ComposeTSByTime(now.Add(-time.Duration(t.plan.GetHistoryRetention())), 0)
+}
+
+// uploadRetainedDeletes writes the deletes within the history retention as the deltalog of the compacted segment,
+// so that the deleted rows kept by compaction are still invisible to the reads after the deletes.
+func (t *mixCompactionTask) uploadRetainedDeletes(ctx context.Context, writer *SegmentWriter, deletes *storage.DeleteData) ([]*datapb.FieldBinlog, error) {
+	if deletes.RowCount == 0 {
+		return nil, nil
+	}
+	deltaWriter := NewSegmentDeltaWriter(writer.GetSegmentID(), writer.GetPartitionID(), writer.GetCollectionID())
+	deltaWriter.WriteBatch(deletes.Pks, deletes.Tss)
+	blob, tr, err := deltaWriter.Finish()
+	if err != nil {
+		return nil, err
+	}
+
+	logID, err := t.AllocOne()
+	if err != nil {
+		return nil, err
+	}
+	blobKey, _ := binlog.BuildLogPath(storage.DeleteBinlog, deltaWriter.collectionID, deltaWriter.partitionID, deltaWriter.segmentID, -1, logID)
+	if err := t.binlogIO.Upload(ctx, map[string][]byte{blobKey: blob.GetValue()}); err != nil {
+		return nil, err
+	}
+
+	return []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{
+		EntriesNum:    deltaWriter.GetRowNum(),
+		LogSize:       int64(len(blob.GetValue())),
+		MemorySize:    blob.GetMemorySize(),
+		LogPath:       blobKey,
+		LogID:         logID,
+		TimestampFrom: tr.GetMinTimestamp(),
+		TimestampTo:   tr.GetMaxTimestamp(),
+	}}}}, nil
+}
+
 func (t *mixCompactionTask) GetCollection() typeutil.UniqueID {
 	// The length of SegmentBinlogs is checked before task enqueueing.
 	return t.plan.GetSegmentBinlogs()[0].GetCollectionID()
@@ -471,6 +471,55 @@ func (s *MixCompactionTaskSuite) TestMergeDeltalogsOneSegment() {
 	s.ElementsMatch(lo.Values(got), lo.Values(expectedMap))
 }
 
+func (s *MixCompactionTaskSuite) TestMergeDeltalogsWithRetention() {
+	blob, err := getInt64DeltaBlobs(
+		100,
+		[]int64{1, 2, 3, 1},
+		[]uint64{20000, 20001, 30000, 40000},
+	)
+	s.Require().NoError(err)
+	s.mockBinlogIO.EXPECT().Download(mock.Anything, []string{"a"}).
+		Return([][]byte{blob.GetValue()}, nil).Once()
+
+	got, retained, err := mergeDeltalogsWithRetention(s.task.ctx, s.task.binlogIO, map[int64][]string{1000: {"a"}}, 25000)
+	s.NoError(err)
+	s.Equal(map[interface{}]uint64{int64(1): 20000, int64(2): 20001}, got)
+	s.EqualValues(2, retained.RowCount)
+	s.ElementsMatch([]uint64{30000, 40000}, retained.Tss)
+}
+
+func (s *MixCompactionTaskSuite) TestUploadRetainedDeletes() {
+	s.mockAlloc.EXPECT().AllocOne().Return(19530, nil)
+	s.mockBinlogIO.EXPECT().Upload(mock.Anything, mock.Anything).Return(nil).Once()
+
+	writer, err := NewSegmentWriter(s.meta.GetSchema(), 100, 19531, PartitionID, CollectionID)
+	s.Require().NoError(err)
+
+	deltalogs, err := s.task.uploadRetainedDeletes(s.task.ctx, writer, &storage.DeleteData{})
+	s.NoError(err)
+	s.Empty(deltalogs)
+
+	deletes := &storage.DeleteData{}
+	deletes.Append(storage.NewInt64PrimaryKey(1), 30000)
+	deletes.Append(storage.NewInt64PrimaryKey(2), 40000)
+	deltalogs, err = s.task.uploadRetainedDeletes(s.task.ctx, writer, deletes)
+	s.NoError(err)
+	s.Require().Len(deltalogs, 1)
+	s.Require().Len(deltalogs[0].GetBinlogs(), 1)
+	s.EqualValues(2, deltalogs[0].GetBinlogs()[0].GetEntriesNum())
+	s.EqualValues(30000, deltalogs[0].GetBinlogs()[0].GetTimestampFrom())
+	s.EqualValues(40000, deltalogs[0].GetBinlogs()[0].GetTimestampTo())
+}
+
+func (s *MixCompactionTaskSuite) TestGetRetainTs() {
+	s.task.plan.HistoryRetention = 0
+	s.EqualValues(uint64(math.MaxUint64), s.task.getRetainTs())
+
+	s.task.plan.HistoryRetention = time.Hour.Nanoseconds()
+	now, _ := tsoutil.ParseTS(s.task.currentTs)
+	s.Equal(tsoutil.ComposeTSByTime(now.Add(-time.Hour), 0), s.task.getRetainTs())
+}
+
 func (s *MixCompactionTaskSuite) TestCompactFail() {
 	s.Run("mock ctx done", func() {
 		ctx, cancel := context.WithCancel(context.Background())
@@ -535,6 +584,37 @@ func (s *MixCompactionTaskSuite) TestIsExpiredEntity() {
 	}
 }
 
+func (s *MixCompactionTaskSuite) TestAlignSchemaFields() {
+	sch := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "nullable", DataType: schemapb.DataType_Int32, Nullable: true},
+			{FieldID: 102, Name: "defaulted", DataType: schemapb.DataType_Double, DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_DoubleData{DoubleData: 1.5}}},
+			{FieldID: 103, Name: "flag", DataType: schemapb.DataType_Bool, DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_BoolData{BoolData: true}}},
+			{FieldID: 104, Name: "vec", DataType: schemapb.DataType_FloatVector},
+			{FieldID: 106, Name: "nullable_varchar", DataType: schemapb.DataType_VarChar, Nullable: true},
+		},
+	}
+	v := &storage.Value{Value: map[int64]any{100: int64(1), 101: int32(7), 105: "dropped"}}
+	alignSchemaFields(sch, v)
+
+	m := v.Value.(map[int64]any)
+	s.Equal(int32(7), m[101])
+	s.Equal(1.5, m[102])
+	s.Equal(true, m[103])
+	s.NotContains(m, int64(104))
+	s.NotContains(m, int64(105))
+	s.Contains(m, int64(106))
+	s.Nil(m[106])
+
+	// nullable field without default value is filled with null
+	v = &storage.Value{Value: map[int64]any{100: int64(2)}}
+	alignSchemaFields(sch, v)
+	m = v.Value.(map[int64]any)
+	s.Contains(m, int64(101))
+	s.Nil(m[101])
+}
+
 func getRow(magic int64) map[int64]interface{} {
 	ts := tsoutil.ComposeTSByTime(getMilvusBirthday(), 0)
 	return map[int64]interface{}{
@@ -0,0 +1,342 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compaction
+
+import (
+	"context"
+	"fmt"
+	sio "io"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/datanode/allocator"
+	"github.com/milvus-io/milvus/internal/datanode/io"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/timerecord"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// repartitionCompactionTask rewrites the rows of the segments into the target partitions by the hash of partition key,
+// after the number of partitions of partition key is increased. It applies the deletes and the TTL as mix compaction.
+type repartitionCompactionTask struct {
+	*mixCompactionTask
+
+	partitionKeyField *schemapb.FieldSchema
+	// the writers of the target partitions, allocated when the first row of the partition is written
+	buckets []*repartitionBucket
+}
+
+type repartitionBucket struct {
+	writer        *SegmentWriter
+	binlogs       map[typeutil.UniqueID]*datapb.FieldBinlog
+	unflushedRows int64
+	remainingRows int64
+}
+
+// make sure compactionTask implements compactor interface
+var _ Compactor = (*repartitionCompactionTask)(nil)
+
+func NewRepartitionCompactionTask(
+	ctx context.Context,
+	binlogIO io.BinlogIO,
+	alloc allocator.Allocator,
+	plan *datapb.CompactionPlan,
+) *repartitionCompactionTask {
+	task := NewMixCompactionTask(ctx, binlogIO, alloc, plan)
+	task.tr = timerecord.NewTimeRecorder("repartition compaction")
+	return &repartitionCompactionTask{mixCompactionTask: task}
+}
+
+func (t *repartitionCompactionTask) Compact() (*datapb.CompactionPlanResult, error) {
+	durInQueue := t.tr.RecordSpan()
+	compactStart := time.Now()
+	ctx, span := otel.Tracer(typeutil.DataNodeRole).Start(t.ctx, fmt.Sprintf("RepartitionCompact-%d", t.GetPlanID()))
+	defer span.End()
+
+	if len(t.plan.GetSegmentBinlogs()) < 1 || len(t.plan.GetTargetPartitionIDs()) < 1 {
+		log.Warn("compact wrong, there's no segments or target partitions", zap.Int64("planID", t.plan.GetPlanID()))
+		return nil, errors.New("compaction plan is illegal")
+	}
+
+	log := log.Ctx(ctx).With(zap.Int64("planID", t.plan.GetPlanID()),
+		zap.Int64("collectionID", t.GetCollection()),
+		zap.Int64s("targetPartitions", t.plan.GetTargetPartitionIDs()),
+		zap.Int32("timeout in seconds", t.plan.GetTimeoutInSeconds()))
+
+	var err error
+	t.partitionKeyField, err = typeutil.GetPartitionKeyFieldSchema(t.plan.GetSchema())
+	if err != nil {
+		log.Warn("compact wrong, collection has no partition key", zap.Error(err))
+		return nil, err
+	}
+	t.buckets = make([]*repartitionBucket, len(t.plan.GetTargetPartitionIDs()))
+
+	if ok := funcutil.CheckCtxValid(ctx); !ok {
+		log.Warn("compact wrong, task context done or timeout")
+		return nil, ctx.Err()
+	}
+
+	ctxTimeout, cancelAll := context.WithTimeout(ctx, time.Duration(t.plan.GetTimeoutInSeconds())*time.Second)
+	defer cancelAll()
+
+	log.Info("compact start")
+
+	segIDs := lo.Map(t.plan.GetSegmentBinlogs(), func(binlogs *datapb.CompactionSegmentBinlogs, _ int) int64 {
+		return binlogs.GetSegmentID()
+	})
+
+	deltaPaths, allPath, err := loadDeltaMap(t.plan.GetSegmentBinlogs())
+	if err != nil {
+		log.Warn("fail to merge deltalogs", zap.Error(err))
+		return nil, err
+	}
+
+	// Unable to deal with all empty segments cases, so return error
+	if len(allPath) == 0 {
+		log.Warn("compact wrong, all segments' binlogs are empty")
+		return nil, errors.New("illegal compaction plan")
+	}
+
+	deltaPk2Ts, retainedDeletes, err := mergeDeltalogsWithRetention(ctxTimeout, t.binlogIO, deltaPaths, t.getRetainTs())
+	if err != nil {
+		log.Warn("compact wrong, fail to merge deltalogs", zap.Error(err))
+		return nil, err
+	}
+
+	compactToSegs, err := t.repartition(ctxTimeout, allPath, deltaPk2Ts)
+	if err != nil {
+		log.Warn("compact wrong, fail to repartition", zap.Error(err))
+		return nil, err
+	}
+
+	// the deletes retained are written to every segment, as the rows deleted might be in any of them
+	for i, compactToSeg := range compactToSegs {
+		compactToSeg.Deltalogs, err = t.uploadRetainedDeletes(ctxTimeout, t.buckets[i].writer, retainedDeletes)
+		if err != nil {
+			log.Warn("compact wrong, fail to upload retained deletes", zap.Error(err))
+			return nil, err
+		}
+	}
+
+	log.Info("compact done",
+		zap.Int64s("compact to segments", lo.Map(compactToSegs, func(seg *datapb.CompactionSegment, _ int) int64 { return seg.GetSegmentID() })),
+		zap.Int64s("compact from segments", segIDs),
+		zap.Duration("compact elapse", time.Since(compactStart)),
+	)
+
+	metrics.DataNodeCompactionLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), t.plan.GetType().String()).Observe(float64(t.tr.ElapseSpan().Milliseconds()))
+	metrics.DataNodeCompactionLatencyInQueue.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Observe(float64(durInQueue.Milliseconds()))
+
+	planResult := &datapb.CompactionPlanResult{
+		State:    datapb.CompactionTaskState_completed,
+		PlanID:   t.GetPlanID(),
+		Channel:  t.GetChannelName(),
+		Segments: compactToSegs,
+		Type:     t.plan.GetType(),
+	}
+
+	return planResult, nil
+}
+
+// repartition writes the rows not deleted or expired into the buckets by the hash of partition key, the segments are
+// returned in the order of the target partitions, only for the partitions which have rows written into.
+func (t *repartitionCompactionTask) repartition(
+	ctx context.Context,
+	binlogPaths [][]string,
+	delta map[interface{}]typeutil.Timestamp,
+) ([]*datapb.CompactionSegment, error) {
+	_ = t.tr.RecordSpan()
+
+	ctx, span := otel.Tracer(typeutil.DataNodeRole).Start(ctx, "CompactRepartition")
+	defer span.End()
+
+	log := log.With(zap.Int64("planID", t.GetPlanID()))
+
+	var (
+		deletedRowCount int64
+		expiredRowCount int64
+	)
+
+	isValueDeleted := func(v *storage.Value) bool {
+		ts, ok := delta[v.PK.GetValue()]
+		// insert task and delete task has the same ts when upsert
+		// here should be < instead of <=
+		// to avoid the upsert data to be deleted after compact
+		return ok && uint64(v.Timestamp) < ts
+	}
+
+	pkField, err := typeutil.GetPrimaryFieldSchema(t.plan.GetSchema())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, paths := range binlogPaths {
+		log := log.With(zap.Strings("paths", paths))
+		allValues, err := t.binlogIO.Download(ctx, paths)
+		if err != nil {
+			log.Warn("compact wrong, fail to download insertLogs", zap.Error(err))
+			return nil, err
+		}
+
+		blobs := lo.Map(allValues, func(v []byte, i int) *storage.Blob {
+			return &storage.Blob{Key: paths[i], Value: v}
+		})
+
+		iter, err := storage.NewBinlogDeserializeReader(blobs, pkField.GetFieldID())
+		if err != nil {
+			log.Warn("compact wrong, failed to new insert binlogs reader", zap.Error(err))
+			return nil, err
+		}
+
+		for {
+			err := iter.Next()
+			if err != nil {
+				if err == sio.EOF {
+					break
+				}
+				log.Warn("compact wrong, failed to iter through data", zap.Error(err))
+				return nil, err
+			}
+			v := iter.Value()
+			if isValueDeleted(v) {
+				deletedRowCount++
+				continue
+			}
+
+			// Filtering expired entity
+			if isExpiredEntity(t.plan.GetCollectionTtl(), t.currentTs, typeutil.Timestamp(v.Timestamp)) {
+				expiredRowCount++
+				continue
+			}
+
+			bucket, err := t.getBucket(v)
+			if err != nil {
+				log.Warn("compact wrong, failed to get bucket of row", zap.Error(err))
+				return nil, err
+			}
+			if err := bucket.writer.Write(v); err != nil {
+				log.Warn("compact wrong, failed to writer row", zap.Error(err))
+				return nil, err
+			}
+			bucket.unflushedRows++
+			bucket.remainingRows++
+
+			if (bucket.unflushedRows+1)%100 == 0 && bucket.writer.FlushAndIsFull() {
+				if err := t.flush(ctx, bucket); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	compactToSegs := make([]*datapb.CompactionSegment, 0, len(t.buckets))
+	for _, bucket := range t.buckets {
+		if bucket == nil {
+			continue
+		}
+		if !bucket.writer.FlushAndIsEmpty() {
+			if err := t.flush(ctx, bucket); err != nil {
+				return nil, err
+			}
+		}
+		sPath, err := statSerializeWrite(ctx, t.binlogIO, t.Allocator, bucket.writer, bucket.remainingRows)
+		if err != nil {
+			log.Warn("compact wrong, failed to serialize write segment stats",
+				zap.Int64("remaining row count", bucket.remainingRows), zap.Error(err))
+			return nil, err
+		}
+		compactToSegs = append(compactToSegs, &datapb.CompactionSegment{
+			SegmentID:           bucket.writer.GetSegmentID(),
+			InsertLogs:          lo.Values(bucket.binlogs),
+			Field2StatslogPaths: []*datapb.FieldBinlog{sPath},
+			NumOfRows:           bucket.remainingRows,
+			Channel:             t.plan.GetChannel(),
+			PartitionID:         bucket.writer.GetPartitionID(),
+		})
+	}
+	// keep the writers of the segments returned only, in the same order
+	t.buckets = lo.Filter(t.buckets, func(bucket *repartitionBucket, _ int) bool { return bucket != nil })
+
+	log.Info("compact repartition end",
+		zap.Int("segment count", len(compactToSegs)),
+		zap.Int64("deleted row count", deletedRowCount),
+		zap.Int64("expired entities", expiredRowCount),
+		zap.Duration("total elapse", t.tr.RecordSpan()))
+
+	return compactToSegs, nil
+}
+
+// getBucket returns the bucket of the row by the hash of partition key, the same as the partition routing of the proxy.
+func (t *repartitionCompactionTask) getBucket(v *storage.Value) (*repartitionBucket, error) {
+	row, ok := v.Value.(map[typeutil.UniqueID]interface{})
+	if !ok {
+		return nil, errors.New("unexpected row data type")
+	}
+
+	var hash uint32
+	switch key := row[t.partitionKeyField.GetFieldID()].(type) {
+	case int64:
+		hash, _ = typeutil.Hash32Int64(key)
+	case string:
+		hash = typeutil.HashString2Uint32(key)
+	default:
+		return nil, merr.WrapErrParameterInvalidMsg("unsupported partition key type %s", t.partitionKeyField.GetDataType().String())
+	}
+
+	index := hash % uint32(len(t.buckets))
+	if t.buckets[index] == nil {
+		segmentID, err := t.AllocOne()
+		if err != nil {
+			return nil, err
+		}
+		writer, err := NewSegmentWriter(t.plan.GetSchema(), t.getNumRows(), segmentID, t.plan.GetTargetPartitionIDs()[index], t.GetCollection())
+		if err != nil {
+			return nil, err
+		}
+		t.buckets[index] = &repartitionBucket{
+			writer:  writer,
+			binlogs: make(map[typeutil.UniqueID]*datapb.FieldBinlog),
+		}
+	}
+	return t.buckets[index], nil
+}
+
+func (t *repartitionCompactionTask) flush(ctx context.Context, bucket *repartitionBucket) error {
+	kvs, partialBinlogs, err := serializeWrite(ctx, t.Allocator, bucket.writer)
+	if err != nil {
+		log.Warn("compact wrong, failed to serialize writer", zap.Error(err))
+		return err
+	}
+	if err := t.binlogIO.Upload(ctx, kvs); err != nil {
+		log.Warn("compact wrong, failed to upload kvs", zap.Error(err))
+		return err
+	}
+	mergeFieldBinlogs(bucket.binlogs, partialBinlogs)
+	bucket.unflushedRows = 0
+	return nil
+}
@@ -0,0 +1,201 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compaction
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/datanode/allocator"
+	"github.com/milvus-io/milvus/internal/datanode/io"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func TestRepartitionCompactionTaskSuite(t *testing.T) {
+	suite.Run(t, new(RepartitionCompactionTaskSuite))
+}
+
+type RepartitionCompactionTaskSuite struct {
+	suite.Suite
+
+	mockBinlogIO *io.MockBinlogIO
+	mockAlloc    *allocator.MockAllocator
+
+	schema *schemapb.CollectionSchema
+	task   *repartitionCompactionTask
+}
+
+func (s *RepartitionCompactionTaskSuite) SetupSuite() {
+	paramtable.Get().Init(paramtable.NewBaseTable())
+}
+
+func (s *RepartitionCompactionTaskSuite) SetupTest() {
+	s.mockBinlogIO = io.NewMockBinlogIO(s.T())
+	s.mockAlloc = allocator.NewMockAllocator(s.T())
+
+	s.schema = proto.Clone(genTestCollectionMeta().GetSchema()).(*schemapb.CollectionSchema)
+	for _, field := range s.schema.GetFields() {
+		if field.GetFieldID() == Int64Field {
+			field.IsPartitionKey = true
+		}
+	}
+
+	s.task = NewRepartitionCompactionTask(context.Background(), s.mockBinlogIO, s.mockAlloc, &datapb.CompactionPlan{
+		PlanID:             999,
+		TimeoutInSeconds:   10,
+		Type:               datapb.CompactionType_RepartitionCompaction,
+		Schema:             s.schema,
+		TargetPartitionIDs: []int64{10, 11},
+	})
+}
+
+func (s *RepartitionCompactionTaskSuite) TestCompact() {
+	var nextID int64 = 19530
+	s.mockAlloc.EXPECT().AllocOne().RunAndReturn(func() (int64, error) {
+		nextID++
+		return nextID, nil
+	})
+	s.mockAlloc.EXPECT().Alloc(mock.Anything).Return(7777777, 8888888, nil)
+	s.mockBinlogIO.EXPECT().Upload(mock.Anything, mock.Anything).Return(nil)
+
+	expected := make(map[int64]int64)
+	for _, segID := range []int64{5, 6, 7, 8} {
+		segWriter, err := NewSegmentWriter(s.schema, 100, segID, PartitionID, CollectionID)
+		s.Require().NoError(err)
+		s.Require().NoError(segWriter.Write(newTestValue(segID)))
+		segWriter.writer.Flush()
+
+		kvs, fBinlogs, err := serializeWrite(context.TODO(), s.task.Allocator, segWriter)
+		s.Require().NoError(err)
+		s.mockBinlogIO.EXPECT().Download(mock.Anything, mock.MatchedBy(func(keys []string) bool {
+			left, right := lo.Difference(keys, lo.Keys(kvs))
+			return len(left) == 0 && len(right) == 0
+		})).Return(lo.Values(kvs), nil).Once()
+
+		s.task.plan.SegmentBinlogs = append(s.task.plan.SegmentBinlogs, &datapb.CompactionSegmentBinlogs{
+			SegmentID:    segID,
+			CollectionID: CollectionID,
+			PartitionID:  PartitionID,
+			FieldBinlogs: lo.Values(fBinlogs),
+		})
+
+		hash, _ := typeutil.Hash32Int64(segID)
+		expected[s.task.plan.GetTargetPartitionIDs()[hash%2]]++
+	}
+
+	result, err := s.task.Compact()
+	s.Require().NoError(err)
+	s.Equal(s.task.plan.GetPlanID(), result.GetPlanID())
+	s.Equal(len(expected), len(result.GetSegments()))
+	for _, segment := range result.GetSegments() {
+		s.Equal(expected[segment.GetPartitionID()], segment.GetNumOfRows())
+		s.NotEmpty(segment.GetInsertLogs())
+		s.NotEmpty(segment.GetField2StatslogPaths())
+		s.Empty(segment.GetDeltalogs())
+	}
+}
+
+func (s *RepartitionCompactionTaskSuite) TestCompactWithL0Deletes() {
+	var nextID int64 = 19530
+	s.mockAlloc.EXPECT().AllocOne().RunAndReturn(func() (int64, error) {
+		nextID++
+		return nextID, nil
+	})
+	s.mockAlloc.EXPECT().Alloc(mock.Anything).Return(7777777, 8888888, nil)
+	s.mockBinlogIO.EXPECT().Upload(mock.Anything, mock.Anything).Return(nil)
+
+	var expectedRows int64
+	for _, segID := range []int64{5, 6, 7, 8} {
+		segWriter, err := NewSegmentWriter(s.schema, 100, segID, PartitionID, CollectionID)
+		s.Require().NoError(err)
+		s.Require().NoError(segWriter.Write(newTestValue(segID)))
+		segWriter.writer.Flush()
+
+		kvs, fBinlogs, err := serializeWrite(context.TODO(), s.task.Allocator, segWriter)
+		s.Require().NoError(err)
+		// the row of segment 6 is deleted by the L0 segment, so it's never read
+		if segID != 6 {
+			expectedRows++
+		}
+		s.mockBinlogIO.EXPECT().Download(mock.Anything, mock.MatchedBy(func(keys []string) bool {
+			left, right := lo.Difference(keys, lo.Keys(kvs))
+			return len(left) == 0 && len(right) == 0
+		})).Return(lo.Values(kvs), nil).Once()
+
+		s.task.plan.SegmentBinlogs = append(s.task.plan.SegmentBinlogs, &datapb.CompactionSegmentBinlogs{
+			SegmentID:    segID,
+			CollectionID: CollectionID,
+			PartitionID:  PartitionID,
+			FieldBinlogs: lo.Values(fBinlogs),
+		})
+	}
+
+	dblobs, err := getInt64DeltaBlobs(100, []int64{6}, []uint64{tsoutil.ComposeTSByTime(getMilvusBirthday().Add(time.Second), 0)})
+	s.Require().NoError(err)
+	s.mockBinlogIO.EXPECT().Download(mock.Anything, []string{"l0"}).Return([][]byte{dblobs.GetValue()}, nil).Once()
+	s.task.plan.SegmentBinlogs = append(s.task.plan.SegmentBinlogs, &datapb.CompactionSegmentBinlogs{
+		SegmentID:    100,
+		CollectionID: CollectionID,
+		PartitionID:  PartitionID,
+		Level:        datapb.SegmentLevel_L0,
+		Deltalogs:    []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{LogPath: "l0"}}}},
+	})
+
+	result, err := s.task.Compact()
+	s.Require().NoError(err)
+	var rows int64
+	for _, segment := range result.GetSegments() {
+		rows += segment.GetNumOfRows()
+		s.NotEqual(int64(100), segment.GetSegmentID())
+	}
+	s.Equal(expectedRows, rows)
+}
+
+func newTestValue(magic int64) *storage.Value {
+	return &storage.Value{
+		PK:        storage.NewInt64PrimaryKey(magic),
+		Timestamp: int64(tsoutil.ComposeTSByTime(getMilvusBirthday(), 0)),
+		Value:     getRow(magic),
+	}
+}
+
+func (s *RepartitionCompactionTaskSuite) TestCompactFail() {
+	s.Run("no target partitions", func() {
+		s.task.plan.TargetPartitionIDs = nil
+		s.task.plan.SegmentBinlogs = []*datapb.CompactionSegmentBinlogs{{SegmentID: 100}}
+		_, err := s.task.Compact()
+		s.Error(err)
+	})
+
+	s.Run("no partition key", func() {
+		s.task.plan.TargetPartitionIDs = []int64{10, 11}
+		s.task.plan.Schema = genTestCollectionMeta().GetSchema()
+		_, err := s.task.Compact()
+		s.Error(err)
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"math"
 
+	"github.com/samber/lo"
 	"go.uber.org/atomic"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
@@ -138,11 +139,69 @@ func (w *SegmentWriter) Write(v *storage.Value) error {
 		w.tsTo = ts
 	}
 
+	alignSchemaFields(w.sch, v)
 	w.pkstats.Update(v.PK)
 	w.rowCount.Inc()
 	return w.writer.Write(v)
 }
 
+// alignSchemaFields fills the fields added after the segment was written with their default values or nulls,
+// and removes the data of the fields dropped from the schema,
+// so that compaction backfills the binlogs of the added fields and cleans up the binlogs of the dropped ones.
+func alignSchemaFields(sch *schemapb.CollectionSchema, v *storage.Value) {
+	m, ok := v.Value.(map[typeutil.UniqueID]any)
+	if !ok {
+		return
+	}
+	for _, field := range sch.GetFields() {
+		if _, ok := m[field.GetFieldID()]; ok {
+			continue
+		}
+		if value, ok := addedFieldDefaultValue(field); ok {
+			m[field.GetFieldID()] = value
+		}
+	}
+	if len(m) <= len(sch.GetFields()) {
+		return
+	}
+	for fieldID := range m {
+		if !lo.ContainsBy(sch.GetFields(), func(field *schemapb.FieldSchema) bool {
+			return field.GetFieldID() == fieldID
+		}) {
+			delete(m, fieldID)
+		}
+	}
+}
+
+// addedFieldDefaultValue returns the default value of the field, or nil as null if the field is nullable without default value,
+// returns false if the field could not be filled.
+func addedFieldDefaultValue(field *schemapb.FieldSchema) (any, bool) {
+	dv := field.GetDefaultValue()
+	if dv == nil {
+		return nil, field.GetNullable()
+	}
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		return dv.GetBoolData(), true
+	case schemapb.DataType_Int8:
+		return int8(dv.GetIntData()), true
+	case schemapb.DataType_Int16:
+		return int16(dv.GetIntData()), true
+	case schemapb.DataType_Int32:
+		return dv.GetIntData(), true
+	case schemapb.DataType_Int64:
+		return dv.GetLongData(), true
+	case schemapb.DataType_Float:
+		return dv.GetFloatData(), true
+	case schemapb.DataType_Double:
+		return dv.GetDoubleData(), true
+	case schemapb.DataType_VarChar, schemapb.DataType_String:
+		return dv.GetStringData(), true
+	default:
+		return nil, field.GetNullable()
+	}
+}
+
 func (w *SegmentWriter) Finish(actualRowCount int64) (*storage.Blob, error) {
 	w.writer.Flush()
 	codec := storage.NewInsertCodecWithSchema(&etcdpb.CollectionMeta{ID: w.collectionID, Schema: w.sch})
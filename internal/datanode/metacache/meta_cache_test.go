@@ -19,6 +19,7 @@ package metacache
 import (
 	"testing"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/suite"
 
@@ -103,6 +104,15 @@ func (s *MetaCacheSuite) TestMetaInfo() {
 	s.Equal(s.collSchema, s.cache.Schema())
 }
 
+func (s *MetaCacheSuite) TestUpdateSchema() {
+	schema := proto.Clone(s.collSchema).(*schemapb.CollectionSchema)
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+		FieldID: 102, DataType: schemapb.DataType_Int64, Name: "added", Nullable: true,
+	})
+	s.cache.UpdateSchema(schema)
+	s.Equal(schema, s.cache.Schema())
+}
+
 func (s *MetaCacheSuite) TestAddSegment() {
 	testSegs := []int64{100, 101, 102}
 	for _, segID := range testSegs {
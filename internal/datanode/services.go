@@ -237,6 +237,13 @@ func (node *DataNode) CompactionV2(ctx context.Context, req *datapb.CompactionPl
 			node.allocator,
 			req,
 		)
+	case datapb.CompactionType_RepartitionCompaction:
+		task = compaction.NewRepartitionCompactionTask(
+			taskCtx,
+			binlogIO,
+			node.allocator,
+			req,
+		)
 	case datapb.CompactionType_ClusteringCompaction:
 		task = compaction.NewClusteringCompactionTask(
 			taskCtx,
@@ -430,6 +437,30 @@ func (node *DataNode) FlushChannels(ctx context.Context, req *datapb.FlushChanne
 	return merr.Success(), nil
 }
 
+// UpdateChannelSchema updates the collection schema of the provided channels after fields are added to the collection.
+func (node *DataNode) UpdateChannelSchema(ctx context.Context, req *datapb.UpdateChannelSchemaRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.Int64("nodeId", node.GetNodeID()),
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Strings("channels", req.GetChannels()))
+
+	log.Info("DataNode receives UpdateChannelSchema request")
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		log.Warn("DataNode.UpdateChannelSchema failed", zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	for _, channel := range req.GetChannels() {
+		err := node.writeBufferManager.UpdateSchema(channel, req.GetSchema())
+		if err != nil {
+			log.Warn("WriteBufferManager failed to update schema", zap.String("channel", channel), zap.Error(err))
+			return merr.Status(err), nil
+		}
+	}
+
+	log.Info("success to UpdateChannelSchema")
+	return merr.Success(), nil
+}
+
 func (node *DataNode) PreImport(ctx context.Context, req *datapb.PreImportRequest) (*commonpb.Status, error) {
 	log := log.Ctx(ctx).With(zap.Int64("taskID", req.GetTaskID()),
 		zap.Int64("jobID", req.GetJobID()),
@@ -38,6 +38,7 @@ import (
 	"github.com/milvus-io/milvus/internal/datanode/metacache"
 	"github.com/milvus-io/milvus/internal/datanode/pipeline"
 	"github.com/milvus-io/milvus/internal/datanode/util"
+	"github.com/milvus-io/milvus/internal/datanode/writebuffer"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/storage"
@@ -1093,6 +1094,47 @@ func (s *DataNodeServicesSuite) TestSyncSegments() {
 	})
 }
 
+func (s *DataNodeServicesSuite) TestUpdateChannelSchema() {
+	s.Run("node not healthy", func() {
+		s.SetupTest()
+		s.node.UpdateStateCode(commonpb.StateCode_Abnormal)
+
+		status, err := s.node.UpdateChannelSchema(context.Background(), &datapb.UpdateChannelSchemaRequest{})
+		s.NoError(err)
+		s.ErrorIs(merr.Error(status), merr.ErrServiceNotReady)
+	})
+
+	s.Run("normal case", func() {
+		s.SetupTest()
+		schema := &schemapb.CollectionSchema{Name: "test"}
+		wbManager := writebuffer.NewMockBufferManager(s.T())
+		wbManager.EXPECT().UpdateSchema("ch-1", schema).Return(nil)
+		s.node.writeBufferManager = wbManager
+
+		status, err := s.node.UpdateChannelSchema(context.Background(), &datapb.UpdateChannelSchemaRequest{
+			CollectionID: 1,
+			Channels:     []string{"ch-1"},
+			Schema:       schema,
+		})
+		s.NoError(err)
+		s.True(merr.Ok(status))
+	})
+
+	s.Run("channel not found", func() {
+		s.SetupTest()
+		wbManager := writebuffer.NewMockBufferManager(s.T())
+		wbManager.EXPECT().UpdateSchema("ch-1", mock.Anything).Return(merr.WrapErrChannelNotFound("ch-1"))
+		s.node.writeBufferManager = wbManager
+
+		status, err := s.node.UpdateChannelSchema(context.Background(), &datapb.UpdateChannelSchemaRequest{
+			CollectionID: 1,
+			Channels:     []string{"ch-1"},
+		})
+		s.NoError(err)
+		s.ErrorIs(merr.Error(status), merr.ErrChannelNotFound)
+	})
+}
+
 func (s *DataNodeServicesSuite) TestDropCompactionPlan() {
 	s.Run("node not healthy", func() {
 		s.SetupTest()
@@ -150,7 +150,7 @@ func (s *storageV1Serializer) setTaskMeta(task *SyncTask, pack *SyncPack) {
 		WithChannelName(pack.channelName).
 		WithSegmentID(pack.segmentID).
 		WithBatchSize(pack.batchSize).
-		WithSchema(s.metacache.Schema()).
+		WithSchema(s.schema).
 		WithStartPosition(pack.startPosition).
 		WithCheckpoint(pack.checkpoint).
 		WithLevel(pack.level).
@@ -1,11 +1,13 @@
 package writebuffer
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 	"testing"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -16,9 +18,11 @@ import (
 	milvus_storage "github.com/milvus-io/milvus-storage/go/storage"
 	"github.com/milvus-io/milvus-storage/go/storage/options"
 	"github.com/milvus-io/milvus-storage/go/storage/schema"
+	"github.com/milvus-io/milvus/internal/allocator"
 	"github.com/milvus-io/milvus/internal/datanode/broker"
 	"github.com/milvus-io/milvus/internal/datanode/metacache"
 	"github.com/milvus-io/milvus/internal/datanode/syncmgr"
+	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/internal/storage"
@@ -421,6 +425,78 @@ func (s *BFWriteBufferSuite) TestAutoSyncWithStorageV2() {
 	})
 }
 
+func (s *BFWriteBufferSuite) TestUpdateSchema() {
+	cache := metacache.NewMetaCache(&datapb.ChannelWatchInfo{
+		Schema: s.collInt64Schema,
+		Vchan: &datapb.VchannelInfo{
+			CollectionID: s.collID,
+			ChannelName:  s.channelName,
+		},
+	}, func(*datapb.SegmentInfo) *metacache.BloomFilterSet {
+		return metacache.NewBloomFilterSet()
+	})
+	idAllocator := allocator.NewMockGIDAllocator()
+	idAllocator.AllocF = func(count uint32) (int64, int64, error) {
+		return time.Now().Unix(), int64(count), nil
+	}
+	chunkManager := mocks.NewChunkManager(s.T())
+	chunkManager.EXPECT().RootPath().Return("files").Maybe()
+	chunkManager.EXPECT().MultiWrite(mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	wb, err := NewBFWriteBuffer(s.channelName, cache, s.storageV2Cache, s.syncMgr, &writeBufferOption{idAllocator: idAllocator})
+	s.Require().NoError(err)
+
+	_, msg := s.composeInsertMsg(1000, 10, 128, schemapb.DataType_Int64)
+	err = wb.BufferData([]*msgstream.InsertMsg{msg}, nil, &msgpb.MsgPosition{Timestamp: 100}, &msgpb.MsgPosition{Timestamp: 200})
+	s.Require().NoError(err)
+
+	newSchema := proto.Clone(s.collInt64Schema).(*schemapb.CollectionSchema)
+	newSchema.Fields = append(newSchema.Fields, &schemapb.FieldSchema{
+		FieldID: 102, Name: "added", DataType: schemapb.DataType_Int64, Nullable: true,
+	})
+	s.NoError(wb.UpdateSchema(newSchema))
+	s.Equal(newSchema, cache.Schema())
+	// same schema again is a no-op
+	s.NoError(wb.UpdateSchema(newSchema))
+
+	_, msg = s.composeInsertMsg(1001, 10, 128, schemapb.DataType_Int64)
+	msg.FieldsData = append(msg.FieldsData, &schemapb.FieldData{
+		FieldId: 102, FieldName: "added", Type: schemapb.DataType_Int64,
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_LongData{
+					LongData: &schemapb.LongArray{
+						Data: lo.RepeatBy(10, func(idx int) int64 { return int64(idx) }),
+					},
+				},
+			},
+		},
+		ValidData: lo.RepeatBy(10, func(idx int) bool { return idx%2 == 0 }),
+	})
+	err = wb.BufferData([]*msgstream.InsertMsg{msg}, nil, &msgpb.MsgPosition{Timestamp: 200}, &msgpb.MsgPosition{Timestamp: 300})
+	s.Require().NoError(err)
+
+	flush := func(segmentID int64) map[int64]*datapb.FieldBinlog {
+		task, err := wb.(*bfWriteBuffer).getSyncTask(context.Background(), segmentID)
+		s.Require().NoError(err)
+		syncTask, ok := task.(*syncmgr.SyncTask)
+		s.Require().True(ok)
+		s.Require().NoError(syncTask.WithChunkManager(chunkManager).Run(context.Background()))
+		insertLogs, _, _ := syncTask.Binlogs()
+		return insertLogs
+	}
+
+	// segment created before the field was added keeps the previous schema
+	insertLogs := flush(1000)
+	s.Contains(insertLogs, int64(101))
+	s.NotContains(insertLogs, int64(102))
+
+	insertLogs = flush(1001)
+	s.Contains(insertLogs, int64(101))
+	s.Contains(insertLogs, int64(102))
+	s.EqualValues(10, insertLogs[102].GetBinlogs()[0].GetEntriesNum())
+}
+
 func (s *BFWriteBufferSuite) TestCreateFailure() {
 	metacache := metacache.NewMockMetaCache(s.T())
 	metacache.EXPECT().Collection().Return(s.collID)
@@ -8,6 +8,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/datanode/metacache"
 	"github.com/milvus-io/milvus/internal/datanode/syncmgr"
 	"github.com/milvus-io/milvus/pkg/log"
@@ -32,6 +33,8 @@ type BufferManager interface {
 	// DropChannel remove write buffer and perform drop.
 	DropChannel(channel string)
 	DropPartitions(channel string, partitionIDs []int64)
+	// UpdateSchema updates the collection schema of the provided write buffer after fields are added.
+	UpdateSchema(channel string, schema *schemapb.CollectionSchema) error
 	// BufferData put data into channel write buffer.
 	BufferData(channel string, insertMsgs []*msgstream.InsertMsg, deleteMsgs []*msgstream.DeleteMsg, startPos, endPos *msgpb.MsgPosition) error
 	// GetCheckpoint returns checkpoint for provided channel.
@@ -261,6 +264,19 @@ func (m *bufferManager) DropChannel(channel string) {
 	buf.Close(context.Background(), true)
 }
 
+func (m *bufferManager) UpdateSchema(channel string, schema *schemapb.CollectionSchema) error {
+	m.mut.RLock()
+	buf, ok := m.buffers[channel]
+	m.mut.RUnlock()
+
+	if !ok {
+		log.Warn("failed to update schema, channel not maintained in manager", zap.String("channel", channel))
+		return merr.WrapErrChannelNotFound(channel)
+	}
+
+	return buf.UpdateSchema(schema)
+}
+
 func (m *bufferManager) DropPartitions(channel string, partitionIDs []int64) {
 	m.mut.RLock()
 	buf, ok := m.buffers[channel]
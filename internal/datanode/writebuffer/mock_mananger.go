@@ -11,6 +11,8 @@ import (
 	msgpb "github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 
 	msgstream "github.com/milvus-io/milvus/pkg/mq/msgstream"
+
+	schemapb "github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 )
 
 // MockBufferManager is an autogenerated mock type for the BufferManager type
@@ -478,6 +480,49 @@ func (_c *MockBufferManager_Stop_Call) RunAndReturn(run func()) *MockBufferManag
 	return _c
 }
 
+// UpdateSchema provides a mock function with given fields: channel, schema
+func (_m *MockBufferManager) UpdateSchema(channel string, schema *schemapb.CollectionSchema) error {
+	ret := _m.Called(channel, schema)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *schemapb.CollectionSchema) error); ok {
+		r0 = rf(channel, schema)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBufferManager_UpdateSchema_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSchema'
+type MockBufferManager_UpdateSchema_Call struct {
+	*mock.Call
+}
+
+// UpdateSchema is a helper method to define mock.On call
+//   - channel string
+//   - schema *schemapb.CollectionSchema
+func (_e *MockBufferManager_Expecter) UpdateSchema(channel interface{}, schema interface{}) *MockBufferManager_UpdateSchema_Call {
+	return &MockBufferManager_UpdateSchema_Call{Call: _e.mock.On("UpdateSchema", channel, schema)}
+}
+
+func (_c *MockBufferManager_UpdateSchema_Call) Run(run func(channel string, schema *schemapb.CollectionSchema)) *MockBufferManager_UpdateSchema_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*schemapb.CollectionSchema))
+	})
+	return _c
+}
+
+func (_c *MockBufferManager_UpdateSchema_Call) Return(_a0 error) *MockBufferManager_UpdateSchema_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBufferManager_UpdateSchema_Call) RunAndReturn(run func(string, *schemapb.CollectionSchema) error) *MockBufferManager_UpdateSchema_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockBufferManager creates a new instance of MockBufferManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockBufferManager(t interface {
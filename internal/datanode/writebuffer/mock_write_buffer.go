@@ -9,6 +9,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	msgstream "github.com/milvus-io/milvus/pkg/mq/msgstream"
+
+	schemapb "github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 )
 
 // MockWriteBuffer is an autogenerated mock type for the WriteBuffer type
@@ -425,6 +427,48 @@ func (_c *MockWriteBuffer_SetFlushTimestamp_Call) RunAndReturn(run func(uint64))
 	return _c
 }
 
+// UpdateSchema provides a mock function with given fields: schema
+func (_m *MockWriteBuffer) UpdateSchema(schema *schemapb.CollectionSchema) error {
+	ret := _m.Called(schema)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*schemapb.CollectionSchema) error); ok {
+		r0 = rf(schema)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWriteBuffer_UpdateSchema_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSchema'
+type MockWriteBuffer_UpdateSchema_Call struct {
+	*mock.Call
+}
+
+// UpdateSchema is a helper method to define mock.On call
+//   - schema *schemapb.CollectionSchema
+func (_e *MockWriteBuffer_Expecter) UpdateSchema(schema interface{}) *MockWriteBuffer_UpdateSchema_Call {
+	return &MockWriteBuffer_UpdateSchema_Call{Call: _e.mock.On("UpdateSchema", schema)}
+}
+
+func (_c *MockWriteBuffer_UpdateSchema_Call) Run(run func(schema *schemapb.CollectionSchema)) *MockWriteBuffer_UpdateSchema_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*schemapb.CollectionSchema))
+	})
+	return _c
+}
+
+func (_c *MockWriteBuffer_UpdateSchema_Call) Return(_a0 error) *MockWriteBuffer_UpdateSchema_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWriteBuffer_UpdateSchema_Call) RunAndReturn(run func(*schemapb.CollectionSchema) error) *MockWriteBuffer_UpdateSchema_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockWriteBuffer creates a new instance of MockWriteBuffer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockWriteBuffer(t interface {
@@ -13,11 +13,13 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/allocator"
 	"github.com/milvus-io/milvus/internal/datanode/metacache"
 	"github.com/milvus-io/milvus/internal/datanode/syncmgr"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
@@ -54,6 +56,8 @@ type WriteBuffer interface {
 	MemorySize() int64
 	// EvictBuffer evicts buffer to sync manager which match provided sync policies.
 	EvictBuffer(policies ...SyncPolicy)
+	// UpdateSchema updates the collection schema after fields are added to the collection.
+	UpdateSchema(schema *schemapb.CollectionSchema) error
 	// Close is the method to close and sink current buffer data.
 	Close(ctx context.Context, drop bool)
 }
@@ -116,6 +120,12 @@ func NewWriteBuffer(channel string, metacache metacache.MetaCache, storageV2Cach
 	}
 }
 
+// segmentSchema is the schema and serializer of a segment created before fields were added to the collection.
+type segmentSchema struct {
+	schema     *schemapb.CollectionSchema
+	serializer syncmgr.Serializer
+}
+
 // writeBufferBase is the common component for buffering data
 type writeBufferBase struct {
 	mut sync.RWMutex
@@ -136,6 +146,11 @@ type writeBufferBase struct {
 	syncCheckpoint *checkpointCandidates
 	syncMgr        syncmgr.SyncManager
 	serializer     syncmgr.Serializer
+	idAllocator    allocator.Interface
+
+	// segments created before fields were added keep the previous schema,
+	// so that all binlogs of a segment share the same fields.
+	segmentSchemas map[int64]*segmentSchema // segmentID => segmentSchema
 
 	checkpoint     *msgpb.MsgPosition
 	flushTimestamp *atomic.Uint64
@@ -198,6 +213,8 @@ func newWriteBufferBase(channel string, metacache metacache.MetaCache, storageV2
 		buffers:          make(map[int64]*segmentBuffer),
 		metaCache:        metacache,
 		serializer:       serializer,
+		idAllocator:      option.idAllocator,
+		segmentSchemas:   make(map[int64]*segmentSchema),
 		syncCheckpoint:   newCheckpointCandiates(),
 		syncPolicies:     option.syncPolicies,
 		flushTimestamp:   flushTs,
@@ -272,6 +289,73 @@ func (wb *writeBufferBase) EvictBuffer(policies ...SyncPolicy) {
 	}
 }
 
+// UpdateSchema updates the collection schema after fields are added to the collection.
+// Growing and sealed segments keep the previous schema, the rows of these segments
+// read the added fields as default values or nulls.
+func (wb *writeBufferBase) UpdateSchema(schema *schemapb.CollectionSchema) error {
+	wb.mut.Lock()
+	defer wb.mut.Unlock()
+
+	currentFields := typeutil.NewSet(lo.Map(wb.collSchema.GetFields(), func(field *schemapb.FieldSchema, _ int) int64 {
+		return field.GetFieldID()
+	})...)
+	addedFields := lo.Filter(schema.GetFields(), func(field *schemapb.FieldSchema, _ int) bool {
+		return !currentFields.Contain(field.GetFieldID())
+	})
+	if len(addedFields) == 0 {
+		return nil
+	}
+	if params.Params.CommonCfg.EnableStorageV2.GetAsBool() {
+		return merr.WrapErrServiceInternal("adding fields is not supported by storage v2")
+	}
+
+	estSize, err := typeutil.EstimateSizePerRecord(schema)
+	if err != nil {
+		return err
+	}
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	if err != nil {
+		return err
+	}
+
+	legacy := &segmentSchema{schema: wb.collSchema, serializer: wb.serializer}
+	segments := wb.metaCache.GetSegmentsBy(metacache.WithSegmentState(commonpb.SegmentState_Growing,
+		commonpb.SegmentState_Sealed, commonpb.SegmentState_Flushing))
+	for _, segment := range segments {
+		if _, ok := wb.segmentSchemas[segment.SegmentID()]; !ok {
+			wb.segmentSchemas[segment.SegmentID()] = legacy
+		}
+	}
+	for segmentID := range wb.segmentSchemas {
+		if _, ok := wb.metaCache.GetSegmentByID(segmentID); !ok {
+			delete(wb.segmentSchemas, segmentID)
+		}
+	}
+
+	wb.metaCache.UpdateSchema(schema)
+	serializer, err := syncmgr.NewStorageSerializer(wb.idAllocator, wb.metaCache, wb.metaWriter)
+	if err != nil {
+		return err
+	}
+
+	wb.collSchema = schema
+	wb.helper = helper
+	wb.estSizePerRecord = estSize
+	wb.serializer = serializer
+	wb.logger.Info("write buffer schema updated", zap.Int64s("addedFields", lo.Map(addedFields, func(field *schemapb.FieldSchema, _ int) int64 {
+		return field.GetFieldID()
+	})), zap.Int("legacySegmentNum", len(wb.segmentSchemas)))
+	return nil
+}
+
+// getSegmentSchema returns the schema and serializer used by the segment.
+func (wb *writeBufferBase) getSegmentSchema(segmentID int64) (*schemapb.CollectionSchema, syncmgr.Serializer) {
+	if legacy, ok := wb.segmentSchemas[segmentID]; ok {
+		return legacy.schema, legacy.serializer
+	}
+	return wb.collSchema, wb.serializer
+}
+
 func (wb *writeBufferBase) GetCheckpoint() *msgpb.MsgPosition {
 	log := wb.cpRatedLogger
 	wb.mut.RLock()
@@ -383,7 +467,8 @@ func (wb *writeBufferBase) getOrCreateBuffer(segmentID int64) *segmentBuffer {
 	buffer, ok := wb.buffers[segmentID]
 	if !ok {
 		var err error
-		buffer, err = newSegmentBuffer(segmentID, wb.collSchema)
+		schema, _ := wb.getSegmentSchema(segmentID)
+		buffer, err = newSegmentBuffer(segmentID, schema)
 		if err != nil {
 			// TODO avoid panic here
 			panic(err)
@@ -460,6 +545,32 @@ func (id *inData) batchPkExists(pks []storage.PrimaryKey, tss []uint64, hits []b
 	return hits
 }
 
+// fillDroppedFields fills the fields dropped after the write buffer was created with zero values,
+// the binlogs of these fields are removed by compaction later.
+// Nullable fields missing in the message, such as fields added after the message was produced, are filled with nulls.
+func fillDroppedFields(msg *msgstream.InsertMsg, schema *schemapb.CollectionSchema) error {
+	if msg.IsRowBased() {
+		return nil
+	}
+	present := typeutil.NewSet(lo.Map(msg.GetFieldsData(), func(fieldData *schemapb.FieldData, _ int) int64 {
+		return fieldData.GetFieldId()
+	})...)
+	for _, field := range schema.GetFields() {
+		if field.GetFieldID() < common.StartOfUserFieldID || present.Contain(field.GetFieldID()) {
+			continue
+		}
+		fieldData, err := typeutil.GenZeroFieldData(field, int(msg.GetNumRows()))
+		if err != nil {
+			return err
+		}
+		if field.GetNullable() {
+			fieldData.ValidData = make([]bool, msg.GetNumRows())
+		}
+		msg.FieldsData = append(msg.FieldsData, fieldData)
+	}
+	return nil
+}
+
 // prepareInsert transfers InsertMsg into organized InsertData grouped by segmentID
 // also returns primary key field data
 func (wb *writeBufferBase) prepareInsert(insertMsgs []*msgstream.InsertMsg) ([]*inData, error) {
@@ -481,14 +592,18 @@ func (wb *writeBufferBase) prepareInsert(insertMsgs []*msgstream.InsertMsg) ([]*
 			inData.strPKTs = make(map[string]int64)
 		}
 
+		schema, _ := wb.getSegmentSchema(segment)
 		for _, msg := range msgs {
-			data, err := storage.InsertMsgToInsertData(msg, wb.collSchema)
+			if err := fillDroppedFields(msg, schema); err != nil {
+				return nil, err
+			}
+			data, err := storage.InsertMsgToInsertData(msg, schema)
 			if err != nil {
 				log.Warn("failed to transfer insert msg to insert data", zap.Error(err))
 				return nil, err
 			}
 
-			pkFieldData, err := storage.GetPkFromInsertData(wb.collSchema, data)
+			pkFieldData, err := storage.GetPkFromInsertData(schema, data)
 			if err != nil {
 				return nil, err
 			}
@@ -632,7 +747,8 @@ func (wb *writeBufferBase) getSyncTask(ctx context.Context, segmentID int64) (sy
 
 	metrics.DataNodeFlowGraphBufferDataSize.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), fmt.Sprint(wb.collectionID)).Sub(totalMemSize)
 
-	return wb.serializer.EncodeBuffer(ctx, pack)
+	_, serializer := wb.getSegmentSchema(segmentID)
+	return serializer.EncodeBuffer(ctx, pack)
 }
 
 // getEstBatchSize returns the batch size based on estimated size per record and FlushBufferSize configuration value.
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/milvus-io/milvus/internal/datanode/syncmgr"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/util/conc"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -381,6 +383,31 @@ func (s *WriteBufferSuite) TestDropPartitions() {
 	wb.dropPartitions([]int64{100, 101})
 }
 
+func (s *WriteBufferSuite) TestFillDroppedFields() {
+	msg := &msgstream.InsertMsg{
+		InsertRequest: msgpb.InsertRequest{
+			Version: msgpb.InsertDataVersion_ColumnBased,
+			NumRows: 2,
+			FieldsData: []*schemapb.FieldData{
+				{FieldId: 100, Type: schemapb.DataType_Int64, Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2}}}},
+				}},
+			},
+		},
+	}
+	s.NoError(fillDroppedFields(msg, s.collSchema))
+	s.Len(msg.GetFieldsData(), 2)
+	s.EqualValues(101, msg.GetFieldsData()[1].GetFieldId())
+	s.Len(msg.GetFieldsData()[1].GetVectors().GetFloatVector().GetData(), 2*128)
+
+	sch := proto.Clone(s.collSchema).(*schemapb.CollectionSchema)
+	sch.Fields = append(sch.Fields, &schemapb.FieldSchema{FieldID: 102, DataType: schemapb.DataType_Int64, Nullable: true})
+	s.NoError(fillDroppedFields(msg, sch))
+	s.Len(msg.GetFieldsData(), 3)
+	s.EqualValues(102, msg.GetFieldsData()[2].GetFieldId())
+	s.Equal([]bool{false, false}, msg.GetFieldsData()[2].GetValidData())
+}
+
 func TestWriteBufferBase(t *testing.T) {
 	suite.Run(t, new(WriteBufferSuite))
 }
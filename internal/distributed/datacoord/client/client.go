@@ -727,6 +727,12 @@ func (c *Client) GcControl(ctx context.Context, req *datapb.GcControlRequest, op
 	})
 }
 
+func (c *Client) RunGarbageCollection(ctx context.Context, req *datapb.RunGarbageCollectionRequest, opts ...grpc.CallOption) (*datapb.RunGarbageCollectionResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.RunGarbageCollectionResponse, error) {
+		return client.RunGarbageCollection(ctx, req)
+	})
+}
+
 func (c *Client) ImportV2(ctx context.Context, in *internalpb.ImportRequestInternal, opts ...grpc.CallOption) (*internalpb.ImportResponse, error) {
 	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*internalpb.ImportResponse, error) {
 		return client.ImportV2(ctx, in)
@@ -750,3 +756,39 @@ func (c *Client) ListIndexes(ctx context.Context, in *indexpb.ListIndexesRequest
 		return client.ListIndexes(ctx, in)
 	})
 }
+
+func (c *Client) CreateSnapshot(ctx context.Context, req *datapb.CreateSnapshotRequest, opts ...grpc.CallOption) (*datapb.CreateSnapshotResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.CreateSnapshotResponse, error) {
+		return client.CreateSnapshot(ctx, req)
+	})
+}
+
+func (c *Client) ListSnapshots(ctx context.Context, req *datapb.ListSnapshotsRequest, opts ...grpc.CallOption) (*datapb.ListSnapshotsResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.ListSnapshotsResponse, error) {
+		return client.ListSnapshots(ctx, req)
+	})
+}
+
+func (c *Client) DropSnapshot(ctx context.Context, req *datapb.DropSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*commonpb.Status, error) {
+		return client.DropSnapshot(ctx, req)
+	})
+}
+
+func (c *Client) RestoreSnapshot(ctx context.Context, req *datapb.RestoreSnapshotRequest, opts ...grpc.CallOption) (*datapb.RestoreSnapshotResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.RestoreSnapshotResponse, error) {
+		return client.RestoreSnapshot(ctx, req)
+	})
+}
+
+func (c *Client) GetCollectionStorageUsage(ctx context.Context, req *internalpb.GetCollectionStorageUsageRequest, opts ...grpc.CallOption) (*internalpb.GetCollectionStorageUsageResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*internalpb.GetCollectionStorageUsageResponse, error) {
+		return client.GetCollectionStorageUsage(ctx, req)
+	})
+}
+
+func (c *Client) GetPartitionKeyStats(ctx context.Context, req *datapb.GetPartitionKeyStatsRequest, opts ...grpc.CallOption) (*datapb.GetPartitionKeyStatsResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.GetPartitionKeyStatsResponse, error) {
+		return client.GetPartitionKeyStats(ctx, req)
+	})
+}
@@ -2224,6 +2224,131 @@ func Test_GcControl(t *testing.T) {
 	assert.ErrorIs(t, err, context.DeadlineExceeded)
 }
 
+func Test_RunGarbageCollection(t *testing.T) {
+	paramtable.Init()
+
+	ctx := context.Background()
+	client, err := NewClient(ctx)
+	assert.NoError(t, err)
+	assert.NotNil(t, client)
+	defer client.Close()
+
+	mockDC := mocks.NewMockDataCoordClient(t)
+	mockGrpcClient := mocks.NewMockGrpcClient[datapb.DataCoordClient](t)
+	mockGrpcClient.EXPECT().Close().Return(nil)
+	mockGrpcClient.EXPECT().ReCall(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, f func(datapb.DataCoordClient) (interface{}, error)) (interface{}, error) {
+		return f(mockDC)
+	})
+	client.(*Client).grpcClient = mockGrpcClient
+
+	// test success
+	mockDC.EXPECT().RunGarbageCollection(mock.Anything, mock.Anything).Return(&datapb.RunGarbageCollectionResponse{Status: merr.Success()}, nil)
+	_, err = client.RunGarbageCollection(ctx, &datapb.RunGarbageCollectionRequest{})
+	assert.Nil(t, err)
+
+	// test return error status
+	mockDC.ExpectedCalls = nil
+	mockDC.EXPECT().RunGarbageCollection(mock.Anything, mock.Anything).Return(
+		&datapb.RunGarbageCollectionResponse{Status: merr.Status(merr.ErrServiceNotReady)}, nil)
+
+	rsp, err := client.RunGarbageCollection(ctx, &datapb.RunGarbageCollectionRequest{})
+	assert.NotEqual(t, int32(0), rsp.GetStatus().GetCode())
+	assert.Nil(t, err)
+
+	// test return error
+	mockDC.ExpectedCalls = nil
+	mockDC.EXPECT().RunGarbageCollection(mock.Anything, mock.Anything).Return(nil, mockErr)
+
+	_, err = client.RunGarbageCollection(ctx, &datapb.RunGarbageCollectionRequest{})
+	assert.NotNil(t, err)
+}
+
+func Test_Snapshot(t *testing.T) {
+	paramtable.Init()
+
+	ctx := context.Background()
+	client, err := NewClient(ctx)
+	assert.NoError(t, err)
+	assert.NotNil(t, client)
+	defer client.Close()
+
+	mockDC := mocks.NewMockDataCoordClient(t)
+	mockGrpcClient := mocks.NewMockGrpcClient[datapb.DataCoordClient](t)
+	mockGrpcClient.EXPECT().Close().Return(nil)
+	mockGrpcClient.EXPECT().ReCall(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, f func(datapb.DataCoordClient) (interface{}, error)) (interface{}, error) {
+		return f(mockDC)
+	})
+	client.(*Client).grpcClient = mockGrpcClient
+
+	mockDC.EXPECT().CreateSnapshot(mock.Anything, mock.Anything).Return(&datapb.CreateSnapshotResponse{Status: merr.Success()}, nil)
+	_, err = client.CreateSnapshot(ctx, &datapb.CreateSnapshotRequest{})
+	assert.NoError(t, err)
+
+	mockDC.EXPECT().ListSnapshots(mock.Anything, mock.Anything).Return(&datapb.ListSnapshotsResponse{Status: merr.Success()}, nil)
+	_, err = client.ListSnapshots(ctx, &datapb.ListSnapshotsRequest{})
+	assert.NoError(t, err)
+
+	mockDC.EXPECT().DropSnapshot(mock.Anything, mock.Anything).Return(merr.Success(), nil)
+	_, err = client.DropSnapshot(ctx, &datapb.DropSnapshotRequest{})
+	assert.NoError(t, err)
+
+	mockDC.EXPECT().RestoreSnapshot(mock.Anything, mock.Anything).Return(nil, mockErr)
+	_, err = client.RestoreSnapshot(ctx, &datapb.RestoreSnapshotRequest{})
+	assert.Error(t, err)
+}
+
+func Test_GetCollectionStorageUsage(t *testing.T) {
+	paramtable.Init()
+
+	ctx := context.Background()
+	client, err := NewClient(ctx)
+	assert.NoError(t, err)
+	assert.NotNil(t, client)
+	defer client.Close()
+
+	mockDC := mocks.NewMockDataCoordClient(t)
+	mockGrpcClient := mocks.NewMockGrpcClient[datapb.DataCoordClient](t)
+	mockGrpcClient.EXPECT().Close().Return(nil)
+	mockGrpcClient.EXPECT().ReCall(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, f func(datapb.DataCoordClient) (interface{}, error)) (interface{}, error) {
+		return f(mockDC)
+	})
+	client.(*Client).grpcClient = mockGrpcClient
+
+	mockDC.EXPECT().GetCollectionStorageUsage(mock.Anything, mock.Anything).Return(&internalpb.GetCollectionStorageUsageResponse{Status: merr.Success()}, nil).Once()
+	_, err = client.GetCollectionStorageUsage(ctx, &internalpb.GetCollectionStorageUsageRequest{})
+	assert.NoError(t, err)
+
+	mockDC.EXPECT().GetCollectionStorageUsage(mock.Anything, mock.Anything).Return(nil, mockErr)
+	_, err = client.GetCollectionStorageUsage(ctx, &internalpb.GetCollectionStorageUsageRequest{})
+	assert.Error(t, err)
+}
+
+func Test_GetPartitionKeyStats(t *testing.T) {
+	paramtable.Init()
+
+	ctx := context.Background()
+	client, err := NewClient(ctx)
+	assert.NoError(t, err)
+	assert.NotNil(t, client)
+	defer client.Close()
+
+	mockDC := mocks.NewMockDataCoordClient(t)
+	mockGrpcClient := mocks.NewMockGrpcClient[datapb.DataCoordClient](t)
+	mockGrpcClient.EXPECT().Close().Return(nil)
+	mockGrpcClient.EXPECT().ReCall(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, f func(datapb.DataCoordClient) (interface{}, error)) (interface{}, error) {
+		return f(mockDC)
+	})
+	client.(*Client).grpcClient = mockGrpcClient
+
+	mockDC.EXPECT().GetPartitionKeyStats(mock.Anything, mock.Anything).Return(&datapb.GetPartitionKeyStatsResponse{Status: merr.Success()}, nil).Once()
+	_, err = client.GetPartitionKeyStats(ctx, &datapb.GetPartitionKeyStatsRequest{})
+	assert.NoError(t, err)
+
+	mockDC.EXPECT().GetPartitionKeyStats(mock.Anything, mock.Anything).Return(nil, mockErr)
+	_, err = client.GetPartitionKeyStats(ctx, &datapb.GetPartitionKeyStatsRequest{})
+	assert.Error(t, err)
+}
+
 func Test_ListIndexes(t *testing.T) {
 	paramtable.Init()
 
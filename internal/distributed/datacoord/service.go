@@ -41,6 +41,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/auditlog"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	_ "github.com/milvus-io/milvus/internal/util/grpcclient"
 	"github.com/milvus-io/milvus/pkg/log"
@@ -184,6 +185,7 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 				}
 				return s.serverID.Load()
 			}),
+			auditlog.UnaryServerInterceptor(nil),
 		)),
 		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
 			otelgrpc.StreamServerInterceptor(opts...),
@@ -474,6 +476,10 @@ func (s *Server) GcControl(ctx context.Context, req *datapb.GcControlRequest) (*
 	return s.dataCoord.GcControl(ctx, req)
 }
 
+func (s *Server) RunGarbageCollection(ctx context.Context, req *datapb.RunGarbageCollectionRequest) (*datapb.RunGarbageCollectionResponse, error) {
+	return s.dataCoord.RunGarbageCollection(ctx, req)
+}
+
 func (s *Server) ImportV2(ctx context.Context, in *internalpb.ImportRequestInternal) (*internalpb.ImportResponse, error) {
 	return s.dataCoord.ImportV2(ctx, in)
 }
@@ -489,3 +495,27 @@ func (s *Server) ListImports(ctx context.Context, in *internalpb.ListImportsRequ
 func (s *Server) ListIndexes(ctx context.Context, in *indexpb.ListIndexesRequest) (*indexpb.ListIndexesResponse, error) {
 	return s.dataCoord.ListIndexes(ctx, in)
 }
+
+func (s *Server) CreateSnapshot(ctx context.Context, req *datapb.CreateSnapshotRequest) (*datapb.CreateSnapshotResponse, error) {
+	return s.dataCoord.CreateSnapshot(ctx, req)
+}
+
+func (s *Server) ListSnapshots(ctx context.Context, req *datapb.ListSnapshotsRequest) (*datapb.ListSnapshotsResponse, error) {
+	return s.dataCoord.ListSnapshots(ctx, req)
+}
+
+func (s *Server) DropSnapshot(ctx context.Context, req *datapb.DropSnapshotRequest) (*commonpb.Status, error) {
+	return s.dataCoord.DropSnapshot(ctx, req)
+}
+
+func (s *Server) RestoreSnapshot(ctx context.Context, req *datapb.RestoreSnapshotRequest) (*datapb.RestoreSnapshotResponse, error) {
+	return s.dataCoord.RestoreSnapshot(ctx, req)
+}
+
+func (s *Server) GetCollectionStorageUsage(ctx context.Context, req *internalpb.GetCollectionStorageUsageRequest) (*internalpb.GetCollectionStorageUsageResponse, error) {
+	return s.dataCoord.GetCollectionStorageUsage(ctx, req)
+}
+
+func (s *Server) GetPartitionKeyStats(ctx context.Context, req *datapb.GetPartitionKeyStatsRequest) (*datapb.GetPartitionKeyStatsResponse, error) {
+	return s.dataCoord.GetPartitionKeyStats(ctx, req)
+}
@@ -311,6 +311,55 @@ func Test_NewServer(t *testing.T) {
 		assert.NotNil(t, ret)
 	})
 
+	t.Run("RunGarbageCollection", func(t *testing.T) {
+		mockDataCoord.EXPECT().RunGarbageCollection(mock.Anything, mock.Anything).Return(&datapb.RunGarbageCollectionResponse{}, nil)
+		ret, err := server.RunGarbageCollection(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, ret)
+	})
+
+	t.Run("CreateSnapshot", func(t *testing.T) {
+		mockDataCoord.EXPECT().CreateSnapshot(mock.Anything, mock.Anything).Return(&datapb.CreateSnapshotResponse{}, nil)
+		ret, err := server.CreateSnapshot(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, ret)
+	})
+
+	t.Run("ListSnapshots", func(t *testing.T) {
+		mockDataCoord.EXPECT().ListSnapshots(mock.Anything, mock.Anything).Return(&datapb.ListSnapshotsResponse{}, nil)
+		ret, err := server.ListSnapshots(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, ret)
+	})
+
+	t.Run("DropSnapshot", func(t *testing.T) {
+		mockDataCoord.EXPECT().DropSnapshot(mock.Anything, mock.Anything).Return(&commonpb.Status{}, nil)
+		ret, err := server.DropSnapshot(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, ret)
+	})
+
+	t.Run("RestoreSnapshot", func(t *testing.T) {
+		mockDataCoord.EXPECT().RestoreSnapshot(mock.Anything, mock.Anything).Return(&datapb.RestoreSnapshotResponse{}, nil)
+		ret, err := server.RestoreSnapshot(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, ret)
+	})
+
+	t.Run("GetCollectionStorageUsage", func(t *testing.T) {
+		mockDataCoord.EXPECT().GetCollectionStorageUsage(mock.Anything, mock.Anything).Return(&internalpb.GetCollectionStorageUsageResponse{}, nil)
+		ret, err := server.GetCollectionStorageUsage(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, ret)
+	})
+
+	t.Run("GetPartitionKeyStats", func(t *testing.T) {
+		mockDataCoord.EXPECT().GetPartitionKeyStats(mock.Anything, mock.Anything).Return(&datapb.GetPartitionKeyStatsResponse{}, nil)
+		ret, err := server.GetPartitionKeyStats(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, ret)
+	})
+
 	t.Run("ListIndex", func(t *testing.T) {
 		mockDataCoord.EXPECT().ListIndexes(mock.Anything, mock.Anything).Return(&indexpb.ListIndexesResponse{
 			Status: merr.Success(),
@@ -86,6 +86,9 @@ func Test_NewClient(t *testing.T) {
 
 		r14, err := client.DropCompactionPlan(ctx, nil)
 		retCheck(retNotNil, r14, err)
+
+		r15, err := client.UpdateChannelSchema(ctx, nil)
+		retCheck(retNotNil, r15, err)
 	}
 
 	client.(*Client).grpcClient = &mock.GRPCClientBase[datapb.DataNodeClient]{
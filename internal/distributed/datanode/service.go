@@ -410,3 +410,7 @@ func (s *Server) QuerySlot(ctx context.Context, req *datapb.QuerySlotRequest) (*
 func (s *Server) DropCompactionPlan(ctx context.Context, req *datapb.DropCompactionPlanRequest) (*commonpb.Status, error) {
 	return s.datanode.DropCompactionPlan(ctx, req)
 }
+
+func (s *Server) UpdateChannelSchema(ctx context.Context, req *datapb.UpdateChannelSchemaRequest) (*commonpb.Status, error) {
+	return s.datanode.UpdateChannelSchema(ctx, req)
+}
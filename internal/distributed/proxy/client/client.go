@@ -217,6 +217,66 @@ func (c *Client) ListImports(ctx context.Context, req *internalpb.ListImportsReq
 	})
 }
 
+func (c *Client) GetCollectionStorageUsage(ctx context.Context, req *internalpb.GetCollectionStorageUsageRequest, opts ...grpc.CallOption) (*internalpb.GetCollectionStorageUsageResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*internalpb.GetCollectionStorageUsageResponse, error) {
+		return client.GetCollectionStorageUsage(ctx, req)
+	})
+}
+
+func (c *Client) AddCollectionField(ctx context.Context, req *internalpb.AddCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*commonpb.Status, error) {
+		return client.AddCollectionField(ctx, req)
+	})
+}
+
+func (c *Client) DropCollectionField(ctx context.Context, req *internalpb.DropCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*commonpb.Status, error) {
+		return client.DropCollectionField(ctx, req)
+	})
+}
+
+func (c *Client) RenameCollectionField(ctx context.Context, req *internalpb.RenameCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*commonpb.Status, error) {
+		return client.RenameCollectionField(ctx, req)
+	})
+}
+
+func (c *Client) AlterAliases(ctx context.Context, req *internalpb.AlterAliasesRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*commonpb.Status, error) {
+		return client.AlterAliases(ctx, req)
+	})
+}
+
+func (c *Client) DropCollectionAsync(ctx context.Context, req *milvuspb.DropCollectionRequest, opts ...grpc.CallOption) (*internalpb.SubmitDDLJobResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*internalpb.SubmitDDLJobResponse, error) {
+		return client.DropCollectionAsync(ctx, req)
+	})
+}
+
+func (c *Client) DropDatabaseAsync(ctx context.Context, req *milvuspb.DropDatabaseRequest, opts ...grpc.CallOption) (*internalpb.SubmitDDLJobResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*internalpb.SubmitDDLJobResponse, error) {
+		return client.DropDatabaseAsync(ctx, req)
+	})
+}
+
+func (c *Client) ListDDLJobs(ctx context.Context, req *internalpb.ListDDLJobsRequest, opts ...grpc.CallOption) (*internalpb.ListDDLJobsResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*internalpb.ListDDLJobsResponse, error) {
+		return client.ListDDLJobs(ctx, req)
+	})
+}
+
+func (c *Client) GetDDLJob(ctx context.Context, req *internalpb.GetDDLJobRequest, opts ...grpc.CallOption) (*internalpb.GetDDLJobResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*internalpb.GetDDLJobResponse, error) {
+		return client.GetDDLJob(ctx, req)
+	})
+}
+
+func (c *Client) ListPrivilegeGroups(ctx context.Context, req *internalpb.ListPrivilegeGroupsRequest, opts ...grpc.CallOption) (*internalpb.ListPrivilegeGroupsResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*internalpb.ListPrivilegeGroupsResponse, error) {
+		return client.ListPrivilegeGroups(ctx, req)
+	})
+}
+
 func (c *Client) InvalidateShardLeaderCache(ctx context.Context, req *proxypb.InvalidateShardLeaderCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*commonpb.Status, error) {
 		return client.InvalidateShardLeaderCache(ctx, req)
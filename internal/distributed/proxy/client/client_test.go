@@ -461,6 +461,46 @@ func Test_ImportV2(t *testing.T) {
 	mockProxy.EXPECT().ListImports(mock.Anything, mock.Anything).Return(&internalpb.ListImportsResponse{Status: merr.Success()}, nil)
 	_, err = client.ListImports(ctx, &internalpb.ListImportsRequest{})
 	assert.Nil(t, err)
+
+	mockProxy.EXPECT().GetCollectionStorageUsage(mock.Anything, mock.Anything).Return(&internalpb.GetCollectionStorageUsageResponse{Status: merr.Success()}, nil)
+	_, err = client.GetCollectionStorageUsage(ctx, &internalpb.GetCollectionStorageUsageRequest{})
+	assert.Nil(t, err)
+
+	mockProxy.EXPECT().ListPrivilegeGroups(mock.Anything, mock.Anything).Return(&internalpb.ListPrivilegeGroupsResponse{Status: merr.Success()}, nil)
+	_, err = client.ListPrivilegeGroups(ctx, &internalpb.ListPrivilegeGroupsRequest{})
+	assert.Nil(t, err)
+
+	mockProxy.EXPECT().AddCollectionField(mock.Anything, mock.Anything).Return(merr.Success(), nil)
+	_, err = client.AddCollectionField(ctx, &internalpb.AddCollectionFieldRequest{})
+	assert.Nil(t, err)
+
+	mockProxy.EXPECT().DropCollectionField(mock.Anything, mock.Anything).Return(merr.Success(), nil)
+	_, err = client.DropCollectionField(ctx, &internalpb.DropCollectionFieldRequest{})
+	assert.Nil(t, err)
+
+	mockProxy.EXPECT().RenameCollectionField(mock.Anything, mock.Anything).Return(merr.Success(), nil)
+	_, err = client.RenameCollectionField(ctx, &internalpb.RenameCollectionFieldRequest{})
+	assert.Nil(t, err)
+
+	mockProxy.EXPECT().AlterAliases(mock.Anything, mock.Anything).Return(merr.Success(), nil)
+	_, err = client.AlterAliases(ctx, &internalpb.AlterAliasesRequest{})
+	assert.Nil(t, err)
+
+	mockProxy.EXPECT().DropCollectionAsync(mock.Anything, mock.Anything).Return(&internalpb.SubmitDDLJobResponse{Status: merr.Success()}, nil)
+	_, err = client.DropCollectionAsync(ctx, &milvuspb.DropCollectionRequest{})
+	assert.Nil(t, err)
+
+	mockProxy.EXPECT().DropDatabaseAsync(mock.Anything, mock.Anything).Return(&internalpb.SubmitDDLJobResponse{Status: merr.Success()}, nil)
+	_, err = client.DropDatabaseAsync(ctx, &milvuspb.DropDatabaseRequest{})
+	assert.Nil(t, err)
+
+	mockProxy.EXPECT().ListDDLJobs(mock.Anything, mock.Anything).Return(&internalpb.ListDDLJobsResponse{Status: merr.Success()}, nil)
+	_, err = client.ListDDLJobs(ctx, &internalpb.ListDDLJobsRequest{})
+	assert.Nil(t, err)
+
+	mockProxy.EXPECT().GetDDLJob(mock.Anything, mock.Anything).Return(&internalpb.GetDDLJobResponse{Status: merr.Success()}, nil)
+	_, err = client.GetDDLJob(ctx, &internalpb.GetDDLJobRequest{})
+	assert.Nil(t, err)
 }
 
 func Test_InvalidateShardLeaderCache(t *testing.T) {
@@ -25,6 +25,7 @@ const (
 	DropAction           = "drop"
 	StatsAction          = "get_stats"
 	LoadStateAction      = "get_load_state"
+	StorageUsageAction   = "get_storage_usage"
 	RenameAction         = "rename"
 	LoadAction           = "load"
 	ReleaseAction        = "release"
@@ -42,6 +43,7 @@ const (
 	RevokeRoleAction      = "revoke_role"
 	GrantPrivilegeAction  = "grant_privilege"
 	RevokePrivilegeAction = "revoke_privilege"
+	PrivilegeGroupsAction = "list_privilege_groups"
 	AlterAction           = "alter"
 	GetProgressAction     = "get_progress"
 )
@@ -55,6 +55,7 @@ func (h *HandlersV2) RegisterRoutesToV2(router gin.IRouter) {
 	router.POST(CollectionCategory+DescribeAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionNameReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.getCollectionDetails)))))
 	router.POST(CollectionCategory+StatsAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionNameReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.getCollectionStats)))))
 	router.POST(CollectionCategory+LoadStateAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionNameReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.getCollectionLoadState)))))
+	router.POST(CollectionCategory+StorageUsageAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionNameReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.getCollectionStorageUsage)))))
 	router.POST(CollectionCategory+CreateAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionReq{AutoID: DisableAutoID} }, wrapperTraceLog(h.wrapperCheckDatabase(h.createCollection)))))
 	router.POST(CollectionCategory+DropAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionNameReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.dropCollection)))))
 	router.POST(CollectionCategory+RenameAction, timeoutMiddleware(wrapperPost(func() any { return &RenameCollectionReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.renameCollection)))))
@@ -122,6 +123,7 @@ func (h *HandlersV2) RegisterRoutesToV2(router gin.IRouter) {
 	router.POST(RoleCategory+DropAction, timeoutMiddleware(wrapperPost(func() any { return &RoleReq{} }, wrapperTraceLog(h.dropRole))))
 	router.POST(RoleCategory+GrantPrivilegeAction, timeoutMiddleware(wrapperPost(func() any { return &GrantReq{} }, wrapperTraceLog(h.addPrivilegeToRole))))
 	router.POST(RoleCategory+RevokePrivilegeAction, timeoutMiddleware(wrapperPost(func() any { return &GrantReq{} }, wrapperTraceLog(h.removePrivilegeFromRole))))
+	router.POST(RoleCategory+PrivilegeGroupsAction, timeoutMiddleware(wrapperPost(func() any { return &DatabaseReq{} }, wrapperTraceLog(h.listPrivilegeGroups))))
 
 	router.POST(IndexCategory+ListAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionNameReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.listIndexes)))))
 	router.POST(IndexCategory+DescribeAction, timeoutMiddleware(wrapperPost(func() any { return &IndexReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.describeIndex)))))
@@ -262,6 +264,7 @@ func wrapperProxy(ctx context.Context, c *gin.Context, req any, checkAuth bool,
 	if !ok {
 		username = ""
 	}
+	ctx = proxy.WithAuditInfo(ctx, fullMethod, username.(string), c.ClientIP())
 	response, err := proxy.HookInterceptor(ctx, req, username.(string), fullMethod, handler)
 	if err == nil {
 		status, ok := requestutil.GetStatusFromResponse(response)
@@ -449,6 +452,52 @@ func (h *HandlersV2) getCollectionStats(ctx context.Context, c *gin.Context, any
 	return resp, err
 }
 
+func (h *HandlersV2) getCollectionStorageUsage(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	collectionGetter, _ := anyReq.(requestutil.CollectionNameGetter)
+	req := &internalpb.GetCollectionStorageUsageRequest{
+		DbName:         dbName,
+		CollectionName: collectionGetter.GetCollectionName(),
+	}
+	c.Set(ContextRequest, req)
+
+	if h.checkAuth {
+		err := checkAuthorizationV2(ctx, c, false, &milvuspb.GetCollectionStatisticsRequest{
+			DbName:         dbName,
+			CollectionName: collectionGetter.GetCollectionName(),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	resp, err := wrapperProxy(ctx, c, req, false, false, "/milvus.proto.milvus.MilvusService/GetCollectionStorageUsage", func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.GetCollectionStorageUsage(reqCtx, req.(*internalpb.GetCollectionStorageUsageRequest))
+	})
+	if err == nil {
+		response := resp.(*internalpb.GetCollectionStorageUsageResponse)
+		indexes := make([]map[string]interface{}, 0, len(response.GetIndexes()))
+		for _, index := range response.GetIndexes() {
+			indexes = append(indexes, map[string]interface{}{
+				"indexId":     index.GetIndexID(),
+				HTTPIndexName: index.GetIndexName(),
+				"fieldId":     index.GetFieldID(),
+				"indexSize":   index.GetSize(),
+			})
+		}
+		HTTPReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(nil), HTTPReturnData: gin.H{
+			HTTPCollectionName:  collectionGetter.GetCollectionName(),
+			"insertBinlogSize":  response.GetInsertBinlogSize(),
+			"deltalogSize":      response.GetDeltalogSize(),
+			"statslogSize":      response.GetStatslogSize(),
+			"indexes":           indexes,
+			"growingSegmentNum": response.GetGrowingSegmentNum(),
+			"sealedSegmentNum":  response.GetSealedSegmentNum(),
+			"l0SegmentNum":      response.GetL0SegmentNum(),
+			"reclaimableSize":   response.GetReclaimableSize(),
+		}})
+	}
+	return resp, err
+}
+
 func (h *HandlersV2) getCollectionLoadState(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
 	collectionGetter, _ := anyReq.(requestutil.CollectionNameGetter)
 	req := &milvuspb.GetLoadStateRequest{
@@ -1561,6 +1610,25 @@ func (h *HandlersV2) listRoles(ctx context.Context, c *gin.Context, anyReq any,
 	return resp, err
 }
 
+func (h *HandlersV2) listPrivilegeGroups(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	req := &internalpb.ListPrivilegeGroupsRequest{}
+	resp, err := wrapperProxy(ctx, c, req, false, false, "/milvus.proto.milvus.MilvusService/ListPrivilegeGroups", func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.ListPrivilegeGroups(reqCtx, req.(*internalpb.ListPrivilegeGroupsRequest))
+	})
+	if err == nil {
+		groups := make([]gin.H, 0)
+		for _, group := range resp.(*internalpb.ListPrivilegeGroupsResponse).GetPrivilegeGroups() {
+			groups = append(groups, gin.H{
+				"privilegeGroupName": group.GetGroupName(),
+				HTTPReturnObjectType: group.GetObjectType(),
+				"privileges":         group.GetPrivileges(),
+			})
+		}
+		HTTPReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(nil), HTTPReturnData: groups})
+	}
+	return resp, err
+}
+
 func (h *HandlersV2) describeRole(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
 	getter, _ := anyReq.(RoleNameGetter)
 	req := &milvuspb.SelectGrantRequest{
@@ -767,6 +767,14 @@ func TestMethodGet(t *testing.T) {
 			{Key: "row_count", Value: "abc"},
 		},
 	}, nil).Once()
+	mp.EXPECT().GetCollectionStorageUsage(mock.Anything, mock.Anything).Return(&internalpb.GetCollectionStorageUsageResponse{
+		Status:           commonSuccessStatus,
+		InsertBinlogSize: 1024,
+		Indexes:          []*internalpb.IndexStorageUsage{{IndexID: 1, IndexName: DefaultIndexName, FieldID: 100, Size: 512}},
+	}, nil).Once()
+	mp.EXPECT().GetCollectionStorageUsage(mock.Anything, mock.Anything).Return(&internalpb.GetCollectionStorageUsageResponse{
+		Status: merr.Status(merr.WrapErrCollectionNotFound(DefaultCollectionName)),
+	}, nil).Once()
 	mp.EXPECT().GetLoadingProgress(mock.Anything, mock.Anything).Return(&milvuspb.GetLoadingProgressResponse{
 		Status:   commonSuccessStatus,
 		Progress: int64(77),
@@ -823,6 +831,12 @@ func TestMethodGet(t *testing.T) {
 			},
 		},
 	}, nil).Once()
+	mp.EXPECT().ListPrivilegeGroups(mock.Anything, mock.Anything).Return(&internalpb.ListPrivilegeGroupsResponse{
+		Status: &StatusSuccess,
+		PrivilegeGroups: []*internalpb.PrivilegeGroupInfo{
+			{GroupName: util.PrivilegeGroupCollectionReadOnly, ObjectType: "Collection", Privileges: []string{"Query", "Search"}},
+		},
+	}, nil).Once()
 	mp.EXPECT().ListAliases(mock.Anything, mock.Anything).Return(&milvuspb.ListAliasesResponse{Status: commonErrorStatus}, nil).Once()
 	mp.EXPECT().ListAliases(mock.Anything, mock.Anything).Return(&milvuspb.ListAliasesResponse{
 		Status: &StatusSuccess,
@@ -886,6 +900,14 @@ func TestMethodGet(t *testing.T) {
 	queryTestCases = append(queryTestCases, rawTestCase{
 		path: versionalV2(CollectionCategory, LoadStateAction),
 	})
+	queryTestCases = append(queryTestCases, rawTestCase{
+		path: versionalV2(CollectionCategory, StorageUsageAction),
+	})
+	queryTestCases = append(queryTestCases, rawTestCase{
+		path:    versionalV2(CollectionCategory, StorageUsageAction),
+		errCode: 100,
+		errMsg:  "collection not found[collection=book]",
+	})
 	queryTestCases = append(queryTestCases, rawTestCase{
 		path: versionalV2(PartitionCategory, ListAction),
 	})
@@ -907,6 +929,9 @@ func TestMethodGet(t *testing.T) {
 	queryTestCases = append(queryTestCases, rawTestCase{
 		path: versionalV2(RoleCategory, DescribeAction),
 	})
+	queryTestCases = append(queryTestCases, rawTestCase{
+		path: versionalV2(RoleCategory, PrivilegeGroupsAction),
+	})
 	queryTestCases = append(queryTestCases, rawTestCase{
 		path: versionalV2(IndexCategory, ListAction),
 	})
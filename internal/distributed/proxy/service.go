@@ -278,6 +278,7 @@ func (s *Server) startExternalGrpc(grpcPort int, errChan chan error) {
 			otelgrpc.UnaryServerInterceptor(opts...),
 			grpc_auth.UnaryServerInterceptor(proxy.AuthenticationInterceptor),
 			proxy.DatabaseInterceptor(),
+			proxy.AuditInterceptor(),
 			proxy.UnaryServerHookInterceptor(),
 			proxy.UnaryServerInterceptor(proxy.PrivilegeInterceptor),
 			logutil.UnaryTraceLoggerInterceptor,
@@ -1231,6 +1232,46 @@ func (s *Server) ListImports(ctx context.Context, req *internalpb.ListImportsReq
 	return s.proxy.ListImports(ctx, req)
 }
 
+func (s *Server) GetCollectionStorageUsage(ctx context.Context, req *internalpb.GetCollectionStorageUsageRequest) (*internalpb.GetCollectionStorageUsageResponse, error) {
+	return s.proxy.GetCollectionStorageUsage(ctx, req)
+}
+
+func (s *Server) AddCollectionField(ctx context.Context, req *internalpb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	return s.proxy.AddCollectionField(ctx, req)
+}
+
+func (s *Server) DropCollectionField(ctx context.Context, req *internalpb.DropCollectionFieldRequest) (*commonpb.Status, error) {
+	return s.proxy.DropCollectionField(ctx, req)
+}
+
+func (s *Server) RenameCollectionField(ctx context.Context, req *internalpb.RenameCollectionFieldRequest) (*commonpb.Status, error) {
+	return s.proxy.RenameCollectionField(ctx, req)
+}
+
+func (s *Server) AlterAliases(ctx context.Context, req *internalpb.AlterAliasesRequest) (*commonpb.Status, error) {
+	return s.proxy.AlterAliases(ctx, req)
+}
+
+func (s *Server) DropCollectionAsync(ctx context.Context, req *milvuspb.DropCollectionRequest) (*internalpb.SubmitDDLJobResponse, error) {
+	return s.proxy.DropCollectionAsync(ctx, req)
+}
+
+func (s *Server) DropDatabaseAsync(ctx context.Context, req *milvuspb.DropDatabaseRequest) (*internalpb.SubmitDDLJobResponse, error) {
+	return s.proxy.DropDatabaseAsync(ctx, req)
+}
+
+func (s *Server) ListDDLJobs(ctx context.Context, req *internalpb.ListDDLJobsRequest) (*internalpb.ListDDLJobsResponse, error) {
+	return s.proxy.ListDDLJobs(ctx, req)
+}
+
+func (s *Server) GetDDLJob(ctx context.Context, req *internalpb.GetDDLJobRequest) (*internalpb.GetDDLJobResponse, error) {
+	return s.proxy.GetDDLJob(ctx, req)
+}
+
+func (s *Server) ListPrivilegeGroups(ctx context.Context, req *internalpb.ListPrivilegeGroupsRequest) (*internalpb.ListPrivilegeGroupsResponse, error) {
+	return s.proxy.ListPrivilegeGroups(ctx, req)
+}
+
 func (s *Server) AlterDatabase(ctx context.Context, req *milvuspb.AlterDatabaseRequest) (*commonpb.Status, error) {
 	return s.proxy.AlterDatabase(ctx, req)
 }
@@ -512,3 +512,58 @@ func (c *Client) CheckQueryNodeDistribution(ctx context.Context, req *querypb.Ch
 		return client.CheckQueryNodeDistribution(ctx, req)
 	})
 }
+
+func (c *Client) GetResourceGroupRecommendations(ctx context.Context, req *querypb.GetResourceGroupRecommendationsRequest, opts ...grpc.CallOption) (*querypb.GetResourceGroupRecommendationsResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*querypb.GetResourceGroupRecommendationsResponse, error) {
+		return client.GetResourceGroupRecommendations(ctx, req)
+	})
+}
+
+func (c *Client) PreviewBalance(ctx context.Context, req *querypb.PreviewBalanceRequest, opts ...grpc.CallOption) (*querypb.PreviewBalanceResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*querypb.PreviewBalanceResponse, error) {
+		return client.PreviewBalance(ctx, req)
+	})
+}
+
+func (c *Client) DrainNode(ctx context.Context, req *querypb.DrainNodeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*commonpb.Status, error) {
+		return client.DrainNode(ctx, req)
+	})
+}
+
+func (c *Client) CancelDrainNode(ctx context.Context, req *querypb.CancelDrainNodeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*commonpb.Status, error) {
+		return client.CancelDrainNode(ctx, req)
+	})
+}
+
+func (c *Client) GetDrainNodeProgress(ctx context.Context, req *querypb.GetDrainNodeProgressRequest, opts ...grpc.CallOption) (*querypb.GetDrainNodeProgressResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*querypb.GetDrainNodeProgressResponse, error) {
+		return client.GetDrainNodeProgress(ctx, req)
+	})
+}
@@ -185,6 +185,21 @@ func Test_NewClient(t *testing.T) {
 
 		r39, err := client.CheckQueryNodeDistribution(ctx, nil)
 		retCheck(retNotNil, r39, err)
+
+		r40, err := client.GetResourceGroupRecommendations(ctx, nil)
+		retCheck(retNotNil, r40, err)
+
+		r41, err := client.PreviewBalance(ctx, nil)
+		retCheck(retNotNil, r41, err)
+
+		r42, err := client.DrainNode(ctx, nil)
+		retCheck(retNotNil, r42, err)
+
+		r43, err := client.CancelDrainNode(ctx, nil)
+		retCheck(retNotNil, r43, err)
+
+		r44, err := client.GetDrainNodeProgress(ctx, nil)
+		retCheck(retNotNil, r44, err)
 	}
 
 	client.(*Client).grpcClient = &mock.GRPCClientBase[querypb.QueryCoordClient]{
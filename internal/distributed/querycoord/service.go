@@ -486,3 +486,23 @@ func (s *Server) TransferChannel(ctx context.Context, req *querypb.TransferChann
 func (s *Server) CheckQueryNodeDistribution(ctx context.Context, req *querypb.CheckQueryNodeDistributionRequest) (*commonpb.Status, error) {
 	return s.queryCoord.CheckQueryNodeDistribution(ctx, req)
 }
+
+func (s *Server) GetResourceGroupRecommendations(ctx context.Context, req *querypb.GetResourceGroupRecommendationsRequest) (*querypb.GetResourceGroupRecommendationsResponse, error) {
+	return s.queryCoord.GetResourceGroupRecommendations(ctx, req)
+}
+
+func (s *Server) PreviewBalance(ctx context.Context, req *querypb.PreviewBalanceRequest) (*querypb.PreviewBalanceResponse, error) {
+	return s.queryCoord.PreviewBalance(ctx, req)
+}
+
+func (s *Server) DrainNode(ctx context.Context, req *querypb.DrainNodeRequest) (*commonpb.Status, error) {
+	return s.queryCoord.DrainNode(ctx, req)
+}
+
+func (s *Server) CancelDrainNode(ctx context.Context, req *querypb.CancelDrainNodeRequest) (*commonpb.Status, error) {
+	return s.queryCoord.CancelDrainNode(ctx, req)
+}
+
+func (s *Server) GetDrainNodeProgress(ctx context.Context, req *querypb.GetDrainNodeProgressRequest) (*querypb.GetDrainNodeProgressResponse, error) {
+	return s.queryCoord.GetDrainNodeProgress(ctx, req)
+}
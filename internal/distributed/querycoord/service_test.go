@@ -355,6 +355,46 @@ func Test_NewServer(t *testing.T) {
 			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
 		})
 
+		t.Run("GetResourceGroupRecommendations", func(t *testing.T) {
+			req := &querypb.GetResourceGroupRecommendationsRequest{}
+			mqc.EXPECT().GetResourceGroupRecommendations(mock.Anything, req).Return(&querypb.GetResourceGroupRecommendationsResponse{Status: merr.Success()}, nil)
+			resp, err := server.GetResourceGroupRecommendations(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		})
+
+		t.Run("PreviewBalance", func(t *testing.T) {
+			req := &querypb.PreviewBalanceRequest{}
+			mqc.EXPECT().PreviewBalance(mock.Anything, req).Return(&querypb.PreviewBalanceResponse{Status: merr.Success()}, nil)
+			resp, err := server.PreviewBalance(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		})
+
+		t.Run("DrainNode", func(t *testing.T) {
+			req := &querypb.DrainNodeRequest{}
+			mqc.EXPECT().DrainNode(mock.Anything, req).Return(merr.Success(), nil)
+			resp, err := server.DrainNode(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		})
+
+		t.Run("CancelDrainNode", func(t *testing.T) {
+			req := &querypb.CancelDrainNodeRequest{}
+			mqc.EXPECT().CancelDrainNode(mock.Anything, req).Return(merr.Success(), nil)
+			resp, err := server.CancelDrainNode(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		})
+
+		t.Run("GetDrainNodeProgress", func(t *testing.T) {
+			req := &querypb.GetDrainNodeProgressRequest{}
+			mqc.EXPECT().GetDrainNodeProgress(mock.Anything, req).Return(&querypb.GetDrainNodeProgressResponse{Status: merr.Success()}, nil)
+			resp, err := server.GetDrainNodeProgress(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		})
+
 		err = server.Stop()
 		assert.NoError(t, err)
 	}
@@ -418,6 +418,18 @@ func (c *Client) AlterAlias(ctx context.Context, req *milvuspb.AlterAliasRequest
 	})
 }
 
+// AlterAliases alters the aliases of a database all or nothing
+func (c *Client) AlterAliases(ctx context.Context, req *internalpb.AlterAliasesRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client rootcoordpb.RootCoordClient) (*commonpb.Status, error) {
+		return client.AlterAliases(ctx, req)
+	})
+}
+
 // DescribeAlias describe alias
 func (c *Client) DescribeAlias(ctx context.Context, req *milvuspb.DescribeAliasRequest, opts ...grpc.CallOption) (*milvuspb.DescribeAliasResponse, error) {
 	req = typeutil.Clone(req)
@@ -592,6 +604,39 @@ func (c *Client) RenameCollection(ctx context.Context, req *milvuspb.RenameColle
 	})
 }
 
+func (c *Client) AddCollectionField(ctx context.Context, req *internalpb.AddCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client rootcoordpb.RootCoordClient) (*commonpb.Status, error) {
+		return client.AddCollectionField(ctx, req)
+	})
+}
+
+func (c *Client) DropCollectionField(ctx context.Context, req *internalpb.DropCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client rootcoordpb.RootCoordClient) (*commonpb.Status, error) {
+		return client.DropCollectionField(ctx, req)
+	})
+}
+
+func (c *Client) RenameCollectionField(ctx context.Context, req *internalpb.RenameCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client rootcoordpb.RootCoordClient) (*commonpb.Status, error) {
+		return client.RenameCollectionField(ctx, req)
+	})
+}
+
 func (c *Client) CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	in = typeutil.Clone(in)
 	commonpbutil.UpdateMsgBase(
@@ -670,3 +715,51 @@ func (c *Client) AlterDatabase(ctx context.Context, request *rootcoordpb.AlterDa
 		return client.AlterDatabase(ctx, request)
 	})
 }
+
+// DropCollectionAsync drops a collection and responds once the drop is accepted by rootcoord
+func (c *Client) DropCollectionAsync(ctx context.Context, req *milvuspb.DropCollectionRequest, opts ...grpc.CallOption) (*internalpb.SubmitDDLJobResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client rootcoordpb.RootCoordClient) (*internalpb.SubmitDDLJobResponse, error) {
+		return client.DropCollectionAsync(ctx, req)
+	})
+}
+
+// DropDatabaseAsync drops a database and responds once the drop is accepted by rootcoord
+func (c *Client) DropDatabaseAsync(ctx context.Context, req *milvuspb.DropDatabaseRequest, opts ...grpc.CallOption) (*internalpb.SubmitDDLJobResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client rootcoordpb.RootCoordClient) (*internalpb.SubmitDDLJobResponse, error) {
+		return client.DropDatabaseAsync(ctx, req)
+	})
+}
+
+// ListDDLJobs lists the DDL jobs of rootcoord
+func (c *Client) ListDDLJobs(ctx context.Context, req *internalpb.ListDDLJobsRequest, opts ...grpc.CallOption) (*internalpb.ListDDLJobsResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client rootcoordpb.RootCoordClient) (*internalpb.ListDDLJobsResponse, error) {
+		return client.ListDDLJobs(ctx, req)
+	})
+}
+
+// GetDDLJob gets the state and progress of a DDL job
+func (c *Client) GetDDLJob(ctx context.Context, req *internalpb.GetDDLJobRequest, opts ...grpc.CallOption) (*internalpb.GetDDLJobResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client rootcoordpb.RootCoordClient) (*internalpb.GetDDLJobResponse, error) {
+		return client.GetDDLJob(ctx, req)
+	})
+}
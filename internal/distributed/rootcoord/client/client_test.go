@@ -240,6 +240,38 @@ func Test_NewClient(t *testing.T) {
 			r, err := client.AlterDatabase(ctx, nil)
 			retCheck(retNotNil, r, err)
 		}
+		{
+			r, err := client.AddCollectionField(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.DropCollectionField(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.RenameCollectionField(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.AlterAliases(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.DropCollectionAsync(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.DropDatabaseAsync(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.ListDDLJobs(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.GetDDLJob(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
 	}
 
 	client.(*Client).grpcClient = &mock.GRPCClientBase[rootcoordpb.RootCoordClient]{
@@ -446,6 +478,38 @@ func Test_NewClient(t *testing.T) {
 		rTimeout, err := client.ListDatabases(shortCtx, nil)
 		retCheck(rTimeout, err)
 	}
+	{
+		rTimeout, err := client.AddCollectionField(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.DropCollectionField(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.RenameCollectionField(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.AlterAliases(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.DropCollectionAsync(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.DropDatabaseAsync(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.ListDDLJobs(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.GetDDLJob(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
 	// clean up
 	err = client.Close()
 	assert.NoError(t, err)
@@ -18,6 +18,7 @@ package grpcrootcoord
 
 import (
 	"context"
+	"fmt"
 	"net"
 	"strconv"
 	"sync"
@@ -42,6 +43,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/rootcoord"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/auditlog"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	_ "github.com/milvus-io/milvus/internal/util/grpcclient"
 	"github.com/milvus-io/milvus/pkg/log"
@@ -51,6 +53,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/interceptor"
 	"github.com/milvus-io/milvus/pkg/util/logutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/tikv"
 )
@@ -97,6 +100,26 @@ func (s *Server) AlterDatabase(ctx context.Context, request *rootcoordpb.AlterDa
 	return s.rootCoord.AlterDatabase(ctx, request)
 }
 
+// DropCollectionAsync drops a collection without waiting for the drop to finish.
+func (s *Server) DropCollectionAsync(ctx context.Context, request *milvuspb.DropCollectionRequest) (*internalpb.SubmitDDLJobResponse, error) {
+	return s.rootCoord.DropCollectionAsync(ctx, request)
+}
+
+// DropDatabaseAsync drops a database without waiting for the drop to finish.
+func (s *Server) DropDatabaseAsync(ctx context.Context, request *milvuspb.DropDatabaseRequest) (*internalpb.SubmitDDLJobResponse, error) {
+	return s.rootCoord.DropDatabaseAsync(ctx, request)
+}
+
+// ListDDLJobs lists the DDL jobs of rootcoord.
+func (s *Server) ListDDLJobs(ctx context.Context, request *internalpb.ListDDLJobsRequest) (*internalpb.ListDDLJobsResponse, error) {
+	return s.rootCoord.ListDDLJobs(ctx, request)
+}
+
+// GetDDLJob gets the state and progress of a DDL job.
+func (s *Server) GetDDLJob(ctx context.Context, request *internalpb.GetDDLJobRequest) (*internalpb.GetDDLJobResponse, error) {
+	return s.rootCoord.GetDDLJob(ctx, request)
+}
+
 func (s *Server) CheckHealth(ctx context.Context, request *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	return s.rootCoord.CheckHealth(ctx, request)
 }
@@ -116,6 +139,11 @@ func (s *Server) AlterAlias(ctx context.Context, request *milvuspb.AlterAliasReq
 	return s.rootCoord.AlterAlias(ctx, request)
 }
 
+// AlterAliases alters the aliases of a database all or nothing.
+func (s *Server) AlterAliases(ctx context.Context, request *internalpb.AlterAliasesRequest) (*commonpb.Status, error) {
+	return s.rootCoord.AlterAliases(ctx, request)
+}
+
 // DescribeAlias show the alias-collection relation for the specified alias.
 func (s *Server) DescribeAlias(ctx context.Context, request *milvuspb.DescribeAliasRequest) (*milvuspb.DescribeAliasResponse, error) {
 	return s.rootCoord.DescribeAlias(ctx, request)
@@ -288,6 +316,7 @@ func (s *Server) startGrpcLoop(port int) {
 				}
 				return s.serverID.Load()
 			}),
+			auditlog.UnaryServerInterceptor(s.auditBefore),
 		)),
 		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
 			otelgrpc.StreamServerInterceptor(opts...),
@@ -308,6 +337,39 @@ func (s *Server) startGrpcLoop(port int) {
 	}
 }
 
+// auditBefore summarizes the object before it's altered, for the audit record of the request.
+func (s *Server) auditBefore(ctx context.Context, req any) string {
+	switch r := req.(type) {
+	case *milvuspb.AlterCollectionRequest:
+		resp, err := s.rootCoord.DescribeCollectionInternal(ctx, &milvuspb.DescribeCollectionRequest{
+			DbName:         r.GetDbName(),
+			CollectionName: r.GetCollectionName(),
+		})
+		if merr.CheckRPCCall(resp, err) != nil {
+			return ""
+		}
+		return fmt.Sprintf("properties=[%s]", auditlog.PropertiesSummary(resp.GetProperties()))
+	case *rootcoordpb.AlterDatabaseRequest:
+		resp, err := s.rootCoord.DescribeDatabase(ctx, &rootcoordpb.DescribeDatabaseRequest{
+			DbName: r.GetDbName(),
+		})
+		if merr.CheckRPCCall(resp, err) != nil {
+			return ""
+		}
+		return fmt.Sprintf("properties=[%s]", auditlog.PropertiesSummary(resp.GetProperties()))
+	case *milvuspb.AlterAliasRequest:
+		resp, err := s.rootCoord.DescribeAlias(ctx, &milvuspb.DescribeAliasRequest{
+			DbName: r.GetDbName(),
+			Alias:  r.GetAlias(),
+		})
+		if merr.CheckRPCCall(resp, err) != nil {
+			return ""
+		}
+		return fmt.Sprintf("collection=%s", resp.GetCollection())
+	}
+	return ""
+}
+
 func (s *Server) start() error {
 	log.Info("RootCoord Core start ...")
 	if err := s.rootCoord.Register(); err != nil {
@@ -528,3 +590,15 @@ func (s *Server) AlterCollection(ctx context.Context, request *milvuspb.AlterCol
 func (s *Server) RenameCollection(ctx context.Context, request *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
 	return s.rootCoord.RenameCollection(ctx, request)
 }
+
+func (s *Server) AddCollectionField(ctx context.Context, request *internalpb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	return s.rootCoord.AddCollectionField(ctx, request)
+}
+
+func (s *Server) DropCollectionField(ctx context.Context, request *internalpb.DropCollectionFieldRequest) (*commonpb.Status, error) {
+	return s.rootCoord.DropCollectionField(ctx, request)
+}
+
+func (s *Server) RenameCollectionField(ctx context.Context, request *internalpb.RenameCollectionFieldRequest) (*commonpb.Status, error) {
+	return s.rootCoord.RenameCollectionField(ctx, request)
+}
@@ -25,12 +25,14 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/tikv/client-go/v2/txnkv"
 	clientv3 "go.etcd.io/etcd/client/v3"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/rootcoord"
 	"github.com/milvus-io/milvus/internal/types"
@@ -68,6 +70,26 @@ func (m *mockCore) RenameCollection(ctx context.Context, request *milvuspb.Renam
 	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
 }
 
+func (m *mockCore) AddCollectionField(ctx context.Context, request *internalpb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (m *mockCore) DropCollectionField(ctx context.Context, request *internalpb.DropCollectionFieldRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (m *mockCore) RenameCollectionField(ctx context.Context, request *internalpb.RenameCollectionFieldRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (m *mockCore) AlterAliases(ctx context.Context, request *internalpb.AlterAliasesRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (m *mockCore) ListDDLJobs(ctx context.Context, request *internalpb.ListDDLJobsRequest) (*internalpb.ListDDLJobsResponse, error) {
+	return &internalpb.ListDDLJobsResponse{Status: merr.Success()}, nil
+}
+
 func (m *mockCore) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	return &milvuspb.CheckHealthResponse{
 		IsHealthy: true,
@@ -186,6 +208,35 @@ func TestRun(t *testing.T) {
 			assert.NoError(t, err)
 		})
 
+		t.Run("AddCollectionField", func(t *testing.T) {
+			ret, err := svr.AddCollectionField(ctx, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, ret.ErrorCode)
+		})
+
+		t.Run("DropCollectionField", func(t *testing.T) {
+			ret, err := svr.DropCollectionField(ctx, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, ret.ErrorCode)
+		})
+
+		t.Run("RenameCollectionField", func(t *testing.T) {
+			ret, err := svr.RenameCollectionField(ctx, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, ret.ErrorCode)
+		})
+
+		t.Run("AlterAliases", func(t *testing.T) {
+			ret, err := svr.AlterAliases(ctx, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, ret.ErrorCode)
+		})
+
+		t.Run("ListDDLJobs", func(t *testing.T) {
+			ret, err := svr.ListDDLJobs(ctx, nil)
+			assert.NoError(t, merr.CheckRPCCall(ret, err))
+		})
+
 		t.Run("CreateDatabase", func(t *testing.T) {
 			ret, err := svr.CreateDatabase(ctx, nil)
 			assert.Nil(t, err)
@@ -327,3 +378,28 @@ func TestServer_QueryCoordClientStartErr(t *testing.T) {
 		assert.NoError(t, err)
 	}
 }
+
+func TestServer_auditBefore(t *testing.T) {
+	ctx := context.Background()
+	rc := mocks.NewRootCoord(t)
+	server := &Server{rootCoord: rc}
+
+	rc.EXPECT().DescribeCollectionInternal(mock.Anything, mock.Anything).Return(&milvuspb.DescribeCollectionResponse{
+		Status:     merr.Success(),
+		Properties: []*commonpb.KeyValuePair{{Key: "k", Value: "v"}},
+	}, nil).Once()
+	assert.Equal(t, "properties=[k=v]", server.auditBefore(ctx, &milvuspb.AlterCollectionRequest{CollectionName: "coll"}))
+
+	rc.EXPECT().DescribeDatabase(mock.Anything, mock.Anything).Return(&rootcoordpb.DescribeDatabaseResponse{
+		Status: merr.Status(merr.WrapErrDatabaseNotFound("db")),
+	}, nil).Once()
+	assert.Equal(t, "", server.auditBefore(ctx, &rootcoordpb.AlterDatabaseRequest{DbName: "db"}))
+
+	rc.EXPECT().DescribeAlias(mock.Anything, mock.Anything).Return(&milvuspb.DescribeAliasResponse{
+		Status:     merr.Success(),
+		Collection: "coll",
+	}, nil).Once()
+	assert.Equal(t, "collection=coll", server.auditBefore(ctx, &milvuspb.AlterAliasRequest{Alias: "alias"}))
+
+	assert.Equal(t, "", server.auditBefore(ctx, &milvuspb.DropCollectionRequest{CollectionName: "coll"}))
+}
@@ -25,6 +25,12 @@ const LogLevelRouterPath = "/log/level"
 // EventLogRouterPath is path for eventlog control.
 const EventLogRouterPath = "/eventlog"
 
+// EventLogQueryRouterPath is path for querying the events persisted on local disk.
+const EventLogQueryRouterPath = "/eventlog/query"
+
+// AuditLogQueryRouterPath is path for querying the audit records of DDL, RBAC and credential changes.
+const AuditLogQueryRouterPath = "/auditlog/query"
+
 // ExprPath is path for expression.
 const ExprPath = "/expr"
 
@@ -46,15 +52,32 @@ const (
 const (
 	RouteGcPause  = "/management/datacoord/garbage_collection/pause"
 	RouteGcResume = "/management/datacoord/garbage_collection/resume"
+	RouteGcRun    = "/management/datacoord/garbage_collection/run"
+
+	RouteCreateSnapshot  = "/management/datacoord/snapshot/create"
+	RouteListSnapshots   = "/management/datacoord/snapshot/list"
+	RouteDropSnapshot    = "/management/datacoord/snapshot/drop"
+	RouteRestoreSnapshot = "/management/datacoord/snapshot/restore"
+
+	RouteGetPartitionKeyStats = "/management/datacoord/partition_key/stats"
 
 	RouteSuspendQueryCoordBalance = "/management/querycoord/balance/suspend"
 	RouteResumeQueryCoordBalance  = "/management/querycoord/balance/resume"
+	RoutePreviewQueryCoordBalance = "/management/querycoord/balance/preview"
 	RouteTransferSegment          = "/management/querycoord/transfer/segment"
 	RouteTransferChannel          = "/management/querycoord/transfer/channel"
 
 	RouteSuspendQueryNode           = "/management/querycoord/node/suspend"
 	RouteResumeQueryNode            = "/management/querycoord/node/resume"
 	RouteListQueryNode              = "/management/querycoord/node/list"
+	RouteDrainQueryNode             = "/management/querycoord/node/drain"
+	RouteCancelDrainQueryNode       = "/management/querycoord/node/drain/cancel"
+	RouteGetDrainQueryNodeProgress  = "/management/querycoord/node/drain/progress"
 	RouteGetQueryNodeDistribution   = "/management/querycoord/distribution/get"
 	RouteCheckQueryNodeDistribution = "/management/querycoord/distribution/check"
+
+	RouteGetResourceGroupRecommendations = "/management/querycoord/resource_group/recommendations"
+
+	// dry run of the partition lifecycle, served by rootcoord
+	RoutePreviewPartitionLifecycle = "/management/rootcoord/partition_lifecycle/preview"
 )
@@ -26,6 +26,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/http/healthz"
+	"github.com/milvus-io/milvus/internal/util/auditlog"
 	"github.com/milvus-io/milvus/pkg/eventlog"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/expr"
@@ -71,6 +72,14 @@ func registerDefaults() {
 		Path:    EventLogRouterPath,
 		Handler: eventlog.Handler(),
 	})
+	Register(&Handler{
+		Path:    EventLogQueryRouterPath,
+		Handler: eventlog.QueryHandler(),
+	})
+	Register(&Handler{
+		Path:    AuditLogQueryRouterPath,
+		Handler: auditlog.QueryHandler(),
+	})
 	Register(&Handler{
 		Path: ExprPath,
 		Handler: http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
@@ -128,6 +128,28 @@ func (suite *HTTPServerTestSuite) TestHealthzHandler() {
 	suite.Equal("{\"state\":\"component m2 state is Abnormal\",\"detail\":[{\"name\":\"m1\",\"code\":1},{\"name\":\"m2\",\"code\":2}]}", string(body))
 }
 
+func (suite *HTTPServerTestSuite) TestEventlogQueryHandler() {
+	url := "http://localhost:" + DefaultListenPort + EventLogQueryRouterPath
+	client := http.Client{}
+	req, _ := http.NewRequest(http.MethodGet, url, nil)
+	resp, err := client.Do(req)
+	suite.Nil(err)
+	defer resp.Body.Close()
+	// disk event log is not enabled
+	suite.Equal(http.StatusServiceUnavailable, resp.StatusCode)
+}
+
+func (suite *HTTPServerTestSuite) TestAuditlogQueryHandler() {
+	url := "http://localhost:" + DefaultListenPort + AuditLogQueryRouterPath
+	client := http.Client{}
+	req, _ := http.NewRequest(http.MethodGet, url, nil)
+	resp, err := client.Do(req)
+	suite.Nil(err)
+	defer resp.Body.Close()
+	// audit log is not enabled
+	suite.Equal(http.StatusServiceUnavailable, resp.StatusCode)
+}
+
 func (suite *HTTPServerTestSuite) TestEventlogHandler() {
 	url := "http://localhost:" + DefaultListenPort + EventLogRouterPath
 	client := http.Client{}
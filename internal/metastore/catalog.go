@@ -8,6 +8,7 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/streamingpb"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
@@ -35,8 +36,14 @@ type RootCoordCatalog interface {
 	CreateAlias(ctx context.Context, alias *model.Alias, ts typeutil.Timestamp) error
 	DropAlias(ctx context.Context, dbID int64, alias string, ts typeutil.Timestamp) error
 	AlterAlias(ctx context.Context, alias *model.Alias, ts typeutil.Timestamp) error
+	// AlterAliases saves and drops the aliases of the database in one transaction.
+	AlterAliases(ctx context.Context, dbID int64, aliases []*model.Alias, droppedAliases []string, ts typeutil.Timestamp) error
 	ListAliases(ctx context.Context, dbID int64, ts typeutil.Timestamp) ([]*model.Alias, error)
 
+	SaveDDLJob(ctx context.Context, job *internalpb.DDLJob) error
+	ListDDLJobs(ctx context.Context) ([]*internalpb.DDLJob, error)
+	DropDDLJob(ctx context.Context, jobID int64) error
+
 	// GetCredential gets the credential info for the username, returns error if no credential exists for this username.
 	GetCredential(ctx context.Context, username string) (*model.Credential, error)
 	// CreateCredential creates credential by Username and EncryptedPassword in crediential. Please make sure credential.Username isn't empty before calling this API. Credentials already exists will be altered.
@@ -166,6 +173,13 @@ type DataCoordCatalog interface {
 	SaveCurrentPartitionStatsVersion(ctx context.Context, collID, partID int64, vChannel string, currentVersion int64) error
 	GetCurrentPartitionStatsVersion(ctx context.Context, collID, partID int64, vChannel string) (int64, error)
 	DropCurrentPartitionStatsVersion(ctx context.Context, collID, partID int64, vChannel string) error
+
+	ListSnapshots(ctx context.Context) ([]*datapb.SnapshotInfo, error)
+	SaveSnapshot(ctx context.Context, snapshot *datapb.SnapshotInfo) error
+	DropSnapshot(ctx context.Context, snapshotID typeutil.UniqueID) error
+
+	// ListBrokenSegments lists the segments marked broken by fsck.
+	ListBrokenSegments(ctx context.Context) ([]typeutil.UniqueID, error)
 }
 
 type QueryCoordCatalog interface {
@@ -31,6 +31,8 @@ const (
 	AnalyzeTaskPrefix                  = MetaPrefix + "/analyze-task"
 	PartitionStatsInfoPrefix           = MetaPrefix + "/partition-stats"
 	PartitionStatsCurrentVersionPrefix = MetaPrefix + "/current-partition-stats-version"
+	SnapshotPrefix                     = MetaPrefix + "/snapshot"
+	BrokenSegmentPrefix                = MetaPrefix + "/fsck/broken-segment"
 
 	NonRemoveFlagTomestone = "non-removed"
 	RemoveFlagTomestone    = "removed"
@@ -423,7 +423,8 @@ func (kc *Catalog) DropSegment(ctx context.Context, segment *datapb.SegmentInfo)
 		return err
 	}
 
-	return nil
+	// the broken mark is useless after the segment is dropped
+	return kc.MetaKv.Remove(BuildBrokenSegmentKey(segment.GetCollectionID(), segment.GetID()))
 }
 
 func (kc *Catalog) MarkChannelAdded(ctx context.Context, channel string) error {
@@ -923,3 +924,53 @@ func (kc *Catalog) DropCurrentPartitionStatsVersion(ctx context.Context, collID,
 	key := buildCurrentPartitionStatsVersionPath(collID, partID, vChannel)
 	return kc.MetaKv.Remove(key)
 }
+
+func (kc *Catalog) ListSnapshots(ctx context.Context) ([]*datapb.SnapshotInfo, error) {
+	snapshots := make([]*datapb.SnapshotInfo, 0)
+
+	_, values, err := kc.MetaKv.LoadWithPrefix(SnapshotPrefix)
+	if err != nil {
+		return nil, err
+	}
+	for _, value := range values {
+		snapshot := &datapb.SnapshotInfo{}
+		err = proto.Unmarshal([]byte(value), snapshot)
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots, nil
+}
+
+func (kc *Catalog) SaveSnapshot(ctx context.Context, snapshot *datapb.SnapshotInfo) error {
+	key := buildSnapshotKey(snapshot.GetSnapshotID())
+	value, err := proto.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	return kc.MetaKv.Save(key, string(value))
+}
+
+func (kc *Catalog) DropSnapshot(ctx context.Context, snapshotID typeutil.UniqueID) error {
+	key := buildSnapshotKey(snapshotID)
+	return kc.MetaKv.Remove(key)
+}
+
+func (kc *Catalog) ListBrokenSegments(ctx context.Context) ([]typeutil.UniqueID, error) {
+	keys, _, err := kc.MetaKv.LoadWithPrefix(BrokenSegmentPrefix)
+	if err != nil {
+		return nil, err
+	}
+	segmentIDs := make([]typeutil.UniqueID, 0, len(keys))
+	for _, key := range keys {
+		ss := strings.Split(key, "/")
+		segmentID, err := strconv.ParseInt(ss[len(ss)-1], 10, 64)
+		if err != nil {
+			log.Warn("invalid broken segment key", zap.String("key", key), zap.Error(err))
+			continue
+		}
+		segmentIDs = append(segmentIDs, segmentID)
+	}
+	return segmentIDs, nil
+}
@@ -524,6 +524,7 @@ func Test_DropSegment(t *testing.T) {
 			}
 			return nil
 		})
+		metakv.EXPECT().Remove(BuildBrokenSegmentKey(segment1.GetCollectionID(), segment1.GetID())).Return(nil)
 
 		catalog := NewCatalog(metakv, rootPath, "")
 		err := catalog.DropSegment(context.TODO(), segment1)
@@ -1518,3 +1519,78 @@ func TestCatalog_Import(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestCatalog_Snapshot(t *testing.T) {
+	kc := &Catalog{}
+	mockErr := errors.New("mock error")
+	snapshot := &datapb.SnapshotInfo{
+		SnapshotID:   1,
+		CollectionID: 100,
+		Segments:     []*datapb.SnapshotSegment{{SegmentID: 1000, PartitionID: 10}},
+	}
+
+	t.Run("SaveSnapshot", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().Save(buildSnapshotKey(1), mock.Anything).Return(nil)
+		kc.MetaKv = txn
+		assert.NoError(t, kc.SaveSnapshot(context.TODO(), snapshot))
+
+		txn = mocks.NewMetaKv(t)
+		txn.EXPECT().Save(mock.Anything, mock.Anything).Return(mockErr)
+		kc.MetaKv = txn
+		assert.Error(t, kc.SaveSnapshot(context.TODO(), snapshot))
+	})
+
+	t.Run("ListSnapshots", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		value, err := proto.Marshal(snapshot)
+		assert.NoError(t, err)
+		txn.EXPECT().LoadWithPrefix(SnapshotPrefix).Return(nil, []string{string(value)}, nil)
+		kc.MetaKv = txn
+		snapshots, err := kc.ListSnapshots(context.TODO())
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(snapshots))
+		assert.True(t, proto.Equal(snapshot, snapshots[0]))
+
+		txn = mocks.NewMetaKv(t)
+		txn.EXPECT().LoadWithPrefix(mock.Anything).Return(nil, []string{"@#%#^#"}, nil)
+		kc.MetaKv = txn
+		_, err = kc.ListSnapshots(context.TODO())
+		assert.Error(t, err)
+
+		txn = mocks.NewMetaKv(t)
+		txn.EXPECT().LoadWithPrefix(mock.Anything).Return(nil, nil, mockErr)
+		kc.MetaKv = txn
+		_, err = kc.ListSnapshots(context.TODO())
+		assert.Error(t, err)
+	})
+
+	t.Run("DropSnapshot", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().Remove(buildSnapshotKey(1)).Return(nil)
+		kc.MetaKv = txn
+		assert.NoError(t, kc.DropSnapshot(context.TODO(), 1))
+	})
+}
+
+func TestCatalog_ListBrokenSegments(t *testing.T) {
+	t.Run("failed to load", func(t *testing.T) {
+		metakv := mocks.NewMetaKv(t)
+		metakv.EXPECT().LoadWithPrefix(BrokenSegmentPrefix).Return(nil, nil, errors.New("error"))
+		catalog := NewCatalog(metakv, rootPath, "")
+		_, err := catalog.ListBrokenSegments(context.TODO())
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		metakv := mocks.NewMetaKv(t)
+		metakv.EXPECT().LoadWithPrefix(BrokenSegmentPrefix).Return([]string{
+			BuildBrokenSegmentKey(1, 10),
+			BrokenSegmentPrefix + "/1/invalid",
+		}, []string{"[]", "[]"}, nil)
+		catalog := NewCatalog(metakv, rootPath, "")
+		segmentIDs, err := catalog.ListBrokenSegments(context.TODO())
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{10}, segmentIDs)
+	})
+}
@@ -350,3 +350,12 @@ func buildPreImportTaskKey(taskID int64) string {
 func buildAnalyzeTaskKey(taskID int64) string {
 	return fmt.Sprintf("%s/%d", AnalyzeTaskPrefix, taskID)
 }
+
+func buildSnapshotKey(snapshotID int64) string {
+	return fmt.Sprintf("%s/%d", SnapshotPrefix, snapshotID)
+}
+
+// BuildBrokenSegmentKey builds the key to mark a segment broken, the segment is excluded from loading and compaction.
+func BuildBrokenSegmentKey(collectionID, segmentID int64) string {
+	return fmt.Sprintf("%s/%d/%d", BrokenSegmentPrefix, collectionID, segmentID)
+}
@@ -420,6 +420,24 @@ func (kc *Catalog) AlterAlias(ctx context.Context, alias *model.Alias, ts typeut
 	return kc.CreateAlias(ctx, alias, ts)
 }
 
+func (kc *Catalog) AlterAliases(ctx context.Context, dbID int64, aliases []*model.Alias, droppedAliases []string, ts typeutil.Timestamp) error {
+	saves := make(map[string]string, len(aliases))
+	removals := make([]string, 0, 2*len(aliases)+3*len(droppedAliases))
+	for _, alias := range aliases {
+		v, err := proto.Marshal(model.MarshalAliasModel(alias))
+		if err != nil {
+			return err
+		}
+		saves[BuildAliasKeyWithDB(dbID, alias.Name)] = string(v)
+		removals = append(removals, BuildAliasKey210(alias.Name), BuildAliasKey(alias.Name))
+	}
+	for _, alias := range droppedAliases {
+		removals = append(removals, BuildAliasKeyWithDB(dbID, alias), BuildAliasKey(alias), BuildAliasKey210(alias))
+	}
+	// all the aliases are changed in one transaction, so that no one sees a part of the changes
+	return kc.Snapshot.MultiSaveAndRemove(saves, removals, ts)
+}
+
 func (kc *Catalog) DropCollection(ctx context.Context, collectionInfo *model.Collection, ts typeutil.Timestamp) error {
 	collectionKeys := []string{BuildCollectionKey(collectionInfo.DBID, collectionInfo.CollectionID)}
 
@@ -481,10 +499,56 @@ func (kc *Catalog) alterModifyCollection(oldColl *model.Collection, newColl *mod
 		return err
 	}
 	saves := map[string]string{newKey: string(value)}
-	if oldKey == newKey {
+	removals := make([]string, 0)
+	if oldKey != newKey {
+		removals = append(removals, oldKey)
+	}
+	// the aliases are moved along with the collection into another database
+	if oldColl.DBID != newColl.DBID {
+		for _, alias := range newColl.Aliases {
+			aliasValue, err := proto.Marshal(model.MarshalAliasModel(&model.Alias{
+				Name:         alias,
+				CollectionID: newColl.CollectionID,
+				CreatedTime:  ts,
+				State:        pb.AliasState_AliasCreated,
+				DbID:         newColl.DBID,
+			}))
+			if err != nil {
+				return err
+			}
+			saves[BuildAliasKeyWithDB(newColl.DBID, alias)] = string(aliasValue)
+			removals = append(removals, BuildAliasKeyWithDB(oldColl.DBID, alias))
+		}
+	}
+	// the fields added, renamed or dropped online are saved as the ones created along with the collection
+	oldFields := make(map[int64]*model.Field, len(oldColl.Fields))
+	for _, field := range oldColl.Fields {
+		oldFields[field.FieldID] = field
+	}
+	for _, field := range newColl.Fields {
+		if oldField, ok := oldFields[field.FieldID]; ok {
+			delete(oldFields, field.FieldID)
+			if oldField.Equal(*field) {
+				continue
+			}
+		}
+		fieldValue, err := proto.Marshal(model.MarshalFieldModel(field))
+		if err != nil {
+			return err
+		}
+		saves[BuildFieldKey(newColl.CollectionID, field.FieldID)] = string(fieldValue)
+	}
+	for fieldID := range oldFields {
+		removals = append(removals, BuildFieldKey(newColl.CollectionID, fieldID))
+	}
+
+	if len(removals) > 0 {
+		return kc.Snapshot.MultiSaveAndRemove(saves, removals, ts)
+	}
+	if len(saves) == 1 {
 		return kc.Snapshot.Save(newKey, string(value), ts)
 	}
-	return kc.Snapshot.MultiSaveAndRemove(saves, []string{oldKey}, ts)
+	return kc.Snapshot.MultiSave(saves, ts)
 }
 
 func (kc *Catalog) AlterCollection(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, alterType metastore.AlterType, ts typeutil.Timestamp) error {
@@ -739,6 +803,34 @@ func (kc *Catalog) ListAliases(ctx context.Context, dbID int64, ts typeutil.Time
 	return kc.listAliasesInDefaultDb(ctx, ts)
 }
 
+func (kc *Catalog) SaveDDLJob(ctx context.Context, job *internalpb.DDLJob) error {
+	value, err := proto.Marshal(job)
+	if err != nil {
+		return err
+	}
+	return kc.Txn.Save(BuildDDLJobKey(job.GetJobID()), string(value))
+}
+
+func (kc *Catalog) ListDDLJobs(ctx context.Context) ([]*internalpb.DDLJob, error) {
+	_, values, err := kc.Txn.LoadWithPrefix(DDLJobPrefix)
+	if err != nil {
+		return nil, err
+	}
+	jobs := make([]*internalpb.DDLJob, 0, len(values))
+	for _, value := range values {
+		job := &internalpb.DDLJob{}
+		if err := proto.Unmarshal([]byte(value), job); err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+func (kc *Catalog) DropDDLJob(ctx context.Context, jobID int64) error {
+	return kc.Txn.Remove(BuildDDLJobKey(jobID))
+}
+
 func (kc *Catalog) ListCredentials(ctx context.Context) ([]string, error) {
 	keys, _, err := kc.Txn.LoadWithPrefix(CredentialPrefix)
 	if err != nil {
@@ -21,6 +21,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/kv"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 	"github.com/milvus-io/milvus/internal/kv/mocks"
 	"github.com/milvus-io/milvus/internal/metastore"
 	"github.com/milvus-io/milvus/internal/metastore/model"
@@ -646,6 +647,64 @@ func TestCatalog_AlterAliasV2(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestCatalog_AlterAliases(t *testing.T) {
+	ctx := context.Background()
+
+	snapshot := kv.NewMockSnapshotKV()
+	snapshot.MultiSaveAndRemoveFunc = func(saves map[string]string, removals []string, ts typeutil.Timestamp) error {
+		return errors.New("mock")
+	}
+
+	kc := Catalog{Snapshot: snapshot}
+
+	err := kc.AlterAliases(ctx, testDb, []*model.Alias{{Name: "a1", CollectionID: 1, DbID: testDb}}, []string{"a2"}, 0)
+	assert.Error(t, err)
+
+	snapshot.MultiSaveAndRemoveFunc = func(saves map[string]string, removals []string, ts typeutil.Timestamp) error {
+		assert.Len(t, saves, 1)
+		assert.Contains(t, saves, BuildAliasKeyWithDB(testDb, "a1"))
+		assert.Contains(t, removals, BuildAliasKeyWithDB(testDb, "a2"))
+		assert.NotContains(t, removals, BuildAliasKeyWithDB(testDb, "a1"))
+		return nil
+	}
+	err = kc.AlterAliases(ctx, testDb, []*model.Alias{{Name: "a1", CollectionID: 1, DbID: testDb}}, []string{"a2"}, 0)
+	assert.NoError(t, err)
+}
+
+func TestCatalog_DDLJob(t *testing.T) {
+	ctx := context.Background()
+	kc := Catalog{Txn: memkv.NewMemoryKV()}
+
+	err := kc.SaveDDLJob(ctx, &internalpb.DDLJob{JobID: 1, JobType: "DropCollection"})
+	assert.NoError(t, err)
+	err = kc.SaveDDLJob(ctx, &internalpb.DDLJob{JobID: 2, JobType: "DropDatabase"})
+	assert.NoError(t, err)
+	err = kc.SaveDDLJob(ctx, &internalpb.DDLJob{JobID: 1, JobType: "DropCollection", State: internalpb.DDLJobState_DDLJobCompleted})
+	assert.NoError(t, err)
+
+	jobs, err := kc.ListDDLJobs(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, jobs, 2)
+	for _, job := range jobs {
+		if job.GetJobID() == 1 {
+			assert.Equal(t, internalpb.DDLJobState_DDLJobCompleted, job.GetState())
+		}
+	}
+
+	err = kc.DropDDLJob(ctx, 1)
+	assert.NoError(t, err)
+	jobs, err = kc.ListDDLJobs(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, jobs, 1)
+	assert.EqualValues(t, 2, jobs[0].GetJobID())
+
+	txn := mocks.NewTxnKV(t)
+	txn.EXPECT().LoadWithPrefix(DDLJobPrefix).Return([]string{BuildDDLJobKey(1)}, []string{"invalid"}, nil)
+	kc = Catalog{Txn: txn}
+	_, err = kc.ListDDLJobs(ctx)
+	assert.Error(t, err)
+}
+
 func Test_dropPartition(t *testing.T) {
 	t.Run("nil, won't panic", func(t *testing.T) {
 		dropPartition(nil, 1)
@@ -1062,6 +1121,83 @@ func TestCatalog_AlterCollection(t *testing.T) {
 		err := kc.AlterCollection(ctx, oldC, newC, metastore.MODIFY, 0)
 		assert.NoError(t, err)
 	})
+
+	t.Run("modify db name with aliases", func(t *testing.T) {
+		var collectionID int64 = 1
+		snapshot := kv.NewMockSnapshotKV()
+		snapshot.MultiSaveAndRemoveFunc = func(saves map[string]string, removals []string, ts typeutil.Timestamp) error {
+			assert.ElementsMatch(t, []string{BuildCollectionKey(2, collectionID), BuildAliasKeyWithDB(2, "alias")}, removals)
+			assert.ElementsMatch(t, []string{BuildCollectionKey(3, collectionID), BuildAliasKeyWithDB(3, "alias")}, maps.Keys(saves))
+			alias := &pb.AliasInfo{}
+			assert.NoError(t, proto.Unmarshal([]byte(saves[BuildAliasKeyWithDB(3, "alias")]), alias))
+			assert.Equal(t, collectionID, alias.GetCollectionId())
+			assert.EqualValues(t, 3, alias.GetDbId())
+			return nil
+		}
+
+		kc := &Catalog{Snapshot: snapshot}
+		ctx := context.Background()
+		oldC := &model.Collection{DBID: 2, CollectionID: collectionID, State: pb.CollectionState_CollectionCreated, Aliases: []string{"alias"}}
+		newC := &model.Collection{DBID: 3, CollectionID: collectionID, State: pb.CollectionState_CollectionCreated, Aliases: []string{"alias"}}
+		err := kc.AlterCollection(ctx, oldC, newC, metastore.MODIFY, 0)
+		assert.NoError(t, err)
+	})
+
+	t.Run("modify, add field", func(t *testing.T) {
+		var collectionID int64 = 1
+		snapshot := kv.NewMockSnapshotKV()
+		kvs := map[string]string{}
+		snapshot.MultiSaveFunc = func(saves map[string]string, ts typeutil.Timestamp) error {
+			for k, v := range saves {
+				kvs[k] = v
+			}
+			return nil
+		}
+
+		kc := &Catalog{Snapshot: snapshot}
+		ctx := context.Background()
+		oldC := &model.Collection{CollectionID: collectionID, Fields: []*model.Field{{FieldID: 100, Name: "pk"}}}
+		newC := oldC.Clone()
+		newC.Fields = append(newC.Fields, &model.Field{FieldID: 101, Name: "added", Nullable: true})
+		err := kc.AlterCollection(ctx, oldC, newC, metastore.MODIFY, 0)
+		assert.NoError(t, err)
+		assert.Len(t, kvs, 2)
+		assert.Contains(t, kvs, BuildCollectionKey(0, collectionID))
+		value, ok := kvs[BuildFieldKey(collectionID, 101)]
+		assert.True(t, ok)
+		field := &schemapb.FieldSchema{}
+		assert.NoError(t, proto.Unmarshal([]byte(value), field))
+		assert.Equal(t, "added", field.GetName())
+		assert.True(t, field.GetNullable())
+	})
+
+	t.Run("modify, rename and drop field", func(t *testing.T) {
+		var collectionID int64 = 1
+		snapshot := kv.NewMockSnapshotKV()
+		var savedKeys, removedKeys []string
+		snapshot.MultiSaveAndRemoveFunc = func(saves map[string]string, removals []string, ts typeutil.Timestamp) error {
+			for k := range saves {
+				savedKeys = append(savedKeys, k)
+			}
+			removedKeys = removals
+			return nil
+		}
+
+		kc := &Catalog{Snapshot: snapshot}
+		ctx := context.Background()
+		oldC := &model.Collection{CollectionID: collectionID, Fields: []*model.Field{
+			{FieldID: 100, Name: "pk"},
+			{FieldID: 101, Name: "a"},
+			{FieldID: 102, Name: "b"},
+		}}
+		newC := oldC.Clone()
+		newC.Fields[1].Name = "renamed"
+		newC.Fields = newC.Fields[:2]
+		err := kc.AlterCollection(ctx, oldC, newC, metastore.MODIFY, 0)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{BuildCollectionKey(0, collectionID), BuildFieldKey(collectionID, 101)}, savedKeys)
+		assert.ElementsMatch(t, []string{BuildFieldKey(collectionID, 102)}, removedKeys)
+	})
 }
 
 func TestCatalog_AlterPartition(t *testing.T) {
@@ -21,6 +21,9 @@ const (
 	AliasMetaPrefix     = ComponentPrefix + "/aliases"
 	FieldMetaPrefix     = ComponentPrefix + "/fields"
 
+	// DDLJobPrefix prefix for the ddl jobs
+	DDLJobPrefix = ComponentPrefix + "/ddl-job"
+
 	// CollectionAliasMetaPrefix210 prefix for collection alias meta
 	CollectionAliasMetaPrefix210 = ComponentPrefix + "/collection-alias"
 
@@ -69,3 +72,7 @@ func getDatabasePrefix(dbID int64) string {
 	}
 	return CollectionMetaPrefix
 }
+
+func BuildDDLJobKey(jobID int64) string {
+	return fmt.Sprintf("%s/%d", DDLJobPrefix, jobID)
+}
@@ -5,6 +5,8 @@ package mocks
 import (
 	context "context"
 
+	internalpb "github.com/milvus-io/milvus/internal/proto/internalpb"
+
 	milvuspb "github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	metastore "github.com/milvus-io/milvus/internal/metastore"
 
@@ -70,6 +72,52 @@ func (_c *RootCoordCatalog_AlterAlias_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// AlterAliases provides a mock function with given fields: ctx, dbID, aliases, droppedAliases, ts
+func (_m *RootCoordCatalog) AlterAliases(ctx context.Context, dbID int64, aliases []*model.Alias, droppedAliases []string, ts uint64) error {
+	ret := _m.Called(ctx, dbID, aliases, droppedAliases, ts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []*model.Alias, []string, uint64) error); ok {
+		r0 = rf(ctx, dbID, aliases, droppedAliases, ts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RootCoordCatalog_AlterAliases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterAliases'
+type RootCoordCatalog_AlterAliases_Call struct {
+	*mock.Call
+}
+
+// AlterAliases is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbID int64
+//   - aliases []*model.Alias
+//   - droppedAliases []string
+//   - ts uint64
+func (_e *RootCoordCatalog_Expecter) AlterAliases(ctx interface{}, dbID interface{}, aliases interface{}, droppedAliases interface{}, ts interface{}) *RootCoordCatalog_AlterAliases_Call {
+	return &RootCoordCatalog_AlterAliases_Call{Call: _e.mock.On("AlterAliases", ctx, dbID, aliases, droppedAliases, ts)}
+}
+
+func (_c *RootCoordCatalog_AlterAliases_Call) Run(run func(ctx context.Context, dbID int64, aliases []*model.Alias, droppedAliases []string, ts uint64)) *RootCoordCatalog_AlterAliases_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].([]*model.Alias), args[3].([]string), args[4].(uint64))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_AlterAliases_Call) Return(_a0 error) *RootCoordCatalog_AlterAliases_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RootCoordCatalog_AlterAliases_Call) RunAndReturn(run func(context.Context, int64, []*model.Alias, []string, uint64) error) *RootCoordCatalog_AlterAliases_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AlterCollection provides a mock function with given fields: ctx, oldColl, newColl, alterType, ts
 func (_m *RootCoordCatalog) AlterCollection(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, alterType metastore.AlterType, ts uint64) error {
 	ret := _m.Called(ctx, oldColl, newColl, alterType, ts)
@@ -858,6 +906,49 @@ func (_c *RootCoordCatalog_DropCredential_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// DropDDLJob provides a mock function with given fields: ctx, jobID
+func (_m *RootCoordCatalog) DropDDLJob(ctx context.Context, jobID int64) error {
+	ret := _m.Called(ctx, jobID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RootCoordCatalog_DropDDLJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropDDLJob'
+type RootCoordCatalog_DropDDLJob_Call struct {
+	*mock.Call
+}
+
+// DropDDLJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int64
+func (_e *RootCoordCatalog_Expecter) DropDDLJob(ctx interface{}, jobID interface{}) *RootCoordCatalog_DropDDLJob_Call {
+	return &RootCoordCatalog_DropDDLJob_Call{Call: _e.mock.On("DropDDLJob", ctx, jobID)}
+}
+
+func (_c *RootCoordCatalog_DropDDLJob_Call) Run(run func(ctx context.Context, jobID int64)) *RootCoordCatalog_DropDDLJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_DropDDLJob_Call) Return(_a0 error) *RootCoordCatalog_DropDDLJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RootCoordCatalog_DropDDLJob_Call) RunAndReturn(run func(context.Context, int64) error) *RootCoordCatalog_DropDDLJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropDatabase provides a mock function with given fields: ctx, dbID, ts
 func (_m *RootCoordCatalog) DropDatabase(ctx context.Context, dbID int64, ts uint64) error {
 	ret := _m.Called(ctx, dbID, ts)
@@ -1327,6 +1418,60 @@ func (_c *RootCoordCatalog_ListCredentials_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// ListDDLJobs provides a mock function with given fields: ctx
+func (_m *RootCoordCatalog) ListDDLJobs(ctx context.Context) ([]*internalpb.DDLJob, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*internalpb.DDLJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*internalpb.DDLJob, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*internalpb.DDLJob); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*internalpb.DDLJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoordCatalog_ListDDLJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDDLJobs'
+type RootCoordCatalog_ListDDLJobs_Call struct {
+	*mock.Call
+}
+
+// ListDDLJobs is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *RootCoordCatalog_Expecter) ListDDLJobs(ctx interface{}) *RootCoordCatalog_ListDDLJobs_Call {
+	return &RootCoordCatalog_ListDDLJobs_Call{Call: _e.mock.On("ListDDLJobs", ctx)}
+}
+
+func (_c *RootCoordCatalog_ListDDLJobs_Call) Run(run func(ctx context.Context)) *RootCoordCatalog_ListDDLJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_ListDDLJobs_Call) Return(_a0 []*internalpb.DDLJob, _a1 error) *RootCoordCatalog_ListDDLJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoordCatalog_ListDDLJobs_Call) RunAndReturn(run func(context.Context) ([]*internalpb.DDLJob, error)) *RootCoordCatalog_ListDDLJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListDatabases provides a mock function with given fields: ctx, ts
 func (_m *RootCoordCatalog) ListDatabases(ctx context.Context, ts uint64) ([]*model.Database, error) {
 	ret := _m.Called(ctx, ts)
@@ -1662,6 +1807,49 @@ func (_c *RootCoordCatalog_ListUserRole_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// SaveDDLJob provides a mock function with given fields: ctx, job
+func (_m *RootCoordCatalog) SaveDDLJob(ctx context.Context, job *internalpb.DDLJob) error {
+	ret := _m.Called(ctx, job)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DDLJob) error); ok {
+		r0 = rf(ctx, job)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RootCoordCatalog_SaveDDLJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveDDLJob'
+type RootCoordCatalog_SaveDDLJob_Call struct {
+	*mock.Call
+}
+
+// SaveDDLJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - job *internalpb.DDLJob
+func (_e *RootCoordCatalog_Expecter) SaveDDLJob(ctx interface{}, job interface{}) *RootCoordCatalog_SaveDDLJob_Call {
+	return &RootCoordCatalog_SaveDDLJob_Call{Call: _e.mock.On("SaveDDLJob", ctx, job)}
+}
+
+func (_c *RootCoordCatalog_SaveDDLJob_Call) Run(run func(ctx context.Context, job *internalpb.DDLJob)) *RootCoordCatalog_SaveDDLJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.DDLJob))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_SaveDDLJob_Call) Return(_a0 error) *RootCoordCatalog_SaveDDLJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RootCoordCatalog_SaveDDLJob_Call) RunAndReturn(run func(context.Context, *internalpb.DDLJob) error) *RootCoordCatalog_SaveDDLJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewRootCoordCatalog creates a new instance of RootCoordCatalog. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewRootCoordCatalog(t interface {
@@ -311,6 +311,61 @@ func (_c *MockDataCoord_CreateIndex_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// CreateSnapshot provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) CreateSnapshot(_a0 context.Context, _a1 *datapb.CreateSnapshotRequest) (*datapb.CreateSnapshotResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *datapb.CreateSnapshotResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CreateSnapshotRequest) (*datapb.CreateSnapshotResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CreateSnapshotRequest) *datapb.CreateSnapshotResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.CreateSnapshotResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CreateSnapshotRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_CreateSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSnapshot'
+type MockDataCoord_CreateSnapshot_Call struct {
+	*mock.Call
+}
+
+// CreateSnapshot is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.CreateSnapshotRequest
+func (_e *MockDataCoord_Expecter) CreateSnapshot(_a0 interface{}, _a1 interface{}) *MockDataCoord_CreateSnapshot_Call {
+	return &MockDataCoord_CreateSnapshot_Call{Call: _e.mock.On("CreateSnapshot", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_CreateSnapshot_Call) Run(run func(_a0 context.Context, _a1 *datapb.CreateSnapshotRequest)) *MockDataCoord_CreateSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.CreateSnapshotRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_CreateSnapshot_Call) Return(_a0 *datapb.CreateSnapshotResponse, _a1 error) *MockDataCoord_CreateSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_CreateSnapshot_Call) RunAndReturn(run func(context.Context, *datapb.CreateSnapshotRequest) (*datapb.CreateSnapshotResponse, error)) *MockDataCoord_CreateSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DescribeIndex provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) DescribeIndex(_a0 context.Context, _a1 *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -421,6 +476,61 @@ func (_c *MockDataCoord_DropIndex_Call) RunAndReturn(run func(context.Context, *
 	return _c
 }
 
+// DropSnapshot provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) DropSnapshot(_a0 context.Context, _a1 *datapb.DropSnapshotRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.DropSnapshotRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.DropSnapshotRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.DropSnapshotRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_DropSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropSnapshot'
+type MockDataCoord_DropSnapshot_Call struct {
+	*mock.Call
+}
+
+// DropSnapshot is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.DropSnapshotRequest
+func (_e *MockDataCoord_Expecter) DropSnapshot(_a0 interface{}, _a1 interface{}) *MockDataCoord_DropSnapshot_Call {
+	return &MockDataCoord_DropSnapshot_Call{Call: _e.mock.On("DropSnapshot", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_DropSnapshot_Call) Run(run func(_a0 context.Context, _a1 *datapb.DropSnapshotRequest)) *MockDataCoord_DropSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.DropSnapshotRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_DropSnapshot_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_DropSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_DropSnapshot_Call) RunAndReturn(run func(context.Context, *datapb.DropSnapshotRequest) (*commonpb.Status, error)) *MockDataCoord_DropSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropVirtualChannel provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) DropVirtualChannel(_a0 context.Context, _a1 *datapb.DropVirtualChannelRequest) (*datapb.DropVirtualChannelResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -696,6 +806,61 @@ func (_c *MockDataCoord_GetCollectionStatistics_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// GetCollectionStorageUsage provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetCollectionStorageUsage(_a0 context.Context, _a1 *internalpb.GetCollectionStorageUsageRequest) (*internalpb.GetCollectionStorageUsageResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.GetCollectionStorageUsageResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetCollectionStorageUsageRequest) (*internalpb.GetCollectionStorageUsageResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetCollectionStorageUsageRequest) *internalpb.GetCollectionStorageUsageResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.GetCollectionStorageUsageResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetCollectionStorageUsageRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetCollectionStorageUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollectionStorageUsage'
+type MockDataCoord_GetCollectionStorageUsage_Call struct {
+	*mock.Call
+}
+
+// GetCollectionStorageUsage is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.GetCollectionStorageUsageRequest
+func (_e *MockDataCoord_Expecter) GetCollectionStorageUsage(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetCollectionStorageUsage_Call {
+	return &MockDataCoord_GetCollectionStorageUsage_Call{Call: _e.mock.On("GetCollectionStorageUsage", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_GetCollectionStorageUsage_Call) Run(run func(_a0 context.Context, _a1 *internalpb.GetCollectionStorageUsageRequest)) *MockDataCoord_GetCollectionStorageUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.GetCollectionStorageUsageRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetCollectionStorageUsage_Call) Return(_a0 *internalpb.GetCollectionStorageUsageResponse, _a1 error) *MockDataCoord_GetCollectionStorageUsage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetCollectionStorageUsage_Call) RunAndReturn(run func(context.Context, *internalpb.GetCollectionStorageUsageRequest) (*internalpb.GetCollectionStorageUsageResponse, error)) *MockDataCoord_GetCollectionStorageUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCompactionState provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) GetCompactionState(_a0 context.Context, _a1 *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1411,6 +1576,61 @@ func (_c *MockDataCoord_GetMetrics_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// GetPartitionKeyStats provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetPartitionKeyStats(_a0 context.Context, _a1 *datapb.GetPartitionKeyStatsRequest) (*datapb.GetPartitionKeyStatsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *datapb.GetPartitionKeyStatsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetPartitionKeyStatsRequest) (*datapb.GetPartitionKeyStatsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetPartitionKeyStatsRequest) *datapb.GetPartitionKeyStatsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetPartitionKeyStatsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetPartitionKeyStatsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetPartitionKeyStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPartitionKeyStats'
+type MockDataCoord_GetPartitionKeyStats_Call struct {
+	*mock.Call
+}
+
+// GetPartitionKeyStats is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.GetPartitionKeyStatsRequest
+func (_e *MockDataCoord_Expecter) GetPartitionKeyStats(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetPartitionKeyStats_Call {
+	return &MockDataCoord_GetPartitionKeyStats_Call{Call: _e.mock.On("GetPartitionKeyStats", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_GetPartitionKeyStats_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetPartitionKeyStatsRequest)) *MockDataCoord_GetPartitionKeyStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.GetPartitionKeyStatsRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetPartitionKeyStats_Call) Return(_a0 *datapb.GetPartitionKeyStatsResponse, _a1 error) *MockDataCoord_GetPartitionKeyStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetPartitionKeyStats_Call) RunAndReturn(run func(context.Context, *datapb.GetPartitionKeyStatsRequest) (*datapb.GetPartitionKeyStatsResponse, error)) *MockDataCoord_GetPartitionKeyStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPartitionStatistics provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) GetPartitionStatistics(_a0 context.Context, _a1 *datapb.GetPartitionStatisticsRequest) (*datapb.GetPartitionStatisticsResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2167,6 +2387,61 @@ func (_c *MockDataCoord_ListIndexes_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// ListSnapshots provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) ListSnapshots(_a0 context.Context, _a1 *datapb.ListSnapshotsRequest) (*datapb.ListSnapshotsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *datapb.ListSnapshotsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListSnapshotsRequest) (*datapb.ListSnapshotsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListSnapshotsRequest) *datapb.ListSnapshotsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.ListSnapshotsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ListSnapshotsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_ListSnapshots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSnapshots'
+type MockDataCoord_ListSnapshots_Call struct {
+	*mock.Call
+}
+
+// ListSnapshots is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.ListSnapshotsRequest
+func (_e *MockDataCoord_Expecter) ListSnapshots(_a0 interface{}, _a1 interface{}) *MockDataCoord_ListSnapshots_Call {
+	return &MockDataCoord_ListSnapshots_Call{Call: _e.mock.On("ListSnapshots", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_ListSnapshots_Call) Run(run func(_a0 context.Context, _a1 *datapb.ListSnapshotsRequest)) *MockDataCoord_ListSnapshots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.ListSnapshotsRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_ListSnapshots_Call) Return(_a0 *datapb.ListSnapshotsResponse, _a1 error) *MockDataCoord_ListSnapshots_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_ListSnapshots_Call) RunAndReturn(run func(context.Context, *datapb.ListSnapshotsRequest) (*datapb.ListSnapshotsResponse, error)) *MockDataCoord_ListSnapshots_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ManualCompaction provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) ManualCompaction(_a0 context.Context, _a1 *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2373,6 +2648,116 @@ func (_c *MockDataCoord_ReportDataNodeTtMsgs_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// RestoreSnapshot provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) RestoreSnapshot(_a0 context.Context, _a1 *datapb.RestoreSnapshotRequest) (*datapb.RestoreSnapshotResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *datapb.RestoreSnapshotResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.RestoreSnapshotRequest) (*datapb.RestoreSnapshotResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.RestoreSnapshotRequest) *datapb.RestoreSnapshotResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.RestoreSnapshotResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.RestoreSnapshotRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_RestoreSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreSnapshot'
+type MockDataCoord_RestoreSnapshot_Call struct {
+	*mock.Call
+}
+
+// RestoreSnapshot is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.RestoreSnapshotRequest
+func (_e *MockDataCoord_Expecter) RestoreSnapshot(_a0 interface{}, _a1 interface{}) *MockDataCoord_RestoreSnapshot_Call {
+	return &MockDataCoord_RestoreSnapshot_Call{Call: _e.mock.On("RestoreSnapshot", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_RestoreSnapshot_Call) Run(run func(_a0 context.Context, _a1 *datapb.RestoreSnapshotRequest)) *MockDataCoord_RestoreSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.RestoreSnapshotRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_RestoreSnapshot_Call) Return(_a0 *datapb.RestoreSnapshotResponse, _a1 error) *MockDataCoord_RestoreSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_RestoreSnapshot_Call) RunAndReturn(run func(context.Context, *datapb.RestoreSnapshotRequest) (*datapb.RestoreSnapshotResponse, error)) *MockDataCoord_RestoreSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunGarbageCollection provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) RunGarbageCollection(_a0 context.Context, _a1 *datapb.RunGarbageCollectionRequest) (*datapb.RunGarbageCollectionResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *datapb.RunGarbageCollectionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.RunGarbageCollectionRequest) (*datapb.RunGarbageCollectionResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.RunGarbageCollectionRequest) *datapb.RunGarbageCollectionResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.RunGarbageCollectionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.RunGarbageCollectionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_RunGarbageCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunGarbageCollection'
+type MockDataCoord_RunGarbageCollection_Call struct {
+	*mock.Call
+}
+
+// RunGarbageCollection is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.RunGarbageCollectionRequest
+func (_e *MockDataCoord_Expecter) RunGarbageCollection(_a0 interface{}, _a1 interface{}) *MockDataCoord_RunGarbageCollection_Call {
+	return &MockDataCoord_RunGarbageCollection_Call{Call: _e.mock.On("RunGarbageCollection", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_RunGarbageCollection_Call) Run(run func(_a0 context.Context, _a1 *datapb.RunGarbageCollectionRequest)) *MockDataCoord_RunGarbageCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.RunGarbageCollectionRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_RunGarbageCollection_Call) Return(_a0 *datapb.RunGarbageCollectionResponse, _a1 error) *MockDataCoord_RunGarbageCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_RunGarbageCollection_Call) RunAndReturn(run func(context.Context, *datapb.RunGarbageCollectionRequest) (*datapb.RunGarbageCollectionResponse, error)) *MockDataCoord_RunGarbageCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SaveBinlogPaths provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) SaveBinlogPaths(_a0 context.Context, _a1 *datapb.SaveBinlogPathsRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -424,6 +424,76 @@ func (_c *MockDataCoordClient_CreateIndex_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// CreateSnapshot provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) CreateSnapshot(ctx context.Context, in *datapb.CreateSnapshotRequest, opts ...grpc.CallOption) (*datapb.CreateSnapshotResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *datapb.CreateSnapshotResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CreateSnapshotRequest, ...grpc.CallOption) (*datapb.CreateSnapshotResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CreateSnapshotRequest, ...grpc.CallOption) *datapb.CreateSnapshotResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.CreateSnapshotResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CreateSnapshotRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_CreateSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSnapshot'
+type MockDataCoordClient_CreateSnapshot_Call struct {
+	*mock.Call
+}
+
+// CreateSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.CreateSnapshotRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) CreateSnapshot(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_CreateSnapshot_Call {
+	return &MockDataCoordClient_CreateSnapshot_Call{Call: _e.mock.On("CreateSnapshot",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_CreateSnapshot_Call) Run(run func(ctx context.Context, in *datapb.CreateSnapshotRequest, opts ...grpc.CallOption)) *MockDataCoordClient_CreateSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.CreateSnapshotRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_CreateSnapshot_Call) Return(_a0 *datapb.CreateSnapshotResponse, _a1 error) *MockDataCoordClient_CreateSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_CreateSnapshot_Call) RunAndReturn(run func(context.Context, *datapb.CreateSnapshotRequest, ...grpc.CallOption) (*datapb.CreateSnapshotResponse, error)) *MockDataCoordClient_CreateSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DescribeIndex provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataCoordClient) DescribeIndex(ctx context.Context, in *indexpb.DescribeIndexRequest, opts ...grpc.CallOption) (*indexpb.DescribeIndexResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -564,6 +634,76 @@ func (_c *MockDataCoordClient_DropIndex_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// DropSnapshot provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) DropSnapshot(ctx context.Context, in *datapb.DropSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.DropSnapshotRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.DropSnapshotRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.DropSnapshotRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_DropSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropSnapshot'
+type MockDataCoordClient_DropSnapshot_Call struct {
+	*mock.Call
+}
+
+// DropSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.DropSnapshotRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) DropSnapshot(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_DropSnapshot_Call {
+	return &MockDataCoordClient_DropSnapshot_Call{Call: _e.mock.On("DropSnapshot",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_DropSnapshot_Call) Run(run func(ctx context.Context, in *datapb.DropSnapshotRequest, opts ...grpc.CallOption)) *MockDataCoordClient_DropSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.DropSnapshotRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_DropSnapshot_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoordClient_DropSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_DropSnapshot_Call) RunAndReturn(run func(context.Context, *datapb.DropSnapshotRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockDataCoordClient_DropSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropVirtualChannel provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataCoordClient) DropVirtualChannel(ctx context.Context, in *datapb.DropVirtualChannelRequest, opts ...grpc.CallOption) (*datapb.DropVirtualChannelResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -914,6 +1054,76 @@ func (_c *MockDataCoordClient_GetCollectionStatistics_Call) RunAndReturn(run fun
 	return _c
 }
 
+// GetCollectionStorageUsage provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) GetCollectionStorageUsage(ctx context.Context, in *internalpb.GetCollectionStorageUsageRequest, opts ...grpc.CallOption) (*internalpb.GetCollectionStorageUsageResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *internalpb.GetCollectionStorageUsageResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetCollectionStorageUsageRequest, ...grpc.CallOption) (*internalpb.GetCollectionStorageUsageResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetCollectionStorageUsageRequest, ...grpc.CallOption) *internalpb.GetCollectionStorageUsageResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.GetCollectionStorageUsageResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetCollectionStorageUsageRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_GetCollectionStorageUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollectionStorageUsage'
+type MockDataCoordClient_GetCollectionStorageUsage_Call struct {
+	*mock.Call
+}
+
+// GetCollectionStorageUsage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.GetCollectionStorageUsageRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) GetCollectionStorageUsage(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetCollectionStorageUsage_Call {
+	return &MockDataCoordClient_GetCollectionStorageUsage_Call{Call: _e.mock.On("GetCollectionStorageUsage",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_GetCollectionStorageUsage_Call) Run(run func(ctx context.Context, in *internalpb.GetCollectionStorageUsageRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetCollectionStorageUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.GetCollectionStorageUsageRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetCollectionStorageUsage_Call) Return(_a0 *internalpb.GetCollectionStorageUsageResponse, _a1 error) *MockDataCoordClient_GetCollectionStorageUsage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetCollectionStorageUsage_Call) RunAndReturn(run func(context.Context, *internalpb.GetCollectionStorageUsageRequest, ...grpc.CallOption) (*internalpb.GetCollectionStorageUsageResponse, error)) *MockDataCoordClient_GetCollectionStorageUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCompactionState provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataCoordClient) GetCompactionState(ctx context.Context, in *milvuspb.GetCompactionStateRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionStateResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -1824,6 +2034,76 @@ func (_c *MockDataCoordClient_GetMetrics_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// GetPartitionKeyStats provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) GetPartitionKeyStats(ctx context.Context, in *datapb.GetPartitionKeyStatsRequest, opts ...grpc.CallOption) (*datapb.GetPartitionKeyStatsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *datapb.GetPartitionKeyStatsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetPartitionKeyStatsRequest, ...grpc.CallOption) (*datapb.GetPartitionKeyStatsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetPartitionKeyStatsRequest, ...grpc.CallOption) *datapb.GetPartitionKeyStatsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetPartitionKeyStatsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetPartitionKeyStatsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_GetPartitionKeyStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPartitionKeyStats'
+type MockDataCoordClient_GetPartitionKeyStats_Call struct {
+	*mock.Call
+}
+
+// GetPartitionKeyStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.GetPartitionKeyStatsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) GetPartitionKeyStats(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetPartitionKeyStats_Call {
+	return &MockDataCoordClient_GetPartitionKeyStats_Call{Call: _e.mock.On("GetPartitionKeyStats",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_GetPartitionKeyStats_Call) Run(run func(ctx context.Context, in *datapb.GetPartitionKeyStatsRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetPartitionKeyStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.GetPartitionKeyStatsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetPartitionKeyStats_Call) Return(_a0 *datapb.GetPartitionKeyStatsResponse, _a1 error) *MockDataCoordClient_GetPartitionKeyStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetPartitionKeyStats_Call) RunAndReturn(run func(context.Context, *datapb.GetPartitionKeyStatsRequest, ...grpc.CallOption) (*datapb.GetPartitionKeyStatsResponse, error)) *MockDataCoordClient_GetPartitionKeyStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPartitionStatistics provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataCoordClient) GetPartitionStatistics(ctx context.Context, in *datapb.GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*datapb.GetPartitionStatisticsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -2734,6 +3014,76 @@ func (_c *MockDataCoordClient_ListIndexes_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// ListSnapshots provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) ListSnapshots(ctx context.Context, in *datapb.ListSnapshotsRequest, opts ...grpc.CallOption) (*datapb.ListSnapshotsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *datapb.ListSnapshotsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListSnapshotsRequest, ...grpc.CallOption) (*datapb.ListSnapshotsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListSnapshotsRequest, ...grpc.CallOption) *datapb.ListSnapshotsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.ListSnapshotsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ListSnapshotsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_ListSnapshots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSnapshots'
+type MockDataCoordClient_ListSnapshots_Call struct {
+	*mock.Call
+}
+
+// ListSnapshots is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.ListSnapshotsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) ListSnapshots(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_ListSnapshots_Call {
+	return &MockDataCoordClient_ListSnapshots_Call{Call: _e.mock.On("ListSnapshots",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_ListSnapshots_Call) Run(run func(ctx context.Context, in *datapb.ListSnapshotsRequest, opts ...grpc.CallOption)) *MockDataCoordClient_ListSnapshots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.ListSnapshotsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_ListSnapshots_Call) Return(_a0 *datapb.ListSnapshotsResponse, _a1 error) *MockDataCoordClient_ListSnapshots_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_ListSnapshots_Call) RunAndReturn(run func(context.Context, *datapb.ListSnapshotsRequest, ...grpc.CallOption) (*datapb.ListSnapshotsResponse, error)) *MockDataCoordClient_ListSnapshots_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ManualCompaction provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataCoordClient) ManualCompaction(ctx context.Context, in *milvuspb.ManualCompactionRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -2944,6 +3294,146 @@ func (_c *MockDataCoordClient_ReportDataNodeTtMsgs_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// RestoreSnapshot provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) RestoreSnapshot(ctx context.Context, in *datapb.RestoreSnapshotRequest, opts ...grpc.CallOption) (*datapb.RestoreSnapshotResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *datapb.RestoreSnapshotResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.RestoreSnapshotRequest, ...grpc.CallOption) (*datapb.RestoreSnapshotResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.RestoreSnapshotRequest, ...grpc.CallOption) *datapb.RestoreSnapshotResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.RestoreSnapshotResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.RestoreSnapshotRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_RestoreSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreSnapshot'
+type MockDataCoordClient_RestoreSnapshot_Call struct {
+	*mock.Call
+}
+
+// RestoreSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.RestoreSnapshotRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) RestoreSnapshot(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_RestoreSnapshot_Call {
+	return &MockDataCoordClient_RestoreSnapshot_Call{Call: _e.mock.On("RestoreSnapshot",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_RestoreSnapshot_Call) Run(run func(ctx context.Context, in *datapb.RestoreSnapshotRequest, opts ...grpc.CallOption)) *MockDataCoordClient_RestoreSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.RestoreSnapshotRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_RestoreSnapshot_Call) Return(_a0 *datapb.RestoreSnapshotResponse, _a1 error) *MockDataCoordClient_RestoreSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_RestoreSnapshot_Call) RunAndReturn(run func(context.Context, *datapb.RestoreSnapshotRequest, ...grpc.CallOption) (*datapb.RestoreSnapshotResponse, error)) *MockDataCoordClient_RestoreSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunGarbageCollection provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) RunGarbageCollection(ctx context.Context, in *datapb.RunGarbageCollectionRequest, opts ...grpc.CallOption) (*datapb.RunGarbageCollectionResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *datapb.RunGarbageCollectionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.RunGarbageCollectionRequest, ...grpc.CallOption) (*datapb.RunGarbageCollectionResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.RunGarbageCollectionRequest, ...grpc.CallOption) *datapb.RunGarbageCollectionResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.RunGarbageCollectionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.RunGarbageCollectionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_RunGarbageCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunGarbageCollection'
+type MockDataCoordClient_RunGarbageCollection_Call struct {
+	*mock.Call
+}
+
+// RunGarbageCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.RunGarbageCollectionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) RunGarbageCollection(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_RunGarbageCollection_Call {
+	return &MockDataCoordClient_RunGarbageCollection_Call{Call: _e.mock.On("RunGarbageCollection",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_RunGarbageCollection_Call) Run(run func(ctx context.Context, in *datapb.RunGarbageCollectionRequest, opts ...grpc.CallOption)) *MockDataCoordClient_RunGarbageCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.RunGarbageCollectionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_RunGarbageCollection_Call) Return(_a0 *datapb.RunGarbageCollectionResponse, _a1 error) *MockDataCoordClient_RunGarbageCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_RunGarbageCollection_Call) RunAndReturn(run func(context.Context, *datapb.RunGarbageCollectionRequest, ...grpc.CallOption) (*datapb.RunGarbageCollectionResponse, error)) *MockDataCoordClient_RunGarbageCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SaveBinlogPaths provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataCoordClient) SaveBinlogPaths(ctx context.Context, in *datapb.SaveBinlogPathsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -1514,6 +1514,61 @@ func (_c *MockDataNode_SyncSegments_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// UpdateChannelSchema provides a mock function with given fields: _a0, _a1
+func (_m *MockDataNode) UpdateChannelSchema(_a0 context.Context, _a1 *datapb.UpdateChannelSchemaRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.UpdateChannelSchemaRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.UpdateChannelSchemaRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.UpdateChannelSchemaRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataNode_UpdateChannelSchema_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateChannelSchema'
+type MockDataNode_UpdateChannelSchema_Call struct {
+	*mock.Call
+}
+
+// UpdateChannelSchema is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.UpdateChannelSchemaRequest
+func (_e *MockDataNode_Expecter) UpdateChannelSchema(_a0 interface{}, _a1 interface{}) *MockDataNode_UpdateChannelSchema_Call {
+	return &MockDataNode_UpdateChannelSchema_Call{Call: _e.mock.On("UpdateChannelSchema", _a0, _a1)}
+}
+
+func (_c *MockDataNode_UpdateChannelSchema_Call) Run(run func(_a0 context.Context, _a1 *datapb.UpdateChannelSchemaRequest)) *MockDataNode_UpdateChannelSchema_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.UpdateChannelSchemaRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataNode_UpdateChannelSchema_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataNode_UpdateChannelSchema_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataNode_UpdateChannelSchema_Call) RunAndReturn(run func(context.Context, *datapb.UpdateChannelSchemaRequest) (*commonpb.Status, error)) *MockDataNode_UpdateChannelSchema_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateStateCode provides a mock function with given fields: stateCode
 func (_m *MockDataNode) UpdateStateCode(stateCode commonpb.StateCode) {
 	_m.Called(stateCode)
@@ -1402,6 +1402,76 @@ func (_c *MockDataNodeClient_SyncSegments_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// UpdateChannelSchema provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataNodeClient) UpdateChannelSchema(ctx context.Context, in *datapb.UpdateChannelSchemaRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.UpdateChannelSchemaRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.UpdateChannelSchemaRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.UpdateChannelSchemaRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataNodeClient_UpdateChannelSchema_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateChannelSchema'
+type MockDataNodeClient_UpdateChannelSchema_Call struct {
+	*mock.Call
+}
+
+// UpdateChannelSchema is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.UpdateChannelSchemaRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataNodeClient_Expecter) UpdateChannelSchema(ctx interface{}, in interface{}, opts ...interface{}) *MockDataNodeClient_UpdateChannelSchema_Call {
+	return &MockDataNodeClient_UpdateChannelSchema_Call{Call: _e.mock.On("UpdateChannelSchema",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataNodeClient_UpdateChannelSchema_Call) Run(run func(ctx context.Context, in *datapb.UpdateChannelSchemaRequest, opts ...grpc.CallOption)) *MockDataNodeClient_UpdateChannelSchema_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.UpdateChannelSchemaRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataNodeClient_UpdateChannelSchema_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataNodeClient_UpdateChannelSchema_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataNodeClient_UpdateChannelSchema_Call) RunAndReturn(run func(context.Context, *datapb.UpdateChannelSchemaRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockDataNodeClient_UpdateChannelSchema_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WatchDmChannels provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataNodeClient) WatchDmChannels(ctx context.Context, in *datapb.WatchDmChannelsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
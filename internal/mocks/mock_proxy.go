@@ -34,6 +34,61 @@ func (_m *MockProxy) EXPECT() *MockProxy_Expecter {
 	return &MockProxy_Expecter{mock: &_m.Mock}
 }
 
+// AddCollectionField provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) AddCollectionField(_a0 context.Context, _a1 *internalpb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AddCollectionFieldRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AddCollectionFieldRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.AddCollectionFieldRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_AddCollectionField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCollectionField'
+type MockProxy_AddCollectionField_Call struct {
+	*mock.Call
+}
+
+// AddCollectionField is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.AddCollectionFieldRequest
+func (_e *MockProxy_Expecter) AddCollectionField(_a0 interface{}, _a1 interface{}) *MockProxy_AddCollectionField_Call {
+	return &MockProxy_AddCollectionField_Call{Call: _e.mock.On("AddCollectionField", _a0, _a1)}
+}
+
+func (_c *MockProxy_AddCollectionField_Call) Run(run func(_a0 context.Context, _a1 *internalpb.AddCollectionFieldRequest)) *MockProxy_AddCollectionField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.AddCollectionFieldRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_AddCollectionField_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_AddCollectionField_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_AddCollectionField_Call) RunAndReturn(run func(context.Context, *internalpb.AddCollectionFieldRequest) (*commonpb.Status, error)) *MockProxy_AddCollectionField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AllocTimestamp provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) AllocTimestamp(_a0 context.Context, _a1 *milvuspb.AllocTimestampRequest) (*milvuspb.AllocTimestampResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -144,6 +199,61 @@ func (_c *MockProxy_AlterAlias_Call) RunAndReturn(run func(context.Context, *mil
 	return _c
 }
 
+// AlterAliases provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) AlterAliases(_a0 context.Context, _a1 *internalpb.AlterAliasesRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AlterAliasesRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AlterAliasesRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.AlterAliasesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_AlterAliases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterAliases'
+type MockProxy_AlterAliases_Call struct {
+	*mock.Call
+}
+
+// AlterAliases is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.AlterAliasesRequest
+func (_e *MockProxy_Expecter) AlterAliases(_a0 interface{}, _a1 interface{}) *MockProxy_AlterAliases_Call {
+	return &MockProxy_AlterAliases_Call{Call: _e.mock.On("AlterAliases", _a0, _a1)}
+}
+
+func (_c *MockProxy_AlterAliases_Call) Run(run func(_a0 context.Context, _a1 *internalpb.AlterAliasesRequest)) *MockProxy_AlterAliases_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.AlterAliasesRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_AlterAliases_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_AlterAliases_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_AlterAliases_Call) RunAndReturn(run func(context.Context, *internalpb.AlterAliasesRequest) (*commonpb.Status, error)) *MockProxy_AlterAliases_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AlterCollection provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) AlterCollection(_a0 context.Context, _a1 *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1464,6 +1574,116 @@ func (_c *MockProxy_DropCollection_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// DropCollectionAsync provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) DropCollectionAsync(_a0 context.Context, _a1 *milvuspb.DropCollectionRequest) (*internalpb.SubmitDDLJobResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.SubmitDDLJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropCollectionRequest) (*internalpb.SubmitDDLJobResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropCollectionRequest) *internalpb.SubmitDDLJobResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.SubmitDDLJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.DropCollectionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_DropCollectionAsync_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropCollectionAsync'
+type MockProxy_DropCollectionAsync_Call struct {
+	*mock.Call
+}
+
+// DropCollectionAsync is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.DropCollectionRequest
+func (_e *MockProxy_Expecter) DropCollectionAsync(_a0 interface{}, _a1 interface{}) *MockProxy_DropCollectionAsync_Call {
+	return &MockProxy_DropCollectionAsync_Call{Call: _e.mock.On("DropCollectionAsync", _a0, _a1)}
+}
+
+func (_c *MockProxy_DropCollectionAsync_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.DropCollectionRequest)) *MockProxy_DropCollectionAsync_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.DropCollectionRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_DropCollectionAsync_Call) Return(_a0 *internalpb.SubmitDDLJobResponse, _a1 error) *MockProxy_DropCollectionAsync_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_DropCollectionAsync_Call) RunAndReturn(run func(context.Context, *milvuspb.DropCollectionRequest) (*internalpb.SubmitDDLJobResponse, error)) *MockProxy_DropCollectionAsync_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DropCollectionField provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) DropCollectionField(_a0 context.Context, _a1 *internalpb.DropCollectionFieldRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DropCollectionFieldRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DropCollectionFieldRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.DropCollectionFieldRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_DropCollectionField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropCollectionField'
+type MockProxy_DropCollectionField_Call struct {
+	*mock.Call
+}
+
+// DropCollectionField is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.DropCollectionFieldRequest
+func (_e *MockProxy_Expecter) DropCollectionField(_a0 interface{}, _a1 interface{}) *MockProxy_DropCollectionField_Call {
+	return &MockProxy_DropCollectionField_Call{Call: _e.mock.On("DropCollectionField", _a0, _a1)}
+}
+
+func (_c *MockProxy_DropCollectionField_Call) Run(run func(_a0 context.Context, _a1 *internalpb.DropCollectionFieldRequest)) *MockProxy_DropCollectionField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.DropCollectionFieldRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_DropCollectionField_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_DropCollectionField_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_DropCollectionField_Call) RunAndReturn(run func(context.Context, *internalpb.DropCollectionFieldRequest) (*commonpb.Status, error)) *MockProxy_DropCollectionField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropDatabase provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) DropDatabase(_a0 context.Context, _a1 *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1519,6 +1739,61 @@ func (_c *MockProxy_DropDatabase_Call) RunAndReturn(run func(context.Context, *m
 	return _c
 }
 
+// DropDatabaseAsync provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) DropDatabaseAsync(_a0 context.Context, _a1 *milvuspb.DropDatabaseRequest) (*internalpb.SubmitDDLJobResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.SubmitDDLJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropDatabaseRequest) (*internalpb.SubmitDDLJobResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropDatabaseRequest) *internalpb.SubmitDDLJobResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.SubmitDDLJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.DropDatabaseRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_DropDatabaseAsync_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropDatabaseAsync'
+type MockProxy_DropDatabaseAsync_Call struct {
+	*mock.Call
+}
+
+// DropDatabaseAsync is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.DropDatabaseRequest
+func (_e *MockProxy_Expecter) DropDatabaseAsync(_a0 interface{}, _a1 interface{}) *MockProxy_DropDatabaseAsync_Call {
+	return &MockProxy_DropDatabaseAsync_Call{Call: _e.mock.On("DropDatabaseAsync", _a0, _a1)}
+}
+
+func (_c *MockProxy_DropDatabaseAsync_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.DropDatabaseRequest)) *MockProxy_DropDatabaseAsync_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.DropDatabaseRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_DropDatabaseAsync_Call) Return(_a0 *internalpb.SubmitDDLJobResponse, _a1 error) *MockProxy_DropDatabaseAsync_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_DropDatabaseAsync_Call) RunAndReturn(run func(context.Context, *milvuspb.DropDatabaseRequest) (*internalpb.SubmitDDLJobResponse, error)) *MockProxy_DropDatabaseAsync_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropIndex provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) DropIndex(_a0 context.Context, _a1 *milvuspb.DropIndexRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2000,6 +2275,61 @@ func (_c *MockProxy_GetCollectionStatistics_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// GetCollectionStorageUsage provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) GetCollectionStorageUsage(_a0 context.Context, _a1 *internalpb.GetCollectionStorageUsageRequest) (*internalpb.GetCollectionStorageUsageResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.GetCollectionStorageUsageResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetCollectionStorageUsageRequest) (*internalpb.GetCollectionStorageUsageResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetCollectionStorageUsageRequest) *internalpb.GetCollectionStorageUsageResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.GetCollectionStorageUsageResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetCollectionStorageUsageRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_GetCollectionStorageUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollectionStorageUsage'
+type MockProxy_GetCollectionStorageUsage_Call struct {
+	*mock.Call
+}
+
+// GetCollectionStorageUsage is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.GetCollectionStorageUsageRequest
+func (_e *MockProxy_Expecter) GetCollectionStorageUsage(_a0 interface{}, _a1 interface{}) *MockProxy_GetCollectionStorageUsage_Call {
+	return &MockProxy_GetCollectionStorageUsage_Call{Call: _e.mock.On("GetCollectionStorageUsage", _a0, _a1)}
+}
+
+func (_c *MockProxy_GetCollectionStorageUsage_Call) Run(run func(_a0 context.Context, _a1 *internalpb.GetCollectionStorageUsageRequest)) *MockProxy_GetCollectionStorageUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.GetCollectionStorageUsageRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_GetCollectionStorageUsage_Call) Return(_a0 *internalpb.GetCollectionStorageUsageResponse, _a1 error) *MockProxy_GetCollectionStorageUsage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_GetCollectionStorageUsage_Call) RunAndReturn(run func(context.Context, *internalpb.GetCollectionStorageUsageRequest) (*internalpb.GetCollectionStorageUsageResponse, error)) *MockProxy_GetCollectionStorageUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCompactionState provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) GetCompactionState(_a0 context.Context, _a1 *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2165,6 +2495,61 @@ func (_c *MockProxy_GetComponentStates_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// GetDDLJob provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) GetDDLJob(_a0 context.Context, _a1 *internalpb.GetDDLJobRequest) (*internalpb.GetDDLJobResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.GetDDLJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetDDLJobRequest) (*internalpb.GetDDLJobResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetDDLJobRequest) *internalpb.GetDDLJobResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.GetDDLJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetDDLJobRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_GetDDLJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDDLJob'
+type MockProxy_GetDDLJob_Call struct {
+	*mock.Call
+}
+
+// GetDDLJob is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.GetDDLJobRequest
+func (_e *MockProxy_Expecter) GetDDLJob(_a0 interface{}, _a1 interface{}) *MockProxy_GetDDLJob_Call {
+	return &MockProxy_GetDDLJob_Call{Call: _e.mock.On("GetDDLJob", _a0, _a1)}
+}
+
+func (_c *MockProxy_GetDDLJob_Call) Run(run func(_a0 context.Context, _a1 *internalpb.GetDDLJobRequest)) *MockProxy_GetDDLJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.GetDDLJobRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_GetDDLJob_Call) Return(_a0 *internalpb.GetDDLJobResponse, _a1 error) *MockProxy_GetDDLJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_GetDDLJob_Call) RunAndReturn(run func(context.Context, *internalpb.GetDDLJobRequest) (*internalpb.GetDDLJobResponse, error)) *MockProxy_GetDDLJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetDdChannel provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) GetDdChannel(_a0 context.Context, _a1 *internalpb.GetDdChannelRequest) (*milvuspb.StringResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -3909,6 +4294,61 @@ func (_c *MockProxy_ListCredUsers_Call) RunAndReturn(run func(context.Context, *
 	return _c
 }
 
+// ListDDLJobs provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) ListDDLJobs(_a0 context.Context, _a1 *internalpb.ListDDLJobsRequest) (*internalpb.ListDDLJobsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.ListDDLJobsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListDDLJobsRequest) (*internalpb.ListDDLJobsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListDDLJobsRequest) *internalpb.ListDDLJobsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ListDDLJobsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListDDLJobsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_ListDDLJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDDLJobs'
+type MockProxy_ListDDLJobs_Call struct {
+	*mock.Call
+}
+
+// ListDDLJobs is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.ListDDLJobsRequest
+func (_e *MockProxy_Expecter) ListDDLJobs(_a0 interface{}, _a1 interface{}) *MockProxy_ListDDLJobs_Call {
+	return &MockProxy_ListDDLJobs_Call{Call: _e.mock.On("ListDDLJobs", _a0, _a1)}
+}
+
+func (_c *MockProxy_ListDDLJobs_Call) Run(run func(_a0 context.Context, _a1 *internalpb.ListDDLJobsRequest)) *MockProxy_ListDDLJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.ListDDLJobsRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_ListDDLJobs_Call) Return(_a0 *internalpb.ListDDLJobsResponse, _a1 error) *MockProxy_ListDDLJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_ListDDLJobs_Call) RunAndReturn(run func(context.Context, *internalpb.ListDDLJobsRequest) (*internalpb.ListDDLJobsResponse, error)) *MockProxy_ListDDLJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListDatabases provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) ListDatabases(_a0 context.Context, _a1 *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -4129,6 +4569,61 @@ func (_c *MockProxy_ListIndexedSegment_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// ListPrivilegeGroups provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) ListPrivilegeGroups(_a0 context.Context, _a1 *internalpb.ListPrivilegeGroupsRequest) (*internalpb.ListPrivilegeGroupsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.ListPrivilegeGroupsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListPrivilegeGroupsRequest) (*internalpb.ListPrivilegeGroupsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListPrivilegeGroupsRequest) *internalpb.ListPrivilegeGroupsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ListPrivilegeGroupsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListPrivilegeGroupsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_ListPrivilegeGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPrivilegeGroups'
+type MockProxy_ListPrivilegeGroups_Call struct {
+	*mock.Call
+}
+
+// ListPrivilegeGroups is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.ListPrivilegeGroupsRequest
+func (_e *MockProxy_Expecter) ListPrivilegeGroups(_a0 interface{}, _a1 interface{}) *MockProxy_ListPrivilegeGroups_Call {
+	return &MockProxy_ListPrivilegeGroups_Call{Call: _e.mock.On("ListPrivilegeGroups", _a0, _a1)}
+}
+
+func (_c *MockProxy_ListPrivilegeGroups_Call) Run(run func(_a0 context.Context, _a1 *internalpb.ListPrivilegeGroupsRequest)) *MockProxy_ListPrivilegeGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.ListPrivilegeGroupsRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_ListPrivilegeGroups_Call) Return(_a0 *internalpb.ListPrivilegeGroupsResponse, _a1 error) *MockProxy_ListPrivilegeGroups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_ListPrivilegeGroups_Call) RunAndReturn(run func(context.Context, *internalpb.ListPrivilegeGroupsRequest) (*internalpb.ListPrivilegeGroupsResponse, error)) *MockProxy_ListPrivilegeGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListResourceGroups provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) ListResourceGroups(_a0 context.Context, _a1 *milvuspb.ListResourceGroupsRequest) (*milvuspb.ListResourceGroupsResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -4885,6 +5380,61 @@ func (_c *MockProxy_RenameCollection_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// RenameCollectionField provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) RenameCollectionField(_a0 context.Context, _a1 *internalpb.RenameCollectionFieldRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.RenameCollectionFieldRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.RenameCollectionFieldRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.RenameCollectionFieldRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_RenameCollectionField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameCollectionField'
+type MockProxy_RenameCollectionField_Call struct {
+	*mock.Call
+}
+
+// RenameCollectionField is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.RenameCollectionFieldRequest
+func (_e *MockProxy_Expecter) RenameCollectionField(_a0 interface{}, _a1 interface{}) *MockProxy_RenameCollectionField_Call {
+	return &MockProxy_RenameCollectionField_Call{Call: _e.mock.On("RenameCollectionField", _a0, _a1)}
+}
+
+func (_c *MockProxy_RenameCollectionField_Call) Run(run func(_a0 context.Context, _a1 *internalpb.RenameCollectionFieldRequest)) *MockProxy_RenameCollectionField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.RenameCollectionFieldRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_RenameCollectionField_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_RenameCollectionField_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_RenameCollectionField_Call) RunAndReturn(run func(context.Context, *internalpb.RenameCollectionFieldRequest) (*commonpb.Status, error)) *MockProxy_RenameCollectionField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReplicateMessage provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) ReplicateMessage(_a0 context.Context, _a1 *milvuspb.ReplicateMessageRequest) (*milvuspb.ReplicateMessageResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -31,43 +31,463 @@ func (_m *MockProxyClient) EXPECT() *MockProxyClient_Expecter {
 	return &MockProxyClient_Expecter{mock: &_m.Mock}
 }
 
+// AddCollectionField provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) AddCollectionField(ctx context.Context, in *internalpb.AddCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AddCollectionFieldRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AddCollectionFieldRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.AddCollectionFieldRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_AddCollectionField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCollectionField'
+type MockProxyClient_AddCollectionField_Call struct {
+	*mock.Call
+}
+
+// AddCollectionField is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.AddCollectionFieldRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) AddCollectionField(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_AddCollectionField_Call {
+	return &MockProxyClient_AddCollectionField_Call{Call: _e.mock.On("AddCollectionField",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_AddCollectionField_Call) Run(run func(ctx context.Context, in *internalpb.AddCollectionFieldRequest, opts ...grpc.CallOption)) *MockProxyClient_AddCollectionField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.AddCollectionFieldRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_AddCollectionField_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxyClient_AddCollectionField_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_AddCollectionField_Call) RunAndReturn(run func(context.Context, *internalpb.AddCollectionFieldRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockProxyClient_AddCollectionField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AlterAliases provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) AlterAliases(ctx context.Context, in *internalpb.AlterAliasesRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AlterAliasesRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AlterAliasesRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.AlterAliasesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_AlterAliases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterAliases'
+type MockProxyClient_AlterAliases_Call struct {
+	*mock.Call
+}
+
+// AlterAliases is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.AlterAliasesRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) AlterAliases(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_AlterAliases_Call {
+	return &MockProxyClient_AlterAliases_Call{Call: _e.mock.On("AlterAliases",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_AlterAliases_Call) Run(run func(ctx context.Context, in *internalpb.AlterAliasesRequest, opts ...grpc.CallOption)) *MockProxyClient_AlterAliases_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.AlterAliasesRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_AlterAliases_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxyClient_AlterAliases_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_AlterAliases_Call) RunAndReturn(run func(context.Context, *internalpb.AlterAliasesRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockProxyClient_AlterAliases_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function with given fields:
 func (_m *MockProxyClient) Close() error {
 	ret := _m.Called()
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func() error); ok {
-		r0 = rf()
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProxyClient_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type MockProxyClient_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+func (_e *MockProxyClient_Expecter) Close() *MockProxyClient_Close_Call {
+	return &MockProxyClient_Close_Call{Call: _e.mock.On("Close")}
+}
+
+func (_c *MockProxyClient_Close_Call) Run(run func()) *MockProxyClient_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_Close_Call) Return(_a0 error) *MockProxyClient_Close_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockProxyClient_Close_Call) RunAndReturn(run func() error) *MockProxyClient_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DropCollectionAsync provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) DropCollectionAsync(ctx context.Context, in *milvuspb.DropCollectionRequest, opts ...grpc.CallOption) (*internalpb.SubmitDDLJobResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *internalpb.SubmitDDLJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropCollectionRequest, ...grpc.CallOption) (*internalpb.SubmitDDLJobResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropCollectionRequest, ...grpc.CallOption) *internalpb.SubmitDDLJobResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.SubmitDDLJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.DropCollectionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_DropCollectionAsync_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropCollectionAsync'
+type MockProxyClient_DropCollectionAsync_Call struct {
+	*mock.Call
+}
+
+// DropCollectionAsync is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *milvuspb.DropCollectionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) DropCollectionAsync(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_DropCollectionAsync_Call {
+	return &MockProxyClient_DropCollectionAsync_Call{Call: _e.mock.On("DropCollectionAsync",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_DropCollectionAsync_Call) Run(run func(ctx context.Context, in *milvuspb.DropCollectionRequest, opts ...grpc.CallOption)) *MockProxyClient_DropCollectionAsync_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*milvuspb.DropCollectionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_DropCollectionAsync_Call) Return(_a0 *internalpb.SubmitDDLJobResponse, _a1 error) *MockProxyClient_DropCollectionAsync_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_DropCollectionAsync_Call) RunAndReturn(run func(context.Context, *milvuspb.DropCollectionRequest, ...grpc.CallOption) (*internalpb.SubmitDDLJobResponse, error)) *MockProxyClient_DropCollectionAsync_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DropCollectionField provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) DropCollectionField(ctx context.Context, in *internalpb.DropCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DropCollectionFieldRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DropCollectionFieldRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.DropCollectionFieldRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_DropCollectionField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropCollectionField'
+type MockProxyClient_DropCollectionField_Call struct {
+	*mock.Call
+}
+
+// DropCollectionField is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.DropCollectionFieldRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) DropCollectionField(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_DropCollectionField_Call {
+	return &MockProxyClient_DropCollectionField_Call{Call: _e.mock.On("DropCollectionField",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_DropCollectionField_Call) Run(run func(ctx context.Context, in *internalpb.DropCollectionFieldRequest, opts ...grpc.CallOption)) *MockProxyClient_DropCollectionField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.DropCollectionFieldRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_DropCollectionField_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxyClient_DropCollectionField_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_DropCollectionField_Call) RunAndReturn(run func(context.Context, *internalpb.DropCollectionFieldRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockProxyClient_DropCollectionField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DropDatabaseAsync provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) DropDatabaseAsync(ctx context.Context, in *milvuspb.DropDatabaseRequest, opts ...grpc.CallOption) (*internalpb.SubmitDDLJobResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *internalpb.SubmitDDLJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropDatabaseRequest, ...grpc.CallOption) (*internalpb.SubmitDDLJobResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropDatabaseRequest, ...grpc.CallOption) *internalpb.SubmitDDLJobResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.SubmitDDLJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.DropDatabaseRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_DropDatabaseAsync_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropDatabaseAsync'
+type MockProxyClient_DropDatabaseAsync_Call struct {
+	*mock.Call
+}
+
+// DropDatabaseAsync is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *milvuspb.DropDatabaseRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) DropDatabaseAsync(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_DropDatabaseAsync_Call {
+	return &MockProxyClient_DropDatabaseAsync_Call{Call: _e.mock.On("DropDatabaseAsync",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_DropDatabaseAsync_Call) Run(run func(ctx context.Context, in *milvuspb.DropDatabaseRequest, opts ...grpc.CallOption)) *MockProxyClient_DropDatabaseAsync_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*milvuspb.DropDatabaseRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_DropDatabaseAsync_Call) Return(_a0 *internalpb.SubmitDDLJobResponse, _a1 error) *MockProxyClient_DropDatabaseAsync_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_DropDatabaseAsync_Call) RunAndReturn(run func(context.Context, *milvuspb.DropDatabaseRequest, ...grpc.CallOption) (*internalpb.SubmitDDLJobResponse, error)) *MockProxyClient_DropDatabaseAsync_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCollectionStorageUsage provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) GetCollectionStorageUsage(ctx context.Context, in *internalpb.GetCollectionStorageUsageRequest, opts ...grpc.CallOption) (*internalpb.GetCollectionStorageUsageResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *internalpb.GetCollectionStorageUsageResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetCollectionStorageUsageRequest, ...grpc.CallOption) (*internalpb.GetCollectionStorageUsageResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetCollectionStorageUsageRequest, ...grpc.CallOption) *internalpb.GetCollectionStorageUsageResponse); ok {
+		r0 = rf(ctx, in, opts...)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.GetCollectionStorageUsageResponse)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetCollectionStorageUsageRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockProxyClient_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
-type MockProxyClient_Close_Call struct {
+// MockProxyClient_GetCollectionStorageUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollectionStorageUsage'
+type MockProxyClient_GetCollectionStorageUsage_Call struct {
 	*mock.Call
 }
 
-// Close is a helper method to define mock.On call
-func (_e *MockProxyClient_Expecter) Close() *MockProxyClient_Close_Call {
-	return &MockProxyClient_Close_Call{Call: _e.mock.On("Close")}
+// GetCollectionStorageUsage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.GetCollectionStorageUsageRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) GetCollectionStorageUsage(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_GetCollectionStorageUsage_Call {
+	return &MockProxyClient_GetCollectionStorageUsage_Call{Call: _e.mock.On("GetCollectionStorageUsage",
+		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockProxyClient_Close_Call) Run(run func()) *MockProxyClient_Close_Call {
+func (_c *MockProxyClient_GetCollectionStorageUsage_Call) Run(run func(ctx context.Context, in *internalpb.GetCollectionStorageUsageRequest, opts ...grpc.CallOption)) *MockProxyClient_GetCollectionStorageUsage_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.GetCollectionStorageUsageRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockProxyClient_Close_Call) Return(_a0 error) *MockProxyClient_Close_Call {
-	_c.Call.Return(_a0)
+func (_c *MockProxyClient_GetCollectionStorageUsage_Call) Return(_a0 *internalpb.GetCollectionStorageUsageResponse, _a1 error) *MockProxyClient_GetCollectionStorageUsage_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockProxyClient_Close_Call) RunAndReturn(run func() error) *MockProxyClient_Close_Call {
+func (_c *MockProxyClient_GetCollectionStorageUsage_Call) RunAndReturn(run func(context.Context, *internalpb.GetCollectionStorageUsageRequest, ...grpc.CallOption) (*internalpb.GetCollectionStorageUsageResponse, error)) *MockProxyClient_GetCollectionStorageUsage_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -142,6 +562,76 @@ func (_c *MockProxyClient_GetComponentStates_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// GetDDLJob provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) GetDDLJob(ctx context.Context, in *internalpb.GetDDLJobRequest, opts ...grpc.CallOption) (*internalpb.GetDDLJobResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *internalpb.GetDDLJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetDDLJobRequest, ...grpc.CallOption) (*internalpb.GetDDLJobResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetDDLJobRequest, ...grpc.CallOption) *internalpb.GetDDLJobResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.GetDDLJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetDDLJobRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_GetDDLJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDDLJob'
+type MockProxyClient_GetDDLJob_Call struct {
+	*mock.Call
+}
+
+// GetDDLJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.GetDDLJobRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) GetDDLJob(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_GetDDLJob_Call {
+	return &MockProxyClient_GetDDLJob_Call{Call: _e.mock.On("GetDDLJob",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_GetDDLJob_Call) Run(run func(ctx context.Context, in *internalpb.GetDDLJobRequest, opts ...grpc.CallOption)) *MockProxyClient_GetDDLJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.GetDDLJobRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_GetDDLJob_Call) Return(_a0 *internalpb.GetDDLJobResponse, _a1 error) *MockProxyClient_GetDDLJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_GetDDLJob_Call) RunAndReturn(run func(context.Context, *internalpb.GetDDLJobRequest, ...grpc.CallOption) (*internalpb.GetDDLJobResponse, error)) *MockProxyClient_GetDDLJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetDdChannel provides a mock function with given fields: ctx, in, opts
 func (_m *MockProxyClient) GetDdChannel(ctx context.Context, in *internalpb.GetDdChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -772,6 +1262,76 @@ func (_c *MockProxyClient_ListClientInfos_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// ListDDLJobs provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) ListDDLJobs(ctx context.Context, in *internalpb.ListDDLJobsRequest, opts ...grpc.CallOption) (*internalpb.ListDDLJobsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *internalpb.ListDDLJobsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListDDLJobsRequest, ...grpc.CallOption) (*internalpb.ListDDLJobsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListDDLJobsRequest, ...grpc.CallOption) *internalpb.ListDDLJobsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ListDDLJobsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListDDLJobsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_ListDDLJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDDLJobs'
+type MockProxyClient_ListDDLJobs_Call struct {
+	*mock.Call
+}
+
+// ListDDLJobs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.ListDDLJobsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) ListDDLJobs(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_ListDDLJobs_Call {
+	return &MockProxyClient_ListDDLJobs_Call{Call: _e.mock.On("ListDDLJobs",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_ListDDLJobs_Call) Run(run func(ctx context.Context, in *internalpb.ListDDLJobsRequest, opts ...grpc.CallOption)) *MockProxyClient_ListDDLJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.ListDDLJobsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_ListDDLJobs_Call) Return(_a0 *internalpb.ListDDLJobsResponse, _a1 error) *MockProxyClient_ListDDLJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_ListDDLJobs_Call) RunAndReturn(run func(context.Context, *internalpb.ListDDLJobsRequest, ...grpc.CallOption) (*internalpb.ListDDLJobsResponse, error)) *MockProxyClient_ListDDLJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListImports provides a mock function with given fields: ctx, in, opts
 func (_m *MockProxyClient) ListImports(ctx context.Context, in *internalpb.ListImportsRequest, opts ...grpc.CallOption) (*internalpb.ListImportsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -842,6 +1402,76 @@ func (_c *MockProxyClient_ListImports_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// ListPrivilegeGroups provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) ListPrivilegeGroups(ctx context.Context, in *internalpb.ListPrivilegeGroupsRequest, opts ...grpc.CallOption) (*internalpb.ListPrivilegeGroupsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *internalpb.ListPrivilegeGroupsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListPrivilegeGroupsRequest, ...grpc.CallOption) (*internalpb.ListPrivilegeGroupsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListPrivilegeGroupsRequest, ...grpc.CallOption) *internalpb.ListPrivilegeGroupsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ListPrivilegeGroupsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListPrivilegeGroupsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_ListPrivilegeGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPrivilegeGroups'
+type MockProxyClient_ListPrivilegeGroups_Call struct {
+	*mock.Call
+}
+
+// ListPrivilegeGroups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.ListPrivilegeGroupsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) ListPrivilegeGroups(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_ListPrivilegeGroups_Call {
+	return &MockProxyClient_ListPrivilegeGroups_Call{Call: _e.mock.On("ListPrivilegeGroups",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_ListPrivilegeGroups_Call) Run(run func(ctx context.Context, in *internalpb.ListPrivilegeGroupsRequest, opts ...grpc.CallOption)) *MockProxyClient_ListPrivilegeGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.ListPrivilegeGroupsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_ListPrivilegeGroups_Call) Return(_a0 *internalpb.ListPrivilegeGroupsResponse, _a1 error) *MockProxyClient_ListPrivilegeGroups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_ListPrivilegeGroups_Call) RunAndReturn(run func(context.Context, *internalpb.ListPrivilegeGroupsRequest, ...grpc.CallOption) (*internalpb.ListPrivilegeGroupsResponse, error)) *MockProxyClient_ListPrivilegeGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RefreshPolicyInfoCache provides a mock function with given fields: ctx, in, opts
 func (_m *MockProxyClient) RefreshPolicyInfoCache(ctx context.Context, in *proxypb.RefreshPolicyInfoCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -912,6 +1542,76 @@ func (_c *MockProxyClient_RefreshPolicyInfoCache_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// RenameCollectionField provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) RenameCollectionField(ctx context.Context, in *internalpb.RenameCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.RenameCollectionFieldRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.RenameCollectionFieldRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.RenameCollectionFieldRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_RenameCollectionField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameCollectionField'
+type MockProxyClient_RenameCollectionField_Call struct {
+	*mock.Call
+}
+
+// RenameCollectionField is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.RenameCollectionFieldRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) RenameCollectionField(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_RenameCollectionField_Call {
+	return &MockProxyClient_RenameCollectionField_Call{Call: _e.mock.On("RenameCollectionField",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_RenameCollectionField_Call) Run(run func(ctx context.Context, in *internalpb.RenameCollectionFieldRequest, opts ...grpc.CallOption)) *MockProxyClient_RenameCollectionField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.RenameCollectionFieldRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_RenameCollectionField_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxyClient_RenameCollectionField_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_RenameCollectionField_Call) RunAndReturn(run func(context.Context, *internalpb.RenameCollectionFieldRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockProxyClient_RenameCollectionField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetRates provides a mock function with given fields: ctx, in, opts
 func (_m *MockProxyClient) SetRates(ctx context.Context, in *proxypb.SetRatesRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
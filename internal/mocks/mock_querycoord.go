@@ -89,6 +89,61 @@ func (_c *MockQueryCoord_ActivateChecker_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// CancelDrainNode provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) CancelDrainNode(_a0 context.Context, _a1 *querypb.CancelDrainNodeRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.CancelDrainNodeRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.CancelDrainNodeRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.CancelDrainNodeRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_CancelDrainNode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelDrainNode'
+type MockQueryCoord_CancelDrainNode_Call struct {
+	*mock.Call
+}
+
+// CancelDrainNode is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.CancelDrainNodeRequest
+func (_e *MockQueryCoord_Expecter) CancelDrainNode(_a0 interface{}, _a1 interface{}) *MockQueryCoord_CancelDrainNode_Call {
+	return &MockQueryCoord_CancelDrainNode_Call{Call: _e.mock.On("CancelDrainNode", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_CancelDrainNode_Call) Run(run func(_a0 context.Context, _a1 *querypb.CancelDrainNodeRequest)) *MockQueryCoord_CancelDrainNode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.CancelDrainNodeRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_CancelDrainNode_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_CancelDrainNode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_CancelDrainNode_Call) RunAndReturn(run func(context.Context, *querypb.CancelDrainNodeRequest) (*commonpb.Status, error)) *MockQueryCoord_CancelDrainNode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckHealth provides a mock function with given fields: _a0, _a1
 func (_m *MockQueryCoord) CheckHealth(_a0 context.Context, _a1 *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -364,6 +419,61 @@ func (_c *MockQueryCoord_DescribeResourceGroup_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// DrainNode provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) DrainNode(_a0 context.Context, _a1 *querypb.DrainNodeRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.DrainNodeRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.DrainNodeRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.DrainNodeRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_DrainNode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DrainNode'
+type MockQueryCoord_DrainNode_Call struct {
+	*mock.Call
+}
+
+// DrainNode is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.DrainNodeRequest
+func (_e *MockQueryCoord_Expecter) DrainNode(_a0 interface{}, _a1 interface{}) *MockQueryCoord_DrainNode_Call {
+	return &MockQueryCoord_DrainNode_Call{Call: _e.mock.On("DrainNode", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_DrainNode_Call) Run(run func(_a0 context.Context, _a1 *querypb.DrainNodeRequest)) *MockQueryCoord_DrainNode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.DrainNodeRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_DrainNode_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_DrainNode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_DrainNode_Call) RunAndReturn(run func(context.Context, *querypb.DrainNodeRequest) (*commonpb.Status, error)) *MockQueryCoord_DrainNode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropResourceGroup provides a mock function with given fields: _a0, _a1
 func (_m *MockQueryCoord) DropResourceGroup(_a0 context.Context, _a1 *milvuspb.DropResourceGroupRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -474,6 +584,61 @@ func (_c *MockQueryCoord_GetComponentStates_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// GetDrainNodeProgress provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) GetDrainNodeProgress(_a0 context.Context, _a1 *querypb.GetDrainNodeProgressRequest) (*querypb.GetDrainNodeProgressResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *querypb.GetDrainNodeProgressResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetDrainNodeProgressRequest) (*querypb.GetDrainNodeProgressResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetDrainNodeProgressRequest) *querypb.GetDrainNodeProgressResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetDrainNodeProgressResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetDrainNodeProgressRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_GetDrainNodeProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDrainNodeProgress'
+type MockQueryCoord_GetDrainNodeProgress_Call struct {
+	*mock.Call
+}
+
+// GetDrainNodeProgress is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.GetDrainNodeProgressRequest
+func (_e *MockQueryCoord_Expecter) GetDrainNodeProgress(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetDrainNodeProgress_Call {
+	return &MockQueryCoord_GetDrainNodeProgress_Call{Call: _e.mock.On("GetDrainNodeProgress", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_GetDrainNodeProgress_Call) Run(run func(_a0 context.Context, _a1 *querypb.GetDrainNodeProgressRequest)) *MockQueryCoord_GetDrainNodeProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.GetDrainNodeProgressRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_GetDrainNodeProgress_Call) Return(_a0 *querypb.GetDrainNodeProgressResponse, _a1 error) *MockQueryCoord_GetDrainNodeProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_GetDrainNodeProgress_Call) RunAndReturn(run func(context.Context, *querypb.GetDrainNodeProgressRequest) (*querypb.GetDrainNodeProgressResponse, error)) *MockQueryCoord_GetDrainNodeProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetMetrics provides a mock function with given fields: _a0, _a1
 func (_m *MockQueryCoord) GetMetrics(_a0 context.Context, _a1 *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -694,6 +859,61 @@ func (_c *MockQueryCoord_GetReplicas_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// GetResourceGroupRecommendations provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) GetResourceGroupRecommendations(_a0 context.Context, _a1 *querypb.GetResourceGroupRecommendationsRequest) (*querypb.GetResourceGroupRecommendationsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *querypb.GetResourceGroupRecommendationsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetResourceGroupRecommendationsRequest) (*querypb.GetResourceGroupRecommendationsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetResourceGroupRecommendationsRequest) *querypb.GetResourceGroupRecommendationsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetResourceGroupRecommendationsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetResourceGroupRecommendationsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_GetResourceGroupRecommendations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetResourceGroupRecommendations'
+type MockQueryCoord_GetResourceGroupRecommendations_Call struct {
+	*mock.Call
+}
+
+// GetResourceGroupRecommendations is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.GetResourceGroupRecommendationsRequest
+func (_e *MockQueryCoord_Expecter) GetResourceGroupRecommendations(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetResourceGroupRecommendations_Call {
+	return &MockQueryCoord_GetResourceGroupRecommendations_Call{Call: _e.mock.On("GetResourceGroupRecommendations", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_GetResourceGroupRecommendations_Call) Run(run func(_a0 context.Context, _a1 *querypb.GetResourceGroupRecommendationsRequest)) *MockQueryCoord_GetResourceGroupRecommendations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.GetResourceGroupRecommendationsRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_GetResourceGroupRecommendations_Call) Return(_a0 *querypb.GetResourceGroupRecommendationsResponse, _a1 error) *MockQueryCoord_GetResourceGroupRecommendations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_GetResourceGroupRecommendations_Call) RunAndReturn(run func(context.Context, *querypb.GetResourceGroupRecommendationsRequest) (*querypb.GetResourceGroupRecommendationsResponse, error)) *MockQueryCoord_GetResourceGroupRecommendations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSegmentInfo provides a mock function with given fields: _a0, _a1
 func (_m *MockQueryCoord) GetSegmentInfo(_a0 context.Context, _a1 *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1285,6 +1505,61 @@ func (_c *MockQueryCoord_LoadPartitions_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// PreviewBalance provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) PreviewBalance(_a0 context.Context, _a1 *querypb.PreviewBalanceRequest) (*querypb.PreviewBalanceResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *querypb.PreviewBalanceResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.PreviewBalanceRequest) (*querypb.PreviewBalanceResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.PreviewBalanceRequest) *querypb.PreviewBalanceResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.PreviewBalanceResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.PreviewBalanceRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_PreviewBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PreviewBalance'
+type MockQueryCoord_PreviewBalance_Call struct {
+	*mock.Call
+}
+
+// PreviewBalance is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.PreviewBalanceRequest
+func (_e *MockQueryCoord_Expecter) PreviewBalance(_a0 interface{}, _a1 interface{}) *MockQueryCoord_PreviewBalance_Call {
+	return &MockQueryCoord_PreviewBalance_Call{Call: _e.mock.On("PreviewBalance", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_PreviewBalance_Call) Run(run func(_a0 context.Context, _a1 *querypb.PreviewBalanceRequest)) *MockQueryCoord_PreviewBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.PreviewBalanceRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_PreviewBalance_Call) Return(_a0 *querypb.PreviewBalanceResponse, _a1 error) *MockQueryCoord_PreviewBalance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_PreviewBalance_Call) RunAndReturn(run func(context.Context, *querypb.PreviewBalanceRequest) (*querypb.PreviewBalanceResponse, error)) *MockQueryCoord_PreviewBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Register provides a mock function with given fields:
 func (_m *MockQueryCoord) Register() error {
 	ret := _m.Called()
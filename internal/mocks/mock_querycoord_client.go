@@ -101,6 +101,76 @@ func (_c *MockQueryCoordClient_ActivateChecker_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// CancelDrainNode provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) CancelDrainNode(ctx context.Context, in *querypb.CancelDrainNodeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.CancelDrainNodeRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.CancelDrainNodeRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.CancelDrainNodeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_CancelDrainNode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelDrainNode'
+type MockQueryCoordClient_CancelDrainNode_Call struct {
+	*mock.Call
+}
+
+// CancelDrainNode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.CancelDrainNodeRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) CancelDrainNode(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_CancelDrainNode_Call {
+	return &MockQueryCoordClient_CancelDrainNode_Call{Call: _e.mock.On("CancelDrainNode",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_CancelDrainNode_Call) Run(run func(ctx context.Context, in *querypb.CancelDrainNodeRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_CancelDrainNode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.CancelDrainNodeRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_CancelDrainNode_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_CancelDrainNode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_CancelDrainNode_Call) RunAndReturn(run func(context.Context, *querypb.CancelDrainNodeRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_CancelDrainNode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckHealth provides a mock function with given fields: ctx, in, opts
 func (_m *MockQueryCoordClient) CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -492,6 +562,76 @@ func (_c *MockQueryCoordClient_DescribeResourceGroup_Call) RunAndReturn(run func
 	return _c
 }
 
+// DrainNode provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) DrainNode(ctx context.Context, in *querypb.DrainNodeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.DrainNodeRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.DrainNodeRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.DrainNodeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_DrainNode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DrainNode'
+type MockQueryCoordClient_DrainNode_Call struct {
+	*mock.Call
+}
+
+// DrainNode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.DrainNodeRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) DrainNode(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_DrainNode_Call {
+	return &MockQueryCoordClient_DrainNode_Call{Call: _e.mock.On("DrainNode",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_DrainNode_Call) Run(run func(ctx context.Context, in *querypb.DrainNodeRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_DrainNode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.DrainNodeRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_DrainNode_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_DrainNode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_DrainNode_Call) RunAndReturn(run func(context.Context, *querypb.DrainNodeRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_DrainNode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropResourceGroup provides a mock function with given fields: ctx, in, opts
 func (_m *MockQueryCoordClient) DropResourceGroup(ctx context.Context, in *milvuspb.DropResourceGroupRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -632,6 +772,76 @@ func (_c *MockQueryCoordClient_GetComponentStates_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// GetDrainNodeProgress provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) GetDrainNodeProgress(ctx context.Context, in *querypb.GetDrainNodeProgressRequest, opts ...grpc.CallOption) (*querypb.GetDrainNodeProgressResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *querypb.GetDrainNodeProgressResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetDrainNodeProgressRequest, ...grpc.CallOption) (*querypb.GetDrainNodeProgressResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetDrainNodeProgressRequest, ...grpc.CallOption) *querypb.GetDrainNodeProgressResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetDrainNodeProgressResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetDrainNodeProgressRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_GetDrainNodeProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDrainNodeProgress'
+type MockQueryCoordClient_GetDrainNodeProgress_Call struct {
+	*mock.Call
+}
+
+// GetDrainNodeProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.GetDrainNodeProgressRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) GetDrainNodeProgress(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetDrainNodeProgress_Call {
+	return &MockQueryCoordClient_GetDrainNodeProgress_Call{Call: _e.mock.On("GetDrainNodeProgress",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_GetDrainNodeProgress_Call) Run(run func(ctx context.Context, in *querypb.GetDrainNodeProgressRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetDrainNodeProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.GetDrainNodeProgressRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetDrainNodeProgress_Call) Return(_a0 *querypb.GetDrainNodeProgressResponse, _a1 error) *MockQueryCoordClient_GetDrainNodeProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetDrainNodeProgress_Call) RunAndReturn(run func(context.Context, *querypb.GetDrainNodeProgressRequest, ...grpc.CallOption) (*querypb.GetDrainNodeProgressResponse, error)) *MockQueryCoordClient_GetDrainNodeProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetMetrics provides a mock function with given fields: ctx, in, opts
 func (_m *MockQueryCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -912,6 +1122,76 @@ func (_c *MockQueryCoordClient_GetReplicas_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// GetResourceGroupRecommendations provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) GetResourceGroupRecommendations(ctx context.Context, in *querypb.GetResourceGroupRecommendationsRequest, opts ...grpc.CallOption) (*querypb.GetResourceGroupRecommendationsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *querypb.GetResourceGroupRecommendationsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetResourceGroupRecommendationsRequest, ...grpc.CallOption) (*querypb.GetResourceGroupRecommendationsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetResourceGroupRecommendationsRequest, ...grpc.CallOption) *querypb.GetResourceGroupRecommendationsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetResourceGroupRecommendationsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetResourceGroupRecommendationsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_GetResourceGroupRecommendations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetResourceGroupRecommendations'
+type MockQueryCoordClient_GetResourceGroupRecommendations_Call struct {
+	*mock.Call
+}
+
+// GetResourceGroupRecommendations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.GetResourceGroupRecommendationsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) GetResourceGroupRecommendations(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetResourceGroupRecommendations_Call {
+	return &MockQueryCoordClient_GetResourceGroupRecommendations_Call{Call: _e.mock.On("GetResourceGroupRecommendations",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_GetResourceGroupRecommendations_Call) Run(run func(ctx context.Context, in *querypb.GetResourceGroupRecommendationsRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetResourceGroupRecommendations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.GetResourceGroupRecommendationsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetResourceGroupRecommendations_Call) Return(_a0 *querypb.GetResourceGroupRecommendationsResponse, _a1 error) *MockQueryCoordClient_GetResourceGroupRecommendations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetResourceGroupRecommendations_Call) RunAndReturn(run func(context.Context, *querypb.GetResourceGroupRecommendationsRequest, ...grpc.CallOption) (*querypb.GetResourceGroupRecommendationsResponse, error)) *MockQueryCoordClient_GetResourceGroupRecommendations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSegmentInfo provides a mock function with given fields: ctx, in, opts
 func (_m *MockQueryCoordClient) GetSegmentInfo(ctx context.Context, in *querypb.GetSegmentInfoRequest, opts ...grpc.CallOption) (*querypb.GetSegmentInfoResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -1612,6 +1892,76 @@ func (_c *MockQueryCoordClient_LoadPartitions_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// PreviewBalance provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) PreviewBalance(ctx context.Context, in *querypb.PreviewBalanceRequest, opts ...grpc.CallOption) (*querypb.PreviewBalanceResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *querypb.PreviewBalanceResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.PreviewBalanceRequest, ...grpc.CallOption) (*querypb.PreviewBalanceResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.PreviewBalanceRequest, ...grpc.CallOption) *querypb.PreviewBalanceResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.PreviewBalanceResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.PreviewBalanceRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_PreviewBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PreviewBalance'
+type MockQueryCoordClient_PreviewBalance_Call struct {
+	*mock.Call
+}
+
+// PreviewBalance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.PreviewBalanceRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) PreviewBalance(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_PreviewBalance_Call {
+	return &MockQueryCoordClient_PreviewBalance_Call{Call: _e.mock.On("PreviewBalance",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_PreviewBalance_Call) Run(run func(ctx context.Context, in *querypb.PreviewBalanceRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_PreviewBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.PreviewBalanceRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_PreviewBalance_Call) Return(_a0 *querypb.PreviewBalanceResponse, _a1 error) *MockQueryCoordClient_PreviewBalance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_PreviewBalance_Call) RunAndReturn(run func(context.Context, *querypb.PreviewBalanceRequest, ...grpc.CallOption) (*querypb.PreviewBalanceResponse, error)) *MockQueryCoordClient_PreviewBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReleaseCollection provides a mock function with given fields: ctx, in, opts
 func (_m *MockQueryCoordClient) ReleaseCollection(ctx context.Context, in *querypb.ReleaseCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -36,6 +36,61 @@ func (_m *RootCoord) EXPECT() *RootCoord_Expecter {
 	return &RootCoord_Expecter{mock: &_m.Mock}
 }
 
+// AddCollectionField provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) AddCollectionField(_a0 context.Context, _a1 *internalpb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AddCollectionFieldRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AddCollectionFieldRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.AddCollectionFieldRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_AddCollectionField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCollectionField'
+type RootCoord_AddCollectionField_Call struct {
+	*mock.Call
+}
+
+// AddCollectionField is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.AddCollectionFieldRequest
+func (_e *RootCoord_Expecter) AddCollectionField(_a0 interface{}, _a1 interface{}) *RootCoord_AddCollectionField_Call {
+	return &RootCoord_AddCollectionField_Call{Call: _e.mock.On("AddCollectionField", _a0, _a1)}
+}
+
+func (_c *RootCoord_AddCollectionField_Call) Run(run func(_a0 context.Context, _a1 *internalpb.AddCollectionFieldRequest)) *RootCoord_AddCollectionField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.AddCollectionFieldRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_AddCollectionField_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_AddCollectionField_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_AddCollectionField_Call) RunAndReturn(run func(context.Context, *internalpb.AddCollectionFieldRequest) (*commonpb.Status, error)) *RootCoord_AddCollectionField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AllocID provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) AllocID(_a0 context.Context, _a1 *rootcoordpb.AllocIDRequest) (*rootcoordpb.AllocIDResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -201,6 +256,61 @@ func (_c *RootCoord_AlterAlias_Call) RunAndReturn(run func(context.Context, *mil
 	return _c
 }
 
+// AlterAliases provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) AlterAliases(_a0 context.Context, _a1 *internalpb.AlterAliasesRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AlterAliasesRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AlterAliasesRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.AlterAliasesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_AlterAliases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterAliases'
+type RootCoord_AlterAliases_Call struct {
+	*mock.Call
+}
+
+// AlterAliases is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.AlterAliasesRequest
+func (_e *RootCoord_Expecter) AlterAliases(_a0 interface{}, _a1 interface{}) *RootCoord_AlterAliases_Call {
+	return &RootCoord_AlterAliases_Call{Call: _e.mock.On("AlterAliases", _a0, _a1)}
+}
+
+func (_c *RootCoord_AlterAliases_Call) Run(run func(_a0 context.Context, _a1 *internalpb.AlterAliasesRequest)) *RootCoord_AlterAliases_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.AlterAliasesRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_AlterAliases_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_AlterAliases_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_AlterAliases_Call) RunAndReturn(run func(context.Context, *internalpb.AlterAliasesRequest) (*commonpb.Status, error)) *RootCoord_AlterAliases_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AlterCollection provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) AlterCollection(_a0 context.Context, _a1 *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1081,6 +1191,116 @@ func (_c *RootCoord_DropCollection_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// DropCollectionAsync provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) DropCollectionAsync(_a0 context.Context, _a1 *milvuspb.DropCollectionRequest) (*internalpb.SubmitDDLJobResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.SubmitDDLJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropCollectionRequest) (*internalpb.SubmitDDLJobResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropCollectionRequest) *internalpb.SubmitDDLJobResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.SubmitDDLJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.DropCollectionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_DropCollectionAsync_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropCollectionAsync'
+type RootCoord_DropCollectionAsync_Call struct {
+	*mock.Call
+}
+
+// DropCollectionAsync is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.DropCollectionRequest
+func (_e *RootCoord_Expecter) DropCollectionAsync(_a0 interface{}, _a1 interface{}) *RootCoord_DropCollectionAsync_Call {
+	return &RootCoord_DropCollectionAsync_Call{Call: _e.mock.On("DropCollectionAsync", _a0, _a1)}
+}
+
+func (_c *RootCoord_DropCollectionAsync_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.DropCollectionRequest)) *RootCoord_DropCollectionAsync_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.DropCollectionRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_DropCollectionAsync_Call) Return(_a0 *internalpb.SubmitDDLJobResponse, _a1 error) *RootCoord_DropCollectionAsync_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_DropCollectionAsync_Call) RunAndReturn(run func(context.Context, *milvuspb.DropCollectionRequest) (*internalpb.SubmitDDLJobResponse, error)) *RootCoord_DropCollectionAsync_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DropCollectionField provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) DropCollectionField(_a0 context.Context, _a1 *internalpb.DropCollectionFieldRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DropCollectionFieldRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DropCollectionFieldRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.DropCollectionFieldRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_DropCollectionField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropCollectionField'
+type RootCoord_DropCollectionField_Call struct {
+	*mock.Call
+}
+
+// DropCollectionField is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.DropCollectionFieldRequest
+func (_e *RootCoord_Expecter) DropCollectionField(_a0 interface{}, _a1 interface{}) *RootCoord_DropCollectionField_Call {
+	return &RootCoord_DropCollectionField_Call{Call: _e.mock.On("DropCollectionField", _a0, _a1)}
+}
+
+func (_c *RootCoord_DropCollectionField_Call) Run(run func(_a0 context.Context, _a1 *internalpb.DropCollectionFieldRequest)) *RootCoord_DropCollectionField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.DropCollectionFieldRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_DropCollectionField_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_DropCollectionField_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_DropCollectionField_Call) RunAndReturn(run func(context.Context, *internalpb.DropCollectionFieldRequest) (*commonpb.Status, error)) *RootCoord_DropCollectionField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropDatabase provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) DropDatabase(_a0 context.Context, _a1 *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1136,6 +1356,61 @@ func (_c *RootCoord_DropDatabase_Call) RunAndReturn(run func(context.Context, *m
 	return _c
 }
 
+// DropDatabaseAsync provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) DropDatabaseAsync(_a0 context.Context, _a1 *milvuspb.DropDatabaseRequest) (*internalpb.SubmitDDLJobResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.SubmitDDLJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropDatabaseRequest) (*internalpb.SubmitDDLJobResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropDatabaseRequest) *internalpb.SubmitDDLJobResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.SubmitDDLJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.DropDatabaseRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_DropDatabaseAsync_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropDatabaseAsync'
+type RootCoord_DropDatabaseAsync_Call struct {
+	*mock.Call
+}
+
+// DropDatabaseAsync is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.DropDatabaseRequest
+func (_e *RootCoord_Expecter) DropDatabaseAsync(_a0 interface{}, _a1 interface{}) *RootCoord_DropDatabaseAsync_Call {
+	return &RootCoord_DropDatabaseAsync_Call{Call: _e.mock.On("DropDatabaseAsync", _a0, _a1)}
+}
+
+func (_c *RootCoord_DropDatabaseAsync_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.DropDatabaseRequest)) *RootCoord_DropDatabaseAsync_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.DropDatabaseRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_DropDatabaseAsync_Call) Return(_a0 *internalpb.SubmitDDLJobResponse, _a1 error) *RootCoord_DropDatabaseAsync_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_DropDatabaseAsync_Call) RunAndReturn(run func(context.Context, *milvuspb.DropDatabaseRequest) (*internalpb.SubmitDDLJobResponse, error)) *RootCoord_DropDatabaseAsync_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropPartition provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) DropPartition(_a0 context.Context, _a1 *milvuspb.DropPartitionRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1356,6 +1631,61 @@ func (_c *RootCoord_GetCredential_Call) RunAndReturn(run func(context.Context, *
 	return _c
 }
 
+// GetDDLJob provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) GetDDLJob(_a0 context.Context, _a1 *internalpb.GetDDLJobRequest) (*internalpb.GetDDLJobResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.GetDDLJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetDDLJobRequest) (*internalpb.GetDDLJobResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetDDLJobRequest) *internalpb.GetDDLJobResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.GetDDLJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetDDLJobRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_GetDDLJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDDLJob'
+type RootCoord_GetDDLJob_Call struct {
+	*mock.Call
+}
+
+// GetDDLJob is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.GetDDLJobRequest
+func (_e *RootCoord_Expecter) GetDDLJob(_a0 interface{}, _a1 interface{}) *RootCoord_GetDDLJob_Call {
+	return &RootCoord_GetDDLJob_Call{Call: _e.mock.On("GetDDLJob", _a0, _a1)}
+}
+
+func (_c *RootCoord_GetDDLJob_Call) Run(run func(_a0 context.Context, _a1 *internalpb.GetDDLJobRequest)) *RootCoord_GetDDLJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.GetDDLJobRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_GetDDLJob_Call) Return(_a0 *internalpb.GetDDLJobResponse, _a1 error) *RootCoord_GetDDLJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_GetDDLJob_Call) RunAndReturn(run func(context.Context, *internalpb.GetDDLJobRequest) (*internalpb.GetDDLJobResponse, error)) *RootCoord_GetDDLJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetMetrics provides a mock function with given fields: ctx, req
 func (_m *RootCoord) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -1837,6 +2167,61 @@ func (_c *RootCoord_ListCredUsers_Call) RunAndReturn(run func(context.Context, *
 	return _c
 }
 
+// ListDDLJobs provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) ListDDLJobs(_a0 context.Context, _a1 *internalpb.ListDDLJobsRequest) (*internalpb.ListDDLJobsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.ListDDLJobsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListDDLJobsRequest) (*internalpb.ListDDLJobsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListDDLJobsRequest) *internalpb.ListDDLJobsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ListDDLJobsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListDDLJobsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_ListDDLJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDDLJobs'
+type RootCoord_ListDDLJobs_Call struct {
+	*mock.Call
+}
+
+// ListDDLJobs is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.ListDDLJobsRequest
+func (_e *RootCoord_Expecter) ListDDLJobs(_a0 interface{}, _a1 interface{}) *RootCoord_ListDDLJobs_Call {
+	return &RootCoord_ListDDLJobs_Call{Call: _e.mock.On("ListDDLJobs", _a0, _a1)}
+}
+
+func (_c *RootCoord_ListDDLJobs_Call) Run(run func(_a0 context.Context, _a1 *internalpb.ListDDLJobsRequest)) *RootCoord_ListDDLJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.ListDDLJobsRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_ListDDLJobs_Call) Return(_a0 *internalpb.ListDDLJobsResponse, _a1 error) *RootCoord_ListDDLJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_ListDDLJobs_Call) RunAndReturn(run func(context.Context, *internalpb.ListDDLJobsRequest) (*internalpb.ListDDLJobsResponse, error)) *RootCoord_ListDDLJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListDatabases provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) ListDatabases(_a0 context.Context, _a1 *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2153,6 +2538,61 @@ func (_c *RootCoord_RenameCollection_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// RenameCollectionField provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) RenameCollectionField(_a0 context.Context, _a1 *internalpb.RenameCollectionFieldRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.RenameCollectionFieldRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.RenameCollectionFieldRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.RenameCollectionFieldRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_RenameCollectionField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameCollectionField'
+type RootCoord_RenameCollectionField_Call struct {
+	*mock.Call
+}
+
+// RenameCollectionField is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.RenameCollectionFieldRequest
+func (_e *RootCoord_Expecter) RenameCollectionField(_a0 interface{}, _a1 interface{}) *RootCoord_RenameCollectionField_Call {
+	return &RootCoord_RenameCollectionField_Call{Call: _e.mock.On("RenameCollectionField", _a0, _a1)}
+}
+
+func (_c *RootCoord_RenameCollectionField_Call) Run(run func(_a0 context.Context, _a1 *internalpb.RenameCollectionFieldRequest)) *RootCoord_RenameCollectionField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.RenameCollectionFieldRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_RenameCollectionField_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_RenameCollectionField_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_RenameCollectionField_Call) RunAndReturn(run func(context.Context, *internalpb.RenameCollectionFieldRequest) (*commonpb.Status, error)) *RootCoord_RenameCollectionField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SelectGrant provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) SelectGrant(_a0 context.Context, _a1 *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
 	ret := _m.Called(_a0, _a1)
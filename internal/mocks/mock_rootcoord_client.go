@@ -33,6 +33,76 @@ func (_m *MockRootCoordClient) EXPECT() *MockRootCoordClient_Expecter {
 	return &MockRootCoordClient_Expecter{mock: &_m.Mock}
 }
 
+// AddCollectionField provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) AddCollectionField(ctx context.Context, in *internalpb.AddCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AddCollectionFieldRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AddCollectionFieldRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.AddCollectionFieldRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_AddCollectionField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCollectionField'
+type MockRootCoordClient_AddCollectionField_Call struct {
+	*mock.Call
+}
+
+// AddCollectionField is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.AddCollectionFieldRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) AddCollectionField(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_AddCollectionField_Call {
+	return &MockRootCoordClient_AddCollectionField_Call{Call: _e.mock.On("AddCollectionField",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_AddCollectionField_Call) Run(run func(ctx context.Context, in *internalpb.AddCollectionFieldRequest, opts ...grpc.CallOption)) *MockRootCoordClient_AddCollectionField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.AddCollectionFieldRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_AddCollectionField_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_AddCollectionField_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_AddCollectionField_Call) RunAndReturn(run func(context.Context, *internalpb.AddCollectionFieldRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_AddCollectionField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AllocID provides a mock function with given fields: ctx, in, opts
 func (_m *MockRootCoordClient) AllocID(ctx context.Context, in *rootcoordpb.AllocIDRequest, opts ...grpc.CallOption) (*rootcoordpb.AllocIDResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -243,6 +313,76 @@ func (_c *MockRootCoordClient_AlterAlias_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// AlterAliases provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) AlterAliases(ctx context.Context, in *internalpb.AlterAliasesRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AlterAliasesRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AlterAliasesRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.AlterAliasesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_AlterAliases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterAliases'
+type MockRootCoordClient_AlterAliases_Call struct {
+	*mock.Call
+}
+
+// AlterAliases is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.AlterAliasesRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) AlterAliases(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_AlterAliases_Call {
+	return &MockRootCoordClient_AlterAliases_Call{Call: _e.mock.On("AlterAliases",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_AlterAliases_Call) Run(run func(ctx context.Context, in *internalpb.AlterAliasesRequest, opts ...grpc.CallOption)) *MockRootCoordClient_AlterAliases_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.AlterAliasesRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_AlterAliases_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_AlterAliases_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_AlterAliases_Call) RunAndReturn(run func(context.Context, *internalpb.AlterAliasesRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_AlterAliases_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AlterCollection provides a mock function with given fields: ctx, in, opts
 func (_m *MockRootCoordClient) AlterCollection(ctx context.Context, in *milvuspb.AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -1404,6 +1544,146 @@ func (_c *MockRootCoordClient_DropCollection_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// DropCollectionAsync provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) DropCollectionAsync(ctx context.Context, in *milvuspb.DropCollectionRequest, opts ...grpc.CallOption) (*internalpb.SubmitDDLJobResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *internalpb.SubmitDDLJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropCollectionRequest, ...grpc.CallOption) (*internalpb.SubmitDDLJobResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropCollectionRequest, ...grpc.CallOption) *internalpb.SubmitDDLJobResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.SubmitDDLJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.DropCollectionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_DropCollectionAsync_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropCollectionAsync'
+type MockRootCoordClient_DropCollectionAsync_Call struct {
+	*mock.Call
+}
+
+// DropCollectionAsync is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *milvuspb.DropCollectionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) DropCollectionAsync(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_DropCollectionAsync_Call {
+	return &MockRootCoordClient_DropCollectionAsync_Call{Call: _e.mock.On("DropCollectionAsync",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_DropCollectionAsync_Call) Run(run func(ctx context.Context, in *milvuspb.DropCollectionRequest, opts ...grpc.CallOption)) *MockRootCoordClient_DropCollectionAsync_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*milvuspb.DropCollectionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_DropCollectionAsync_Call) Return(_a0 *internalpb.SubmitDDLJobResponse, _a1 error) *MockRootCoordClient_DropCollectionAsync_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_DropCollectionAsync_Call) RunAndReturn(run func(context.Context, *milvuspb.DropCollectionRequest, ...grpc.CallOption) (*internalpb.SubmitDDLJobResponse, error)) *MockRootCoordClient_DropCollectionAsync_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DropCollectionField provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) DropCollectionField(ctx context.Context, in *internalpb.DropCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DropCollectionFieldRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DropCollectionFieldRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.DropCollectionFieldRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_DropCollectionField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropCollectionField'
+type MockRootCoordClient_DropCollectionField_Call struct {
+	*mock.Call
+}
+
+// DropCollectionField is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.DropCollectionFieldRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) DropCollectionField(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_DropCollectionField_Call {
+	return &MockRootCoordClient_DropCollectionField_Call{Call: _e.mock.On("DropCollectionField",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_DropCollectionField_Call) Run(run func(ctx context.Context, in *internalpb.DropCollectionFieldRequest, opts ...grpc.CallOption)) *MockRootCoordClient_DropCollectionField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.DropCollectionFieldRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_DropCollectionField_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_DropCollectionField_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_DropCollectionField_Call) RunAndReturn(run func(context.Context, *internalpb.DropCollectionFieldRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_DropCollectionField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropDatabase provides a mock function with given fields: ctx, in, opts
 func (_m *MockRootCoordClient) DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -1474,6 +1754,76 @@ func (_c *MockRootCoordClient_DropDatabase_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// DropDatabaseAsync provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) DropDatabaseAsync(ctx context.Context, in *milvuspb.DropDatabaseRequest, opts ...grpc.CallOption) (*internalpb.SubmitDDLJobResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *internalpb.SubmitDDLJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropDatabaseRequest, ...grpc.CallOption) (*internalpb.SubmitDDLJobResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropDatabaseRequest, ...grpc.CallOption) *internalpb.SubmitDDLJobResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.SubmitDDLJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.DropDatabaseRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_DropDatabaseAsync_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropDatabaseAsync'
+type MockRootCoordClient_DropDatabaseAsync_Call struct {
+	*mock.Call
+}
+
+// DropDatabaseAsync is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *milvuspb.DropDatabaseRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) DropDatabaseAsync(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_DropDatabaseAsync_Call {
+	return &MockRootCoordClient_DropDatabaseAsync_Call{Call: _e.mock.On("DropDatabaseAsync",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_DropDatabaseAsync_Call) Run(run func(ctx context.Context, in *milvuspb.DropDatabaseRequest, opts ...grpc.CallOption)) *MockRootCoordClient_DropDatabaseAsync_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*milvuspb.DropDatabaseRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_DropDatabaseAsync_Call) Return(_a0 *internalpb.SubmitDDLJobResponse, _a1 error) *MockRootCoordClient_DropDatabaseAsync_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_DropDatabaseAsync_Call) RunAndReturn(run func(context.Context, *milvuspb.DropDatabaseRequest, ...grpc.CallOption) (*internalpb.SubmitDDLJobResponse, error)) *MockRootCoordClient_DropDatabaseAsync_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropPartition provides a mock function with given fields: ctx, in, opts
 func (_m *MockRootCoordClient) DropPartition(ctx context.Context, in *milvuspb.DropPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -1754,6 +2104,76 @@ func (_c *MockRootCoordClient_GetCredential_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// GetDDLJob provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) GetDDLJob(ctx context.Context, in *internalpb.GetDDLJobRequest, opts ...grpc.CallOption) (*internalpb.GetDDLJobResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *internalpb.GetDDLJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetDDLJobRequest, ...grpc.CallOption) (*internalpb.GetDDLJobResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetDDLJobRequest, ...grpc.CallOption) *internalpb.GetDDLJobResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.GetDDLJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetDDLJobRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_GetDDLJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDDLJob'
+type MockRootCoordClient_GetDDLJob_Call struct {
+	*mock.Call
+}
+
+// GetDDLJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.GetDDLJobRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) GetDDLJob(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_GetDDLJob_Call {
+	return &MockRootCoordClient_GetDDLJob_Call{Call: _e.mock.On("GetDDLJob",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_GetDDLJob_Call) Run(run func(ctx context.Context, in *internalpb.GetDDLJobRequest, opts ...grpc.CallOption)) *MockRootCoordClient_GetDDLJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.GetDDLJobRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_GetDDLJob_Call) Return(_a0 *internalpb.GetDDLJobResponse, _a1 error) *MockRootCoordClient_GetDDLJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_GetDDLJob_Call) RunAndReturn(run func(context.Context, *internalpb.GetDDLJobRequest, ...grpc.CallOption) (*internalpb.GetDDLJobResponse, error)) *MockRootCoordClient_GetDDLJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetMetrics provides a mock function with given fields: ctx, in, opts
 func (_m *MockRootCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -2314,6 +2734,76 @@ func (_c *MockRootCoordClient_ListCredUsers_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// ListDDLJobs provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) ListDDLJobs(ctx context.Context, in *internalpb.ListDDLJobsRequest, opts ...grpc.CallOption) (*internalpb.ListDDLJobsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *internalpb.ListDDLJobsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListDDLJobsRequest, ...grpc.CallOption) (*internalpb.ListDDLJobsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListDDLJobsRequest, ...grpc.CallOption) *internalpb.ListDDLJobsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ListDDLJobsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListDDLJobsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_ListDDLJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDDLJobs'
+type MockRootCoordClient_ListDDLJobs_Call struct {
+	*mock.Call
+}
+
+// ListDDLJobs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.ListDDLJobsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) ListDDLJobs(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_ListDDLJobs_Call {
+	return &MockRootCoordClient_ListDDLJobs_Call{Call: _e.mock.On("ListDDLJobs",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_ListDDLJobs_Call) Run(run func(ctx context.Context, in *internalpb.ListDDLJobsRequest, opts ...grpc.CallOption)) *MockRootCoordClient_ListDDLJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.ListDDLJobsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_ListDDLJobs_Call) Return(_a0 *internalpb.ListDDLJobsResponse, _a1 error) *MockRootCoordClient_ListDDLJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_ListDDLJobs_Call) RunAndReturn(run func(context.Context, *internalpb.ListDDLJobsRequest, ...grpc.CallOption) (*internalpb.ListDDLJobsResponse, error)) *MockRootCoordClient_ListDDLJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListDatabases provides a mock function with given fields: ctx, in, opts
 func (_m *MockRootCoordClient) ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest, opts ...grpc.CallOption) (*milvuspb.ListDatabasesResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -2664,6 +3154,76 @@ func (_c *MockRootCoordClient_RenameCollection_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// RenameCollectionField provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) RenameCollectionField(ctx context.Context, in *internalpb.RenameCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.RenameCollectionFieldRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.RenameCollectionFieldRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.RenameCollectionFieldRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_RenameCollectionField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameCollectionField'
+type MockRootCoordClient_RenameCollectionField_Call struct {
+	*mock.Call
+}
+
+// RenameCollectionField is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.RenameCollectionFieldRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) RenameCollectionField(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_RenameCollectionField_Call {
+	return &MockRootCoordClient_RenameCollectionField_Call{Call: _e.mock.On("RenameCollectionField",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_RenameCollectionField_Call) Run(run func(ctx context.Context, in *internalpb.RenameCollectionFieldRequest, opts ...grpc.CallOption)) *MockRootCoordClient_RenameCollectionField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.RenameCollectionFieldRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_RenameCollectionField_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_RenameCollectionField_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_RenameCollectionField_Call) RunAndReturn(run func(context.Context, *internalpb.RenameCollectionFieldRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_RenameCollectionField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SelectGrant provides a mock function with given fields: ctx, in, opts
 func (_m *MockRootCoordClient) SelectGrant(ctx context.Context, in *milvuspb.SelectGrantRequest, opts ...grpc.CallOption) (*milvuspb.SelectGrantResponse, error) {
 	_va := make([]interface{}, len(opts))
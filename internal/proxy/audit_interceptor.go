@@ -0,0 +1,46 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"github.com/milvus-io/milvus/internal/util/auditlog"
+)
+
+// AuditInterceptor forwards the user and the client address of the audited requests to the coordinators,
+// which record them into the audit log.
+func AuditInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		var sourceAddr string
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			sourceAddr = p.Addr.String()
+		}
+		return handler(WithAuditInfo(ctx, info.FullMethod, GetCurUserFromContextOrDefault(ctx), sourceAddr), req)
+	}
+}
+
+// WithAuditInfo attaches the user and the client address to the outgoing context if the method is audited.
+func WithAuditInfo(ctx context.Context, fullMethod string, username string, sourceAddr string) context.Context {
+	if !auditlog.IsAudited(fullMethod) {
+		return ctx
+	}
+	return auditlog.AppendToOutgoingContext(ctx, username, sourceAddr)
+}
@@ -0,0 +1,54 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/auditlog"
+)
+
+func TestAuditInterceptor(t *testing.T) {
+	interceptor := AuditInterceptor()
+	ctx := peer.NewContext(NewContextWithMetadata(context.Background(), "alice", "db"), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 1234},
+	})
+
+	var outgoing metadata.MD
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		outgoing, _ = metadata.FromOutgoingContext(ctx)
+		return nil, nil
+	}
+
+	_, err := interceptor(ctx, &milvuspb.DropCollectionRequest{}, &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/DropCollection"}, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"alice"}, outgoing.Get(auditlog.ActorKey))
+	assert.Equal(t, []string{"10.0.0.1:1234"}, outgoing.Get(auditlog.SourceAddrKey))
+
+	outgoing = nil
+	_, err = interceptor(ctx, &milvuspb.SearchRequest{}, &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/Search"}, handler)
+	assert.NoError(t, err)
+	assert.Empty(t, outgoing.Get(auditlog.ActorKey))
+}
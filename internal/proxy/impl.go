@@ -21,6 +21,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"os"
+	"sort"
 	"strconv"
 	"sync"
 	"time"
@@ -6286,6 +6287,414 @@ func (node *Proxy) ListImports(ctx context.Context, req *internalpb.ListImportsR
 	return resp, nil
 }
 
+func (node *Proxy) GetCollectionStorageUsage(ctx context.Context, req *internalpb.GetCollectionStorageUsageRequest) (*internalpb.GetCollectionStorageUsageResponse, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &internalpb.GetCollectionStorageUsageResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	log := log.Ctx(ctx).With(
+		zap.String("dbName", req.GetDbName()),
+		zap.String("collectionName", req.GetCollectionName()),
+	)
+	method := "GetCollectionStorageUsage"
+	tr := timerecord.NewTimeRecorder(method)
+	log.Debug(rpcReceived(method))
+
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.TotalLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetDbName(), req.GetCollectionName())
+	if err != nil {
+		log.Warn("failed to get collection id", zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+		return &internalpb.GetCollectionStorageUsageResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	resp, err := node.dataCoord.GetCollectionStorageUsage(ctx, &internalpb.GetCollectionStorageUsageRequest{
+		Base:           req.GetBase(),
+		DbName:         req.GetDbName(),
+		CollectionName: req.GetCollectionName(),
+		CollectionID:   collectionID,
+	})
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		log.Warn("get collection storage usage failed", zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+		return &internalpb.GetCollectionStorageUsageResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.SuccessLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(nodeID, method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return resp, nil
+}
+
+// ListPrivilegeGroups lists the built-in privilege groups and the privileges each group contains.
+func (node *Proxy) ListPrivilegeGroups(ctx context.Context, req *internalpb.ListPrivilegeGroupsRequest) (*internalpb.ListPrivilegeGroupsResponse, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &internalpb.ListPrivilegeGroupsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	log.Ctx(ctx).Debug(rpcReceived("ListPrivilegeGroups"))
+
+	groups := make([]*internalpb.PrivilegeGroupInfo, 0)
+	for objectType, objectGroups := range util.PrivilegeGroups {
+		for name, privileges := range objectGroups {
+			groups = append(groups, &internalpb.PrivilegeGroupInfo{
+				GroupName:  name,
+				ObjectType: objectType,
+				Privileges: lo.Map(privileges, func(privilege string, _ int) string {
+					return util.MetaStore2API(privilege)
+				}),
+			})
+		}
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].GetGroupName() < groups[j].GetGroupName()
+	})
+	return &internalpb.ListPrivilegeGroupsResponse{
+		Status:          merr.Success(),
+		PrivilegeGroups: groups,
+	}, nil
+}
+
+// AddCollectionField appends a nullable or defaulted field to an existing collection.
+func (node *Proxy) AddCollectionField(ctx context.Context, request *internalpb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-AddCollectionField")
+	defer sp.End()
+	method := "AddCollectionField"
+	tr := timerecord.NewTimeRecorder(method)
+
+	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.TotalLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+
+	act := &addCollectionFieldTask{
+		ctx:                       ctx,
+		Condition:                 NewTaskCondition(ctx),
+		AddCollectionFieldRequest: request,
+		rootCoord:                 node.rootCoord,
+	}
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.GetDbName()),
+		zap.String("collection", request.GetCollectionName()),
+		zap.String("field", request.GetField().GetName()))
+
+	log.Info(rpcReceived(method))
+
+	if err := node.sched.ddQueue.Enqueue(act); err != nil {
+		log.Warn(rpcFailedToEnqueue(method), zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.AbandonLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+		return merr.Status(err), nil
+	}
+
+	if err := act.WaitToFinish(); err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+		return merr.Status(err), nil
+	}
+
+	log.Info(rpcDone(method), zap.Uint64("ts", act.BeginTs()))
+
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.SuccessLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(nodeID, method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return act.result, nil
+}
+
+// DropCollectionField removes a field from an existing collection.
+func (node *Proxy) DropCollectionField(ctx context.Context, request *internalpb.DropCollectionFieldRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-DropCollectionField")
+	defer sp.End()
+	method := "DropCollectionField"
+	tr := timerecord.NewTimeRecorder(method)
+
+	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.TotalLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+
+	act := &dropCollectionFieldTask{
+		ctx:                        ctx,
+		Condition:                  NewTaskCondition(ctx),
+		DropCollectionFieldRequest: request,
+		rootCoord:                  node.rootCoord,
+	}
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.GetDbName()),
+		zap.String("collection", request.GetCollectionName()),
+		zap.String("field", request.GetFieldName()))
+
+	log.Info(rpcReceived(method))
+
+	if err := node.sched.ddQueue.Enqueue(act); err != nil {
+		log.Warn(rpcFailedToEnqueue(method), zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.AbandonLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+		return merr.Status(err), nil
+	}
+
+	if err := act.WaitToFinish(); err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+		return merr.Status(err), nil
+	}
+
+	log.Info(rpcDone(method), zap.Uint64("ts", act.BeginTs()))
+
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.SuccessLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(nodeID, method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return act.result, nil
+}
+
+// RenameCollectionField renames a field of an existing collection.
+func (node *Proxy) RenameCollectionField(ctx context.Context, request *internalpb.RenameCollectionFieldRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-RenameCollectionField")
+	defer sp.End()
+	method := "RenameCollectionField"
+	tr := timerecord.NewTimeRecorder(method)
+
+	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.TotalLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+
+	act := &renameCollectionFieldTask{
+		ctx:                          ctx,
+		Condition:                    NewTaskCondition(ctx),
+		RenameCollectionFieldRequest: request,
+		rootCoord:                    node.rootCoord,
+	}
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.GetDbName()),
+		zap.String("collection", request.GetCollectionName()),
+		zap.String("oldName", request.GetOldName()),
+		zap.String("newName", request.GetNewName()))
+
+	log.Info(rpcReceived(method))
+
+	if err := node.sched.ddQueue.Enqueue(act); err != nil {
+		log.Warn(rpcFailedToEnqueue(method), zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.AbandonLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+		return merr.Status(err), nil
+	}
+
+	if err := act.WaitToFinish(); err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+		return merr.Status(err), nil
+	}
+
+	log.Info(rpcDone(method), zap.Uint64("ts", act.BeginTs()))
+
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.SuccessLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(nodeID, method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return act.result, nil
+}
+
+// AlterAliases creates, alters and drops several aliases of a database atomically.
+func (node *Proxy) AlterAliases(ctx context.Context, request *internalpb.AlterAliasesRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-AlterAliases")
+	defer sp.End()
+	method := "AlterAliases"
+	tr := timerecord.NewTimeRecorder(method)
+
+	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.TotalLabel, request.GetDbName(), "").Inc()
+
+	act := &AlterAliasesTask{
+		ctx:                 ctx,
+		Condition:           NewTaskCondition(ctx),
+		AlterAliasesRequest: request,
+		rootCoord:           node.rootCoord,
+	}
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.GetDbName()),
+		zap.Any("changes", request.GetChanges()))
+
+	log.Info(rpcReceived(method))
+
+	if err := node.sched.ddQueue.Enqueue(act); err != nil {
+		log.Warn(rpcFailedToEnqueue(method), zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.AbandonLabel, request.GetDbName(), "").Inc()
+		return merr.Status(err), nil
+	}
+
+	if err := act.WaitToFinish(); err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, request.GetDbName(), "").Inc()
+		return merr.Status(err), nil
+	}
+
+	log.Info(rpcDone(method), zap.Uint64("ts", act.BeginTs()))
+
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.SuccessLabel, request.GetDbName(), "").Inc()
+	metrics.ProxyReqLatency.WithLabelValues(nodeID, method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return act.result, nil
+}
+
+// DropCollectionAsync submits the drop of a collection and returns the ddl job tracking it
+// without waiting for the background cleanup of rootcoord.
+func (node *Proxy) DropCollectionAsync(ctx context.Context, request *milvuspb.DropCollectionRequest) (*internalpb.SubmitDDLJobResponse, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &internalpb.SubmitDDLJobResponse{Status: merr.Status(err)}, nil
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-DropCollectionAsync")
+	defer sp.End()
+	method := "DropCollectionAsync"
+	tr := timerecord.NewTimeRecorder(method)
+
+	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.TotalLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+
+	dct := &dropCollectionTask{
+		ctx:                   ctx,
+		Condition:             NewTaskCondition(ctx),
+		DropCollectionRequest: request,
+		rootCoord:             node.rootCoord,
+		chMgr:                 node.chMgr,
+		chTicker:              node.chTicker,
+		async:                 true,
+		nodeCtx:               node.ctx,
+	}
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.GetDbName()),
+		zap.String("collection", request.GetCollectionName()))
+
+	log.Info(rpcReceived(method))
+
+	if err := node.sched.ddQueue.Enqueue(dct); err != nil {
+		log.Warn(rpcFailedToEnqueue(method), zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.AbandonLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+		return &internalpb.SubmitDDLJobResponse{Status: merr.Status(err)}, nil
+	}
+
+	if err := dct.WaitToFinish(); err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+		return &internalpb.SubmitDDLJobResponse{Status: merr.Status(err)}, nil
+	}
+
+	log.Info(rpcDone(method), zap.Int64("jobID", dct.jobID))
+
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.SuccessLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(nodeID, method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return &internalpb.SubmitDDLJobResponse{Status: dct.result, JobID: dct.jobID}, nil
+}
+
+// DropDatabaseAsync submits the drop of a database and returns the ddl job tracking it.
+func (node *Proxy) DropDatabaseAsync(ctx context.Context, request *milvuspb.DropDatabaseRequest) (*internalpb.SubmitDDLJobResponse, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &internalpb.SubmitDDLJobResponse{Status: merr.Status(err)}, nil
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-DropDatabaseAsync")
+	defer sp.End()
+	method := "DropDatabaseAsync"
+	tr := timerecord.NewTimeRecorder(method)
+
+	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.TotalLabel, request.GetDbName(), "").Inc()
+
+	dct := &dropDatabaseTask{
+		ctx:                 ctx,
+		Condition:           NewTaskCondition(ctx),
+		DropDatabaseRequest: request,
+		rootCoord:           node.rootCoord,
+		replicateMsgStream:  node.replicateMsgStream,
+		async:               true,
+		nodeCtx:             node.ctx,
+	}
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.GetDbName()))
+
+	log.Info(rpcReceived(method))
+
+	if err := node.sched.ddQueue.Enqueue(dct); err != nil {
+		log.Warn(rpcFailedToEnqueue(method), zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.AbandonLabel, request.GetDbName(), "").Inc()
+		return &internalpb.SubmitDDLJobResponse{Status: merr.Status(err)}, nil
+	}
+
+	if err := dct.WaitToFinish(); err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, request.GetDbName(), "").Inc()
+		return &internalpb.SubmitDDLJobResponse{Status: merr.Status(err)}, nil
+	}
+
+	log.Info(rpcDone(method), zap.Int64("jobID", dct.jobID))
+
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.SuccessLabel, request.GetDbName(), "").Inc()
+	metrics.ProxyReqLatency.WithLabelValues(nodeID, method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return &internalpb.SubmitDDLJobResponse{Status: dct.result, JobID: dct.jobID}, nil
+}
+
+// ListDDLJobs lists the ddl jobs recorded by rootcoord.
+func (node *Proxy) ListDDLJobs(ctx context.Context, request *internalpb.ListDDLJobsRequest) (*internalpb.ListDDLJobsResponse, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &internalpb.ListDDLJobsResponse{Status: merr.Status(err)}, nil
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-ListDDLJobs")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(
+		zap.String("db", request.GetDbName()),
+		zap.String("collection", request.GetCollectionName()))
+	log.Debug(rpcReceived("ListDDLJobs"))
+
+	resp, err := node.rootCoord.ListDDLJobs(ctx, request)
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		log.Warn("failed to list ddl jobs", zap.Error(err))
+		return &internalpb.ListDDLJobsResponse{Status: merr.Status(err)}, nil
+	}
+	return resp, nil
+}
+
+// GetDDLJob returns the state and the step progress of a ddl job.
+func (node *Proxy) GetDDLJob(ctx context.Context, request *internalpb.GetDDLJobRequest) (*internalpb.GetDDLJobResponse, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &internalpb.GetDDLJobResponse{Status: merr.Status(err)}, nil
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-GetDDLJob")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(zap.Int64("jobID", request.GetJobID()))
+	log.Debug(rpcReceived("GetDDLJob"))
+
+	resp, err := node.rootCoord.GetDDLJob(ctx, request)
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		log.Warn("failed to get ddl job", zap.Error(err))
+		return &internalpb.GetDDLJobResponse{Status: merr.Status(err)}, nil
+	}
+	return resp, nil
+}
+
 // DeregisterSubLabel must add the sub-labels here if using other labels for the sub-labels
 func DeregisterSubLabel(subLabel string) {
 	rateCol.DeregisterSubLabel(internalpb.RateType_DQLQuery.String(), subLabel)
@@ -47,6 +47,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/log"
 	mqcommon "github.com/milvus-io/milvus/pkg/mq/common"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
+	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -1742,6 +1743,292 @@ func TestProxy_ImportV2(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, int32(0), rsp.GetStatus().GetCode())
 	})
+
+	t.Run("GetCollectionStorageUsage", func(t *testing.T) {
+		// server is not healthy
+		node := &Proxy{}
+		node.UpdateStateCode(commonpb.StateCode_Abnormal)
+		rsp, err := node.GetCollectionStorageUsage(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotEqual(t, int32(0), rsp.GetStatus().GetCode())
+		node.UpdateStateCode(commonpb.StateCode_Healthy)
+
+		// collection not found
+		mc := NewMockCache(t)
+		mc.EXPECT().GetCollectionID(mock.Anything, mock.Anything, "col").Return(0, merr.WrapErrCollectionNotFound("col")).Once()
+		globalMetaCache = mc
+		rsp, err = node.GetCollectionStorageUsage(ctx, &internalpb.GetCollectionStorageUsageRequest{
+			CollectionName: "col",
+		})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(rsp.GetStatus()), merr.ErrCollectionNotFound)
+
+		// normal case
+		mc.EXPECT().GetCollectionID(mock.Anything, mock.Anything, "col").Return(100, nil)
+		dataCoord := mocks.NewMockDataCoordClient(t)
+		dataCoord.EXPECT().GetCollectionStorageUsage(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, req *internalpb.GetCollectionStorageUsageRequest, opts ...grpc.CallOption) (*internalpb.GetCollectionStorageUsageResponse, error) {
+				return &internalpb.GetCollectionStorageUsageResponse{
+					Status:           merr.Success(),
+					CollectionID:     req.GetCollectionID(),
+					InsertBinlogSize: 1024,
+				}, nil
+			})
+		node.dataCoord = dataCoord
+		rsp, err = node.GetCollectionStorageUsage(ctx, &internalpb.GetCollectionStorageUsageRequest{
+			CollectionName: "col",
+		})
+		assert.NoError(t, merr.CheckRPCCall(rsp, err))
+		assert.EqualValues(t, 100, rsp.GetCollectionID())
+		assert.EqualValues(t, 1024, rsp.GetInsertBinlogSize())
+	})
+
+	t.Run("ListPrivilegeGroups", func(t *testing.T) {
+		node := &Proxy{}
+		node.UpdateStateCode(commonpb.StateCode_Abnormal)
+		rsp, err := node.ListPrivilegeGroups(ctx, &internalpb.ListPrivilegeGroupsRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, int32(0), rsp.GetStatus().GetCode())
+		node.UpdateStateCode(commonpb.StateCode_Healthy)
+
+		rsp, err = node.ListPrivilegeGroups(ctx, &internalpb.ListPrivilegeGroupsRequest{})
+		assert.NoError(t, merr.CheckRPCCall(rsp, err))
+		assert.Len(t, rsp.GetPrivilegeGroups(), 6)
+		for _, group := range rsp.GetPrivilegeGroups() {
+			if group.GetGroupName() == util.PrivilegeGroupCollectionReadOnly {
+				assert.Equal(t, commonpb.ObjectType_Collection.String(), group.GetObjectType())
+				assert.Contains(t, group.GetPrivileges(), "Query")
+				assert.NotContains(t, group.GetPrivileges(), "Insert")
+			}
+		}
+	})
+}
+
+func TestProxy_AddCollectionField(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+
+	t.Run("not healthy", func(t *testing.T) {
+		node := &Proxy{}
+		node.UpdateStateCode(commonpb.StateCode_Abnormal)
+		resp, err := node.AddCollectionField(ctx, &internalpb.AddCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrServiceNotReady)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		factory := dependency.NewDefaultFactory(true)
+		node, err := NewProxy(ctx, factory)
+		assert.NoError(t, err)
+		node.UpdateStateCode(commonpb.StateCode_Healthy)
+		node.sched, err = newTaskScheduler(node.ctx, newMockTsoAllocator(), node.factory)
+		assert.NoError(t, err)
+		node.sched.Start()
+		defer node.sched.Close()
+
+		mc := NewMockCache(t)
+		mc.EXPECT().GetCollectionID(mock.Anything, mock.Anything, "col").Return(100, nil)
+		mc.EXPECT().GetCollectionSchema(mock.Anything, mock.Anything, "col").Return(newSchemaInfo(&schemapb.CollectionSchema{}), nil)
+		globalMetaCache = mc
+		defer func() { globalMetaCache = nil }()
+
+		rc := mocks.NewMockRootCoordClient(t)
+		rc.EXPECT().AddCollectionField(mock.Anything, mock.Anything).Return(merr.Success(), nil)
+		node.rootCoord = rc
+
+		resp, err := node.AddCollectionField(ctx, &internalpb.AddCollectionFieldRequest{
+			CollectionName: "col",
+			Field:          &schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Int64, Nullable: true},
+		})
+		assert.NoError(t, merr.CheckRPCCall(resp, err))
+	})
+}
+
+func TestProxy_DropAndRenameCollectionField(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+
+	t.Run("not healthy", func(t *testing.T) {
+		node := &Proxy{}
+		node.UpdateStateCode(commonpb.StateCode_Abnormal)
+		resp, err := node.DropCollectionField(ctx, &internalpb.DropCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrServiceNotReady)
+		resp, err = node.RenameCollectionField(ctx, &internalpb.RenameCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrServiceNotReady)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		factory := dependency.NewDefaultFactory(true)
+		node, err := NewProxy(ctx, factory)
+		assert.NoError(t, err)
+		node.UpdateStateCode(commonpb.StateCode_Healthy)
+		node.sched, err = newTaskScheduler(node.ctx, newMockTsoAllocator(), node.factory)
+		assert.NoError(t, err)
+		node.sched.Start()
+		defer node.sched.Close()
+
+		mc := NewMockCache(t)
+		mc.EXPECT().GetCollectionID(mock.Anything, mock.Anything, "col").Return(100, nil)
+		globalMetaCache = mc
+		defer func() { globalMetaCache = nil }()
+
+		rc := mocks.NewMockRootCoordClient(t)
+		rc.EXPECT().DropCollectionField(mock.Anything, mock.Anything).Return(merr.Success(), nil)
+		rc.EXPECT().RenameCollectionField(mock.Anything, mock.Anything).Return(merr.Success(), nil)
+		node.rootCoord = rc
+
+		resp, err := node.DropCollectionField(ctx, &internalpb.DropCollectionFieldRequest{
+			CollectionName: "col",
+			FieldName:      "f",
+		})
+		assert.NoError(t, merr.CheckRPCCall(resp, err))
+
+		resp, err = node.RenameCollectionField(ctx, &internalpb.RenameCollectionFieldRequest{
+			CollectionName: "col",
+			OldName:        "f",
+			NewName:        "g",
+		})
+		assert.NoError(t, merr.CheckRPCCall(resp, err))
+	})
+}
+
+func TestProxy_AlterAliases(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+
+	t.Run("not healthy", func(t *testing.T) {
+		node := &Proxy{}
+		node.UpdateStateCode(commonpb.StateCode_Abnormal)
+		resp, err := node.AlterAliases(ctx, &internalpb.AlterAliasesRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrServiceNotReady)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		factory := dependency.NewDefaultFactory(true)
+		node, err := NewProxy(ctx, factory)
+		assert.NoError(t, err)
+		node.UpdateStateCode(commonpb.StateCode_Healthy)
+		node.sched, err = newTaskScheduler(node.ctx, newMockTsoAllocator(), node.factory)
+		assert.NoError(t, err)
+		node.sched.Start()
+		defer node.sched.Close()
+
+		rc := mocks.NewMockRootCoordClient(t)
+		rc.EXPECT().AlterAliases(mock.Anything, mock.Anything).Return(merr.Success(), nil)
+		node.rootCoord = rc
+
+		resp, err := node.AlterAliases(ctx, &internalpb.AlterAliasesRequest{
+			Changes: []*internalpb.AliasChange{
+				{Alias: "a1", CollectionName: "col2"},
+				{Alias: "a2"},
+			},
+		})
+		assert.NoError(t, merr.CheckRPCCall(resp, err))
+
+		resp, err = node.AlterAliases(ctx, &internalpb.AlterAliasesRequest{})
+		assert.Error(t, merr.CheckRPCCall(resp, err))
+	})
+}
+
+func TestProxy_DDLJobs(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+
+	t.Run("not healthy", func(t *testing.T) {
+		node := &Proxy{}
+		node.UpdateStateCode(commonpb.StateCode_Abnormal)
+		submitResp, err := node.DropCollectionAsync(ctx, &milvuspb.DropCollectionRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(submitResp.GetStatus()), merr.ErrServiceNotReady)
+
+		submitResp, err = node.DropDatabaseAsync(ctx, &milvuspb.DropDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(submitResp.GetStatus()), merr.ErrServiceNotReady)
+
+		listResp, err := node.ListDDLJobs(ctx, &internalpb.ListDDLJobsRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(listResp.GetStatus()), merr.ErrServiceNotReady)
+
+		getResp, err := node.GetDDLJob(ctx, &internalpb.GetDDLJobRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(getResp.GetStatus()), merr.ErrServiceNotReady)
+	})
+
+	t.Run("async drop", func(t *testing.T) {
+		factory := dependency.NewDefaultFactory(true)
+		node, err := NewProxy(ctx, factory)
+		assert.NoError(t, err)
+		node.UpdateStateCode(commonpb.StateCode_Healthy)
+		node.sched, err = newTaskScheduler(node.ctx, newMockTsoAllocator(), node.factory)
+		assert.NoError(t, err)
+		node.sched.Start()
+		defer node.sched.Close()
+
+		cache := NewMockCache(t)
+		cache.EXPECT().RemoveDatabase(mock.Anything, mock.Anything).Maybe()
+		globalMetaCache = cache
+
+		rc := mocks.NewMockRootCoordClient(t)
+		rc.EXPECT().DropCollectionAsync(mock.Anything, mock.Anything).Return(&internalpb.SubmitDDLJobResponse{
+			Status: merr.Success(),
+			JobID:  100,
+		}, nil)
+		rc.EXPECT().DropDatabaseAsync(mock.Anything, mock.Anything).Return(&internalpb.SubmitDDLJobResponse{
+			Status: merr.Success(),
+			JobID:  101,
+		}, nil)
+		rc.EXPECT().GetDDLJob(mock.Anything, mock.Anything).Return(&internalpb.GetDDLJobResponse{
+			Status: merr.Success(),
+			Job:    &internalpb.DDLJob{JobID: 101, State: internalpb.DDLJobState_DDLJobCompleted},
+		}, nil).Maybe()
+		node.rootCoord = rc
+
+		resp, err := node.DropCollectionAsync(ctx, &milvuspb.DropCollectionRequest{CollectionName: "coll"})
+		assert.NoError(t, merr.CheckRPCCall(resp, err))
+		assert.Equal(t, int64(100), resp.GetJobID())
+
+		resp, err = node.DropDatabaseAsync(ctx, &milvuspb.DropDatabaseRequest{DbName: "db"})
+		assert.NoError(t, merr.CheckRPCCall(resp, err))
+		assert.Equal(t, int64(101), resp.GetJobID())
+
+		// invalid names are rejected by proxy.
+		resp, err = node.DropCollectionAsync(ctx, &milvuspb.DropCollectionRequest{CollectionName: "#coll"})
+		assert.Error(t, merr.CheckRPCCall(resp, err))
+	})
+
+	t.Run("list and get", func(t *testing.T) {
+		node := &Proxy{}
+		node.UpdateStateCode(commonpb.StateCode_Healthy)
+		rc := mocks.NewMockRootCoordClient(t)
+		rc.EXPECT().ListDDLJobs(mock.Anything, mock.Anything).Return(&internalpb.ListDDLJobsResponse{
+			Status: merr.Success(),
+			Jobs:   []*internalpb.DDLJob{{JobID: 100}},
+		}, nil).Once()
+		rc.EXPECT().ListDDLJobs(mock.Anything, mock.Anything).Return(nil, errors.New("error mock ListDDLJobs")).Once()
+		rc.EXPECT().GetDDLJob(mock.Anything, mock.Anything).Return(&internalpb.GetDDLJobResponse{
+			Status: merr.Success(),
+			Job:    &internalpb.DDLJob{JobID: 100},
+		}, nil).Once()
+		rc.EXPECT().GetDDLJob(mock.Anything, mock.Anything).Return(&internalpb.GetDDLJobResponse{
+			Status: merr.Status(merr.WrapErrParameterInvalidMsg("ddl job 101 not found")),
+		}, nil).Once()
+		node.rootCoord = rc
+
+		listResp, err := node.ListDDLJobs(ctx, &internalpb.ListDDLJobsRequest{})
+		assert.NoError(t, merr.CheckRPCCall(listResp, err))
+		assert.Len(t, listResp.GetJobs(), 1)
+		listResp, err = node.ListDDLJobs(ctx, &internalpb.ListDDLJobsRequest{})
+		assert.Error(t, merr.CheckRPCCall(listResp, err))
+
+		getResp, err := node.GetDDLJob(ctx, &internalpb.GetDDLJobRequest{JobID: 100})
+		assert.NoError(t, merr.CheckRPCCall(getResp, err))
+		assert.Equal(t, int64(100), getResp.GetJob().GetJobID())
+		getResp, err = node.GetDDLJob(ctx, &internalpb.GetDDLJobRequest{JobID: 101})
+		assert.ErrorIs(t, merr.CheckRPCCall(getResp, err), merr.ErrParameterInvalid)
+	})
 }
 
 func TestGetCollectionRateSubLabel(t *testing.T) {
@@ -44,6 +44,30 @@ func RegisterMgrRoute(proxy *Proxy) {
 			Path:        management.RouteGcResume,
 			HandlerFunc: proxy.ResumeDatacoordGC,
 		})
+		management.Register(&management.Handler{
+			Path:        management.RouteGcRun,
+			HandlerFunc: proxy.RunDatacoordGC,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteCreateSnapshot,
+			HandlerFunc: proxy.CreateSnapshot,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteListSnapshots,
+			HandlerFunc: proxy.ListSnapshots,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteDropSnapshot,
+			HandlerFunc: proxy.DropSnapshot,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteRestoreSnapshot,
+			HandlerFunc: proxy.RestoreSnapshot,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteGetPartitionKeyStats,
+			HandlerFunc: proxy.GetPartitionKeyStats,
+		})
 		management.Register(&management.Handler{
 			Path:        management.RouteListQueryNode,
 			HandlerFunc: proxy.ListQueryNode,
@@ -60,6 +84,10 @@ func RegisterMgrRoute(proxy *Proxy) {
 			Path:        management.RouteResumeQueryCoordBalance,
 			HandlerFunc: proxy.ResumeQueryCoordBalance,
 		})
+		management.Register(&management.Handler{
+			Path:        management.RoutePreviewQueryCoordBalance,
+			HandlerFunc: proxy.PreviewQueryCoordBalance,
+		})
 		management.Register(&management.Handler{
 			Path:        management.RouteSuspendQueryNode,
 			HandlerFunc: proxy.SuspendQueryNode,
@@ -68,6 +96,18 @@ func RegisterMgrRoute(proxy *Proxy) {
 			Path:        management.RouteResumeQueryNode,
 			HandlerFunc: proxy.ResumeQueryNode,
 		})
+		management.Register(&management.Handler{
+			Path:        management.RouteDrainQueryNode,
+			HandlerFunc: proxy.DrainQueryNode,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteCancelDrainQueryNode,
+			HandlerFunc: proxy.CancelDrainQueryNode,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteGetDrainQueryNodeProgress,
+			HandlerFunc: proxy.GetDrainQueryNodeProgress,
+		})
 		management.Register(&management.Handler{
 			Path:        management.RouteTransferSegment,
 			HandlerFunc: proxy.TransferSegment,
@@ -80,6 +120,10 @@ func RegisterMgrRoute(proxy *Proxy) {
 			Path:        management.RouteCheckQueryNodeDistribution,
 			HandlerFunc: proxy.CheckQueryNodeDistribution,
 		})
+		management.Register(&management.Handler{
+			Path:        management.RouteGetResourceGroupRecommendations,
+			HandlerFunc: proxy.GetResourceGroupRecommendations,
+		})
 	})
 }
 
@@ -126,6 +170,310 @@ func (node *Proxy) ResumeDatacoordGC(w http.ResponseWriter, req *http.Request) {
 	w.Write([]byte(`{"msg": "OK"}`))
 }
 
+func (node *Proxy) RunDatacoordGC(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to run garbage collection, %s"}`, err.Error())))
+		return
+	}
+	// the pass covers all collections if collection_id is not specified.
+	var collectionID int64
+	if req.FormValue("collection_id") != "" {
+		collectionID, err = strconv.ParseInt(req.FormValue("collection_id"), 10, 64)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(fmt.Sprintf(`{"msg": "failed to run garbage collection, %s"}`, err.Error())))
+			return
+		}
+	}
+	var dryRun bool
+	if req.FormValue("dry_run") != "" {
+		dryRun, err = strconv.ParseBool(req.FormValue("dry_run"))
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(fmt.Sprintf(`{"msg": "failed to run garbage collection, %s"}`, err.Error())))
+			return
+		}
+	}
+
+	resp, err := node.dataCoord.RunGarbageCollection(req.Context(), &datapb.RunGarbageCollectionRequest{
+		Base:         commonpbutil.NewMsgBase(),
+		CollectionID: collectionID,
+		DryRun:       dryRun,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to run garbage collection, %s"}`, err.Error())))
+		return
+	}
+	if !merr.Ok(resp.GetStatus()) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to run garbage collection, %s"}`, resp.GetStatus().GetReason())))
+		return
+	}
+	// skip marshal status to output
+	resp.Status = nil
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to run garbage collection, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bytes)
+}
+
+func (node *Proxy) CreateSnapshot(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to create snapshot, %s"}`, err.Error())))
+		return
+	}
+	collectionID, err := strconv.ParseInt(req.FormValue("collection_id"), 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to create snapshot, %s"}`, err.Error())))
+		return
+	}
+
+	resp, err := node.dataCoord.CreateSnapshot(req.Context(), &datapb.CreateSnapshotRequest{
+		Base:         commonpbutil.NewMsgBase(),
+		CollectionID: collectionID,
+		Name:         req.FormValue("name"),
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to create snapshot, %s"}`, err.Error())))
+		return
+	}
+	if !merr.Ok(resp.GetStatus()) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to create snapshot, %s"}`, resp.GetStatus().GetReason())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(fmt.Sprintf(`{"msg": "OK", "snapshot_id": %d}`, resp.GetSnapshot().GetSnapshotID())))
+}
+
+func (node *Proxy) ListSnapshots(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list snapshots, %s"}`, err.Error())))
+		return
+	}
+	// all snapshots will be returned if collection_id is not specified.
+	var collectionID int64
+	if req.FormValue("collection_id") != "" {
+		collectionID, err = strconv.ParseInt(req.FormValue("collection_id"), 10, 64)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list snapshots, %s"}`, err.Error())))
+			return
+		}
+	}
+
+	resp, err := node.dataCoord.ListSnapshots(req.Context(), &datapb.ListSnapshotsRequest{
+		Base:         commonpbutil.NewMsgBase(),
+		CollectionID: collectionID,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list snapshots, %s"}`, err.Error())))
+		return
+	}
+	if !merr.Ok(resp.GetStatus()) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list snapshots, %s"}`, resp.GetStatus().GetReason())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	// skip marshal status to output
+	resp.Status = nil
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list snapshots, %s"}`, err.Error())))
+		return
+	}
+	w.Write(bytes)
+}
+
+func (node *Proxy) DropSnapshot(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to drop snapshot, %s"}`, err.Error())))
+		return
+	}
+	snapshotID, err := strconv.ParseInt(req.FormValue("snapshot_id"), 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to drop snapshot, %s"}`, err.Error())))
+		return
+	}
+
+	resp, err := node.dataCoord.DropSnapshot(req.Context(), &datapb.DropSnapshotRequest{
+		Base:       commonpbutil.NewMsgBase(),
+		SnapshotID: snapshotID,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to drop snapshot, %s"}`, err.Error())))
+		return
+	}
+	if !merr.Ok(resp) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to drop snapshot, %s"}`, resp.GetReason())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
+
+func (node *Proxy) RestoreSnapshot(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to restore snapshot, %s"}`, err.Error())))
+		return
+	}
+	snapshotID, err := strconv.ParseInt(req.FormValue("snapshot_id"), 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to restore snapshot, %s"}`, err.Error())))
+		return
+	}
+
+	resp, err := node.dataCoord.RestoreSnapshot(req.Context(), &datapb.RestoreSnapshotRequest{
+		Base:           commonpbutil.NewMsgBase(),
+		SnapshotID:     snapshotID,
+		DbName:         req.FormValue("db_name"),
+		CollectionName: req.FormValue("collection_name"),
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to restore snapshot, %s"}`, err.Error())))
+		return
+	}
+	if !merr.Ok(resp.GetStatus()) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to restore snapshot, %s"}`, resp.GetStatus().GetReason())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	// skip marshal status to output
+	resp.Status = nil
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to restore snapshot, %s"}`, err.Error())))
+		return
+	}
+	w.Write(bytes)
+}
+
+type partitionKeyPartitionStats struct {
+	PartitionName string `json:"partition_name"`
+	*datapb.PartitionKeyPartitionStats
+}
+
+type partitionKeyStatsResponse struct {
+	Partitions          []*partitionKeyPartitionStats `json:"partitions"`
+	RepartitionSegments int64                         `json:"repartition_segments"`
+	RepartitionRows     int64                         `json:"repartition_rows"`
+}
+
+func (node *Proxy) GetPartitionKeyStats(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get partition key stats, %s"}`, err.Error())))
+		return
+	}
+	dbName := req.FormValue("db_name")
+	collectionName := req.FormValue("collection_name")
+	if collectionName == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"msg": "failed to get partition key stats, collection_name is required"}`))
+		return
+	}
+	withKeyStats := false
+	if req.FormValue("with_key_stats") != "" {
+		withKeyStats, err = strconv.ParseBool(req.FormValue("with_key_stats"))
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get partition key stats, %s"}`, err.Error())))
+			return
+		}
+	}
+	var topK int64
+	if req.FormValue("top_k") != "" {
+		topK, err = strconv.ParseInt(req.FormValue("top_k"), 10, 64)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get partition key stats, %s"}`, err.Error())))
+			return
+		}
+	}
+
+	collectionID, err := globalMetaCache.GetCollectionID(req.Context(), dbName, collectionName)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get partition key stats, %s"}`, err.Error())))
+		return
+	}
+	partitions, err := globalMetaCache.GetPartitions(req.Context(), dbName, collectionName)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get partition key stats, %s"}`, err.Error())))
+		return
+	}
+
+	resp, err := node.dataCoord.GetPartitionKeyStats(req.Context(), &datapb.GetPartitionKeyStatsRequest{
+		Base:         commonpbutil.NewMsgBase(),
+		CollectionID: collectionID,
+		WithKeyStats: withKeyStats,
+		TopK:         topK,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get partition key stats, %s"}`, err.Error())))
+		return
+	}
+	if !merr.Ok(resp.GetStatus()) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get partition key stats, %s"}`, resp.GetStatus().GetReason())))
+		return
+	}
+
+	partitionNames := make(map[int64]string, len(partitions))
+	for name, id := range partitions {
+		partitionNames[id] = name
+	}
+	stats := &partitionKeyStatsResponse{
+		Partitions:          make([]*partitionKeyPartitionStats, 0, len(resp.GetPartitions())),
+		RepartitionSegments: resp.GetRepartitionSegments(),
+		RepartitionRows:     resp.GetRepartitionRows(),
+	}
+	for _, partition := range resp.GetPartitions() {
+		stats.Partitions = append(stats.Partitions, &partitionKeyPartitionStats{
+			PartitionName:              partitionNames[partition.GetPartitionID()],
+			PartitionKeyPartitionStats: partition,
+		})
+	}
+	bytes, err := json.Marshal(stats)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get partition key stats, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bytes)
+}
+
 func (node *Proxy) ListQueryNode(w http.ResponseWriter, req *http.Request) {
 	resp, err := node.queryCoord.ListQueryNode(req.Context(), &querypb.ListQueryNodeRequest{
 		Base: commonpbutil.NewMsgBase(),
@@ -234,6 +582,53 @@ func (node *Proxy) ResumeQueryCoordBalance(w http.ResponseWriter, req *http.Requ
 	w.Write([]byte(`{"msg": "OK"}`))
 }
 
+func (node *Proxy) PreviewQueryCoordBalance(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to preview balance, %s"}`, err.Error())))
+		return
+	}
+
+	// all loaded collections will be previewed if collection_id is not specified.
+	collectionIDs := make([]int64, 0, len(req.Form["collection_id"]))
+	for _, value := range req.Form["collection_id"] {
+		collectionID, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(fmt.Sprintf(`{"msg": "failed to preview balance, %s"}`, err.Error())))
+			return
+		}
+		collectionIDs = append(collectionIDs, collectionID)
+	}
+
+	resp, err := node.queryCoord.PreviewBalance(req.Context(), &querypb.PreviewBalanceRequest{
+		Base:          commonpbutil.NewMsgBase(),
+		CollectionIDs: collectionIDs,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to preview balance, %s"}`, err.Error())))
+		return
+	}
+
+	if !merr.Ok(resp.GetStatus()) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to preview balance, %s"}`, resp.GetStatus().GetReason())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	// skip marshal status to output
+	resp.Status = nil
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to preview balance, %s"}`, err.Error())))
+		return
+	}
+	w.Write(bytes)
+}
+
 func (node *Proxy) SuspendQueryNode(w http.ResponseWriter, req *http.Request) {
 	err := req.ParseForm()
 	if err != nil {
@@ -300,6 +695,113 @@ func (node *Proxy) ResumeQueryNode(w http.ResponseWriter, req *http.Request) {
 	w.Write([]byte(`{"msg": "OK"}`))
 }
 
+func (node *Proxy) DrainQueryNode(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to drain node, %s"}`, err.Error())))
+		return
+	}
+
+	nodeID, err := strconv.ParseInt(req.FormValue("node_id"), 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to drain node, %s"}`, err.Error())))
+		return
+	}
+	resp, err := node.queryCoord.DrainNode(req.Context(), &querypb.DrainNodeRequest{
+		Base:   commonpbutil.NewMsgBase(),
+		NodeID: nodeID,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to drain node, %s"}`, err.Error())))
+		return
+	}
+
+	if !merr.Ok(resp) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to drain node, %s"}`, resp.GetReason())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
+
+func (node *Proxy) CancelDrainQueryNode(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to cancel drain node, %s"}`, err.Error())))
+		return
+	}
+
+	nodeID, err := strconv.ParseInt(req.FormValue("node_id"), 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to cancel drain node, %s"}`, err.Error())))
+		return
+	}
+	resp, err := node.queryCoord.CancelDrainNode(req.Context(), &querypb.CancelDrainNodeRequest{
+		Base:   commonpbutil.NewMsgBase(),
+		NodeID: nodeID,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to cancel drain node, %s"}`, err.Error())))
+		return
+	}
+
+	if !merr.Ok(resp) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to cancel drain node, %s"}`, resp.GetReason())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
+
+func (node *Proxy) GetDrainQueryNodeProgress(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get drain progress, %s"}`, err.Error())))
+		return
+	}
+
+	nodeID, err := strconv.ParseInt(req.FormValue("node_id"), 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get drain progress, %s"}`, err.Error())))
+		return
+	}
+	resp, err := node.queryCoord.GetDrainNodeProgress(req.Context(), &querypb.GetDrainNodeProgressRequest{
+		Base:   commonpbutil.NewMsgBase(),
+		NodeID: nodeID,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get drain progress, %s"}`, err.Error())))
+		return
+	}
+
+	if !merr.Ok(resp.GetStatus()) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get drain progress, %s"}`, resp.GetStatus().GetReason())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	// skip marshal status to output
+	resp.Status = nil
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get drain progress, %s"}`, err.Error())))
+		return
+	}
+	w.Write(bytes)
+}
+
 func (node *Proxy) TransferSegment(w http.ResponseWriter, req *http.Request) {
 	err := req.ParseForm()
 	if err != nil {
@@ -484,3 +986,39 @@ func (node *Proxy) CheckQueryNodeDistribution(w http.ResponseWriter, req *http.R
 	w.WriteHeader(http.StatusOK)
 	w.Write([]byte(`{"msg": "OK"}`))
 }
+
+func (node *Proxy) GetResourceGroupRecommendations(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get resource group recommendations, %s"}`, err.Error())))
+		return
+	}
+
+	// all resource groups will be returned if resource_group is not specified.
+	resp, err := node.queryCoord.GetResourceGroupRecommendations(req.Context(), &querypb.GetResourceGroupRecommendationsRequest{
+		Base:           commonpbutil.NewMsgBase(),
+		ResourceGroups: req.Form["resource_group"],
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get resource group recommendations, %s"}`, err.Error())))
+		return
+	}
+
+	if !merr.Ok(resp.GetStatus()) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get resource group recommendations, %s"}`, resp.GetStatus().GetReason())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	// skip marshal status to output
+	resp.Status = nil
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get resource group recommendations, %s"}`, err.Error())))
+		return
+	}
+	w.Write(bytes)
+}
@@ -166,6 +166,75 @@ func (s *ProxyManagementSuite) TestResumeDatacoordGC() {
 	})
 }
 
+func (s *ProxyManagementSuite) TestRunDatacoordGC() {
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+		s.datacoord.EXPECT().RunGarbageCollection(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *datapb.RunGarbageCollectionRequest, options ...grpc.CallOption) (*datapb.RunGarbageCollectionResponse, error) {
+			s.EqualValues(100, req.GetCollectionID())
+			s.True(req.GetDryRun())
+			return &datapb.RunGarbageCollectionResponse{
+				Status: merr.Success(),
+				Candidates: []*datapb.GarbageCandidate{
+					{Type: "segment", CollectionID: 100, SegmentID: 1, Reason: "mocked"},
+				},
+			}, nil
+		})
+
+		req, err := http.NewRequest(http.MethodGet, management.RouteGcRun+"?collection_id=100&dry_run=true", nil)
+		s.Require().NoError(err)
+
+		recorder := httptest.NewRecorder()
+		s.proxy.RunDatacoordGC(recorder, req)
+
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Contains(recorder.Body.String(), "mocked")
+	})
+
+	s.Run("invalid_param", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		req, err := http.NewRequest(http.MethodGet, management.RouteGcRun+"?dry_run=abc", nil)
+		s.Require().NoError(err)
+
+		recorder := httptest.NewRecorder()
+		s.proxy.RunDatacoordGC(recorder, req)
+
+		s.Equal(http.StatusBadRequest, recorder.Code)
+	})
+
+	s.Run("return_error", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+		s.datacoord.EXPECT().RunGarbageCollection(mock.Anything, mock.Anything).Return(nil, errors.New("mock"))
+
+		req, err := http.NewRequest(http.MethodGet, management.RouteGcRun, nil)
+		s.Require().NoError(err)
+
+		recorder := httptest.NewRecorder()
+		s.proxy.RunDatacoordGC(recorder, req)
+
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+
+	s.Run("return_failure", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+		s.datacoord.EXPECT().RunGarbageCollection(mock.Anything, mock.Anything).Return(&datapb.RunGarbageCollectionResponse{
+			Status: merr.Status(merr.WrapErrServiceUnavailable("garbage collection not enabled")),
+		}, nil)
+
+		req, err := http.NewRequest(http.MethodGet, management.RouteGcRun, nil)
+		s.Require().NoError(err)
+
+		recorder := httptest.NewRecorder()
+		s.proxy.RunDatacoordGC(recorder, req)
+
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
 func (s *ProxyManagementSuite) TestListQueryNode() {
 	s.Run("normal", func() {
 		s.SetupTest()
@@ -492,6 +561,172 @@ func (s *ProxyManagementSuite) TestResumeQueryNode() {
 	})
 }
 
+func (s *ProxyManagementSuite) TestDrainQueryNode() {
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.querycoord.EXPECT().DrainNode(mock.Anything, mock.Anything).Return(merr.Success(), nil)
+
+		req, err := http.NewRequest(http.MethodPost, management.RouteDrainQueryNode, strings.NewReader("node_id=1"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		recorder := httptest.NewRecorder()
+		s.proxy.DrainQueryNode(recorder, req)
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Equal(`{"msg": "OK"}`, recorder.Body.String())
+	})
+
+	s.Run("return_error", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		// test miss requested param
+		req, err := http.NewRequest(http.MethodPost, management.RouteDrainQueryNode, strings.NewReader(""))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder := httptest.NewRecorder()
+		s.proxy.DrainQueryNode(recorder, req)
+		s.Equal(http.StatusBadRequest, recorder.Code)
+
+		// test rpc return error
+		s.querycoord.EXPECT().DrainNode(mock.Anything, mock.Anything).Return(nil, errors.New("mocked error"))
+		req, err = http.NewRequest(http.MethodPost, management.RouteDrainQueryNode, strings.NewReader("node_id=1"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder = httptest.NewRecorder()
+		s.proxy.DrainQueryNode(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+
+	s.Run("return_failure", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.querycoord.EXPECT().DrainNode(mock.Anything, mock.Anything).Return(merr.Status(merr.ErrServiceNotReady), nil)
+		req, err := http.NewRequest(http.MethodPost, management.RouteDrainQueryNode, strings.NewReader("node_id=1"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder := httptest.NewRecorder()
+		s.proxy.DrainQueryNode(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func (s *ProxyManagementSuite) TestCancelDrainQueryNode() {
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.querycoord.EXPECT().CancelDrainNode(mock.Anything, mock.Anything).Return(merr.Success(), nil)
+
+		req, err := http.NewRequest(http.MethodPost, management.RouteCancelDrainQueryNode, strings.NewReader("node_id=1"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		recorder := httptest.NewRecorder()
+		s.proxy.CancelDrainQueryNode(recorder, req)
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Equal(`{"msg": "OK"}`, recorder.Body.String())
+	})
+
+	s.Run("return_error", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		// test miss requested param
+		req, err := http.NewRequest(http.MethodPost, management.RouteCancelDrainQueryNode, strings.NewReader(""))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder := httptest.NewRecorder()
+		s.proxy.CancelDrainQueryNode(recorder, req)
+		s.Equal(http.StatusBadRequest, recorder.Code)
+
+		// test rpc return error
+		s.querycoord.EXPECT().CancelDrainNode(mock.Anything, mock.Anything).Return(nil, errors.New("mocked error"))
+		req, err = http.NewRequest(http.MethodPost, management.RouteCancelDrainQueryNode, strings.NewReader("node_id=1"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder = httptest.NewRecorder()
+		s.proxy.CancelDrainQueryNode(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+
+	s.Run("return_failure", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.querycoord.EXPECT().CancelDrainNode(mock.Anything, mock.Anything).Return(merr.Status(merr.ErrServiceNotReady), nil)
+		req, err := http.NewRequest(http.MethodPost, management.RouteCancelDrainQueryNode, strings.NewReader("node_id=1"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder := httptest.NewRecorder()
+		s.proxy.CancelDrainQueryNode(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func (s *ProxyManagementSuite) TestGetDrainQueryNodeProgress() {
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.querycoord.EXPECT().GetDrainNodeProgress(mock.Anything, mock.Anything).Return(&querypb.GetDrainNodeProgressResponse{
+			Status:       merr.Success(),
+			NodeID:       1,
+			State:        querypb.DrainState_DrainCompleted,
+			SafeToRemove: true,
+		}, nil)
+
+		req, err := http.NewRequest(http.MethodPost, management.RouteGetDrainQueryNodeProgress, strings.NewReader("node_id=1"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		recorder := httptest.NewRecorder()
+		s.proxy.GetDrainQueryNodeProgress(recorder, req)
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Contains(recorder.Body.String(), `"safe_to_remove":true`)
+	})
+
+	s.Run("return_error", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		// test miss requested param
+		req, err := http.NewRequest(http.MethodPost, management.RouteGetDrainQueryNodeProgress, strings.NewReader(""))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder := httptest.NewRecorder()
+		s.proxy.GetDrainQueryNodeProgress(recorder, req)
+		s.Equal(http.StatusBadRequest, recorder.Code)
+
+		// test rpc return error
+		s.querycoord.EXPECT().GetDrainNodeProgress(mock.Anything, mock.Anything).Return(nil, errors.New("mocked error"))
+		req, err = http.NewRequest(http.MethodPost, management.RouteGetDrainQueryNodeProgress, strings.NewReader("node_id=1"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder = httptest.NewRecorder()
+		s.proxy.GetDrainQueryNodeProgress(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+
+	s.Run("return_failure", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.querycoord.EXPECT().GetDrainNodeProgress(mock.Anything, mock.Anything).Return(&querypb.GetDrainNodeProgressResponse{
+			Status: merr.Status(merr.ErrServiceNotReady),
+		}, nil)
+		req, err := http.NewRequest(http.MethodPost, management.RouteGetDrainQueryNodeProgress, strings.NewReader("node_id=1"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder := httptest.NewRecorder()
+		s.proxy.GetDrainQueryNodeProgress(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
 func (s *ProxyManagementSuite) TestTransferSegment() {
 	s.Run("normal", func() {
 		s.SetupTest()
@@ -687,6 +922,262 @@ func (s *ProxyManagementSuite) TestCheckQueryNodeDistribution() {
 	})
 }
 
+func (s *ProxyManagementSuite) TestPreviewQueryCoordBalance() {
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.querycoord.EXPECT().PreviewBalance(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, req *querypb.PreviewBalanceRequest, opts ...grpc.CallOption) (*querypb.PreviewBalanceResponse, error) {
+				s.ElementsMatch([]int64{1, 2}, req.GetCollectionIDs())
+				return &querypb.PreviewBalanceResponse{
+					Status: merr.Success(),
+					SegmentPlans: []*querypb.SegmentBalancePlan{
+						{SegmentID: 1, CollectionID: 1, SourceNodeID: 1, TargetNodeID: 2},
+					},
+				}, nil
+			})
+
+		req, err := http.NewRequest(http.MethodGet, management.RoutePreviewQueryCoordBalance+"?collection_id=1&collection_id=2", nil)
+		s.Require().NoError(err)
+		recorder := httptest.NewRecorder()
+		s.proxy.PreviewQueryCoordBalance(recorder, req)
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Contains(recorder.Body.String(), `"target_nodeID":2`)
+	})
+
+	s.Run("return_error", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		// test invalid collection id
+		req, err := http.NewRequest(http.MethodGet, management.RoutePreviewQueryCoordBalance+"?collection_id=a", nil)
+		s.Require().NoError(err)
+		recorder := httptest.NewRecorder()
+		s.proxy.PreviewQueryCoordBalance(recorder, req)
+		s.Equal(http.StatusBadRequest, recorder.Code)
+
+		// test rpc return error
+		s.querycoord.EXPECT().PreviewBalance(mock.Anything, mock.Anything).Return(nil, errors.New("mocked error"))
+		req, err = http.NewRequest(http.MethodGet, management.RoutePreviewQueryCoordBalance, nil)
+		s.Require().NoError(err)
+		recorder = httptest.NewRecorder()
+		s.proxy.PreviewQueryCoordBalance(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+
+	s.Run("return_failure", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.querycoord.EXPECT().PreviewBalance(mock.Anything, mock.Anything).Return(&querypb.PreviewBalanceResponse{
+			Status: merr.Status(merr.ErrServiceNotReady),
+		}, nil)
+		req, err := http.NewRequest(http.MethodGet, management.RoutePreviewQueryCoordBalance, nil)
+		s.Require().NoError(err)
+		recorder := httptest.NewRecorder()
+		s.proxy.PreviewQueryCoordBalance(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func (s *ProxyManagementSuite) TestGetResourceGroupRecommendations() {
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.querycoord.EXPECT().GetResourceGroupRecommendations(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, req *querypb.GetResourceGroupRecommendationsRequest, opts ...grpc.CallOption) (*querypb.GetResourceGroupRecommendationsResponse, error) {
+				s.ElementsMatch([]string{"rg1", "rg2"}, req.GetResourceGroups())
+				return &querypb.GetResourceGroupRecommendationsResponse{
+					Status: merr.Success(),
+					Recommendations: []*querypb.ResourceGroupRecommendation{
+						{ResourceGroup: "rg1", CurrentNodeNum: 1, DesiredNodeNum: 2},
+					},
+				}, nil
+			})
+
+		req, err := http.NewRequest(http.MethodGet, management.RouteGetResourceGroupRecommendations+"?resource_group=rg1&resource_group=rg2", nil)
+		s.Require().NoError(err)
+		recorder := httptest.NewRecorder()
+		s.proxy.GetResourceGroupRecommendations(recorder, req)
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Contains(recorder.Body.String(), `"desired_node_num":2`)
+	})
+
+	s.Run("return_error", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.querycoord.EXPECT().GetResourceGroupRecommendations(mock.Anything, mock.Anything).Return(nil, errors.New("mocked error"))
+		req, err := http.NewRequest(http.MethodGet, management.RouteGetResourceGroupRecommendations, nil)
+		s.Require().NoError(err)
+		recorder := httptest.NewRecorder()
+		s.proxy.GetResourceGroupRecommendations(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+
+	s.Run("return_failure", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.querycoord.EXPECT().GetResourceGroupRecommendations(mock.Anything, mock.Anything).Return(&querypb.GetResourceGroupRecommendationsResponse{
+			Status: merr.Status(merr.ErrServiceNotReady),
+		}, nil)
+		req, err := http.NewRequest(http.MethodGet, management.RouteGetResourceGroupRecommendations, nil)
+		s.Require().NoError(err)
+		recorder := httptest.NewRecorder()
+		s.proxy.GetResourceGroupRecommendations(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func (s *ProxyManagementSuite) TestSnapshot() {
+	s.Run("create", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.datacoord.EXPECT().CreateSnapshot(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, req *datapb.CreateSnapshotRequest, opts ...grpc.CallOption) (*datapb.CreateSnapshotResponse, error) {
+				s.EqualValues(1, req.GetCollectionID())
+				s.Equal("s1", req.GetName())
+				return &datapb.CreateSnapshotResponse{Status: merr.Success(), Snapshot: &datapb.SnapshotInfo{SnapshotID: 10}}, nil
+			})
+		req, err := http.NewRequest(http.MethodPost, management.RouteCreateSnapshot, strings.NewReader("collection_id=1&name=s1"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder := httptest.NewRecorder()
+		s.proxy.CreateSnapshot(recorder, req)
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Contains(recorder.Body.String(), `"snapshot_id": 10`)
+
+		req, err = http.NewRequest(http.MethodPost, management.RouteCreateSnapshot, strings.NewReader("name=s1"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder = httptest.NewRecorder()
+		s.proxy.CreateSnapshot(recorder, req)
+		s.Equal(http.StatusBadRequest, recorder.Code)
+	})
+
+	s.Run("list", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.datacoord.EXPECT().ListSnapshots(mock.Anything, mock.Anything).Return(&datapb.ListSnapshotsResponse{
+			Status:    merr.Success(),
+			Snapshots: []*datapb.SnapshotInfo{{SnapshotID: 10, Name: "s1"}},
+		}, nil)
+		req, err := http.NewRequest(http.MethodGet, management.RouteListSnapshots+"?collection_id=1", nil)
+		s.Require().NoError(err)
+		recorder := httptest.NewRecorder()
+		s.proxy.ListSnapshots(recorder, req)
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Contains(recorder.Body.String(), `"name":"s1"`)
+	})
+
+	s.Run("drop", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.datacoord.EXPECT().DropSnapshot(mock.Anything, mock.Anything).Return(merr.Status(merr.ErrServiceNotReady), nil)
+		req, err := http.NewRequest(http.MethodPost, management.RouteDropSnapshot+"?snapshot_id=10", nil)
+		s.Require().NoError(err)
+		recorder := httptest.NewRecorder()
+		s.proxy.DropSnapshot(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+
+	s.Run("restore", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.datacoord.EXPECT().RestoreSnapshot(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, req *datapb.RestoreSnapshotRequest, opts ...grpc.CallOption) (*datapb.RestoreSnapshotResponse, error) {
+				s.EqualValues(10, req.GetSnapshotID())
+				s.Equal("restored", req.GetCollectionName())
+				return &datapb.RestoreSnapshotResponse{Status: merr.Success(), CollectionID: 2, SegmentIDs: []int64{100}}, nil
+			}).Once()
+		req, err := http.NewRequest(http.MethodPost, management.RouteRestoreSnapshot+"?snapshot_id=10&collection_name=restored", nil)
+		s.Require().NoError(err)
+		recorder := httptest.NewRecorder()
+		s.proxy.RestoreSnapshot(recorder, req)
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Contains(recorder.Body.String(), `"segmentIDs":[100]`)
+
+		s.datacoord.EXPECT().RestoreSnapshot(mock.Anything, mock.Anything).Return(nil, errors.New("mocked error"))
+		recorder = httptest.NewRecorder()
+		s.proxy.RestoreSnapshot(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func (s *ProxyManagementSuite) TestGetPartitionKeyStats() {
+	cache := NewMockCache(s.T())
+	cache.EXPECT().GetCollectionID(mock.Anything, "db", "coll").Return(1, nil).Maybe()
+	cache.EXPECT().GetPartitions(mock.Anything, "db", "coll").Return(map[string]int64{"_default_0": 10, "_default_1": 11}, nil).Maybe()
+	originCache := globalMetaCache
+	globalMetaCache = cache
+	defer func() { globalMetaCache = originCache }()
+
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.datacoord.EXPECT().GetPartitionKeyStats(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, req *datapb.GetPartitionKeyStatsRequest, opts ...grpc.CallOption) (*datapb.GetPartitionKeyStatsResponse, error) {
+				s.EqualValues(1, req.GetCollectionID())
+				s.True(req.GetWithKeyStats())
+				s.EqualValues(5, req.GetTopK())
+				return &datapb.GetPartitionKeyStatsResponse{
+					Status: merr.Success(),
+					Partitions: []*datapb.PartitionKeyPartitionStats{
+						{PartitionID: 10, NumRows: 100},
+						{PartitionID: 11, NumRows: 200},
+					},
+					RepartitionSegments: 1,
+				}, nil
+			})
+		req, err := http.NewRequest(http.MethodGet, management.RouteGetPartitionKeyStats+"?db_name=db&collection_name=coll&with_key_stats=true&top_k=5", nil)
+		s.Require().NoError(err)
+		recorder := httptest.NewRecorder()
+		s.proxy.GetPartitionKeyStats(recorder, req)
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Contains(recorder.Body.String(), `"partition_name":"_default_1"`)
+		s.Contains(recorder.Body.String(), `"repartition_segments":1`)
+	})
+
+	s.Run("invalid request", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		req, err := http.NewRequest(http.MethodGet, management.RouteGetPartitionKeyStats, nil)
+		s.Require().NoError(err)
+		recorder := httptest.NewRecorder()
+		s.proxy.GetPartitionKeyStats(recorder, req)
+		s.Equal(http.StatusBadRequest, recorder.Code)
+
+		req, err = http.NewRequest(http.MethodGet, management.RouteGetPartitionKeyStats+"?db_name=db&collection_name=coll&top_k=x", nil)
+		s.Require().NoError(err)
+		recorder = httptest.NewRecorder()
+		s.proxy.GetPartitionKeyStats(recorder, req)
+		s.Equal(http.StatusBadRequest, recorder.Code)
+	})
+
+	s.Run("return_failure", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.datacoord.EXPECT().GetPartitionKeyStats(mock.Anything, mock.Anything).Return(&datapb.GetPartitionKeyStatsResponse{
+			Status: merr.Status(merr.ErrServiceNotReady),
+		}, nil)
+		req, err := http.NewRequest(http.MethodGet, management.RouteGetPartitionKeyStats+"?db_name=db&collection_name=coll", nil)
+		s.Require().NoError(err)
+		recorder := httptest.NewRecorder()
+		s.proxy.GetPartitionKeyStats(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
 func TestProxyManagement(t *testing.T) {
 	suite.Run(t, new(ProxyManagementSuite))
 }
@@ -23,6 +23,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
@@ -72,6 +73,8 @@ type Cache interface {
 	// GetCollectionSchema get collection's schema.
 	GetCollectionSchema(ctx context.Context, database, collectionName string) (*schemaInfo, error)
 	GetShards(ctx context.Context, withCache bool, database, collectionName string, collectionID int64) (map[string][]nodeInfo, error)
+	// GetLoadFields get the fields of the schema which the collection is loaded with, empty if unknown.
+	GetLoadFields(ctx context.Context, database, collectionName string, collectionID int64) ([]int64, error)
 	DeprecateShardCache(database, collectionName string)
 	InvalidateShardLeaderCache(collections []int64)
 	RemoveCollection(ctx context.Context, database, collectionName string)
@@ -100,6 +103,11 @@ type collectionBasicInfo struct {
 	createdUtcTimestamp   uint64
 	consistencyLevel      commonpb.ConsistencyLevel
 	partitionKeyIsolation bool
+	historyRetention      time.Duration
+	// the number of partitions of partition key before increasing and the ts of increasing
+	repartitionFrom int64
+	repartitionTs   uint64
+	schemaVersion   int64
 }
 
 type collectionInfo struct {
@@ -110,6 +118,11 @@ type collectionInfo struct {
 	createdUtcTimestamp   uint64
 	consistencyLevel      commonpb.ConsistencyLevel
 	partitionKeyIsolation bool
+	historyRetention      time.Duration
+	// the number of partitions of partition key before increasing and the ts of increasing
+	repartitionFrom int64
+	repartitionTs   uint64
+	schemaVersion   int64
 }
 
 type databaseInfo struct {
@@ -192,6 +205,10 @@ func (info *collectionInfo) getBasicInfo() *collectionBasicInfo {
 		createdUtcTimestamp:   info.createdUtcTimestamp,
 		consistencyLevel:      info.consistencyLevel,
 		partitionKeyIsolation: info.partitionKeyIsolation,
+		historyRetention:      info.historyRetention,
+		repartitionFrom:       info.repartitionFrom,
+		repartitionTs:         info.repartitionTs,
+		schemaVersion:         info.schemaVersion,
 	}
 
 	return basicInfo
@@ -208,6 +225,7 @@ type shardLeaders struct {
 
 	collectionID int64
 	shardLeaders map[string][]nodeInfo
+	loadFields   []int64
 }
 
 type shardLeadersReader struct {
@@ -393,6 +411,21 @@ func (m *MetaCache) update(ctx context.Context, database, collectionName string,
 		return nil, err
 	}
 
+	historyRetention, err := common.CollectionHistoryRetention(funcutil.KeyValuePair2Map(collection.Properties))
+	if err != nil {
+		return nil, err
+	}
+
+	repartitionFrom, repartitionTs, err := common.PartitionKeyRepartition(funcutil.KeyValuePair2Map(collection.Properties))
+	if err != nil {
+		return nil, err
+	}
+
+	schemaVersion, err := common.CollectionSchemaVersion(funcutil.KeyValuePair2Map(collection.Properties))
+	if err != nil {
+		return nil, err
+	}
+
 	schemaInfo := newSchemaInfo(collection.Schema)
 	m.collInfo[database][collectionName] = &collectionInfo{
 		collID:                collection.CollectionID,
@@ -402,6 +435,10 @@ func (m *MetaCache) update(ctx context.Context, database, collectionName string,
 		createdUtcTimestamp:   collection.CreatedUtcTimestamp,
 		consistencyLevel:      collection.ConsistencyLevel,
 		partitionKeyIsolation: isolation,
+		historyRetention:      historyRetention,
+		repartitionFrom:       repartitionFrom,
+		repartitionTs:         repartitionTs,
+		schemaVersion:         schemaVersion,
 	}
 
 	log.Info("meta update success", zap.String("database", database), zap.String("collectionName", collectionName), zap.Int64("collectionID", collection.CollectionID))
@@ -959,6 +996,7 @@ func (m *MetaCache) GetShards(ctx context.Context, withCache bool, database, col
 	newShardLeaders := &shardLeaders{
 		collectionID: info.collID,
 		shardLeaders: shards,
+		loadFields:   resp.GetLoadFields(),
 		deprecated:   atomic.NewBool(false),
 		idx:          atomic.NewInt64(0),
 	}
@@ -1003,6 +1041,22 @@ func parseShardLeaderList2QueryNode(shardsLeaders []*querypb.ShardLeadersList) m
 	return shard2QueryNodes
 }
 
+// GetLoadFields returns the fields of the schema which the collection is loaded with,
+// which are fetched with the shard leaders of the collection.
+func (m *MetaCache) GetLoadFields(ctx context.Context, database, collectionName string, collectionID int64) ([]int64, error) {
+	if leaders, ok := m.getCollectionShardLeader(database, collectionName); ok {
+		return leaders.loadFields, nil
+	}
+	if _, err := m.GetShards(ctx, false, database, collectionName, collectionID); err != nil {
+		return nil, err
+	}
+	leaders, _ := m.getCollectionShardLeader(database, collectionName)
+	if leaders == nil {
+		return nil, nil
+	}
+	return leaders.loadFields, nil
+}
+
 // DeprecateShardCache clear the shard leader cache of a collection
 func (m *MetaCache) DeprecateShardCache(database, collectionName string) {
 	log.Info("clearing shard cache for collection", zap.String("collectionName", collectionName))
@@ -505,6 +505,63 @@ func (_c *MockCache_GetDatabaseInfo_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// GetLoadFields provides a mock function with given fields: ctx, database, collectionName, collectionID
+func (_m *MockCache) GetLoadFields(ctx context.Context, database string, collectionName string, collectionID int64) ([]int64, error) {
+	ret := _m.Called(ctx, database, collectionName, collectionID)
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64) ([]int64, error)); ok {
+		return rf(ctx, database, collectionName, collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64) []int64); ok {
+		r0 = rf(ctx, database, collectionName, collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int64) error); ok {
+		r1 = rf(ctx, database, collectionName, collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCache_GetLoadFields_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoadFields'
+type MockCache_GetLoadFields_Call struct {
+	*mock.Call
+}
+
+// GetLoadFields is a helper method to define mock.On call
+//   - ctx context.Context
+//   - database string
+//   - collectionName string
+//   - collectionID int64
+func (_e *MockCache_Expecter) GetLoadFields(ctx interface{}, database interface{}, collectionName interface{}, collectionID interface{}) *MockCache_GetLoadFields_Call {
+	return &MockCache_GetLoadFields_Call{Call: _e.mock.On("GetLoadFields", ctx, database, collectionName, collectionID)}
+}
+
+func (_c *MockCache_GetLoadFields_Call) Run(run func(ctx context.Context, database string, collectionName string, collectionID int64)) *MockCache_GetLoadFields_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *MockCache_GetLoadFields_Call) Return(_a0 []int64, _a1 error) *MockCache_GetLoadFields_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCache_GetLoadFields_Call) RunAndReturn(run func(context.Context, string, string, int64) ([]int64, error)) *MockCache_GetLoadFields_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPartitionID provides a mock function with given fields: ctx, database, collectionName, partitionName
 func (_m *MockCache) GetPartitionID(ctx context.Context, database string, collectionName string, partitionName string) (int64, error) {
 	ret := _m.Called(ctx, database, collectionName, partitionName)
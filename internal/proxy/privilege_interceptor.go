@@ -27,7 +27,7 @@ type PrivilegeFunc func(ctx context.Context, req interface{}) (context.Context,
 const (
 	// sub -> role name, like admin, public
 	// obj -> contact object with object name, like Global-*, Collection-col1
-	// act -> privilege, like CreateCollection, DescribeCollection
+	// act -> privilege, like CreateCollection, DescribeCollection, or privilege group, like CollectionReadOnly
 	ModelStr = `
 [request_definition]
 r = sub, obj, act
@@ -39,7 +39,7 @@ p = sub, obj, act
 e = some(where (p.eft == allow))
 
 [matchers]
-m = r.sub == p.sub && globMatch(r.obj, p.obj) && globMatch(r.act, p.act) || r.sub == "admin" || (r.sub == p.sub && dbMatch(r.obj, p.obj) && p.act == "PrivilegeAll")
+m = r.sub == p.sub && globMatch(r.obj, p.obj) && (globMatch(r.act, p.act) || privilegeGroupMatch(r.act, p.act)) || r.sub == "admin" || (r.sub == p.sub && dbMatch(r.obj, p.obj) && p.act == "PrivilegeAll")
 `
 )
 
@@ -60,6 +60,7 @@ func getEnforcer() *casbin.SyncedEnforcer {
 		adapter := NewMetaCacheCasbinAdapter(func() Cache { return globalMetaCache })
 		e.InitWithModelAndAdapter(casbinModel, adapter)
 		e.AddFunction("dbMatch", DBMatchFunc)
+		e.AddFunction("privilegeGroupMatch", PrivilegeGroupMatchFunc)
 		enforcer = e
 	})
 	return enforcer
@@ -213,3 +214,11 @@ func DBMatchFunc(args ...interface{}) (interface{}, error) {
 
 	return db1 == db2, nil
 }
+
+// PrivilegeGroupMatchFunc returns whether the privilege of the request is in the privilege group of the policy.
+func PrivilegeGroupMatchFunc(args ...interface{}) (interface{}, error) {
+	privilege := args[0].(string)
+	group := args[1].(string)
+
+	return util.PrivilegeGroupContains(group, privilege), nil
+}
@@ -231,3 +231,59 @@ func TestResourceGroupPrivilege(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestPrivilegeGroup(t *testing.T) {
+	paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+
+	ctx := GetContext(context.Background(), "fooo:123456")
+	client := &MockRootCoordClientInterface{}
+	queryCoord := &mocks.MockQueryCoordClient{}
+	mgr := newShardClientMgr()
+
+	client.listPolicy = func(ctx context.Context, in *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error) {
+		return &internalpb.ListPolicyResponse{
+			Status: merr.Success(),
+			PolicyInfos: []string{
+				funcutil.PolicyForPrivilege("role1", commonpb.ObjectType_Collection.String(), "col1", util.PrivilegeNameForMetastore(util.PrivilegeGroupCollectionReadOnly), "default"),
+				funcutil.PolicyForPrivilege("role1", commonpb.ObjectType_Global.String(), "*", util.PrivilegeNameForMetastore(util.PrivilegeGroupDatabaseReadOnly), "default"),
+			},
+			UserRoles: []string{
+				funcutil.EncodeUserRoleCache("fooo", "role1"),
+			},
+		}, nil
+	}
+	InitMetaCache(ctx, client, queryCoord, mgr)
+
+	_, err := PrivilegeInterceptor(ctx, &milvuspb.QueryRequest{CollectionName: "col1"})
+	assert.NoError(t, err)
+	_, err = PrivilegeInterceptor(ctx, &milvuspb.SearchRequest{CollectionName: "col1"})
+	assert.NoError(t, err)
+	_, err = PrivilegeInterceptor(ctx, &milvuspb.DescribeCollectionRequest{CollectionName: "col1"})
+	assert.NoError(t, err)
+	_, err = PrivilegeInterceptor(ctx, &milvuspb.ShowCollectionsRequest{})
+	assert.NoError(t, err)
+
+	// the privileges out of the groups
+	_, err = PrivilegeInterceptor(ctx, &milvuspb.InsertRequest{CollectionName: "col1"})
+	assert.Error(t, err)
+	_, err = PrivilegeInterceptor(ctx, &milvuspb.QueryRequest{CollectionName: "col2"})
+	assert.Error(t, err)
+	_, err = PrivilegeInterceptor(ctx, &milvuspb.CreateCollectionRequest{CollectionName: "col2"})
+	assert.Error(t, err)
+}
+
+func TestPrivilegeGroupMatchFunc(t *testing.T) {
+	group := util.PrivilegeNameForMetastore(util.PrivilegeGroupCollectionReadWrite)
+	matched, err := PrivilegeGroupMatchFunc(commonpb.ObjectPrivilege_PrivilegeInsert.String(), group)
+	assert.NoError(t, err)
+	assert.True(t, matched.(bool))
+
+	matched, err = PrivilegeGroupMatchFunc(commonpb.ObjectPrivilege_PrivilegeDropIndex.String(), group)
+	assert.NoError(t, err)
+	assert.False(t, matched.(bool))
+
+	matched, err = PrivilegeGroupMatchFunc(commonpb.ObjectPrivilege_PrivilegeInsert.String(), commonpb.ObjectPrivilege_PrivilegeInsert.String())
+	assert.NoError(t, err)
+	assert.False(t, matched.(bool))
+}
@@ -1114,6 +1114,38 @@ func (coord *RootCoordMock) RenameCollection(ctx context.Context, req *milvuspb.
 	return &commonpb.Status{}, nil
 }
 
+func (coord *RootCoordMock) AddCollectionField(ctx context.Context, req *internalpb.AddCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (coord *RootCoordMock) DropCollectionField(ctx context.Context, req *internalpb.DropCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (coord *RootCoordMock) RenameCollectionField(ctx context.Context, req *internalpb.RenameCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (coord *RootCoordMock) AlterAliases(ctx context.Context, req *internalpb.AlterAliasesRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (coord *RootCoordMock) DropCollectionAsync(ctx context.Context, req *milvuspb.DropCollectionRequest, opts ...grpc.CallOption) (*internalpb.SubmitDDLJobResponse, error) {
+	return &internalpb.SubmitDDLJobResponse{Status: merr.Success()}, nil
+}
+
+func (coord *RootCoordMock) DropDatabaseAsync(ctx context.Context, req *milvuspb.DropDatabaseRequest, opts ...grpc.CallOption) (*internalpb.SubmitDDLJobResponse, error) {
+	return &internalpb.SubmitDDLJobResponse{Status: merr.Success()}, nil
+}
+
+func (coord *RootCoordMock) ListDDLJobs(ctx context.Context, req *internalpb.ListDDLJobsRequest, opts ...grpc.CallOption) (*internalpb.ListDDLJobsResponse, error) {
+	return &internalpb.ListDDLJobsResponse{Status: merr.Success()}, nil
+}
+
+func (coord *RootCoordMock) GetDDLJob(ctx context.Context, req *internalpb.GetDDLJobRequest, opts ...grpc.CallOption) (*internalpb.GetDDLJobResponse, error) {
+	return &internalpb.GetDDLJobResponse{Status: merr.Success()}, nil
+}
+
 func (coord *RootCoordMock) DescribeDatabase(ctx context.Context, in *rootcoordpb.DescribeDatabaseRequest, opts ...grpc.CallOption) (*rootcoordpb.DescribeDatabaseResponse, error) {
 	return &rootcoordpb.DescribeDatabaseResponse{}, nil
 }
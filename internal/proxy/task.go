@@ -31,14 +31,17 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/ratelimitutil"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -55,6 +58,8 @@ const (
 	RoundDecimalKey      = "round_decimal"
 	OffsetKey            = "offset"
 	LimitKey             = "limit"
+	// AsOfKey is the timestamp to read the history data of the collection, must be within the history retention
+	AsOfKey = "as_of"
 
 	InsertTaskName                = "InsertTask"
 	CreateCollectionTaskName      = "CreateCollectionTask"
@@ -75,9 +80,13 @@ const (
 	CreateAliasTaskName           = "CreateAliasTask"
 	DropAliasTaskName             = "DropAliasTask"
 	AlterAliasTaskName            = "AlterAliasTask"
+	AlterAliasesTaskName          = "AlterAliasesTask"
 	DescribeAliasTaskName         = "DescribeAliasTask"
 	ListAliasesTaskName           = "ListAliasesTask"
 	AlterCollectionTaskName       = "AlterCollectionTask"
+	AddCollectionFieldTaskName    = "AddCollectionFieldTask"
+	DropCollectionFieldTaskName   = "DropCollectionFieldTask"
+	RenameCollectionFieldTaskName = "RenameCollectionFieldTask"
 	UpsertTaskName                = "UpsertTask"
 	CreateResourceGroupTaskName   = "CreateResourceGroupTask"
 	UpdateResourceGroupsTaskName  = "UpdateResourceGroupsTask"
@@ -402,6 +411,12 @@ type dropCollectionTask struct {
 	result    *commonpb.Status
 	chMgr     channelsMgr
 	chTicker  channelsTimeTicker
+
+	// respond once rootcoord accepts the drop if async, jobID is the ddl job of the drop
+	async bool
+	jobID int64
+	// the lifetime context of the proxy, which bounds the wait for the ddl job of the async drop
+	nodeCtx context.Context
 }
 
 func (t *dropCollectionTask) TraceCtx() context.Context {
@@ -454,11 +469,29 @@ func (t *dropCollectionTask) PreExecute(ctx context.Context) error {
 }
 
 func (t *dropCollectionTask) Execute(ctx context.Context) error {
+	if t.async {
+		resp, err := t.rootCoord.DropCollectionAsync(ctx, t.DropCollectionRequest)
+		t.result, t.jobID = resp.GetStatus(), resp.GetJobID()
+		if err == nil && merr.Ok(t.result) {
+			// the collection may still be rejected by rootcoord, its rate limit is kept until the job completes
+			go t.waitJobCompleted(t.nodeCtx)
+		}
+		return err
+	}
 	var err error
 	t.result, err = t.rootCoord.DropCollection(ctx, t.DropCollectionRequest)
 	return err
 }
 
+// waitJobCompleted waits for the ddl job of the async drop, the rate limit of the collection is
+// deregistered only if the job completes.
+func (t *dropCollectionTask) waitJobCompleted(ctx context.Context) {
+	ctx = log.WithFields(ctx, zap.String("db", t.GetDbName()), zap.String("collection", t.GetCollectionName()))
+	if waitDDLJobCompleted(ctx, t.rootCoord, t.jobID) {
+		DeregisterSubLabel(ratelimitutil.GetCollectionSubLabel(t.GetDbName(), t.GetCollectionName()))
+	}
+}
+
 func (t *dropCollectionTask) PostExecute(ctx context.Context) error {
 	return nil
 }
@@ -952,6 +985,10 @@ func (t *alterCollectionTask) PreExecute(ctx context.Context) error {
 		}
 	}
 
+	if _, err := common.CollectionHistoryRetention(funcutil.KeyValuePair2Map(t.Properties)); err != nil {
+		return merr.WrapErrParameterInvalidMsg("%s", err.Error())
+	}
+
 	isPartitionKeyMode, err := isPartitionKeyMode(ctx, t.GetDbName(), t.CollectionName)
 	if err != nil {
 		return err
@@ -1021,6 +1058,242 @@ func (t *alterCollectionTask) PostExecute(ctx context.Context) error {
 	return nil
 }
 
+type addCollectionFieldTask struct {
+	baseTask
+	Condition
+	*internalpb.AddCollectionFieldRequest
+	ctx       context.Context
+	rootCoord types.RootCoordClient
+	result    *commonpb.Status
+}
+
+func (t *addCollectionFieldTask) TraceCtx() context.Context {
+	return t.ctx
+}
+
+func (t *addCollectionFieldTask) ID() UniqueID {
+	return t.Base.MsgID
+}
+
+func (t *addCollectionFieldTask) SetID(uid UniqueID) {
+	t.Base.MsgID = uid
+}
+
+func (t *addCollectionFieldTask) Name() string {
+	return AddCollectionFieldTaskName
+}
+
+func (t *addCollectionFieldTask) Type() commonpb.MsgType {
+	return t.Base.MsgType
+}
+
+func (t *addCollectionFieldTask) BeginTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *addCollectionFieldTask) EndTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *addCollectionFieldTask) SetTs(ts Timestamp) {
+	t.Base.Timestamp = ts
+}
+
+func (t *addCollectionFieldTask) OnEnqueue() error {
+	if t.Base == nil {
+		t.Base = commonpbutil.NewMsgBase()
+	}
+	return nil
+}
+
+func (t *addCollectionFieldTask) PreExecute(ctx context.Context) error {
+	t.Base.MsgType = commonpb.MsgType_AlterCollection
+	t.Base.SourceID = paramtable.GetNodeID()
+
+	if t.GetField() == nil {
+		return merr.WrapErrParameterInvalidMsg("field schema is empty")
+	}
+	if err := validateFieldName(t.GetField().GetName()); err != nil {
+		return err
+	}
+
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, t.GetDbName(), t.GetCollectionName())
+	if err != nil {
+		return err
+	}
+	t.CollectionID = collectionID
+
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, t.GetDbName(), t.GetCollectionName())
+	if err != nil {
+		return err
+	}
+	if len(schema.GetFields())+1 > Params.ProxyCfg.MaxFieldNum.GetAsInt() {
+		return merr.WrapErrParameterInvalidMsg("maximum field's number should be limited to %d", Params.ProxyCfg.MaxFieldNum.GetAsInt())
+	}
+	return nil
+}
+
+func (t *addCollectionFieldTask) Execute(ctx context.Context) error {
+	var err error
+	t.result, err = t.rootCoord.AddCollectionField(ctx, t.AddCollectionFieldRequest)
+	return err
+}
+
+func (t *addCollectionFieldTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+type dropCollectionFieldTask struct {
+	baseTask
+	Condition
+	*internalpb.DropCollectionFieldRequest
+	ctx       context.Context
+	rootCoord types.RootCoordClient
+	result    *commonpb.Status
+}
+
+func (t *dropCollectionFieldTask) TraceCtx() context.Context {
+	return t.ctx
+}
+
+func (t *dropCollectionFieldTask) ID() UniqueID {
+	return t.Base.MsgID
+}
+
+func (t *dropCollectionFieldTask) SetID(uid UniqueID) {
+	t.Base.MsgID = uid
+}
+
+func (t *dropCollectionFieldTask) Name() string {
+	return DropCollectionFieldTaskName
+}
+
+func (t *dropCollectionFieldTask) Type() commonpb.MsgType {
+	return t.Base.MsgType
+}
+
+func (t *dropCollectionFieldTask) BeginTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *dropCollectionFieldTask) EndTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *dropCollectionFieldTask) SetTs(ts Timestamp) {
+	t.Base.Timestamp = ts
+}
+
+func (t *dropCollectionFieldTask) OnEnqueue() error {
+	if t.Base == nil {
+		t.Base = commonpbutil.NewMsgBase()
+	}
+	return nil
+}
+
+func (t *dropCollectionFieldTask) PreExecute(ctx context.Context) error {
+	t.Base.MsgType = commonpb.MsgType_AlterCollection
+	t.Base.SourceID = paramtable.GetNodeID()
+
+	if t.GetFieldName() == "" {
+		return merr.WrapErrParameterInvalidMsg("field name is empty")
+	}
+
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, t.GetDbName(), t.GetCollectionName())
+	if err != nil {
+		return err
+	}
+	t.CollectionID = collectionID
+	return nil
+}
+
+func (t *dropCollectionFieldTask) Execute(ctx context.Context) error {
+	var err error
+	t.result, err = t.rootCoord.DropCollectionField(ctx, t.DropCollectionFieldRequest)
+	return err
+}
+
+func (t *dropCollectionFieldTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+type renameCollectionFieldTask struct {
+	baseTask
+	Condition
+	*internalpb.RenameCollectionFieldRequest
+	ctx       context.Context
+	rootCoord types.RootCoordClient
+	result    *commonpb.Status
+}
+
+func (t *renameCollectionFieldTask) TraceCtx() context.Context {
+	return t.ctx
+}
+
+func (t *renameCollectionFieldTask) ID() UniqueID {
+	return t.Base.MsgID
+}
+
+func (t *renameCollectionFieldTask) SetID(uid UniqueID) {
+	t.Base.MsgID = uid
+}
+
+func (t *renameCollectionFieldTask) Name() string {
+	return RenameCollectionFieldTaskName
+}
+
+func (t *renameCollectionFieldTask) Type() commonpb.MsgType {
+	return t.Base.MsgType
+}
+
+func (t *renameCollectionFieldTask) BeginTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *renameCollectionFieldTask) EndTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *renameCollectionFieldTask) SetTs(ts Timestamp) {
+	t.Base.Timestamp = ts
+}
+
+func (t *renameCollectionFieldTask) OnEnqueue() error {
+	if t.Base == nil {
+		t.Base = commonpbutil.NewMsgBase()
+	}
+	return nil
+}
+
+func (t *renameCollectionFieldTask) PreExecute(ctx context.Context) error {
+	t.Base.MsgType = commonpb.MsgType_AlterCollection
+	t.Base.SourceID = paramtable.GetNodeID()
+
+	if t.GetOldName() == "" {
+		return merr.WrapErrParameterInvalidMsg("field name is empty")
+	}
+	if err := validateFieldName(t.GetNewName()); err != nil {
+		return err
+	}
+
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, t.GetDbName(), t.GetCollectionName())
+	if err != nil {
+		return err
+	}
+	t.CollectionID = collectionID
+	return nil
+}
+
+func (t *renameCollectionFieldTask) Execute(ctx context.Context) error {
+	var err error
+	t.result, err = t.rootCoord.RenameCollectionField(ctx, t.RenameCollectionFieldRequest)
+	return err
+}
+
+func (t *renameCollectionFieldTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
 type createPartitionTask struct {
 	baseTask
 	Condition
@@ -21,8 +21,10 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
@@ -264,6 +266,87 @@ func (t *AlterAliasTask) PostExecute(ctx context.Context) error {
 	return nil
 }
 
+// AlterAliasesTask is the task to create, alter and drop several aliases atomically
+type AlterAliasesTask struct {
+	baseTask
+	Condition
+	*internalpb.AlterAliasesRequest
+	ctx       context.Context
+	rootCoord types.RootCoordClient
+	result    *commonpb.Status
+}
+
+func (t *AlterAliasesTask) TraceCtx() context.Context {
+	return t.ctx
+}
+
+func (t *AlterAliasesTask) ID() UniqueID {
+	return t.Base.MsgID
+}
+
+func (t *AlterAliasesTask) SetID(uid UniqueID) {
+	t.Base.MsgID = uid
+}
+
+func (t *AlterAliasesTask) Name() string {
+	return AlterAliasesTaskName
+}
+
+func (t *AlterAliasesTask) Type() commonpb.MsgType {
+	return t.Base.MsgType
+}
+
+func (t *AlterAliasesTask) BeginTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *AlterAliasesTask) EndTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *AlterAliasesTask) SetTs(ts Timestamp) {
+	t.Base.Timestamp = ts
+}
+
+func (t *AlterAliasesTask) OnEnqueue() error {
+	if t.Base == nil {
+		t.Base = commonpbutil.NewMsgBase()
+	}
+	return nil
+}
+
+func (t *AlterAliasesTask) PreExecute(ctx context.Context) error {
+	t.Base.MsgType = commonpb.MsgType_AlterAlias
+	t.Base.SourceID = paramtable.GetNodeID()
+
+	if len(t.GetChanges()) == 0 {
+		return merr.WrapErrParameterInvalidMsg("no alias change specified")
+	}
+	for _, change := range t.GetChanges() {
+		if err := ValidateCollectionAlias(change.GetAlias()); err != nil {
+			return err
+		}
+		// an empty collection name drops the alias
+		if change.GetCollectionName() == "" {
+			continue
+		}
+		if err := validateCollectionName(change.GetCollectionName()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *AlterAliasesTask) Execute(ctx context.Context) error {
+	var err error
+	t.result, err = t.rootCoord.AlterAliases(ctx, t.AlterAliasesRequest)
+	return err
+}
+
+func (t *AlterAliasesTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
 // DescribeAliasTask is the task to describe alias
 type DescribeAliasTask struct {
 	baseTask
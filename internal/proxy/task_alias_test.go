@@ -25,6 +25,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/uniquegenerator"
@@ -157,6 +158,57 @@ func TestAlterAlias_all(t *testing.T) {
 	assert.NoError(t, task.PostExecute(ctx))
 }
 
+func TestAlterAliases_all(t *testing.T) {
+	rc := NewRootCoordMock()
+
+	defer rc.Close()
+	ctx := context.Background()
+	prefix := "TestAlterAliases_all"
+	collectionName := prefix + funcutil.GenRandomStr()
+	task := &AlterAliasesTask{
+		Condition: NewTaskCondition(ctx),
+		AlterAliasesRequest: &internalpb.AlterAliasesRequest{
+			Base: nil,
+			Changes: []*internalpb.AliasChange{
+				{Alias: "alias1", CollectionName: collectionName},
+				{Alias: "alias2"},
+			},
+		},
+		ctx:       ctx,
+		result:    merr.Success(),
+		rootCoord: rc,
+	}
+
+	assert.NoError(t, task.OnEnqueue())
+
+	assert.NotNil(t, task.TraceCtx())
+
+	id := UniqueID(uniquegenerator.GetUniqueIntGeneratorIns().GetInt())
+	task.SetID(id)
+	assert.Equal(t, id, task.ID())
+	assert.Equal(t, AlterAliasesTaskName, task.Name())
+
+	task.Base.MsgType = commonpb.MsgType_AlterAlias
+	assert.Equal(t, commonpb.MsgType_AlterAlias, task.Type())
+	ts := Timestamp(time.Now().UnixNano())
+	task.SetTs(ts)
+	assert.Equal(t, ts, task.BeginTs())
+	assert.Equal(t, ts, task.EndTs())
+
+	changes := task.Changes
+	task.Changes = nil
+	assert.Error(t, task.PreExecute(ctx))
+	task.Changes = []*internalpb.AliasChange{{Alias: "illgal-alias:!", CollectionName: collectionName}}
+	assert.Error(t, task.PreExecute(ctx))
+	task.Changes = []*internalpb.AliasChange{{Alias: "alias1", CollectionName: "illgal-collection:!"}}
+	assert.Error(t, task.PreExecute(ctx))
+	task.Changes = changes
+
+	assert.NoError(t, task.PreExecute(ctx))
+	assert.NoError(t, task.Execute(ctx))
+	assert.NoError(t, task.PostExecute(ctx))
+}
+
 func TestDescribeAlias_all(t *testing.T) {
 	rc := NewRootCoordMock()
 
@@ -2,11 +2,14 @@ package proxy
 
 import (
 	"context"
+	"time"
 
+	"github.com/cockroachdb/errors"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/pkg/log"
@@ -14,8 +17,12 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/ratelimitutil"
 )
 
+// ddlJobCheckInterval is the interval to check the ddl jobs of the async ddl requests.
+var ddlJobCheckInterval = time.Second
+
 type createDatabaseTask struct {
 	baseTask
 	Condition
@@ -94,6 +101,12 @@ type dropDatabaseTask struct {
 	result    *commonpb.Status
 
 	replicateMsgStream msgstream.MsgStream
+
+	// respond once rootcoord accepts the drop if async, jobID is the ddl job of the drop
+	async bool
+	jobID int64
+	// the lifetime context of the proxy, which bounds the wait for the ddl job of the async drop
+	nodeCtx context.Context
 }
 
 func (ddt *dropDatabaseTask) TraceCtx() context.Context {
@@ -142,16 +155,76 @@ func (ddt *dropDatabaseTask) PreExecute(ctx context.Context) error {
 }
 
 func (ddt *dropDatabaseTask) Execute(ctx context.Context) error {
+	if ddt.async {
+		resp, err := ddt.rootCoord.DropDatabaseAsync(ctx, ddt.DropDatabaseRequest)
+		ddt.result, ddt.jobID = resp.GetStatus(), resp.GetJobID()
+		if err == nil && merr.Ok(ddt.result) {
+			// the database may still be rejected by rootcoord, it's dropped only if the job completes
+			go ddt.waitJobCompleted(ddt.nodeCtx)
+		}
+		return err
+	}
+
 	var err error
 	ddt.result, err = ddt.rootCoord.DropDatabase(ctx, ddt.DropDatabaseRequest)
-
 	if ddt.result != nil && ddt.result.ErrorCode == commonpb.ErrorCode_Success {
-		globalMetaCache.RemoveDatabase(ctx, ddt.DbName)
-		SendReplicateMessagePack(ctx, ddt.replicateMsgStream, ddt.DropDatabaseRequest)
+		ddt.onDropped(ctx)
 	}
 	return err
 }
 
+// waitJobCompleted waits for the ddl job of the async drop,
+// the database is removed from the cache and replicated only if the job completes.
+func (ddt *dropDatabaseTask) waitJobCompleted(ctx context.Context) {
+	ctx = log.WithFields(ctx, zap.String("db", ddt.GetDbName()))
+	if waitDDLJobCompleted(ctx, ddt.rootCoord, ddt.jobID) {
+		ddt.onDropped(ctx)
+	}
+}
+
+// waitDDLJobCompleted checks the ddl job until it's finished, returns whether the job completes.
+// The job interrupted by the restart of rootcoord is still in progress, as the steps left are recovered in background.
+// It gives up when the proxy stops or after proxy.ddlJobWaitTimeout.
+func waitDDLJobCompleted(ctx context.Context, rootCoord types.RootCoordClient, jobID int64) bool {
+	log := log.Ctx(ctx).With(zap.Int64("jobID", jobID))
+	ctx, cancel := context.WithTimeout(ctx, Params.ProxyCfg.DDLJobWaitTimeout.GetAsDuration(time.Second))
+	defer cancel()
+	ticker := time.NewTicker(ddlJobCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Warn("stop waiting for ddl job", zap.Error(ctx.Err()))
+			return false
+		case <-ticker.C:
+		}
+		resp, err := rootCoord.GetDDLJob(ctx, &internalpb.GetDDLJobRequest{JobID: jobID})
+		if err := merr.CheckRPCCall(resp, err); err != nil {
+			if errors.Is(err, merr.ErrParameterInvalid) {
+				log.Warn("ddl job not found", zap.Error(err))
+				return false
+			}
+			log.Warn("failed to get ddl job, retry later", zap.Error(err))
+			continue
+		}
+		switch resp.GetJob().GetState() {
+		case internalpb.DDLJobState_DDLJobCompleted:
+			return true
+		case internalpb.DDLJobState_DDLJobFailed:
+			log.Warn("ddl job failed", zap.String("reason", resp.GetJob().GetReason()))
+			return false
+		}
+	}
+}
+
+func (ddt *dropDatabaseTask) onDropped(ctx context.Context) {
+	globalMetaCache.RemoveDatabase(ctx, ddt.DbName)
+	SendReplicateMessagePack(ctx, ddt.replicateMsgStream, ddt.DropDatabaseRequest)
+	if ddt.async {
+		DeregisterSubLabel(ratelimitutil.GetDBSubLabel(ddt.GetDbName()))
+	}
+}
+
 func (ddt *dropDatabaseTask) PostExecute(ctx context.Context) error {
 	return nil
 }
@@ -4,7 +4,9 @@ import (
 	"context"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc/metadata"
@@ -12,6 +14,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util"
@@ -114,6 +117,104 @@ func TestDropDatabaseTask(t *testing.T) {
 		assert.Equal(t, UniqueID(0), task.ID())
 	})
 
+	t.Run("async", func(t *testing.T) {
+		defer func(interval time.Duration) { ddlJobCheckInterval = interval }(ddlJobCheckInterval)
+		ddlJobCheckInterval = time.Millisecond
+
+		removed := make(chan string, 1)
+		cache := NewMockCache(t)
+		cache.EXPECT().RemoveDatabase(mock.Anything, mock.Anything).Run(func(ctx context.Context, database string) {
+			removed <- database
+		}).Once()
+		globalMetaCache = cache
+
+		rc := mocks.NewMockRootCoordClient(t)
+		rc.EXPECT().DropDatabaseAsync(mock.Anything, mock.Anything).Return(&internalpb.SubmitDDLJobResponse{
+			Status: merr.Success(),
+			JobID:  100,
+		}, nil)
+		// the database is removed from the cache after the job completes
+		rc.EXPECT().GetDDLJob(mock.Anything, mock.Anything).Return(&internalpb.GetDDLJobResponse{
+			Status: merr.Success(),
+			Job:    &internalpb.DDLJob{JobID: 100, State: internalpb.DDLJobState_DDLJobCompleted},
+		}, nil).Once()
+		task := &dropDatabaseTask{
+			Condition:           NewTaskCondition(ctx),
+			DropDatabaseRequest: &milvuspb.DropDatabaseRequest{DbName: "db"},
+			ctx:                 ctx,
+			rootCoord:           rc,
+			async:               true,
+			nodeCtx:             ctx,
+		}
+		err := task.Execute(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, task.result.GetErrorCode())
+		assert.Equal(t, int64(100), task.jobID)
+		assert.Equal(t, "db", <-removed)
+	})
+
+	t.Run("async wait job", func(t *testing.T) {
+		defer func(interval time.Duration) { ddlJobCheckInterval = interval }(ddlJobCheckInterval)
+		ddlJobCheckInterval = time.Millisecond
+
+		removed := make(chan string, 1)
+		cache := NewMockCache(t)
+		cache.EXPECT().RemoveDatabase(mock.Anything, mock.Anything).Run(func(ctx context.Context, database string) {
+			removed <- database
+		}).Once()
+		globalMetaCache = cache
+
+		rc := mocks.NewMockRootCoordClient(t)
+		rc.EXPECT().GetDDLJob(mock.Anything, mock.Anything).Return(nil, errors.New("error mock GetDDLJob")).Once()
+		rc.EXPECT().GetDDLJob(mock.Anything, mock.Anything).Return(&internalpb.GetDDLJobResponse{
+			Status: merr.Success(),
+			Job:    &internalpb.DDLJob{JobID: 100, State: internalpb.DDLJobState_DDLJobExecuting},
+		}, nil).Once()
+		// the job interrupted by the restart of rootcoord is still in progress
+		rc.EXPECT().GetDDLJob(mock.Anything, mock.Anything).Return(&internalpb.GetDDLJobResponse{
+			Status: merr.Success(),
+			Job:    &internalpb.DDLJob{JobID: 100, State: internalpb.DDLJobState_DDLJobInterrupted},
+		}, nil).Once()
+		rc.EXPECT().GetDDLJob(mock.Anything, mock.Anything).Return(&internalpb.GetDDLJobResponse{
+			Status: merr.Success(),
+			Job:    &internalpb.DDLJob{JobID: 100, State: internalpb.DDLJobState_DDLJobCompleted},
+		}, nil).Once()
+		task := &dropDatabaseTask{
+			DropDatabaseRequest: &milvuspb.DropDatabaseRequest{DbName: "db"},
+			rootCoord:           rc,
+			async:               true,
+			jobID:               100,
+		}
+		task.waitJobCompleted(ctx)
+		assert.Equal(t, "db", <-removed)
+
+		// the database is kept if the drop fails
+		rc.EXPECT().GetDDLJob(mock.Anything, mock.Anything).Return(&internalpb.GetDDLJobResponse{
+			Status: merr.Success(),
+			Job:    &internalpb.DDLJob{JobID: 100, State: internalpb.DDLJobState_DDLJobFailed},
+		}, nil).Once()
+		task.waitJobCompleted(ctx)
+
+		// the wait stops with the proxy
+		rc.EXPECT().GetDDLJob(mock.Anything, mock.Anything).Return(&internalpb.GetDDLJobResponse{
+			Status: merr.Success(),
+			Job:    &internalpb.DDLJob{JobID: 100, State: internalpb.DDLJobState_DDLJobExecuting},
+		}, nil).Maybe()
+		nodeCtx, cancel := context.WithCancel(ctx)
+		done := make(chan struct{})
+		go func() {
+			task.waitJobCompleted(nodeCtx)
+			close(done)
+		}()
+		cancel()
+		<-done
+
+		// or after timeout
+		Params.Save(Params.ProxyCfg.DDLJobWaitTimeout.Key, "0.01")
+		defer Params.Reset(Params.ProxyCfg.DDLJobWaitTimeout.Key)
+		task.waitJobCompleted(ctx)
+	})
+
 	t.Run("pre execute fail", func(t *testing.T) {
 		task.DbName = "#0xc0de"
 		err := task.PreExecute(ctx)
@@ -883,6 +883,8 @@ func TestDeleteRunner_Run(t *testing.T) {
 			schema, nil)
 		mockCache.EXPECT().GetPartitionsIndex(mock.Anything, mock.Anything, mock.Anything).
 			Return(indexedPartitions, nil)
+		mockCache.EXPECT().GetCollectionInfo(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(&collectionBasicInfo{}, nil)
 		globalMetaCache = mockCache
 		defer func() { globalMetaCache = metaCache }()
 
@@ -1090,6 +1092,9 @@ func TestDeleteRunner_StreamingQueryAndDelteFunc(t *testing.T) {
 		mockCache := NewMockCache(t)
 		mockCache.EXPECT().GetPartitionsIndex(mock.Anything, mock.Anything, mock.Anything).
 			Return(indexedPartitions, nil)
+		mockCache.EXPECT().GetCollectionInfo(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(&collectionBasicInfo{}, nil)
+		mockCache.EXPECT().GetCollectionID(mock.Anything, mock.Anything, mock.Anything).Return(collectionID, nil)
 		mockCache.EXPECT().GetCollectionSchema(mock.Anything, mock.Anything, mock.Anything).Return(
 			schema, nil)
 		mockCache.EXPECT().GetPartitions(mock.Anything, mock.Anything, mock.Anything).Return(
@@ -411,6 +411,11 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 			zap.Error(err2))
 		return err2
 	}
+	if err := checkFieldsLoaded(ctx, t.request.GetDbName(), collectionName, collectionInfo, t.schema,
+		t.RetrieveRequest.GetOutputFieldsId(), t.RetrieveRequest.GetSerializedExprPlan()); err != nil {
+		log.Warn("read the fields not loaded", zap.Error(err))
+		return err
+	}
 
 	guaranteeTs := t.request.GetGuaranteeTimestamp()
 	var consistencyLevel commonpb.ConsistencyLevel
@@ -428,6 +433,15 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 			guaranteeTs = parseGuaranteeTsFromConsistency(guaranteeTs, t.BeginTs(), consistencyLevel)
 		}
 	}
+	asOf, err := parseAsOfTimestamp(t.request.GetQueryParams(), t.request.GetTravelTimestamp(), t.BeginTs(), collectionInfo.historyRetention)
+	if err != nil {
+		return err
+	}
+	if asOf > 0 {
+		// read the snapshot at as_of, which must be visible before reading
+		t.RetrieveRequest.MvccTimestamp = asOf
+		guaranteeTs = max(guaranteeTs, asOf)
+	}
 	t.GuaranteeTimestamp = guaranteeTs
 
 	deadline, ok := t.TraceCtx().Deadline()
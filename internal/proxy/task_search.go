@@ -220,6 +220,15 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 			zap.String("collectionName", collectionName), zap.Int64("collectionID", t.CollectionID), zap.Error(err2))
 		return err2
 	}
+	serializedPlans := [][]byte{t.SearchRequest.GetSerializedExprPlan()}
+	for _, subReq := range t.SearchRequest.GetSubReqs() {
+		serializedPlans = append(serializedPlans, subReq.GetSerializedExprPlan())
+	}
+	if err := checkFieldsLoaded(ctx, t.request.GetDbName(), collectionName, collectionInfo, t.schema,
+		t.SearchRequest.GetOutputFieldsId(), serializedPlans...); err != nil {
+		log.Warn("search the fields not loaded", zap.Error(err))
+		return err
+	}
 	guaranteeTs := t.request.GetGuaranteeTimestamp()
 	var consistencyLevel commonpb.ConsistencyLevel
 	useDefaultConsistency := t.request.GetUseDefaultConsistency()
@@ -236,6 +245,15 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 			guaranteeTs = parseGuaranteeTsFromConsistency(guaranteeTs, t.BeginTs(), consistencyLevel)
 		}
 	}
+	asOf, err := parseAsOfTimestamp(t.request.GetSearchParams(), t.request.GetTravelTimestamp(), t.BeginTs(), collectionInfo.historyRetention)
+	if err != nil {
+		return err
+	}
+	if asOf > 0 {
+		// read the snapshot at as_of, which must be visible before reading
+		t.SearchRequest.MvccTimestamp = asOf
+		guaranteeTs = max(guaranteeTs, asOf)
+	}
 	t.SearchRequest.GuaranteeTimestamp = guaranteeTs
 	t.SearchRequest.ConsistencyLevel = consistencyLevel
 
@@ -42,6 +42,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
@@ -2425,6 +2426,29 @@ func Test_dropCollectionTask_Execute(t *testing.T) {
 	assert.Equal(t, commonpb.ErrorCode_Success, dct.result.GetErrorCode())
 }
 
+func Test_dropCollectionTask_ExecuteAsync(t *testing.T) {
+	mockRC := mocks.NewMockRootCoordClient(t)
+	mockRC.EXPECT().DropCollectionAsync(mock.Anything, mock.Anything).Return(&internalpb.SubmitDDLJobResponse{
+		Status: merr.Success(),
+		JobID:  100,
+	}, nil).Once()
+	mockRC.EXPECT().DropCollectionAsync(mock.Anything, mock.Anything).Return(nil, errors.New("error mock DropCollectionAsync")).Once()
+	mockRC.EXPECT().GetDDLJob(mock.Anything, mock.Anything).Return(&internalpb.GetDDLJobResponse{
+		Status: merr.Success(),
+		Job:    &internalpb.DDLJob{JobID: 100, State: internalpb.DDLJobState_DDLJobCompleted},
+	}, nil).Maybe()
+
+	ctx := context.Background()
+	dct := &dropCollectionTask{rootCoord: mockRC, async: true, nodeCtx: ctx, DropCollectionRequest: &milvuspb.DropCollectionRequest{CollectionName: "normal"}}
+	err := dct.Execute(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, dct.result.GetErrorCode())
+	assert.Equal(t, int64(100), dct.jobID)
+
+	err = dct.Execute(ctx)
+	assert.Error(t, err)
+}
+
 func Test_dropCollectionTask_PostExecute(t *testing.T) {
 	dct := &dropCollectionTask{}
 	assert.NoError(t, dct.PostExecute(context.Background()))
@@ -3643,6 +3667,153 @@ func TestAlterCollectionCheckLoaded(t *testing.T) {
 	assert.Equal(t, merr.Code(merr.ErrCollectionLoaded), merr.Code(err))
 }
 
+func TestAddCollectionFieldTask(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	mc := NewMockCache(t)
+	globalMetaCache = mc
+	defer func() { globalMetaCache = nil }()
+
+	newTask := func(field *schemapb.FieldSchema, rc types.RootCoordClient) *addCollectionFieldTask {
+		task := &addCollectionFieldTask{
+			ctx:       ctx,
+			Condition: NewTaskCondition(ctx),
+			AddCollectionFieldRequest: &internalpb.AddCollectionFieldRequest{
+				CollectionName: "col",
+				Field:          field,
+			},
+			rootCoord: rc,
+		}
+		assert.NoError(t, task.OnEnqueue())
+		return task
+	}
+
+	// invalid field
+	assert.Error(t, newTask(nil, nil).PreExecute(ctx))
+	assert.Error(t, newTask(&schemapb.FieldSchema{Name: "$meta"}, nil).PreExecute(ctx))
+
+	// collection not found
+	field := &schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Int64, Nullable: true}
+	mc.EXPECT().GetCollectionID(mock.Anything, mock.Anything, "col").Return(0, merr.WrapErrCollectionNotFound("col")).Once()
+	assert.ErrorIs(t, newTask(field, nil).PreExecute(ctx), merr.ErrCollectionNotFound)
+
+	mc.EXPECT().GetCollectionID(mock.Anything, mock.Anything, "col").Return(100, nil)
+	mc.EXPECT().GetCollectionSchema(mock.Anything, mock.Anything, "col").Return(newSchemaInfo(&schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64}},
+	}), nil)
+
+	// too many fields
+	bak := Params.ProxyCfg.MaxFieldNum.GetValue()
+	paramtable.Get().Save(Params.ProxyCfg.MaxFieldNum.Key, "1")
+	assert.Error(t, newTask(field, nil).PreExecute(ctx))
+	paramtable.Get().Save(Params.ProxyCfg.MaxFieldNum.Key, bak)
+
+	rc := mocks.NewMockRootCoordClient(t)
+	rc.EXPECT().AddCollectionField(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, req *internalpb.AddCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			assert.EqualValues(t, 100, req.GetCollectionID())
+			assert.Equal(t, commonpb.MsgType_AlterCollection, req.GetBase().GetMsgType())
+			return merr.Success(), nil
+		})
+	task := newTask(field, rc)
+	assert.NoError(t, task.PreExecute(ctx))
+	assert.NoError(t, task.Execute(ctx))
+	assert.NoError(t, merr.Error(task.result))
+	assert.NoError(t, task.PostExecute(ctx))
+	assert.Equal(t, AddCollectionFieldTaskName, task.Name())
+}
+
+func TestDropCollectionFieldTask(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	mc := NewMockCache(t)
+	globalMetaCache = mc
+	defer func() { globalMetaCache = nil }()
+
+	newTask := func(fieldName string, rc types.RootCoordClient) *dropCollectionFieldTask {
+		task := &dropCollectionFieldTask{
+			ctx:       ctx,
+			Condition: NewTaskCondition(ctx),
+			DropCollectionFieldRequest: &internalpb.DropCollectionFieldRequest{
+				CollectionName: "col",
+				FieldName:      fieldName,
+			},
+			rootCoord: rc,
+		}
+		assert.NoError(t, task.OnEnqueue())
+		return task
+	}
+
+	// empty field name
+	assert.Error(t, newTask("", nil).PreExecute(ctx))
+
+	// collection not found
+	mc.EXPECT().GetCollectionID(mock.Anything, mock.Anything, "col").Return(0, merr.WrapErrCollectionNotFound("col")).Once()
+	assert.ErrorIs(t, newTask("f", nil).PreExecute(ctx), merr.ErrCollectionNotFound)
+
+	mc.EXPECT().GetCollectionID(mock.Anything, mock.Anything, "col").Return(100, nil)
+	rc := mocks.NewMockRootCoordClient(t)
+	rc.EXPECT().DropCollectionField(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, req *internalpb.DropCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			assert.EqualValues(t, 100, req.GetCollectionID())
+			assert.Equal(t, "f", req.GetFieldName())
+			assert.Equal(t, commonpb.MsgType_AlterCollection, req.GetBase().GetMsgType())
+			return merr.Success(), nil
+		})
+	task := newTask("f", rc)
+	assert.NoError(t, task.PreExecute(ctx))
+	assert.NoError(t, task.Execute(ctx))
+	assert.NoError(t, merr.Error(task.result))
+	assert.NoError(t, task.PostExecute(ctx))
+	assert.Equal(t, DropCollectionFieldTaskName, task.Name())
+}
+
+func TestRenameCollectionFieldTask(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	mc := NewMockCache(t)
+	globalMetaCache = mc
+	defer func() { globalMetaCache = nil }()
+
+	newTask := func(oldName, newName string, rc types.RootCoordClient) *renameCollectionFieldTask {
+		task := &renameCollectionFieldTask{
+			ctx:       ctx,
+			Condition: NewTaskCondition(ctx),
+			RenameCollectionFieldRequest: &internalpb.RenameCollectionFieldRequest{
+				CollectionName: "col",
+				OldName:        oldName,
+				NewName:        newName,
+			},
+			rootCoord: rc,
+		}
+		assert.NoError(t, task.OnEnqueue())
+		return task
+	}
+
+	// invalid field names
+	assert.Error(t, newTask("", "g", nil).PreExecute(ctx))
+	assert.Error(t, newTask("f", "$meta", nil).PreExecute(ctx))
+
+	// collection not found
+	mc.EXPECT().GetCollectionID(mock.Anything, mock.Anything, "col").Return(0, merr.WrapErrCollectionNotFound("col")).Once()
+	assert.ErrorIs(t, newTask("f", "g", nil).PreExecute(ctx), merr.ErrCollectionNotFound)
+
+	mc.EXPECT().GetCollectionID(mock.Anything, mock.Anything, "col").Return(100, nil)
+	rc := mocks.NewMockRootCoordClient(t)
+	rc.EXPECT().RenameCollectionField(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, req *internalpb.RenameCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			assert.EqualValues(t, 100, req.GetCollectionID())
+			assert.Equal(t, "g", req.GetNewName())
+			return merr.Success(), nil
+		})
+	task := newTask("f", "g", rc)
+	assert.NoError(t, task.PreExecute(ctx))
+	assert.NoError(t, task.Execute(ctx))
+	assert.NoError(t, merr.Error(task.result))
+	assert.NoError(t, task.PostExecute(ctx))
+	assert.Equal(t, RenameCollectionFieldTaskName, task.Name())
+}
+
 func TestTaskPartitionKeyIsolation(t *testing.T) {
 	rc := NewRootCoordMock()
 	defer rc.Close()
@@ -25,9 +25,11 @@ import (
 	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
@@ -44,6 +46,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/contextutil"
 	"github.com/milvus-io/milvus/pkg/util/crypto"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/indexparamcheck"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metric"
@@ -800,6 +803,37 @@ func parseGuaranteeTs(ts, tMax typeutil.Timestamp) typeutil.Timestamp {
 	return ts
 }
 
+// parseAsOfTimestamp parses the as_of timestamp from the search or query params. If not set, the travel timestamp is used
+// only when the collection retains history, as the legacy clients may still send it.
+// Zero is returned if the request reads the latest data.
+func parseAsOfTimestamp(params []*commonpb.KeyValuePair, travelTs, tMax typeutil.Timestamp, historyRetention time.Duration) (typeutil.Timestamp, error) {
+	var asOf typeutil.Timestamp
+	if historyRetention > 0 {
+		asOf = travelTs
+	}
+	if str, err := funcutil.GetAttrByKeyFromRepeatedKV(AsOfKey, params); err == nil {
+		asOf, err = strconv.ParseUint(str, 0, 64)
+		if err != nil {
+			return 0, merr.WrapErrParameterInvalidMsg("%s [%s] is invalid", AsOfKey, str)
+		}
+	}
+	if asOf == 0 {
+		return 0, nil
+	}
+
+	if historyRetention <= 0 {
+		return 0, merr.WrapErrParameterInvalidMsg("%s is not allowed since the collection doesn't retain history, set %s to enable it",
+			AsOfKey, common.CollectionHistoryRetentionKey)
+	}
+	if asOf > tMax {
+		return 0, merr.WrapErrParameterInvalidMsg("%s [%d] is later than the current timestamp %d", AsOfKey, asOf, tMax)
+	}
+	if asOf < tsoutil.AddPhysicalDurationOnTs(tMax, -historyRetention) {
+		return 0, merr.WrapErrParameterInvalidMsg("%s [%d] is out of the history retention %s of the collection", AsOfKey, asOf, historyRetention)
+	}
+	return asOf, nil
+}
+
 func validateName(entity string, nameType string) error {
 	entity = strings.TrimSpace(entity)
 
@@ -1123,6 +1157,65 @@ func isPartitionLoaded(ctx context.Context, qc types.QueryCoordClient, collID in
 	return false, nil
 }
 
+// checkFieldsLoaded rejects reading the fields added after the collection is loaded,
+// these fields are unknown by the segcore of query nodes until the collection is released and loaded again.
+func checkFieldsLoaded(ctx context.Context, dbName, collectionName string, collInfo *collectionBasicInfo,
+	schema *schemaInfo, fieldIDs []int64, serializedPlans ...[]byte,
+) error {
+	if collInfo.schemaVersion == 0 {
+		// the schema is never changed since the collection is created
+		return nil
+	}
+	loadFields, err := globalMetaCache.GetLoadFields(ctx, dbName, collectionName, collInfo.collID)
+	if err != nil {
+		return err
+	}
+	if len(loadFields) == 0 {
+		// the collection is loaded before the load fields are recorded
+		return nil
+	}
+
+	referenced := typeutil.NewSet(fieldIDs...)
+	for _, serializedPlan := range serializedPlans {
+		plan := &planpb.PlanNode{}
+		if err := proto.Unmarshal(serializedPlan, plan); err != nil {
+			return err
+		}
+		collectPlanFieldIDs(proto.MessageReflect(plan), referenced)
+	}
+	loaded := typeutil.NewSet(loadFields...)
+	for _, field := range schema.GetFields() {
+		if referenced.Contain(field.GetFieldID()) && !loaded.Contain(field.GetFieldID()) {
+			return merr.WrapErrParameterInvalidMsg("field %s is added after the collection is loaded, "+
+				"release and load the collection again to read it", field.GetName())
+		}
+	}
+	return nil
+}
+
+// collectPlanFieldIDs collects the ids of the fields referenced by the plan,
+// which are the columns of the predicates, the vector field to search, the group by field and the output fields.
+func collectPlanFieldIDs(msg protoreflect.Message, fieldIDs typeutil.Set[int64]) {
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+		case fd.Message() != nil && fd.IsList():
+			for i := 0; i < v.List().Len(); i++ {
+				collectPlanFieldIDs(v.List().Get(i).Message(), fieldIDs)
+			}
+		case fd.Message() != nil:
+			collectPlanFieldIDs(v.Message(), fieldIDs)
+		case fd.Name() == "output_field_ids":
+			for i := 0; i < v.List().Len(); i++ {
+				fieldIDs.Insert(v.List().Get(i).Int())
+			}
+		case fd.Name() == "field_id" || fd.Name() == "group_by_field_id":
+			fieldIDs.Insert(v.Int())
+		}
+		return true
+	})
+}
+
 func checkFieldsDataBySchema(schema *schemapb.CollectionSchema, insertMsg *msgstream.InsertMsg, inInsert bool) error {
 	log := log.With(zap.String("collection", schema.GetName()))
 	primaryKeyNum := 0
@@ -1423,6 +1516,15 @@ func getDefaultPartitionsInPartitionKeyMode(ctx context.Context, dbName string,
 		return nil, err
 	}
 
+	// the rows are routed by the previous partitions until the new partitions are all created
+	repartitionFrom, repartitionTs, err := getPartitionKeyRepartition(ctx, dbName, collectionName)
+	if err != nil {
+		return nil, err
+	}
+	if repartitionFrom > 0 && repartitionTs == 0 && repartitionFrom < int64(len(partitionNames)) {
+		partitionNames = partitionNames[:repartitionFrom]
+	}
+
 	return partitionNames, nil
 }
 
@@ -1443,6 +1545,20 @@ func assignChannelsByPK(pks *schemapb.IDs, channelNames []string, insertMsg *msg
 	return channel2RowOffsets
 }
 
+// getPartitionKeyRepartition returns the number of partitions of partition key before increasing
+// and the ts of increasing, zero if the partitions are not being increased.
+func getPartitionKeyRepartition(ctx context.Context, dbName string, collectionName string) (int64, uint64, error) {
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
+	if err != nil {
+		return 0, 0, err
+	}
+	collInfo, err := globalMetaCache.GetCollectionInfo(ctx, dbName, collectionName, collectionID)
+	if err != nil {
+		return 0, 0, err
+	}
+	return collInfo.repartitionFrom, collInfo.repartitionTs, nil
+}
+
 func assignPartitionKeys(ctx context.Context, dbName string, collName string, keys []*planpb.GenericValue) ([]string, error) {
 	partitionNames, err := globalMetaCache.GetPartitionsIndex(ctx, dbName, collName)
 	if err != nil {
@@ -1460,7 +1576,23 @@ func assignPartitionKeys(ctx context.Context, dbName string, collName string, ke
 	}
 
 	hashedPartitionNames, err := typeutil2.HashKey2Partitions(partitionKeyFieldSchema, keys, partitionNames)
-	return hashedPartitionNames, err
+	if err != nil {
+		return nil, err
+	}
+
+	// the rows might be still in the previous partitions while the partitions of partition key are being increased
+	repartitionFrom, _, err := getPartitionKeyRepartition(ctx, dbName, collName)
+	if err != nil {
+		return nil, err
+	}
+	if repartitionFrom > 0 && repartitionFrom < int64(len(partitionNames)) {
+		previousPartitionNames, err := typeutil2.HashKey2Partitions(partitionKeyFieldSchema, keys, partitionNames[:repartitionFrom])
+		if err != nil {
+			return nil, err
+		}
+		hashedPartitionNames = typeutil.NewSet(append(hashedPartitionNames, previousPartitionNames...)...).Collect()
+	}
+	return hashedPartitionNames, nil
 }
 
 func ErrWithLog(logger *log.MLogger, msg string, err error) error {
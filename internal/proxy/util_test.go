@@ -26,6 +26,8 @@ import (
 	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go.uber.org/zap"
@@ -38,6 +40,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/pkg/common"
@@ -2148,6 +2151,42 @@ func Test_ParseGuaranteeTs(t *testing.T) {
 	assert.Equal(t, tsNow, parseGuaranteeTs(tsNow, tsMax))
 }
 
+func Test_ParseAsOfTimestamp(t *testing.T) {
+	tsMax := tsoutil.GetCurrentTime()
+	asOf := tsoutil.AddPhysicalDurationOnTs(tsMax, -time.Minute)
+	params := []*commonpb.KeyValuePair{{Key: AsOfKey, Value: strconv.FormatUint(asOf, 10)}}
+
+	ts, err := parseAsOfTimestamp(nil, 0, tsMax, time.Hour)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, ts)
+
+	ts, err = parseAsOfTimestamp(params, 0, tsMax, time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, asOf, ts)
+
+	// fallback to the travel timestamp
+	ts, err = parseAsOfTimestamp(nil, asOf, tsMax, time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, asOf, ts)
+
+	// the travel timestamp is ignored if the collection doesn't retain history
+	ts, err = parseAsOfTimestamp(nil, asOf, tsMax, 0)
+	assert.NoError(t, err)
+	assert.Zero(t, ts)
+
+	_, err = parseAsOfTimestamp(params, 0, tsMax, 0)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+	_, err = parseAsOfTimestamp(params, 0, tsMax, time.Second)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+	_, err = parseAsOfTimestamp(nil, tsoutil.AddPhysicalDurationOnTs(tsMax, time.Minute), tsMax, time.Hour)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+	_, err = parseAsOfTimestamp([]*commonpb.KeyValuePair{{Key: AsOfKey, Value: "yesterday"}}, 0, tsMax, time.Hour)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+}
+
 func Test_ParseGuaranteeTsFromConsistency(t *testing.T) {
 	strong := commonpb.ConsistencyLevel_Strong
 	bounded := commonpb.ConsistencyLevel_Bounded
@@ -2472,3 +2511,91 @@ func TestGetCostValue(t *testing.T) {
 		assert.Equal(t, 100, cost)
 	})
 }
+
+func TestPartitionKeyRoutingWhileRepartition(t *testing.T) {
+	partitionNames := []string{"_default_0", "_default_1", "_default_2", "_default_3"}
+	partitions := map[string]int64{"_default_0": 10, "_default_1": 11, "_default_2": 12, "_default_3": 13}
+	schema := newSchemaInfo(&schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "key", DataType: schemapb.DataType_Int64, IsPartitionKey: true},
+		},
+	})
+	keys := []*planpb.GenericValue{{Val: &planpb.GenericValue_Int64Val{Int64Val: 7}}}
+
+	newCache := func(info *collectionBasicInfo) *MockCache {
+		cache := NewMockCache(t)
+		cache.EXPECT().GetPartitions(mock.Anything, mock.Anything, mock.Anything).Return(partitions, nil).Maybe()
+		cache.EXPECT().GetPartitionsIndex(mock.Anything, mock.Anything, mock.Anything).Return(partitionNames, nil).Maybe()
+		cache.EXPECT().GetCollectionSchema(mock.Anything, mock.Anything, mock.Anything).Return(schema, nil).Maybe()
+		cache.EXPECT().GetCollectionID(mock.Anything, "db", "coll").Return(1, nil)
+		cache.EXPECT().GetCollectionInfo(mock.Anything, "db", "coll", int64(1)).Return(info, nil)
+		return cache
+	}
+	originCache := globalMetaCache
+	defer func() { globalMetaCache = originCache }()
+
+	hash, _ := typeutil.Hash32Int64(7)
+	current, previous := partitionNames[hash%4], partitionNames[hash%2]
+
+	globalMetaCache = newCache(&collectionBasicInfo{})
+	names, err := assignPartitionKeys(context.Background(), "db", "coll", keys)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{current}, names)
+
+	// the rows might be still in the previous partitions
+	globalMetaCache = newCache(&collectionBasicInfo{repartitionFrom: 2, repartitionTs: 100})
+	names, err = assignPartitionKeys(context.Background(), "db", "coll", keys)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, lo.Uniq([]string{current, previous}), names)
+
+	// the inserts are routed by the previous partitions until the new ones are all created
+	globalMetaCache = newCache(&collectionBasicInfo{repartitionFrom: 2})
+	names, err = getDefaultPartitionsInPartitionKeyMode(context.Background(), "db", "coll")
+	assert.NoError(t, err)
+	assert.Equal(t, partitionNames[:2], names)
+
+	globalMetaCache = newCache(&collectionBasicInfo{repartitionFrom: 2, repartitionTs: 100})
+	names, err = getDefaultPartitionsInPartitionKeyMode(context.Background(), "db", "coll")
+	assert.NoError(t, err)
+	assert.Equal(t, partitionNames, names)
+}
+
+func TestCheckFieldsLoaded(t *testing.T) {
+	schema := newSchemaInfo(&schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+			{FieldID: 102, Name: "added", DataType: schemapb.DataType_Int32, Nullable: true},
+		},
+	})
+	plan := func(fieldID int64) []byte {
+		bs, err := proto.Marshal(&planpb.PlanNode{
+			Node: &planpb.PlanNode_Query{Query: &planpb.QueryPlanNode{
+				Predicates: &planpb.Expr{Expr: &planpb.Expr_UnaryRangeExpr{UnaryRangeExpr: &planpb.UnaryRangeExpr{
+					ColumnInfo: &planpb.ColumnInfo{FieldId: fieldID},
+					Op:         planpb.OpType_GreaterThan,
+					Value:      &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: 1}},
+				}}},
+			}},
+		})
+		assert.NoError(t, err)
+		return bs
+	}
+	originCache := globalMetaCache
+	defer func() { globalMetaCache = originCache }()
+	cache := NewMockCache(t)
+	globalMetaCache = cache
+
+	// the schema never changed
+	err := checkFieldsLoaded(context.TODO(), "db", "coll", &collectionBasicInfo{collID: 1}, schema, []int64{102}, plan(102))
+	assert.NoError(t, err)
+
+	info := &collectionBasicInfo{collID: 1, schemaVersion: 1}
+	cache.EXPECT().GetLoadFields(mock.Anything, "db", "coll", int64(1)).Return([]int64{100, 101}, nil)
+	err = checkFieldsLoaded(context.TODO(), "db", "coll", info, schema, []int64{100}, plan(101))
+	assert.NoError(t, err)
+	err = checkFieldsLoaded(context.TODO(), "db", "coll", info, schema, []int64{102}, plan(101))
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	err = checkFieldsLoaded(context.TODO(), "db", "coll", info, schema, []int64{100}, plan(102))
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+}
@@ -0,0 +1,334 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package balance
+
+import (
+	"sort"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/internal/querycoordv2/task"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// MemoryAwareBalancer use the estimated resident memory of segments as node's score,
+// and try to make each node has almost same memory usage through balance segment.
+// Segment's memory is estimated by index size, field data size and mmap resident ratio,
+// so collections with different vector dimensions and index types can be compared fairly.
+// Segment won't be assigned to node if node's estimated memory exceeds the memory watermark.
+type MemoryAwareBalancer struct {
+	*ScoreBasedBalancer
+}
+
+func NewMemoryAwareBalancer(scheduler task.Scheduler,
+	nodeManager *session.NodeManager,
+	dist *meta.DistributionManager,
+	meta *meta.Meta,
+	targetMgr *meta.TargetManager,
+) *MemoryAwareBalancer {
+	return &MemoryAwareBalancer{
+		ScoreBasedBalancer: NewScoreBasedBalancer(scheduler, nodeManager, dist, meta, targetMgr),
+	}
+}
+
+// AssignSegment got a segment list, and try to assign each segment to node with lowest estimated memory
+func (b *MemoryAwareBalancer) AssignSegment(collectionID int64, segments []*meta.Segment, nodes []int64, manualBalance bool) []SegmentAssignPlan {
+	// skip out suspend node and stopping node during assignment, but skip this check for manual balance
+	if !manualBalance {
+		nodes = lo.Filter(nodes, func(node int64, _ int) bool {
+			info := b.nodeManager.Get(node)
+			return info != nil && info.GetState() == session.NodeStateNormal
+		})
+	}
+
+	nodeItems := b.convertToNodeItems(nodes)
+	if len(nodeItems) == 0 {
+		return nil
+	}
+	nodeItemsMap := lo.SliceToMap(nodeItems, func(item *nodeItem) (int64, *nodeItem) { return item.nodeID, item })
+
+	// sort segments by estimated memory, assign the biggest segment first
+	segmentMemory := lo.SliceToMap(segments, func(s *meta.Segment) (int64, int) { return s.GetID(), estimateSegmentMemory(s) })
+	sort.Slice(segments, func(i, j int) bool {
+		return segmentMemory[segments[i].GetID()] > segmentMemory[segments[j].GetID()]
+	})
+
+	plans := make([]SegmentAssignPlan, 0, len(segments))
+	for _, s := range segments {
+		memoryChange := segmentMemory[s.GetID()]
+		sourceNode := nodeItemsMap[s.Node]
+
+		// pick the node with the least memory which can still hold the segment under watermark
+		// notice: we should skip watermark check for manual balance
+		candidates := lo.Filter(nodeItems, func(item *nodeItem, _ int) bool {
+			return item != sourceNode && (manualBalance || b.underWatermark(item, memoryChange))
+		})
+		if len(candidates) == 0 {
+			log.RatedWarn(10, "no node can hold the segment under memory watermark",
+				zap.Int64("collectionID", collectionID),
+				zap.Int64("segmentID", s.GetID()),
+				zap.Int("estimatedMemory", memoryChange))
+			continue
+		}
+		targetNode := lo.MinBy(candidates, func(a, b *nodeItem) bool { return a.getPriority() < b.getPriority() })
+
+		// if segment's node exist, which means this segment comes from balancer. we should consider the benefit
+		// if the segment reassignment doesn't got enough benefit, we should skip this reassignment
+		// notice: we should skip benefit check for manual balance
+		if !manualBalance && sourceNode != nil && !b.hasEnoughBenefit(sourceNode, targetNode, memoryChange) {
+			continue
+		}
+
+		plans = append(plans, SegmentAssignPlan{
+			From:    -1,
+			To:      targetNode.nodeID,
+			Segment: s,
+		})
+
+		// update the node's estimated memory
+		if sourceNode != nil {
+			sourceNode.setPriority(sourceNode.getPriority() - memoryChange)
+		}
+		targetNode.setPriority(targetNode.getPriority() + memoryChange)
+	}
+	return plans
+}
+
+// underWatermark checks whether the node's estimated memory is still under watermark after loading the segment.
+// The check is skipped if the node doesn't report its memory capacity.
+func (b *MemoryAwareBalancer) underWatermark(item *nodeItem, memoryChange int) bool {
+	info := b.nodeManager.Get(item.nodeID)
+	if info == nil || info.MemCapacity() <= 0 {
+		return true
+	}
+	watermark := info.MemCapacity() * 1024 * 1024 * params.Params.QueryCoordCfg.BalanceMemoryWatermark.GetAsFloat()
+	return float64(item.getPriority()+memoryChange) <= watermark
+}
+
+func (b *MemoryAwareBalancer) convertToNodeItems(nodeIDs []int64) []*nodeItem {
+	ret := make([]*nodeItem, 0, len(nodeIDs))
+	for _, node := range nodeIDs {
+		nodeItem := newNodeItem(b.calculateMemory(node), node)
+		ret = append(ret, &nodeItem)
+	}
+	return ret
+}
+
+// calculateMemory estimates the resident memory of all segments on node.
+func (b *MemoryAwareBalancer) calculateMemory(nodeID int64) int {
+	delegatorOverloadFactor := params.Params.QueryCoordCfg.DelegatorMemoryOverloadFactor.GetAsFloat()
+
+	nodeMemory := 0
+	collectionMemory := make(map[int64]int)
+	collectionRowCount := make(map[int64]int64)
+	for _, s := range b.dist.SegmentDistManager.GetByFilter(meta.WithNodeID(nodeID)) {
+		memory := estimateSegmentMemory(s)
+		nodeMemory += memory
+		collectionMemory[s.GetCollectionID()] += memory
+		collectionRowCount[s.GetCollectionID()] += s.GetNumOfRows()
+	}
+
+	// growing segment carry no binlog, estimate it by the average row size of sealed segments in same collection
+	views := b.dist.LeaderViewManager.GetByFilter(meta.WithNodeID2LeaderView(nodeID))
+	for _, view := range views {
+		if rowCount := collectionRowCount[view.CollectionID]; rowCount > 0 {
+			nodeMemory += int(float64(view.NumOfGrowingRows) * float64(collectionMemory[view.CollectionID]) / float64(rowCount))
+		}
+		nodeMemory += int(float64(collectionMemory[view.CollectionID]) * delegatorOverloadFactor)
+	}
+	return nodeMemory
+}
+
+// estimateSegmentMemory estimates the resident memory of segment after loaded.
+// For the field which has index, the index size is used, otherwise the field data size is used.
+// Only a part of data is resident in memory if mmap is enabled.
+func estimateSegmentMemory(s *meta.Segment) int {
+	mmapEnabled := paramtable.Get().QueryNodeCfg.MmapEnabled.GetAsBool()
+	mmapResidentRatio := params.Params.QueryCoordCfg.BalanceMmapResidentRatio.GetAsFloat()
+	residentSize := func(size int64, mmap bool) float64 {
+		if mmap {
+			return float64(size) * mmapResidentRatio
+		}
+		return float64(size)
+	}
+
+	memory := 0.0
+	for _, fieldBinlog := range s.GetBinlogs() {
+		if indexInfo, ok := s.IndexInfo[fieldBinlog.GetFieldID()]; ok && indexInfo.GetIndexSize() > 0 {
+			mmap := mmapEnabled || common.IsMmapEnabled(indexInfo.GetIndexParams()...)
+			memory += residentSize(indexInfo.GetIndexSize(), mmap)
+			continue
+		}
+		memory += residentSize(getBinlogMemorySize(fieldBinlog), mmapEnabled)
+	}
+	for _, fieldBinlog := range s.GetStatslogs() {
+		memory += float64(getBinlogMemorySize(fieldBinlog))
+	}
+	for _, fieldBinlog := range s.GetDeltalogs() {
+		memory += float64(getBinlogMemorySize(fieldBinlog))
+	}
+	return int(memory)
+}
+
+// getBinlogMemorySize returns the memory size of binlogs, the log size is used for old binlog without memory size.
+func getBinlogMemorySize(fieldBinlog *datapb.FieldBinlog) int64 {
+	size := int64(0)
+	for _, binlog := range fieldBinlog.GetBinlogs() {
+		if binlog.GetMemorySize() > 0 {
+			size += binlog.GetMemorySize()
+		} else {
+			size += binlog.GetLogSize()
+		}
+	}
+	return size
+}
+
+func (b *MemoryAwareBalancer) BalanceReplica(replica *meta.Replica) ([]SegmentAssignPlan, []ChannelAssignPlan) {
+	log := log.With(
+		zap.Int64("collection", replica.GetCollectionID()),
+		zap.Int64("replica id", replica.GetID()),
+		zap.String("replica group", replica.GetResourceGroup()),
+	)
+	if replica.NodesCount() == 0 {
+		return nil, nil
+	}
+
+	rwNodes := replica.GetRWNodes()
+	roNodes := replica.GetRONodes()
+
+	if len(rwNodes) == 0 {
+		// no available nodes to balance
+		return nil, nil
+	}
+
+	segmentPlans, channelPlans := make([]SegmentAssignPlan, 0), make([]ChannelAssignPlan, 0)
+	if len(roNodes) != 0 {
+		if !paramtable.Get().QueryCoordCfg.EnableStoppingBalance.GetAsBool() {
+			log.RatedInfo(10, "stopping balance is disabled!", zap.Int64s("stoppingNode", roNodes))
+			return nil, nil
+		}
+
+		log.Info("Handle stopping nodes",
+			zap.Any("stopping nodes", roNodes),
+			zap.Any("available nodes", rwNodes),
+		)
+		// handle stopped nodes here, have to assign segments on stopping nodes to nodes with the least memory
+		channelPlans = append(channelPlans, b.genStoppingChannelPlan(replica, rwNodes, roNodes)...)
+		if len(channelPlans) == 0 {
+			segmentPlans = append(segmentPlans, b.genStoppingSegmentPlan(replica, rwNodes, roNodes)...)
+		}
+	} else {
+		if paramtable.Get().QueryCoordCfg.AutoBalanceChannel.GetAsBool() {
+			channelPlans = append(channelPlans, b.genChannelPlan(replica, rwNodes)...)
+		}
+
+		if len(channelPlans) == 0 {
+			segmentPlans = append(segmentPlans, b.genSegmentPlan(replica, rwNodes)...)
+		}
+	}
+
+	return segmentPlans, channelPlans
+}
+
+func (b *MemoryAwareBalancer) genStoppingSegmentPlan(replica *meta.Replica, onlineNodes []int64, offlineNodes []int64) []SegmentAssignPlan {
+	segmentPlans := make([]SegmentAssignPlan, 0)
+	for _, nodeID := range offlineNodes {
+		dist := b.dist.SegmentDistManager.GetByFilter(meta.WithCollectionID(replica.GetCollectionID()), meta.WithNodeID(nodeID))
+		segments := lo.Filter(dist, func(segment *meta.Segment, _ int) bool {
+			return b.targetMgr.GetSealedSegment(segment.GetCollectionID(), segment.GetID(), meta.CurrentTarget) != nil &&
+				b.targetMgr.GetSealedSegment(segment.GetCollectionID(), segment.GetID(), meta.NextTarget) != nil &&
+				segment.GetLevel() != datapb.SegmentLevel_L0
+		})
+		plans := b.AssignSegment(replica.GetCollectionID(), segments, onlineNodes, false)
+		for i := range plans {
+			plans[i].From = nodeID
+			plans[i].Replica = replica
+		}
+		segmentPlans = append(segmentPlans, plans...)
+	}
+	return segmentPlans
+}
+
+func (b *MemoryAwareBalancer) genSegmentPlan(replica *meta.Replica, onlineNodes []int64) []SegmentAssignPlan {
+	segmentDist := make(map[int64][]*meta.Segment)
+	nodeMemory := make(map[int64]int, 0)
+	totalMemory := 0
+
+	// list all segment which could be balanced, and calculate node's memory
+	for _, node := range onlineNodes {
+		dist := b.dist.SegmentDistManager.GetByFilter(meta.WithCollectionID(replica.GetCollectionID()), meta.WithNodeID(node))
+		segments := lo.Filter(dist, func(segment *meta.Segment, _ int) bool {
+			return b.targetMgr.GetSealedSegment(segment.GetCollectionID(), segment.GetID(), meta.CurrentTarget) != nil &&
+				b.targetMgr.GetSealedSegment(segment.GetCollectionID(), segment.GetID(), meta.NextTarget) != nil &&
+				segment.GetLevel() != datapb.SegmentLevel_L0
+		})
+		segmentDist[node] = segments
+
+		memory := b.calculateMemory(node)
+		totalMemory += memory
+		nodeMemory[node] = memory
+	}
+
+	if totalMemory == 0 {
+		return nil
+	}
+
+	// find the segment from the node which has more memory than the average
+	segmentsToMove := make([]*meta.Segment, 0)
+	average := totalMemory / len(onlineNodes)
+	for node, segments := range segmentDist {
+		leftMemory := nodeMemory[node]
+		if leftMemory <= average {
+			continue
+		}
+
+		sort.Slice(segments, func(i, j int) bool {
+			return estimateSegmentMemory(segments[i]) < estimateSegmentMemory(segments[j])
+		})
+		for _, s := range segments {
+			segmentsToMove = append(segmentsToMove, s)
+			leftMemory -= estimateSegmentMemory(s)
+			if leftMemory <= average {
+				break
+			}
+		}
+	}
+
+	// if the segment are redundant, skip it's balance for now
+	segmentsToMove = lo.Filter(segmentsToMove, func(s *meta.Segment, _ int) bool {
+		return len(b.dist.SegmentDistManager.GetByFilter(meta.WithReplica(replica), meta.WithSegmentID(s.GetID()))) == 1
+	})
+
+	if len(segmentsToMove) == 0 {
+		return nil
+	}
+
+	segmentPlans := b.AssignSegment(replica.GetCollectionID(), segmentsToMove, onlineNodes, false)
+	for i := range segmentPlans {
+		segmentPlans[i].From = segmentPlans[i].Segment.Node
+		segmentPlans[i].Replica = replica
+	}
+
+	return segmentPlans
+}
@@ -0,0 +1,237 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package balance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/internal/querycoordv2/task"
+	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/kv"
+	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type MemoryAwareBalancerTestSuite struct {
+	suite.Suite
+	balancer      *MemoryAwareBalancer
+	kv            kv.MetaKv
+	broker        *meta.MockBroker
+	mockScheduler *task.MockScheduler
+}
+
+func (suite *MemoryAwareBalancerTestSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (suite *MemoryAwareBalancerTestSuite) SetupTest() {
+	var err error
+	config := GenerateEtcdConfig()
+	cli, err := etcd.GetEtcdClient(
+		config.UseEmbedEtcd.GetAsBool(),
+		config.EtcdUseSSL.GetAsBool(),
+		config.Endpoints.GetAsStrings(),
+		config.EtcdTLSCert.GetValue(),
+		config.EtcdTLSKey.GetValue(),
+		config.EtcdTLSCACert.GetValue(),
+		config.EtcdTLSMinVersion.GetValue())
+	suite.Require().NoError(err)
+	suite.kv = etcdkv.NewEtcdKV(cli, config.MetaRootPath.GetValue())
+	suite.broker = meta.NewMockBroker(suite.T())
+
+	store := querycoord.NewCatalog(suite.kv)
+	idAllocator := RandomIncrementIDAllocator()
+	nodeManager := session.NewNodeManager()
+	testMeta := meta.NewMeta(idAllocator, store, nodeManager)
+	testTarget := meta.NewTargetManager(suite.broker, testMeta)
+
+	distManager := meta.NewDistributionManager()
+	suite.mockScheduler = task.NewMockScheduler(suite.T())
+	suite.balancer = NewMemoryAwareBalancer(suite.mockScheduler, nodeManager, distManager, testMeta, testTarget)
+
+	suite.mockScheduler.EXPECT().GetSegmentTaskDelta(mock.Anything, mock.Anything).Return(0).Maybe()
+	suite.mockScheduler.EXPECT().GetChannelTaskDelta(mock.Anything, mock.Anything).Return(0).Maybe()
+}
+
+func (suite *MemoryAwareBalancerTestSuite) TearDownTest() {
+	suite.kv.Close()
+}
+
+func newTestMemorySegment(id int64, collectionID int64, numOfRows int64, memorySize int64, node int64) *meta.Segment {
+	return &meta.Segment{
+		SegmentInfo: &datapb.SegmentInfo{
+			ID:           id,
+			CollectionID: collectionID,
+			NumOfRows:    numOfRows,
+			Binlogs: []*datapb.FieldBinlog{
+				{FieldID: 101, Binlogs: []*datapb.Binlog{{MemorySize: memorySize}}},
+			},
+		},
+		Node: node,
+	}
+}
+
+func (suite *MemoryAwareBalancerTestSuite) TestEstimateSegmentMemory() {
+	segment := &meta.Segment{
+		SegmentInfo: &datapb.SegmentInfo{
+			ID: 1,
+			Binlogs: []*datapb.FieldBinlog{
+				{FieldID: 100, Binlogs: []*datapb.Binlog{{MemorySize: 100}, {LogSize: 50}}},
+				{FieldID: 101, Binlogs: []*datapb.Binlog{{MemorySize: 1000}}},
+			},
+			Statslogs: []*datapb.FieldBinlog{
+				{FieldID: 100, Binlogs: []*datapb.Binlog{{MemorySize: 10}}},
+			},
+			Deltalogs: []*datapb.FieldBinlog{
+				{FieldID: 100, Binlogs: []*datapb.Binlog{{MemorySize: 20}}},
+			},
+		},
+	}
+	suite.Equal(1180, estimateSegmentMemory(segment))
+
+	// index size is used for the field which has index
+	segment.IndexInfo = map[int64]*querypb.FieldIndexInfo{
+		101: {FieldID: 101, IndexSize: 3000},
+	}
+	suite.Equal(3180, estimateSegmentMemory(segment))
+
+	// only a part of mmap index is resident in memory
+	segment.IndexInfo[101].IndexParams = []*commonpb.KeyValuePair{{Key: common.MmapEnabledKey, Value: "true"}}
+	suite.Equal(480, estimateSegmentMemory(segment))
+
+	paramtable.Get().Save(paramtable.Get().QueryNodeCfg.MmapEnabled.Key, "true")
+	defer paramtable.Get().Reset(paramtable.Get().QueryNodeCfg.MmapEnabled.Key)
+	suite.Equal(345, estimateSegmentMemory(segment))
+}
+
+func (suite *MemoryAwareBalancerTestSuite) TestAssignSegment() {
+	balancer := suite.balancer
+	for _, nodeID := range []int64{1, 2, 3} {
+		nodeInfo := session.NewNodeInfo(session.ImmutableNodeInfo{
+			NodeID:   nodeID,
+			Address:  "127.0.0.1:0",
+			Hostname: "localhost",
+		})
+		nodeInfo.SetState(session.NodeStateNormal)
+		balancer.nodeManager.Add(nodeInfo)
+	}
+	// node 1 hold few rows with big index, node 2 hold many rows with small index
+	balancer.dist.SegmentDistManager.Update(1, newTestMemorySegment(1, 1, 10, 5000, 1))
+	balancer.dist.SegmentDistManager.Update(2, newTestMemorySegment(2, 1, 1000, 1000, 2))
+	balancer.nodeManager.Get(3).SetState(session.NodeStateStopping)
+
+	plans := balancer.AssignSegment(1, []*meta.Segment{
+		newTestMemorySegment(3, 1, 100, 2000, 0),
+		newTestMemorySegment(4, 1, 100, 3000, 0),
+	}, []int64{1, 2, 3}, false)
+	assertSegmentAssignPlanElementMatch(&suite.Suite, []SegmentAssignPlan{
+		{Segment: newTestMemorySegment(4, 1, 100, 3000, 0), From: -1, To: 2},
+		{Segment: newTestMemorySegment(3, 1, 100, 2000, 0), From: -1, To: 2},
+	}, plans)
+}
+
+func (suite *MemoryAwareBalancerTestSuite) TestAssignSegmentWithWatermark() {
+	balancer := suite.balancer
+	for _, nodeID := range []int64{1, 2} {
+		nodeInfo := session.NewNodeInfo(session.ImmutableNodeInfo{
+			NodeID:   nodeID,
+			Address:  "127.0.0.1:0",
+			Hostname: "localhost",
+		})
+		nodeInfo.SetState(session.NodeStateNormal)
+		nodeInfo.UpdateStats(session.WithMemCapacity(1))
+		balancer.nodeManager.Add(nodeInfo)
+	}
+
+	// each node can only hold one segment under watermark
+	segments := []*meta.Segment{
+		newTestMemorySegment(1, 1, 10, 800*1024, 0),
+		newTestMemorySegment(2, 1, 10, 800*1024, 0),
+		newTestMemorySegment(3, 1, 10, 800*1024, 0),
+	}
+	plans := balancer.AssignSegment(1, segments, []int64{1, 2}, false)
+	suite.Len(plans, 2)
+	suite.NotEqual(plans[0].To, plans[1].To)
+
+	// watermark is skipped for manual balance
+	plans = balancer.AssignSegment(1, segments, []int64{1, 2}, true)
+	suite.Len(plans, 3)
+}
+
+func (suite *MemoryAwareBalancerTestSuite) TestBalanceOneRound() {
+	balancer := suite.balancer
+	collectionID, replicaID := int64(1), int64(1)
+	nodes := []int64{1, 2}
+
+	collection := utils.CreateTestCollection(collectionID, int32(replicaID))
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, collectionID).Return(
+		nil, []*datapb.SegmentInfo{
+			{ID: 1, PartitionID: 1}, {ID: 2, PartitionID: 1}, {ID: 3, PartitionID: 1},
+		}, nil)
+	suite.broker.EXPECT().GetPartitions(mock.Anything, collectionID).Return([]int64{collectionID}, nil).Maybe()
+	collection.LoadPercentage = 100
+	collection.Status = querypb.LoadStatus_Loaded
+	balancer.meta.CollectionManager.PutCollection(collection)
+	balancer.meta.CollectionManager.PutPartition(utils.CreateTestPartition(collectionID, collectionID))
+	balancer.meta.ReplicaManager.Put(utils.CreateTestReplica(replicaID, collectionID, nodes))
+	balancer.targetMgr.UpdateCollectionNextTarget(collectionID)
+	balancer.targetMgr.UpdateCollectionCurrentTarget(collectionID)
+	balancer.targetMgr.UpdateCollectionNextTarget(collectionID)
+
+	// row count looks even, but node 1 holds much more memory than node 2
+	balancer.dist.SegmentDistManager.Update(1,
+		newTestMemorySegment(1, collectionID, 10, 1000, 1),
+		newTestMemorySegment(2, collectionID, 10, 500, 1),
+	)
+	balancer.dist.SegmentDistManager.Update(2,
+		newTestMemorySegment(3, collectionID, 1000, 100, 2),
+	)
+	for _, node := range nodes {
+		nodeInfo := session.NewNodeInfo(session.ImmutableNodeInfo{
+			NodeID:   node,
+			Address:  "127.0.0.1:0",
+			Hostname: "localhost",
+		})
+		nodeInfo.SetState(session.NodeStateNormal)
+		balancer.nodeManager.Add(nodeInfo)
+		balancer.meta.ResourceManager.HandleNodeUp(node)
+	}
+	utils.RecoverAllCollection(balancer.meta)
+
+	replica := balancer.meta.ReplicaManager.GetByCollection(collectionID)[0]
+	segmentPlans, channelPlans := balancer.BalanceReplica(replica)
+	suite.Len(channelPlans, 0)
+	assertSegmentAssignPlanElementMatch(&suite.Suite, []SegmentAssignPlan{
+		{Segment: newTestMemorySegment(1, collectionID, 10, 1000, 1), From: 1, To: 2, Replica: replica},
+	}, segmentPlans)
+}
+
+func TestMemoryAwareBalancerSuite(t *testing.T) {
+	suite.Run(t, new(MemoryAwareBalancerTestSuite))
+}
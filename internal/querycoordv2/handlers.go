@@ -18,6 +18,8 @@ package querycoordv2
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -236,6 +238,61 @@ func (s *Server) balanceChannels(ctx context.Context,
 	return nil
 }
 
+// balancePlanReason returns the reason of balance plan, plans from stopping node are generated by stopping balance.
+func balancePlanReason(replica *meta.Replica, from int64, defaultReason string) string {
+	if replica.ContainRONode(from) {
+		return fmt.Sprintf("node %d is stopping", from)
+	}
+	return defaultReason
+}
+
+// estimateNodeLoads computes the segment num, row count and channel num of nodes before and after applying the balance plans.
+func (s *Server) estimateNodeLoads(nodes []int64, segmentPlans []*querypb.SegmentBalancePlan, channelPlans []*querypb.ChannelBalancePlan) []*querypb.NodeBalanceLoad {
+	loads := make(map[int64]*querypb.NodeBalanceLoad, len(nodes))
+	getLoad := func(nodeID int64) *querypb.NodeBalanceLoad {
+		load, ok := loads[nodeID]
+		if !ok {
+			load = &querypb.NodeBalanceLoad{NodeID: nodeID}
+			for _, segment := range s.dist.SegmentDistManager.GetByFilter(meta.WithNodeID(nodeID)) {
+				load.SegmentNumBefore++
+				load.RowCountBefore += segment.GetNumOfRows()
+			}
+			load.ChannelNumBefore = int64(len(s.dist.ChannelDistManager.GetByFilter(meta.WithNodeID2Channel(nodeID))))
+			load.SegmentNumAfter = load.SegmentNumBefore
+			load.RowCountAfter = load.RowCountBefore
+			load.ChannelNumAfter = load.ChannelNumBefore
+			loads[nodeID] = load
+		}
+		return load
+	}
+
+	for _, node := range nodes {
+		getLoad(node)
+	}
+	for _, plan := range segmentPlans {
+		if plan.GetSourceNodeID() != -1 {
+			source := getLoad(plan.GetSourceNodeID())
+			source.SegmentNumAfter--
+			source.RowCountAfter -= plan.GetNumOfRows()
+		}
+		target := getLoad(plan.GetTargetNodeID())
+		target.SegmentNumAfter++
+		target.RowCountAfter += plan.GetNumOfRows()
+	}
+	for _, plan := range channelPlans {
+		if plan.GetSourceNodeID() != -1 {
+			getLoad(plan.GetSourceNodeID()).ChannelNumAfter--
+		}
+		getLoad(plan.GetTargetNodeID()).ChannelNumAfter++
+	}
+
+	ret := lo.Values(loads)
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].GetNodeID() < ret[j].GetNodeID()
+	})
+	return ret
+}
+
 // TODO(dragondriver): add more detail metrics
 func (s *Server) getSystemInfoMetrics(
 	ctx context.Context,
@@ -188,6 +188,11 @@ func (job *LoadCollectionJob) Execute() error {
 		}
 	})
 
+	// the query nodes keep the schema which the collection is loaded with at first
+	loadFields := getLoadFields(req.GetSchema())
+	if coll := job.meta.CollectionManager.GetCollection(req.GetCollectionID()); coll != nil {
+		loadFields = coll.GetLoadFields()
+	}
 	ctx, sp := otel.Tracer(typeutil.QueryCoordRole).Start(job.ctx, "LoadCollection", trace.WithNewRoot())
 	collection := &meta.Collection{
 		CollectionLoadInfo: &querypb.CollectionLoadInfo{
@@ -196,6 +201,7 @@ func (job *LoadCollectionJob) Execute() error {
 			Status:        querypb.LoadStatus_Loading,
 			FieldIndexID:  req.GetFieldIndexID(),
 			LoadType:      querypb.LoadType_LoadCollection,
+			LoadFields:    loadFields,
 		},
 		CreatedAt: time.Now(),
 		LoadSpan:  sp,
@@ -207,7 +213,7 @@ func (job *LoadCollectionJob) Execute() error {
 		log.Warn(msg, zap.Error(err))
 		return errors.Wrap(err, msg)
 	}
-	eventlog.Record(eventlog.NewRawEvt(eventlog.Level_Info, fmt.Sprintf("Start load collection %d", collection.CollectionID)))
+	eventlog.Record(eventlog.NewCollectionRawEvt(eventlog.Level_Info, collection.CollectionID, fmt.Sprintf("Start load collection %d", collection.CollectionID)))
 	metrics.QueryCoordNumPartitions.WithLabelValues().Add(float64(len(partitions)))
 
 	// 5. update next target, no need to rollback if pull target failed, target observer will pull target in periodically
@@ -381,6 +387,7 @@ func (job *LoadPartitionJob) Execute() error {
 				Status:        querypb.LoadStatus_Loading,
 				FieldIndexID:  req.GetFieldIndexID(),
 				LoadType:      querypb.LoadType_LoadPartition,
+				LoadFields:    getLoadFields(req.GetSchema()),
 			},
 			CreatedAt: time.Now(),
 			LoadSpan:  sp,
@@ -27,6 +27,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/rgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/metastore"
 	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
@@ -229,6 +230,9 @@ func (suite *JobSuite) TestLoadCollection() {
 			CollectionID: collection,
 			// It will be set to 1
 			// ReplicaNumber: 1,
+			Schema: &schemapb.CollectionSchema{
+				Fields: []*schemapb.FieldSchema{{FieldID: 100}, {FieldID: 101}},
+			},
 		}
 		job := NewLoadCollectionJob(
 			ctx,
@@ -246,6 +250,7 @@ func (suite *JobSuite) TestLoadCollection() {
 		err := job.Wait()
 		suite.NoError(err)
 		suite.EqualValues(1, suite.meta.GetReplicaNumber(collection))
+		suite.ElementsMatch([]int64{100, 101}, suite.meta.CollectionManager.GetCollection(collection).GetLoadFields())
 		suite.targetMgr.UpdateCollectionCurrentTarget(collection)
 		suite.assertCollectionLoaded(collection)
 	}
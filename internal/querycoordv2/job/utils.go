@@ -147,3 +147,10 @@ func releasePartitions(ctx context.Context,
 		}
 	}
 }
+
+// getLoadFields returns the ids of the fields in the schema which the collection is loaded with.
+func getLoadFields(schema *schemapb.CollectionSchema) []int64 {
+	return lo.Map(schema.GetFields(), func(field *schemapb.FieldSchema, _ int) int64 {
+		return field.GetFieldID()
+	})
+}
@@ -501,7 +501,7 @@ func (m *CollectionManager) UpdateLoadPercent(partitionID int64, loadPercent int
 		newPartition.RecoverTimes = 0
 		elapsed := time.Since(newPartition.CreatedAt)
 		metrics.QueryCoordLoadLatency.WithLabelValues().Observe(float64(elapsed.Milliseconds()))
-		eventlog.Record(eventlog.NewRawEvt(eventlog.Level_Info, fmt.Sprintf("Partition %d loaded", partitionID)))
+		eventlog.Record(eventlog.NewCollectionRawEvt(eventlog.Level_Info, newPartition.GetCollectionID(), fmt.Sprintf("Partition %d loaded", partitionID)))
 	}
 	err := m.putPartition([]*Partition{newPartition}, savePartition)
 	if err != nil {
@@ -533,7 +533,7 @@ func (m *CollectionManager) UpdateLoadPercent(partitionID int64, loadPercent int
 		metrics.QueryCoordNumCollections.WithLabelValues().Inc()
 		elapsed := time.Since(newCollection.CreatedAt)
 		metrics.QueryCoordLoadLatency.WithLabelValues().Observe(float64(elapsed.Milliseconds()))
-		eventlog.Record(eventlog.NewRawEvt(eventlog.Level_Info, fmt.Sprintf("Collection %d loaded", newCollection.CollectionID)))
+		eventlog.Record(eventlog.NewCollectionRawEvt(eventlog.Level_Info, newCollection.CollectionID, fmt.Sprintf("Collection %d loaded", newCollection.CollectionID)))
 	}
 	return collectionPercent, m.putCollection(saveCollection, newCollection)
 }
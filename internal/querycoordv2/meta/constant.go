@@ -22,4 +22,5 @@ const (
 	ScoreBasedBalancerName        = "ScoreBasedBalancer"
 	MultiTargetBalancerName       = "MultipleTargetBalancer"
 	ChannelLevelScoreBalancerName = "ChannelLevelScoreBalancer"
+	MemoryAwareBalancerName       = "MemoryAwareBalancer"
 )
@@ -0,0 +1,272 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoordv2
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+const defaultDrainCheckInterval = 3 * time.Second
+
+// drainTask records the progress of draining a query node.
+type drainTask struct {
+	nodeID          int64
+	state           querypb.DrainState
+	reason          string
+	startTime       time.Time
+	totalSegmentNum int64
+	totalChannelNum int64
+	// suspended is true if the node was suspended by the drain task, it will be resumed on cancel.
+	suspended bool
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// nodeDrainer moves all segments and channels off the draining query nodes.
+// The node is suspended first to stop new assignments, then every segment and channel is
+// transferred by a balance task which loads it on the target node before releasing it on the source,
+// so the replica keeps serviceable during the whole drain.
+type nodeDrainer struct {
+	server   *Server
+	interval time.Duration
+
+	mu    sync.Mutex
+	tasks map[int64]*drainTask
+}
+
+func newNodeDrainer(server *Server) *nodeDrainer {
+	return &nodeDrainer{
+		server:   server,
+		interval: defaultDrainCheckInterval,
+		tasks:    make(map[int64]*drainTask),
+	}
+}
+
+// Drain starts to drain the given node, it's a no-op if the node is draining already.
+func (d *nodeDrainer) Drain(nodeID int64) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	prev, ok := d.tasks[nodeID]
+	if ok && prev.state == querypb.DrainState_Draining {
+		return nil
+	}
+
+	node := d.server.nodeMgr.Get(nodeID)
+	if node == nil {
+		return merr.WrapErrNodeNotFound(nodeID)
+	}
+
+	t := &drainTask{
+		nodeID:    nodeID,
+		state:     querypb.DrainState_Draining,
+		startTime: time.Now(),
+		done:      make(chan struct{}),
+	}
+	switch node.GetState() {
+	case session.NodeStateNormal:
+		if err := d.server.nodeMgr.Suspend(nodeID); err != nil {
+			return err
+		}
+		t.suspended = true
+	case session.NodeStateSuspend:
+		// keep resuming the node on cancel if it was suspended by a previous drain
+		t.suspended = ok && prev.suspended && prev.state != querypb.DrainState_DrainCanceled
+	default:
+		return merr.WrapErrNodeStateUnexpected(nodeID, node.GetState().String(), "failed to drain query node")
+	}
+	segmentNum, channelNum := d.remaining(nodeID)
+	t.totalSegmentNum, t.totalChannelNum = int64(segmentNum), int64(channelNum)
+
+	ctx, cancel := context.WithCancel(d.server.ctx)
+	t.cancel = cancel
+	d.tasks[nodeID] = t
+
+	d.server.wg.Add(1)
+	go func() {
+		defer d.server.wg.Done()
+		d.run(ctx, t)
+	}()
+	return nil
+}
+
+// Cancel stops draining the given node, the segments and channels moved already won't be moved back.
+func (d *nodeDrainer) Cancel(nodeID int64) error {
+	d.mu.Lock()
+	t, ok := d.tasks[nodeID]
+	if !ok || t.state != querypb.DrainState_Draining {
+		d.mu.Unlock()
+		return merr.WrapErrNodeStateUnexpected(nodeID, "not draining", "failed to cancel drain")
+	}
+	t.state = querypb.DrainState_DrainCanceled
+	t.reason = "canceled by user"
+	d.mu.Unlock()
+
+	t.cancel()
+	<-t.done
+
+	if t.suspended {
+		if err := d.server.nodeMgr.Resume(nodeID); err != nil {
+			log.Warn("failed to resume node after drain canceled", zap.Int64("nodeID", nodeID), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// Progress returns the drain progress of the given node.
+func (d *nodeDrainer) Progress(nodeID int64) *querypb.GetDrainNodeProgressResponse {
+	segmentNum, channelNum := d.remaining(nodeID)
+	resp := &querypb.GetDrainNodeProgressResponse{
+		Status:              merr.Success(),
+		NodeID:              nodeID,
+		State:               querypb.DrainState_DrainNone,
+		RemainingSegmentNum: int64(segmentNum),
+		RemainingChannelNum: int64(channelNum),
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	t, ok := d.tasks[nodeID]
+	if !ok {
+		return resp
+	}
+	resp.State = t.state
+	resp.Reason = t.reason
+	resp.StartTime = t.startTime.UnixMilli()
+	resp.TotalSegmentNum = t.totalSegmentNum
+	resp.TotalChannelNum = t.totalChannelNum
+	resp.SafeToRemove = t.state == querypb.DrainState_DrainCompleted
+	return resp
+}
+
+func (d *nodeDrainer) run(ctx context.Context, t *drainTask) {
+	defer close(t.done)
+	log := log.Ctx(ctx).With(zap.Int64("nodeID", t.nodeID))
+	log.Info("start to drain query node")
+
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+	for {
+		if d.server.nodeMgr.Get(t.nodeID) == nil {
+			log.Warn("query node went offline during drain")
+			d.finish(t, querypb.DrainState_DrainFailed, "node is offline")
+			return
+		}
+
+		d.drainOnce(ctx, t)
+
+		segmentNum, channelNum := d.remaining(t.nodeID)
+		if segmentNum == 0 && channelNum == 0 {
+			log.Info("drain query node done, the node is safe to remove")
+			d.finish(t, querypb.DrainState_DrainCompleted, "")
+			return
+		}
+		log.Info("query node still has data to drain",
+			zap.Int("segmentNum", segmentNum),
+			zap.Int("channelNum", channelNum))
+
+		select {
+		case <-ctx.Done():
+			log.Info("drain query node stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// drainOnce moves all channels and segments in current target off the node, channels go first
+// so that the segments are loaded by the new shard leader.
+func (d *nodeDrainer) drainOnce(ctx context.Context, t *drainTask) {
+	s := d.server
+	for _, replica := range s.meta.ReplicaManager.GetByNode(t.nodeID) {
+		log := log.Ctx(ctx).With(
+			zap.Int64("nodeID", t.nodeID),
+			zap.Int64("collectionID", replica.GetCollectionID()),
+			zap.Int64("replicaID", replica.GetID()))
+
+		dstNodes := lo.Filter(replica.GetRWNodes(), func(node int64, _ int) bool {
+			info := s.nodeMgr.Get(node)
+			return node != t.nodeID && info != nil && info.GetState() == session.NodeStateNormal
+		})
+		if len(dstNodes) == 0 {
+			log.Warn("no available node to drain to in replica")
+			d.setReason(t, fmt.Sprintf("no available node in replica %d", replica.GetID()))
+			continue
+		}
+
+		channels := s.dist.ChannelDistManager.GetByCollectionAndFilter(replica.GetCollectionID(), meta.WithNodeID2Channel(t.nodeID))
+		channels = lo.Filter(channels, func(ch *meta.DmChannel, _ int) bool {
+			return s.targetMgr.GetDmChannel(ch.GetCollectionID(), ch.GetChannelName(), meta.CurrentTarget) != nil
+		})
+		if len(channels) > 0 {
+			if err := s.balanceChannels(ctx, replica.GetCollectionID(), replica, t.nodeID, dstNodes, channels, true, false); err != nil {
+				log.Warn("failed to drain channels, will retry later", zap.Error(err))
+				d.setReason(t, err.Error())
+				continue
+			}
+		}
+
+		segments := s.dist.SegmentDistManager.GetByFilter(meta.WithCollectionID(replica.GetCollectionID()), meta.WithNodeID(t.nodeID))
+		segments = lo.Filter(segments, func(segment *meta.Segment, _ int) bool {
+			return s.targetMgr.GetSealedSegment(segment.GetCollectionID(), segment.GetID(), meta.CurrentTarget) != nil
+		})
+		if len(segments) > 0 {
+			if err := s.balanceSegments(ctx, replica.GetCollectionID(), replica, t.nodeID, dstNodes, segments, true, false); err != nil {
+				log.Warn("failed to drain segments, will retry later", zap.Error(err))
+				d.setReason(t, err.Error())
+			}
+		}
+	}
+}
+
+// remaining returns the count of segments and channels left on the node.
+func (d *nodeDrainer) remaining(nodeID int64) (int, int) {
+	segments := d.server.dist.SegmentDistManager.GetByFilter(meta.WithNodeID(nodeID))
+	channels := d.server.dist.ChannelDistManager.GetByFilter(meta.WithNodeID2Channel(nodeID))
+	return len(segments), len(channels)
+}
+
+func (d *nodeDrainer) setReason(t *drainTask, reason string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if t.state == querypb.DrainState_Draining {
+		t.reason = reason
+	}
+}
+
+func (d *nodeDrainer) finish(t *drainTask, state querypb.DrainState, reason string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	// the task may be canceled concurrently, keep the canceled state
+	if t.state == querypb.DrainState_Draining {
+		t.state = state
+		t.reason = reason
+	}
+}
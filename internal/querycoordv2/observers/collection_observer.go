@@ -347,5 +347,5 @@ func (ob *CollectionObserver) observePartitionLoadStatus(ctx context.Context, pa
 		zap.Int32("partitionLoadPercentage", loadPercentage),
 		zap.Int32("collectionLoadPercentage", collectionPercentage),
 	)
-	eventlog.Record(eventlog.NewRawEvt(eventlog.Level_Info, fmt.Sprintf("collection %d load percentage update: %d", partition.CollectionID, loadPercentage)))
+	eventlog.Record(eventlog.NewCollectionRawEvt(eventlog.Level_Info, partition.CollectionID, fmt.Sprintf("collection %d load percentage update: %d", partition.CollectionID, loadPercentage)))
 }
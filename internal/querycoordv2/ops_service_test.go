@@ -19,6 +19,7 @@ package querycoordv2
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/mock"
@@ -26,6 +27,8 @@ import (
 	"go.uber.org/atomic"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/rgpb"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/metastore"
 	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
@@ -145,6 +148,8 @@ func (suite *OpsServiceSuite) SetupTest() {
 		ctx:                 context.Background(),
 		checkerController:   suite.checkerController,
 	}
+	suite.server.nodeDrainer = newNodeDrainer(suite.server)
+	suite.server.nodeDrainer.interval = 10 * time.Millisecond
 	suite.server.collectionObserver = observers.NewCollectionObserver(
 		suite.server.dist,
 		suite.server.meta,
@@ -898,6 +903,270 @@ func (suite *OpsServiceSuite) TestTransferChannel() {
 	suite.Len(nodeSet.Collect(), 3)
 }
 
+func (suite *OpsServiceSuite) TestGetResourceGroupRecommendations() {
+	// test server unhealthy
+	suite.server.UpdateStateCode(commonpb.StateCode_Abnormal)
+	ctx := context.Background()
+	resp, err := suite.server.GetResourceGroupRecommendations(ctx, &querypb.GetResourceGroupRecommendationsRequest{})
+	suite.NoError(err)
+	suite.False(merr.Ok(resp.GetStatus()))
+
+	// test resource group not found
+	suite.server.UpdateStateCode(commonpb.StateCode_Healthy)
+	resp, err = suite.server.GetResourceGroupRecommendations(ctx, &querypb.GetResourceGroupRecommendationsRequest{
+		ResourceGroups: []string{"rg_not_exist"},
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrResourceGroupNotFound)
+
+	// test recommend by node metrics
+	err = suite.meta.ResourceManager.AddResourceGroup("rg1", &rgpb.ResourceGroupConfig{
+		Requests: &rgpb.ResourceGroupLimit{NodeNum: 1},
+		Limits:   &rgpb.ResourceGroupLimit{NodeNum: 3},
+	})
+	suite.NoError(err)
+	for _, nodeID := range []int64{1, 2} {
+		suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+			NodeID:   nodeID,
+			Address:  "localhost",
+			Hostname: "localhost",
+		}))
+		suite.meta.ResourceManager.HandleNodeUp(nodeID)
+	}
+	suite.cluster.EXPECT().GetMetrics(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, nodeID int64, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
+			infos, err := metricsinfo.MarshalComponentInfos(newTestQueryNodeInfos(nodeID, 90, 100, 10, 0))
+			suite.NoError(err)
+			return &milvuspb.GetMetricsResponse{
+				Status:   merr.Success(),
+				Response: infos,
+			}, nil
+		})
+	resp, err = suite.server.GetResourceGroupRecommendations(ctx, &querypb.GetResourceGroupRecommendationsRequest{})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Len(resp.GetRecommendations(), 2)
+	for _, r := range resp.GetRecommendations() {
+		suite.EqualValues(1, r.GetCurrentNodeNum())
+		suite.EqualValues(2, r.GetDesiredNodeNum())
+		suite.InDelta(0.9, r.GetMemoryUsageRatio(), 1e-6)
+	}
+
+	resp, err = suite.server.GetResourceGroupRecommendations(ctx, &querypb.GetResourceGroupRecommendationsRequest{
+		ResourceGroups: []string{"rg1"},
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Len(resp.GetRecommendations(), 1)
+	suite.Equal("rg1", resp.GetRecommendations()[0].GetResourceGroup())
+}
+
+func (suite *OpsServiceSuite) TestPreviewBalance() {
+	ctx := context.Background()
+
+	// test server unhealthy
+	suite.server.UpdateStateCode(commonpb.StateCode_Abnormal)
+	resp, err := suite.server.PreviewBalance(ctx, &querypb.PreviewBalanceRequest{})
+	suite.NoError(err)
+	suite.False(merr.Ok(resp.GetStatus()))
+
+	// test collection not loaded
+	suite.server.UpdateStateCode(commonpb.StateCode_Healthy)
+	resp, err = suite.server.PreviewBalance(ctx, &querypb.PreviewBalanceRequest{
+		CollectionIDs: []int64{1},
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrCollectionNotLoaded)
+
+	collectionID := int64(1)
+	partitionID := int64(1)
+	nodes := []int64{1, 2}
+	replica := utils.CreateTestReplica(1, collectionID, nodes)
+	suite.meta.ReplicaManager.Put(replica)
+	collection := utils.CreateTestCollection(collectionID, 1)
+	collection.Status = querypb.LoadStatus_Loaded
+	suite.meta.PutCollection(collection, utils.CreateTestPartition(partitionID, collectionID))
+
+	// test collection without current target is skipped
+	resp, err = suite.server.PreviewBalance(ctx, &querypb.PreviewBalanceRequest{})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Len(resp.GetSegmentPlans(), 0)
+
+	segments := []*datapb.SegmentInfo{
+		{ID: 1, CollectionID: collectionID, PartitionID: partitionID, InsertChannel: "channel-1", NumOfRows: 10},
+		{ID: 2, CollectionID: collectionID, PartitionID: partitionID, InsertChannel: "channel-1", NumOfRows: 10},
+	}
+	channels := []*datapb.VchannelInfo{{CollectionID: collectionID, ChannelName: "channel-1"}}
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, collectionID).Return(channels, segments, nil)
+	suite.targetMgr.UpdateCollectionNextTarget(collectionID)
+	suite.targetMgr.UpdateCollectionCurrentTarget(collectionID)
+	segmentInfos := lo.Map(segments, func(segment *datapb.SegmentInfo, _ int) *meta.Segment {
+		return &meta.Segment{SegmentInfo: segment, Node: nodes[0]}
+	})
+	suite.dist.SegmentDistManager.Update(nodes[0], segmentInfos...)
+	suite.dist.ChannelDistManager.Update(nodes[0], &meta.DmChannel{VchannelInfo: channels[0], Node: nodes[0]})
+
+	// test preview plans of balancer, and the plans should not be executed
+	balancer := balance.NewMockBalancer(suite.T())
+	suite.server.getBalancerFunc = func() balance.Balance { return balancer }
+	balancer.EXPECT().BalanceReplica(mock.Anything).Return([]balance.SegmentAssignPlan{
+		{Segment: segmentInfos[0], From: nodes[0], To: nodes[1], Replica: replica},
+	}, nil)
+	resp, err = suite.server.PreviewBalance(ctx, &querypb.PreviewBalanceRequest{})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Len(resp.GetSegmentPlans(), 1)
+	plan := resp.GetSegmentPlans()[0]
+	suite.EqualValues(1, plan.GetSegmentID())
+	suite.EqualValues(nodes[0], plan.GetSourceNodeID())
+	suite.EqualValues(nodes[1], plan.GetTargetNodeID())
+	suite.EqualValues(10, plan.GetNumOfRows())
+
+	suite.Len(resp.GetNodeLoads(), 2)
+	load1, load2 := resp.GetNodeLoads()[0], resp.GetNodeLoads()[1]
+	suite.EqualValues(nodes[0], load1.GetNodeID())
+	suite.EqualValues(2, load1.GetSegmentNumBefore())
+	suite.EqualValues(1, load1.GetSegmentNumAfter())
+	suite.EqualValues(20, load1.GetRowCountBefore())
+	suite.EqualValues(10, load1.GetRowCountAfter())
+	suite.EqualValues(1, load1.GetChannelNumAfter())
+	suite.EqualValues(nodes[1], load2.GetNodeID())
+	suite.EqualValues(0, load2.GetSegmentNumBefore())
+	suite.EqualValues(1, load2.GetSegmentNumAfter())
+	suite.EqualValues(10, load2.GetRowCountAfter())
+}
+
+func (suite *OpsServiceSuite) TestDrainNode() {
+	ctx := context.Background()
+
+	// test server unhealthy
+	suite.server.UpdateStateCode(commonpb.StateCode_Abnormal)
+	resp, err := suite.server.DrainNode(ctx, &querypb.DrainNodeRequest{})
+	suite.NoError(err)
+	suite.False(merr.Ok(resp))
+	resp, err = suite.server.CancelDrainNode(ctx, &querypb.CancelDrainNodeRequest{})
+	suite.NoError(err)
+	suite.False(merr.Ok(resp))
+	progress, err := suite.server.GetDrainNodeProgress(ctx, &querypb.GetDrainNodeProgressRequest{})
+	suite.NoError(err)
+	suite.False(merr.Ok(progress.GetStatus()))
+
+	// test node not found
+	suite.server.UpdateStateCode(commonpb.StateCode_Healthy)
+	resp, err = suite.server.DrainNode(ctx, &querypb.DrainNodeRequest{NodeID: 1})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrNodeNotFound)
+
+	// test cancel a node which is not draining
+	resp, err = suite.server.CancelDrainNode(ctx, &querypb.CancelDrainNodeRequest{NodeID: 1})
+	suite.NoError(err)
+	suite.False(merr.Ok(resp))
+
+	collectionID := int64(1)
+	partitionID := int64(1)
+	nodes := []int64{1, 2}
+	for _, node := range nodes {
+		suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+			NodeID:   node,
+			Address:  "localhost",
+			Hostname: "localhost",
+		}))
+	}
+	replica := utils.CreateTestReplica(1, collectionID, nodes)
+	suite.meta.ReplicaManager.Put(replica)
+	collection := utils.CreateTestCollection(collectionID, 1)
+	suite.meta.PutCollection(collection, utils.CreateTestPartition(partitionID, collectionID))
+
+	segments := []*datapb.SegmentInfo{
+		{ID: 1, CollectionID: collectionID, PartitionID: partitionID, InsertChannel: "channel-1", NumOfRows: 1},
+		{ID: 2, CollectionID: collectionID, PartitionID: partitionID, InsertChannel: "channel-1", NumOfRows: 1},
+	}
+	channels := []*datapb.VchannelInfo{{CollectionID: collectionID, ChannelName: "channel-1"}}
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, collectionID).Return(channels, segments, nil)
+	suite.targetMgr.UpdateCollectionNextTarget(collectionID)
+	suite.targetMgr.UpdateCollectionCurrentTarget(collectionID)
+	suite.dist.SegmentDistManager.Update(nodes[0], lo.Map(segments, func(segment *datapb.SegmentInfo, _ int) *meta.Segment {
+		return &meta.Segment{SegmentInfo: segment, Node: nodes[0]}
+	})...)
+	suite.dist.ChannelDistManager.Update(nodes[0], &meta.DmChannel{VchannelInfo: channels[0], Node: nodes[0]})
+
+	// block the drain until the moves are allowed to finish
+	allowMove := make(chan struct{})
+	suite.taskScheduler.EXPECT().Add(mock.Anything).RunAndReturn(func(t task.Task) error {
+		// each move must load on the target node before releasing on the source node
+		actions := t.Actions()
+		suite.Len(actions, 2)
+		suite.Equal(task.ActionTypeGrow, actions[0].Type())
+		suite.Equal(nodes[1], actions[0].Node())
+		suite.Equal(task.ActionTypeReduce, actions[1].Type())
+		suite.Equal(nodes[0], actions[1].Node())
+		go func() {
+			<-allowMove
+			switch t := t.(type) {
+			case *task.ChannelTask:
+				suite.dist.ChannelDistManager.Update(nodes[0])
+				suite.dist.ChannelDistManager.Update(nodes[1], &meta.DmChannel{VchannelInfo: channels[0], Node: nodes[1]})
+			case *task.SegmentTask:
+				remain := suite.dist.SegmentDistManager.GetByFilter(meta.WithNodeID(nodes[0]))
+				suite.dist.SegmentDistManager.Update(nodes[0], lo.Filter(remain, func(segment *meta.Segment, _ int) bool {
+					return segment.GetID() != t.SegmentID()
+				})...)
+			}
+			t.SetStatus(task.TaskStatusSucceeded)
+			t.Cancel(nil)
+		}()
+		return nil
+	})
+
+	resp, err = suite.server.DrainNode(ctx, &querypb.DrainNodeRequest{NodeID: nodes[0]})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp))
+	suite.Equal(session.NodeStateSuspend, suite.nodeMgr.Get(nodes[0]).GetState())
+
+	// drain again is a no-op
+	resp, err = suite.server.DrainNode(ctx, &querypb.DrainNodeRequest{NodeID: nodes[0]})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp))
+
+	progress, err = suite.server.GetDrainNodeProgress(ctx, &querypb.GetDrainNodeProgressRequest{NodeID: nodes[0]})
+	suite.NoError(err)
+	suite.True(merr.Ok(progress.GetStatus()))
+	suite.Equal(querypb.DrainState_Draining, progress.GetState())
+	suite.EqualValues(2, progress.GetTotalSegmentNum())
+	suite.EqualValues(1, progress.GetTotalChannelNum())
+	suite.False(progress.GetSafeToRemove())
+
+	close(allowMove)
+	suite.Eventually(func() bool {
+		progress, err := suite.server.GetDrainNodeProgress(ctx, &querypb.GetDrainNodeProgressRequest{NodeID: nodes[0]})
+		return err == nil && progress.GetState() == querypb.DrainState_DrainCompleted
+	}, 5*time.Second, 10*time.Millisecond)
+	progress, err = suite.server.GetDrainNodeProgress(ctx, &querypb.GetDrainNodeProgressRequest{NodeID: nodes[0]})
+	suite.NoError(err)
+	suite.True(progress.GetSafeToRemove())
+	suite.EqualValues(0, progress.GetRemainingSegmentNum())
+	suite.EqualValues(0, progress.GetRemainingChannelNum())
+	// the drained node keeps suspended until it's removed
+	suite.Equal(session.NodeStateSuspend, suite.nodeMgr.Get(nodes[0]).GetState())
+
+	// test cancel drain, the node should be resumed
+	// no node is available to drain to since the other node is suspended, so the drain will keep going
+	suite.dist.SegmentDistManager.Update(nodes[1], &meta.Segment{SegmentInfo: segments[0], Node: nodes[1]})
+	suite.server.nodeDrainer.interval = time.Hour
+	resp, err = suite.server.DrainNode(ctx, &querypb.DrainNodeRequest{NodeID: nodes[1]})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp))
+	resp, err = suite.server.CancelDrainNode(ctx, &querypb.CancelDrainNodeRequest{NodeID: nodes[1]})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp))
+	progress, err = suite.server.GetDrainNodeProgress(ctx, &querypb.GetDrainNodeProgressRequest{NodeID: nodes[1]})
+	suite.NoError(err)
+	suite.Equal(querypb.DrainState_DrainCanceled, progress.GetState())
+	suite.False(progress.GetSafeToRemove())
+	suite.Equal(session.NodeStateNormal, suite.nodeMgr.Get(nodes[1]).GetState())
+}
+
 func TestOpsService(t *testing.T) {
 	suite.Run(t, new(OpsServiceSuite))
 }
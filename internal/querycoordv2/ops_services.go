@@ -28,8 +28,10 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -458,3 +460,197 @@ func (s *Server) CheckQueryNodeDistribution(ctx context.Context, req *querypb.Ch
 
 	return merr.Success(), nil
 }
+
+// GetResourceGroupRecommendations returns the recommended node count of resource groups,
+// which is computed from the memory, cpu and qps pressure of query nodes in each resource group.
+func (s *Server) GetResourceGroupRecommendations(ctx context.Context, req *querypb.GetResourceGroupRecommendationsRequest) (*querypb.GetResourceGroupRecommendationsResponse, error) {
+	log := log.Ctx(ctx).With(zap.Strings("resourceGroups", req.GetResourceGroups()))
+	log.Info("GetResourceGroupRecommendations request received")
+
+	errMsg := "failed to get resource group recommendations"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.GetResourceGroupRecommendationsResponse{
+			Status: merr.Status(errors.Wrap(err, errMsg)),
+		}, nil
+	}
+
+	rgNames := req.GetResourceGroups()
+	if len(rgNames) == 0 {
+		rgNames = s.meta.ResourceManager.ListResourceGroups()
+	}
+	rgs := make([]*meta.ResourceGroup, 0, len(rgNames))
+	nodes := make([]*session.NodeInfo, 0)
+	for _, rgName := range rgNames {
+		rg := s.meta.ResourceManager.GetResourceGroup(rgName)
+		if rg == nil {
+			err := merr.WrapErrResourceGroupNotFound(rgName)
+			log.Warn(errMsg, zap.Error(err))
+			return &querypb.GetResourceGroupRecommendationsResponse{
+				Status: merr.Status(err),
+			}, nil
+		}
+		rgs = append(rgs, rg)
+		for _, nodeID := range rg.GetNodes() {
+			if node := s.nodeMgr.Get(nodeID); node != nil {
+				nodes = append(nodes, node)
+			}
+		}
+	}
+
+	metricReq, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.SystemInfoMetrics)
+	if err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.GetResourceGroupRecommendationsResponse{
+			Status: merr.Status(errors.Wrap(err, errMsg)),
+		}, nil
+	}
+	nodeMetrics := parseQueryNodeMetrics(s.tryGetNodesMetrics(ctx, metricReq, nodes...))
+
+	recommendations := make([]*querypb.ResourceGroupRecommendation, 0, len(rgs))
+	for _, rg := range rgs {
+		recommendation := recommendResourceGroup(rg, newResourceGroupPressure(rg, nodeMetrics))
+		if recommendation.GetDesiredNodeNum() != recommendation.GetCurrentNodeNum() {
+			log.Info("resource group node num recommended",
+				zap.String("resourceGroup", recommendation.GetResourceGroup()),
+				zap.Int32("current", recommendation.GetCurrentNodeNum()),
+				zap.Int32("desired", recommendation.GetDesiredNodeNum()),
+				zap.Strings("reasons", recommendation.GetReasons()))
+		}
+		recommendations = append(recommendations, recommendation)
+	}
+	return &querypb.GetResourceGroupRecommendationsResponse{
+		Status:          merr.Success(),
+		Recommendations: recommendations,
+	}, nil
+}
+
+// PreviewBalance runs the configured balancer against current distribution and returns the planned moves,
+// the plans won't be submitted to task scheduler.
+func (s *Server) PreviewBalance(ctx context.Context, req *querypb.PreviewBalanceRequest) (*querypb.PreviewBalanceResponse, error) {
+	log := log.Ctx(ctx).With(zap.Int64s("collectionIDs", req.GetCollectionIDs()))
+	log.Info("PreviewBalance request received")
+
+	errMsg := "failed to preview balance"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.PreviewBalanceResponse{
+			Status: merr.Status(errors.Wrap(err, errMsg)),
+		}, nil
+	}
+
+	collectionIDs := req.GetCollectionIDs()
+	if len(collectionIDs) == 0 {
+		collectionIDs = s.meta.CollectionManager.GetAll()
+	}
+	replicas := make([]*meta.Replica, 0)
+	for _, collectionID := range collectionIDs {
+		collection := s.meta.CollectionManager.GetCollection(collectionID)
+		if collection == nil {
+			err := merr.WrapErrCollectionNotLoaded(collectionID)
+			log.Warn(errMsg, zap.Error(err))
+			return &querypb.PreviewBalanceResponse{
+				Status: merr.Status(err),
+			}, nil
+		}
+		// balance is only triggered on loaded collection with current target
+		if collection.GetStatus() != querypb.LoadStatus_Loaded ||
+			!s.targetMgr.IsCurrentTargetExist(collectionID, common.AllPartitionsID) {
+			log.Info("collection is not ready to balance, skip it", zap.Int64("collectionID", collectionID))
+			continue
+		}
+		replicas = append(replicas, s.meta.ReplicaManager.GetByCollection(collectionID)...)
+	}
+
+	balancer := s.getBalancerFunc()
+	resp := &querypb.PreviewBalanceResponse{
+		Status:   merr.Success(),
+		Balancer: Params.QueryCoordCfg.Balancer.GetValue(),
+	}
+	nodes := typeutil.NewUniqueSet()
+	for _, replica := range replicas {
+		nodes.Insert(replica.GetNodes()...)
+		segmentPlans, channelPlans := balancer.BalanceReplica(replica)
+		for _, plan := range segmentPlans {
+			resp.SegmentPlans = append(resp.SegmentPlans, &querypb.SegmentBalancePlan{
+				SegmentID:    plan.Segment.GetID(),
+				CollectionID: plan.Segment.GetCollectionID(),
+				ReplicaID:    replica.GetID(),
+				SourceNodeID: plan.From,
+				TargetNodeID: plan.To,
+				NumOfRows:    plan.Segment.GetNumOfRows(),
+				Reason:       balancePlanReason(replica, plan.From, "segment unbalanced"),
+			})
+		}
+		for _, plan := range channelPlans {
+			resp.ChannelPlans = append(resp.ChannelPlans, &querypb.ChannelBalancePlan{
+				ChannelName:  plan.Channel.GetChannelName(),
+				CollectionID: plan.Channel.GetCollectionID(),
+				ReplicaID:    replica.GetID(),
+				SourceNodeID: plan.From,
+				TargetNodeID: plan.To,
+				Reason:       balancePlanReason(replica, plan.From, "channel unbalanced"),
+			})
+		}
+	}
+	resp.NodeLoads = s.estimateNodeLoads(nodes.Collect(), resp.GetSegmentPlans(), resp.GetChannelPlans())
+
+	log.Info("PreviewBalance done",
+		zap.Int("segmentPlanNum", len(resp.GetSegmentPlans())),
+		zap.Int("channelPlanNum", len(resp.GetChannelPlans())))
+	return resp, nil
+}
+
+// DrainNode moves all segments and channels off the given node, and the node will be marked as safe to remove
+// after that. the node is suspended during the drain, and each move loads data on target node before releasing on source.
+func (s *Server) DrainNode(ctx context.Context, req *querypb.DrainNodeRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.Int64("nodeID", req.GetNodeID()))
+	log.Info("DrainNode request received")
+
+	errMsg := "failed to drain query node"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(errors.Wrap(err, errMsg)), nil
+	}
+
+	if err := s.nodeDrainer.Drain(req.GetNodeID()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	return merr.Success(), nil
+}
+
+// CancelDrainNode stops draining the given node, and resumes the node if it's suspended by drain.
+func (s *Server) CancelDrainNode(ctx context.Context, req *querypb.CancelDrainNodeRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.Int64("nodeID", req.GetNodeID()))
+	log.Info("CancelDrainNode request received")
+
+	errMsg := "failed to cancel drain query node"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(errors.Wrap(err, errMsg)), nil
+	}
+
+	if err := s.nodeDrainer.Cancel(req.GetNodeID()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	return merr.Success(), nil
+}
+
+func (s *Server) GetDrainNodeProgress(ctx context.Context, req *querypb.GetDrainNodeProgressRequest) (*querypb.GetDrainNodeProgressResponse, error) {
+	log := log.Ctx(ctx).With(zap.Int64("nodeID", req.GetNodeID()))
+	log.Debug("GetDrainNodeProgress request received")
+
+	errMsg := "failed to get drain progress of query node"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.GetDrainNodeProgressResponse{
+			Status: merr.Status(errors.Wrap(err, errMsg)),
+		}, nil
+	}
+
+	return s.nodeDrainer.Progress(req.GetNodeID()), nil
+}
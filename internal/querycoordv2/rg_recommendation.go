@@ -0,0 +1,189 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoordv2
+
+import (
+	"fmt"
+	"math"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
+)
+
+// resourceGroupPressure is the pressure of a resource group aggregated from metrics of its query nodes.
+type resourceGroupPressure struct {
+	nodeNum         int // the count of nodes in resource group
+	reportedNodeNum int // the count of nodes which report metrics successfully
+	memoryUsed      uint64
+	memoryTotal     uint64
+	cpuUsage        float64 // the sum of cpu usage ratio of reported nodes
+	nqPerSecond     float64
+}
+
+// newResourceGroupPressure aggregates the pressure of given resource group from node metrics.
+func newResourceGroupPressure(rg *meta.ResourceGroup, nodeMetrics map[int64]*metricsinfo.QueryNodeInfos) *resourceGroupPressure {
+	pressure := &resourceGroupPressure{
+		nodeNum: rg.NodeNum(),
+	}
+	for _, nodeID := range rg.GetNodes() {
+		info, ok := nodeMetrics[nodeID]
+		if !ok {
+			continue
+		}
+		hms := info.HardwareInfos
+		if info.QuotaMetrics != nil {
+			hms = info.QuotaMetrics.Hms
+			for _, rm := range info.QuotaMetrics.Rms {
+				if rm.Label == metricsinfo.NQPerSecond {
+					pressure.nqPerSecond += rm.Rate
+				}
+			}
+		}
+		pressure.reportedNodeNum++
+		pressure.memoryUsed += hms.MemoryUsage
+		pressure.memoryTotal += hms.Memory
+		// cpu usage is reported in percentage.
+		pressure.cpuUsage += hms.CPUCoreUsage / 100
+	}
+	return pressure
+}
+
+// MemoryUsageRatio returns the memory usage ratio of resource group.
+func (p *resourceGroupPressure) MemoryUsageRatio() float64 {
+	if p.memoryTotal == 0 {
+		return 0
+	}
+	return float64(p.memoryUsed) / float64(p.memoryTotal)
+}
+
+// CPUUsageRatio returns the average cpu usage ratio of reported nodes.
+func (p *resourceGroupPressure) CPUUsageRatio() float64 {
+	if p.reportedNodeNum == 0 {
+		return 0
+	}
+	return p.cpuUsage / float64(p.reportedNodeNum)
+}
+
+// recommendResourceGroup computes the desired node count of resource group from its pressure.
+// More nodes are recommended if any of memory, cpu or qps pressure is above the high watermark,
+// and less nodes are recommended only if all of them are below the low watermark.
+// The recommendation never goes beyond the requests and limits of resource group.
+func recommendResourceGroup(rg *meta.ResourceGroup, pressure *resourceGroupPressure) *querypb.ResourceGroupRecommendation {
+	memHigh := Params.QueryCoordCfg.RGRecommendMemoryHighWatermark.GetAsFloat()
+	memLow := Params.QueryCoordCfg.RGRecommendMemoryLowWatermark.GetAsFloat()
+	cpuHigh := Params.QueryCoordCfg.RGRecommendCPUHighWatermark.GetAsFloat()
+	cpuLow := Params.QueryCoordCfg.RGRecommendCPULowWatermark.GetAsFloat()
+	nqPerNode := Params.QueryCoordCfg.RGRecommendNQPerSecondPerNode.GetAsFloat()
+
+	current := pressure.nodeNum
+	memRatio := pressure.MemoryUsageRatio()
+	cpuRatio := pressure.CPUUsageRatio()
+	recommendation := &querypb.ResourceGroupRecommendation{
+		ResourceGroup:    rg.GetName(),
+		CurrentNodeNum:   int32(current),
+		MemoryUsageRatio: memRatio,
+		CpuUsageRatio:    cpuRatio,
+		NqPerSecond:      pressure.nqPerSecond,
+	}
+
+	desired := current
+	if pressure.reportedNodeNum == 0 {
+		if current > 0 {
+			recommendation.Reasons = append(recommendation.Reasons, "no metrics reported by nodes of resource group, keep current node num")
+		}
+	} else {
+		// the node count required to keep the pressure under high watermark.
+		requiredByMem := requiredNodeNum(float64(current)*memRatio, memHigh)
+		requiredByCPU := requiredNodeNum(float64(current)*cpuRatio, cpuHigh)
+		requiredByNQ := 0
+		if nqPerNode > 0 {
+			requiredByNQ = requiredNodeNum(pressure.nqPerSecond, nqPerNode)
+		}
+		required := max(requiredByMem, requiredByCPU, requiredByNQ, 1)
+
+		scaleUp := false
+		if memRatio > memHigh {
+			scaleUp = true
+			recommendation.Reasons = append(recommendation.Reasons,
+				fmt.Sprintf("memory usage ratio %.2f is above high watermark %.2f", memRatio, memHigh))
+		}
+		if cpuRatio > cpuHigh {
+			scaleUp = true
+			recommendation.Reasons = append(recommendation.Reasons,
+				fmt.Sprintf("cpu usage ratio %.2f is above high watermark %.2f", cpuRatio, cpuHigh))
+		}
+		if nqPerNode > 0 && requiredByNQ > current {
+			scaleUp = true
+			recommendation.Reasons = append(recommendation.Reasons,
+				fmt.Sprintf("search nq per second %.2f exceeds the capacity of %d nodes", pressure.nqPerSecond, current))
+		}
+
+		switch {
+		case scaleUp:
+			desired = max(required, current)
+		case memRatio < memLow && cpuRatio < cpuLow && required < current:
+			desired = required
+			recommendation.Reasons = append(recommendation.Reasons,
+				fmt.Sprintf("memory usage ratio %.2f and cpu usage ratio %.2f are below low watermarks %.2f and %.2f", memRatio, cpuRatio, memLow, cpuLow))
+		}
+	}
+
+	requests := int(rg.GetConfig().GetRequests().GetNodeNum())
+	limits := int(rg.GetConfig().GetLimits().GetNodeNum())
+	if desired < requests {
+		desired = requests
+		recommendation.Reasons = append(recommendation.Reasons,
+			fmt.Sprintf("node num is raised to the requests %d of resource group", requests))
+	}
+	if desired > limits {
+		desired = limits
+		recommendation.Reasons = append(recommendation.Reasons,
+			fmt.Sprintf("node num is capped by the limits %d of resource group", limits))
+	}
+	recommendation.DesiredNodeNum = int32(desired)
+	return recommendation
+}
+
+// requiredNodeNum returns the node count required to keep the load of each node under the capacity.
+func requiredNodeNum(load float64, capacity float64) int {
+	if capacity <= 0 {
+		return 0
+	}
+	return int(math.Ceil(load / capacity))
+}
+
+// parseQueryNodeMetrics parses the system info metrics of query nodes, the failed ones are skipped.
+func parseQueryNodeMetrics(nodeMetrics []*metricResp) map[int64]*metricsinfo.QueryNodeInfos {
+	ret := make(map[int64]*metricsinfo.QueryNodeInfos, len(nodeMetrics))
+	for _, metric := range nodeMetrics {
+		if metric.err != nil || !merr.Ok(metric.resp.GetStatus()) {
+			continue
+		}
+		infos := &metricsinfo.QueryNodeInfos{}
+		if err := metricsinfo.UnmarshalComponentInfos(metric.resp.GetResponse(), infos); err != nil {
+			log.Warn("invalid metrics of query node was found", zap.Error(err))
+			continue
+		}
+		ret[infos.ID] = infos
+	}
+	return ret
+}
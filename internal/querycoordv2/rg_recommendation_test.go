@@ -0,0 +1,130 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoordv2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/rgpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func newTestQueryNodeInfos(nodeID int64, memUsed uint64, memTotal uint64, cpuPercent float64, nq float64) *metricsinfo.QueryNodeInfos {
+	hms := metricsinfo.HardwareMetrics{
+		CPUCoreUsage: cpuPercent,
+		Memory:       memTotal,
+		MemoryUsage:  memUsed,
+	}
+	return &metricsinfo.QueryNodeInfos{
+		BaseComponentInfos: metricsinfo.BaseComponentInfos{
+			ID:            nodeID,
+			HardwareInfos: hms,
+		},
+		QuotaMetrics: &metricsinfo.QueryNodeQuotaMetrics{
+			Hms: hms,
+			Rms: []metricsinfo.RateMetric{{Label: metricsinfo.NQPerSecond, Rate: nq}},
+		},
+	}
+}
+
+func newTestResourceGroup(name string, requests int32, limits int32, nodes ...int64) *meta.ResourceGroup {
+	rg := meta.NewResourceGroupFromMeta(&querypb.ResourceGroup{
+		Name: name,
+		Config: &rgpb.ResourceGroupConfig{
+			Requests: &rgpb.ResourceGroupLimit{NodeNum: requests},
+			Limits:   &rgpb.ResourceGroupLimit{NodeNum: limits},
+		},
+		Nodes: nodes,
+	})
+	return rg
+}
+
+func TestRecommendResourceGroup(t *testing.T) {
+	paramtable.Init()
+
+	// memory pressure is high, scale up.
+	rg := newTestResourceGroup("rg1", 1, 10, 1, 2)
+	metrics := map[int64]*metricsinfo.QueryNodeInfos{
+		1: newTestQueryNodeInfos(1, 90, 100, 50, 0),
+		2: newTestQueryNodeInfos(2, 90, 100, 50, 0),
+	}
+	r := recommendResourceGroup(rg, newResourceGroupPressure(rg, metrics))
+	assert.Equal(t, "rg1", r.GetResourceGroup())
+	assert.EqualValues(t, 2, r.GetCurrentNodeNum())
+	assert.EqualValues(t, 3, r.GetDesiredNodeNum())
+	assert.InDelta(t, 0.9, r.GetMemoryUsageRatio(), 1e-6)
+	assert.InDelta(t, 0.5, r.GetCpuUsageRatio(), 1e-6)
+	assert.Len(t, r.GetReasons(), 1)
+
+	// scale up is capped by limits.
+	rg = newTestResourceGroup("rg1", 1, 2, 1, 2)
+	r = recommendResourceGroup(rg, newResourceGroupPressure(rg, metrics))
+	assert.EqualValues(t, 2, r.GetDesiredNodeNum())
+	assert.Len(t, r.GetReasons(), 2)
+
+	// cpu pressure is high.
+	rg = newTestResourceGroup("rg1", 1, 10, 1, 2)
+	metrics = map[int64]*metricsinfo.QueryNodeInfos{
+		1: newTestQueryNodeInfos(1, 50, 100, 100, 0),
+		2: newTestQueryNodeInfos(2, 50, 100, 100, 0),
+	}
+	r = recommendResourceGroup(rg, newResourceGroupPressure(rg, metrics))
+	assert.EqualValues(t, 3, r.GetDesiredNodeNum())
+
+	// all pressure is low, scale down but never below requests.
+	rg = newTestResourceGroup("rg1", 2, 10, 1, 2, 3, 4)
+	metrics = map[int64]*metricsinfo.QueryNodeInfos{
+		1: newTestQueryNodeInfos(1, 10, 100, 10, 0),
+		2: newTestQueryNodeInfos(2, 10, 100, 10, 0),
+		3: newTestQueryNodeInfos(3, 10, 100, 10, 0),
+		4: newTestQueryNodeInfos(4, 10, 100, 10, 0),
+	}
+	r = recommendResourceGroup(rg, newResourceGroupPressure(rg, metrics))
+	assert.EqualValues(t, 2, r.GetDesiredNodeNum())
+	assert.Len(t, r.GetReasons(), 2)
+
+	// qps pressure is considered only if nq capacity of node is configured.
+	r = recommendResourceGroup(rg, newResourceGroupPressure(rg, map[int64]*metricsinfo.QueryNodeInfos{
+		1: newTestQueryNodeInfos(1, 10, 100, 10, 1000),
+		2: newTestQueryNodeInfos(2, 10, 100, 10, 1000),
+		3: newTestQueryNodeInfos(3, 10, 100, 10, 1000),
+		4: newTestQueryNodeInfos(4, 10, 100, 10, 1000),
+	}))
+	assert.EqualValues(t, 2, r.GetDesiredNodeNum())
+	assert.InDelta(t, 4000, r.GetNqPerSecond(), 1e-6)
+
+	paramtable.Get().Save(Params.QueryCoordCfg.RGRecommendNQPerSecondPerNode.Key, "800")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.RGRecommendNQPerSecondPerNode.Key)
+	r = recommendResourceGroup(rg, newResourceGroupPressure(rg, map[int64]*metricsinfo.QueryNodeInfos{
+		1: newTestQueryNodeInfos(1, 10, 100, 10, 1000),
+		2: newTestQueryNodeInfos(2, 10, 100, 10, 1000),
+		3: newTestQueryNodeInfos(3, 10, 100, 10, 1000),
+		4: newTestQueryNodeInfos(4, 10, 100, 10, 1000),
+	}))
+	assert.EqualValues(t, 5, r.GetDesiredNodeNum())
+
+	// no metrics reported, keep current node num but respect requests.
+	rg = newTestResourceGroup("rg1", 3, 10, 1, 2)
+	r = recommendResourceGroup(rg, newResourceGroupPressure(rg, nil))
+	assert.EqualValues(t, 3, r.GetDesiredNodeNum())
+	assert.Len(t, r.GetReasons(), 2)
+}
@@ -119,6 +119,8 @@ type Server struct {
 	balancerMap     map[string]balance.Balance
 	balancerLock    sync.RWMutex
 
+	nodeDrainer *nodeDrainer
+
 	// Active-standby
 	enableActiveStandBy bool
 	activateFunc        func() error
@@ -314,6 +316,8 @@ func (s *Server) initQueryCoord() error {
 			balancer = balance.NewMultiTargetBalancer(s.taskScheduler, s.nodeMgr, s.dist, s.meta, s.targetMgr)
 		case meta.ChannelLevelScoreBalancerName:
 			balancer = balance.NewChannelLevelScoreBalancer(s.taskScheduler, s.nodeMgr, s.dist, s.meta, s.targetMgr)
+		case meta.MemoryAwareBalancerName:
+			balancer = balance.NewMemoryAwareBalancer(s.taskScheduler, s.nodeMgr, s.dist, s.meta, s.targetMgr)
 		default:
 			log.Info(fmt.Sprintf("default to use %s", meta.ScoreBasedBalancerName))
 			balancer = balance.NewScoreBasedBalancer(s.taskScheduler, s.nodeMgr, s.dist, s.meta, s.targetMgr)
@@ -332,6 +336,9 @@ func (s *Server) initQueryCoord() error {
 		s.getBalancerFunc,
 	)
 
+	// Init node drainer
+	s.nodeDrainer = newNodeDrainer(s)
+
 	// Init observers
 	s.initObserver()
 
@@ -899,10 +899,14 @@ func (s *Server) GetShardLeaders(ctx context.Context, req *querypb.GetShardLeade
 	}
 
 	leaders, err := utils.GetShardLeaders(s.meta, s.targetMgr, s.dist, s.nodeMgr, req.GetCollectionID())
-	return &querypb.GetShardLeadersResponse{
+	resp := &querypb.GetShardLeadersResponse{
 		Status: merr.Status(err),
 		Shards: leaders,
-	}, nil
+	}
+	if collection := s.meta.CollectionManager.GetCollection(req.GetCollectionID()); collection != nil {
+		resp.LoadFields = collection.GetLoadFields()
+	}
+	return resp, nil
 }
 
 func (s *Server) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
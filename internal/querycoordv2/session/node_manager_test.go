@@ -101,6 +101,10 @@ func (s *NodeManagerSuite) TestNodeInfo() {
 	s.Equal(5, node.ChannelCnt())
 	s.Equal(5, node.SegmentCnt())
 
+	s.Equal(0.0, node.MemCapacity())
+	node.UpdateStats(WithMemCapacity(1024))
+	s.Equal(1024.0, node.MemCapacity())
+
 	node.SetLastHeartbeat(time.Now())
 	s.NotNil(node.LastHeartbeat())
 }
@@ -23,10 +23,12 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus/internal/proto/segcorepb"
 	"github.com/milvus-io/milvus/internal/querynodev2/delegator"
 	"github.com/milvus-io/milvus/internal/querynodev2/segments"
 	"github.com/milvus-io/milvus/internal/storage"
 	base "github.com/milvus-io/milvus/internal/util/pipeline"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -48,6 +50,10 @@ func (iNode *insertNode) addInsertData(insertDatas map[UniqueID]*delegator.Inser
 		log.Error(err.Error(), zap.Int64("collectionID", iNode.collectionID), zap.String("channel", iNode.channel))
 		panic(err)
 	}
+	if err := fillDroppedFields(collection, insertRecord); err != nil {
+		log.Error("failed to fill dropped fields", zap.Int64("collectionID", iNode.collectionID), zap.Error(err))
+		panic(err)
+	}
 	iData, ok := insertDatas[msg.SegmentID]
 	if !ok {
 		iData = &delegator.InsertData{
@@ -85,6 +91,29 @@ func (iNode *insertNode) addInsertData(insertDatas map[UniqueID]*delegator.Inser
 		zap.Uint64("timestampMax", msg.EndTimestamp))
 }
 
+// fillDroppedFields fills the fields dropped after the collection was loaded with zero values,
+// since the growing segment requires the data of all the fields known by segcore.
+func fillDroppedFields(collection *Collection, insertRecord *segcorepb.InsertRecord) error {
+	current := typeutil.NewSet[int64]()
+	for _, field := range collection.Schema().GetFields() {
+		current.Insert(field.GetFieldID())
+	}
+	for _, fieldData := range insertRecord.GetFieldsData() {
+		current.Insert(fieldData.GetFieldId())
+	}
+	for _, field := range collection.SegcoreSchema().GetFields() {
+		if field.GetFieldID() < common.StartOfUserFieldID || current.Contain(field.GetFieldID()) {
+			continue
+		}
+		fieldData, err := typeutil.GenZeroFieldData(field, int(insertRecord.GetNumRows()))
+		if err != nil {
+			return err
+		}
+		insertRecord.FieldsData = append(insertRecord.FieldsData, fieldData)
+	}
+	return nil
+}
+
 // Insert task
 func (iNode *insertNode) Operate(in Msg) Msg {
 	metrics.QueryNodeWaitProcessingMsgCount.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.InsertLabel).Dec()
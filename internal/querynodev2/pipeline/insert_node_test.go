@@ -19,6 +19,7 @@ package pipeline
 import (
 	"testing"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -128,6 +129,40 @@ func (suite *InsertNodeSuite) TestDataTypeNotSupported() {
 	})
 }
 
+func (suite *InsertNodeSuite) TestDroppedField() {
+	schema := segments.GenTestCollectionSchema(suite.collectionName, schemapb.DataType_Int64, true)
+	dropped := schema.GetFields()[len(schema.GetFields())-1]
+	newSchema := proto.Clone(schema).(*schemapb.CollectionSchema)
+	newSchema.Fields = newSchema.Fields[:len(newSchema.Fields)-1]
+	in := suite.buildInsertNodeMsg(newSchema)
+
+	collectionManager := segments.NewCollectionManager()
+	collectionManager.PutOrRef(suite.collectionID, schema, segments.GenTestIndexMeta(suite.collectionID, schema), &querypb.LoadMetaInfo{
+		LoadType: querypb.LoadType_LoadCollection,
+	})
+	collectionManager.PutOrRef(suite.collectionID, newSchema, nil, nil)
+	collection := collectionManager.Get(suite.collectionID)
+	collection.AddPartition(suite.partitionID)
+
+	suite.manager = &segments.Manager{
+		Collection: collectionManager,
+		Segment:    segments.NewMockSegmentManager(suite.T()),
+	}
+
+	suite.delegator = delegator.NewMockShardDelegator(suite.T())
+	suite.delegator.EXPECT().ProcessInsert(mock.Anything).Run(func(insertRecords map[int64]*delegator.InsertData) {
+		for _, data := range insertRecords {
+			suite.Len(data.InsertRecord.GetFieldsData(), len(schema.GetFields()))
+			suite.True(lo.ContainsBy(data.InsertRecord.GetFieldsData(), func(fieldData *schemapb.FieldData) bool {
+				return fieldData.GetFieldId() == dropped.GetFieldID()
+			}))
+		}
+	})
+
+	node := newInsertNode(suite.collectionID, suite.channel, suite.manager, suite.delegator, 8)
+	node.Operate(in)
+}
+
 func (suite *InsertNodeSuite) buildInsertNodeMsg(schema *schemapb.CollectionSchema) *insertNodeMsg {
 	nodeMsg := insertNodeMsg{
 		insertMsgs: []*InsertMsg{},
@@ -146,6 +146,10 @@ type Collection struct {
 	metricType atomic.String // deprecated
 	schema     atomic.Pointer[schemapb.CollectionSchema]
 	isGpuIndex bool
+	// the fields of the schema which the segcore collection was created with,
+	// the fields added later are not known by segcore until the collection is loaded again
+	segcoreFields typeutil.Set[int64]
+	segcoreSchema *schemapb.CollectionSchema
 
 	refCount *atomic.Uint32
 }
@@ -170,6 +174,17 @@ func (c *Collection) Schema() *schemapb.CollectionSchema {
 	return c.schema.Load()
 }
 
+// IsSegcoreField returns whether the field is known by the segcore collection.
+func (c *Collection) IsSegcoreField(fieldID int64) bool {
+	return c.segcoreFields == nil || c.segcoreFields.Contain(fieldID)
+}
+
+// SegcoreSchema returns the schema which the segcore collection was created with,
+// which may still contain the fields dropped after the collection was loaded.
+func (c *Collection) SegcoreSchema() *schemapb.CollectionSchema {
+	return c.segcoreSchema
+}
+
 // IsGpuIndex returns a boolean value indicating whether the collection is using a GPU index.
 func (c *Collection) IsGpuIndex() bool {
 	return c.isGpuIndex
@@ -263,6 +278,11 @@ func NewCollection(collectionID int64, schema *schemapb.CollectionSchema, indexM
 		resourceGroup: loadMetaInfo.GetResourceGroup(),
 		refCount:      atomic.NewUint32(0),
 		isGpuIndex:    isGpuIndex,
+		segcoreFields: typeutil.NewSet[int64](),
+		segcoreSchema: schema,
+	}
+	for _, field := range schema.GetFields() {
+		coll.segcoreFields.Insert(field.GetFieldID())
 	}
 	for _, partitionID := range loadMetaInfo.GetPartitionIDs() {
 		coll.partitions.Insert(partitionID)
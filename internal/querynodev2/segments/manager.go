@@ -346,7 +346,7 @@ func (mgr *segmentManager) Put(ctx context.Context, segmentType SegmentType, seg
 		}
 		targetMap[segment.ID()] = segment
 
-		eventlog.Record(eventlog.NewRawEvt(eventlog.Level_Info, fmt.Sprintf("Segment %d[%d] loaded", segment.ID(), segment.Collection())))
+		eventlog.Record(eventlog.NewCollectionRawEvt(eventlog.Level_Info, segment.Collection(), fmt.Sprintf("Segment %d[%d] loaded", segment.ID(), segment.Collection())))
 		metrics.QueryNodeNumSegments.WithLabelValues(
 			fmt.Sprint(paramtable.GetNodeID()),
 			fmt.Sprint(segment.Collection()),
@@ -1046,6 +1046,74 @@ func (s *LocalSegment) LoadFieldData(ctx context.Context, fieldID int64, rowCoun
 	return nil
 }
 
+// LoadFieldDefaultData loads the default values of the field added after the segment was written.
+// The rows of the nullable field without default value are nulls, segcore does not keep the validity of rows
+// and reads them as the zero value of the type, the same as the null rows loaded from binlogs.
+func (s *LocalSegment) LoadFieldDefaultData(ctx context.Context, field *schemapb.FieldSchema, rowCount int64) error {
+	if !s.ptrLock.RLockIf(state.IsNotReleased) {
+		return merr.WrapErrSegmentNotLoaded(s.ID(), "segment released")
+	}
+	defer s.ptrLock.RUnlock()
+
+	if rowCount <= 0 {
+		return nil
+	}
+	data, err := defaultFieldData(field, rowCount)
+	if err != nil {
+		return err
+	}
+
+	var status C.CStatus
+	GetLoadPool().Submit(func() (any, error) {
+		status = C.LoadFieldRawData(s.ptr, C.int64_t(field.GetFieldID()), data, C.int64_t(rowCount))
+		return nil, nil
+	}).Await()
+	if err := HandleCStatus(ctx, &status, "LoadFieldRawData failed",
+		zap.Int64("collectionID", s.Collection()),
+		zap.Int64("segmentID", s.ID()),
+		zap.Int64("fieldID", field.GetFieldID())); err != nil {
+		return err
+	}
+
+	log.Ctx(ctx).Info("load default field data done",
+		zap.Int64("collectionID", s.Collection()),
+		zap.Int64("segmentID", s.ID()),
+		zap.Int64("fieldID", field.GetFieldID()),
+		zap.Int64("rowCount", rowCount))
+	return nil
+}
+
+// defaultFieldData returns the pointer to rowCount default values of the fixed-width field.
+func defaultFieldData(field *schemapb.FieldSchema, rowCount int64) (unsafe.Pointer, error) {
+	dv := field.GetDefaultValue()
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		return repeatedData(dv.GetBoolData(), rowCount), nil
+	case schemapb.DataType_Int8:
+		return repeatedData(int8(dv.GetIntData()), rowCount), nil
+	case schemapb.DataType_Int16:
+		return repeatedData(int16(dv.GetIntData()), rowCount), nil
+	case schemapb.DataType_Int32:
+		return repeatedData(dv.GetIntData(), rowCount), nil
+	case schemapb.DataType_Int64:
+		return repeatedData(dv.GetLongData(), rowCount), nil
+	case schemapb.DataType_Float:
+		return repeatedData(dv.GetFloatData(), rowCount), nil
+	case schemapb.DataType_Double:
+		return repeatedData(dv.GetDoubleData(), rowCount), nil
+	default:
+		return nil, merr.WrapErrParameterInvalidMsg("could not fill default value of field %d with type %s", field.GetFieldID(), field.GetDataType())
+	}
+}
+
+func repeatedData[T any](value T, rowCount int64) unsafe.Pointer {
+	data := make([]T, rowCount)
+	for i := range data {
+		data[i] = value
+	}
+	return unsafe.Pointer(&data[0])
+}
+
 func (s *LocalSegment) LoadDeltaData2(ctx context.Context, schema *schemapb.CollectionSchema) error {
 	deleteReader, err := s.space.ScanDelete()
 	if err != nil {
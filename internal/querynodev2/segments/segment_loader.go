@@ -35,6 +35,7 @@ import (
 	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
 	"github.com/samber/lo"
 	"go.opentelemetry.io/otel"
 	"go.uber.org/atomic"
@@ -1028,6 +1029,7 @@ func (loader *segmentLoader) loadSealedSegment(ctx context.Context, loadInfo *qu
 	}()
 
 	collection := segment.GetCollection()
+	loadInfo = filterSegcoreFields(collection, loadInfo)
 
 	indexedFieldInfos, fieldBinlogs := separateIndexAndBinlog(loadInfo)
 	schemaHelper, _ := typeutil.CreateSchemaHelper(collection.Schema())
@@ -1068,6 +1070,9 @@ func (loader *segmentLoader) loadSealedSegment(ctx context.Context, loadInfo *qu
 	if err := loadSealedSegmentFields(ctx, collection, segment, fieldBinlogs, loadInfo.GetNumOfRows()); err != nil {
 		return err
 	}
+	if err := loadAddedFieldsDefault(ctx, collection, segment, loadInfo); err != nil {
+		return err
+	}
 	loadRawDataSpan := tr.RecordSpan()
 
 	// 4. rectify entries number for binlog in very rare cases
@@ -1206,6 +1211,51 @@ func (loader *segmentLoader) filterPKStatsBinlogs(fieldBinlogs []*datapb.FieldBi
 	return result, storage.DefaultStatsType
 }
 
+// filterSegcoreFields drops the binlogs and indexes of the fields unknown by the segcore collection,
+// which are added after the collection is loaded and become visible after the collection is loaded again.
+func filterSegcoreFields(collection *Collection, loadInfo *querypb.SegmentLoadInfo) *querypb.SegmentLoadInfo {
+	unknown := func(fieldID int64) bool {
+		return fieldID >= common.StartOfUserFieldID && !collection.IsSegcoreField(fieldID)
+	}
+	if !lo.ContainsBy(loadInfo.GetBinlogPaths(), func(binlog *datapb.FieldBinlog) bool { return unknown(binlog.GetFieldID()) }) &&
+		!lo.ContainsBy(loadInfo.GetIndexInfos(), func(info *querypb.FieldIndexInfo) bool { return unknown(info.GetFieldID()) }) {
+		return loadInfo
+	}
+
+	filtered := proto.Clone(loadInfo).(*querypb.SegmentLoadInfo)
+	filtered.BinlogPaths = lo.Filter(filtered.GetBinlogPaths(), func(binlog *datapb.FieldBinlog, _ int) bool {
+		return !unknown(binlog.GetFieldID())
+	})
+	filtered.IndexInfos = lo.Filter(filtered.GetIndexInfos(), func(info *querypb.FieldIndexInfo, _ int) bool {
+		return !unknown(info.GetFieldID())
+	})
+	return filtered
+}
+
+// loadAddedFieldsDefault fills the fields added after the segment was written with default values,
+// the binlogs of these fields are backfilled by compaction lazily.
+func loadAddedFieldsDefault(ctx context.Context, collection *Collection, segment *LocalSegment, loadInfo *querypb.SegmentLoadInfo) error {
+	loaded := typeutil.NewSet[int64]()
+	for _, binlog := range loadInfo.GetBinlogPaths() {
+		loaded.Insert(binlog.GetFieldID())
+	}
+	for _, info := range loadInfo.GetIndexInfos() {
+		loaded.Insert(info.GetFieldID())
+	}
+
+	for _, field := range collection.Schema().GetFields() {
+		if field.GetFieldID() < common.StartOfUserFieldID || loaded.Contain(field.GetFieldID()) ||
+			!collection.IsSegcoreField(field.GetFieldID()) ||
+			(!field.GetNullable() && field.GetDefaultValue() == nil) {
+			continue
+		}
+		if err := segment.LoadFieldDefaultData(ctx, field, loadInfo.GetNumOfRows()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func loadSealedSegmentFields(ctx context.Context, collection *Collection, segment *LocalSegment, fields []*datapb.FieldBinlog, rowCount int64) error {
 	runningGroup, _ := errgroup.WithContext(ctx)
 	for _, field := range fields {
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"path/filepath"
 	"testing"
+	"unsafe"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
@@ -13,6 +15,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	storage "github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/initcore"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
@@ -221,3 +224,35 @@ func (suite *SegmentSuite) TestSegmentReleased() {
 func TestSegment(t *testing.T) {
 	suite.Run(t, new(SegmentSuite))
 }
+
+func TestFilterSegcoreFields(t *testing.T) {
+	collection := &Collection{segcoreFields: typeutil.NewSet[int64](common.RowIDField, common.TimeStampField, 100, 101)}
+	loadInfo := &querypb.SegmentLoadInfo{
+		BinlogPaths: []*datapb.FieldBinlog{{FieldID: common.RowIDField}, {FieldID: 100}, {FieldID: 101}},
+		IndexInfos:  []*querypb.FieldIndexInfo{{FieldID: 101}},
+	}
+	assert.Same(t, loadInfo, filterSegcoreFields(collection, loadInfo))
+
+	loadInfo.BinlogPaths = append(loadInfo.BinlogPaths, &datapb.FieldBinlog{FieldID: 102})
+	loadInfo.IndexInfos = append(loadInfo.IndexInfos, &querypb.FieldIndexInfo{FieldID: 102})
+	filtered := filterSegcoreFields(collection, loadInfo)
+	assert.Len(t, filtered.GetBinlogPaths(), 3)
+	assert.Len(t, filtered.GetIndexInfos(), 1)
+	assert.Len(t, loadInfo.GetBinlogPaths(), 4)
+}
+
+func TestDefaultFieldData(t *testing.T) {
+	data, err := defaultFieldData(&schemapb.FieldSchema{
+		DataType:     schemapb.DataType_Int32,
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 7}},
+	}, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []int32{7, 7, 7}, unsafe.Slice((*int32)(data), 3))
+
+	data, err = defaultFieldData(&schemapb.FieldSchema{DataType: schemapb.DataType_Double, Nullable: true}, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{0, 0}, unsafe.Slice((*float64)(data), 2))
+
+	_, err = defaultFieldData(&schemapb.FieldSchema{DataType: schemapb.DataType_VarChar, Nullable: true}, 2)
+	assert.Error(t, err)
+}
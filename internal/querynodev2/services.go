@@ -49,6 +49,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/hardware"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -1271,6 +1272,8 @@ func (node *QueryNode) GetDataDistribution(ctx context.Context, req *querypb.Get
 		Channels:     channelVersionInfos,
 		LeaderViews:  leaderViews,
 		LastModifyTs: lastModifyTs,
+		// the memory capacity is used by querycoord to avoid assigning too much data to node.
+		MemCapacityInMB: float64(hardware.GetMemoryCount() / 1024 / 1024),
 	}, nil
 }
 
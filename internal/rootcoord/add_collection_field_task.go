@@ -0,0 +1,167 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/proxyutil"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// the data types of the fields which could be added online,
+// the existing segments are filled with the default value of these fixed-width types when loaded
+var addableFieldTypes = map[schemapb.DataType]struct{}{
+	schemapb.DataType_Bool:   {},
+	schemapb.DataType_Int8:   {},
+	schemapb.DataType_Int16:  {},
+	schemapb.DataType_Int32:  {},
+	schemapb.DataType_Int64:  {},
+	schemapb.DataType_Float:  {},
+	schemapb.DataType_Double: {},
+}
+
+// addCollectionFieldTask appends a field to an existing collection and increases the schema version.
+// The rows written before have no data of the field, they are read as the default value (or null),
+// and the physical binlogs are backfilled by compaction.
+type addCollectionFieldTask struct {
+	baseTask
+	Req *internalpb.AddCollectionFieldRequest
+}
+
+func (t *addCollectionFieldTask) jobTarget() (string, string, string) {
+	return "AddCollectionField", t.Req.GetDbName(), t.Req.GetCollectionName()
+}
+
+func (t *addCollectionFieldTask) Prepare(ctx context.Context) error {
+	if t.Req.GetCollectionName() == "" {
+		return merr.WrapErrParameterInvalidMsg("collection name is empty")
+	}
+	if t.Req.GetField() == nil {
+		return merr.WrapErrParameterInvalidMsg("field schema is empty")
+	}
+	return nil
+}
+
+func checkAddedField(coll *model.Collection, field *schemapb.FieldSchema) error {
+	if field.GetName() == "" {
+		return merr.WrapErrParameterInvalidMsg("field name is empty")
+	}
+	for _, f := range coll.Fields {
+		if f.Name == field.GetName() {
+			return merr.WrapErrParameterInvalidMsg("duplicated field name %s", field.GetName())
+		}
+	}
+	if field.GetIsPrimaryKey() || field.GetAutoID() || field.GetIsPartitionKey() ||
+		field.GetIsClusteringKey() || field.GetIsDynamic() {
+		return merr.WrapErrParameterInvalidMsg("the added field %s could not be primary key, partition key, clustering key or dynamic field", field.GetName())
+	}
+	if _, ok := addableFieldTypes[field.GetDataType()]; !ok {
+		return merr.WrapErrParameterInvalidMsg("field %s of type %s could not be added to an existing collection", field.GetName(), field.GetDataType())
+	}
+	if !field.GetNullable() && field.GetDefaultValue() == nil {
+		return merr.WrapErrParameterInvalidMsg("the added field %s must be nullable or have a default value", field.GetName())
+	}
+	return checkDefaultValue(&schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{field}})
+}
+
+// nextFieldID returns the id for the added field, the ids of the dropped fields are never reused
+// since their binlogs may not be removed by compaction yet.
+func nextFieldID(coll *model.Collection) int64 {
+	fieldID := int64(common.StartOfUserFieldID)
+	for _, f := range coll.Fields {
+		if f.FieldID >= fieldID {
+			fieldID = f.FieldID + 1
+		}
+	}
+	dropped, _ := common.CollectionDroppedFields(funcutil.KeyValuePair2Map(coll.Properties))
+	for _, id := range dropped {
+		if id >= fieldID {
+			fieldID = id + 1
+		}
+	}
+	return fieldID
+}
+
+func (t *addCollectionFieldTask) Execute(ctx context.Context) error {
+	oldColl, err := t.core.meta.GetCollectionByName(ctx, t.Req.GetDbName(), t.Req.GetCollectionName(), t.ts)
+	if err != nil {
+		log.Warn("get collection failed during adding field",
+			zap.String("collectionName", t.Req.GetCollectionName()), zap.Uint64("ts", t.ts))
+		return err
+	}
+	if err := checkAddedField(oldColl, t.Req.GetField()); err != nil {
+		return err
+	}
+
+	newColl := oldColl.Clone()
+	field := model.UnmarshalFieldModel(t.Req.GetField())
+	field.FieldID = nextFieldID(oldColl)
+	field.State = schemapb.FieldState_FieldCreated
+	newColl.Fields = append(newColl.Fields, field)
+	if err := increaseSchemaVersion(newColl); err != nil {
+		return err
+	}
+
+	log.Info("add collection field",
+		zap.String("collectionName", t.Req.GetCollectionName()),
+		zap.Int64("collectionID", oldColl.CollectionID),
+		zap.String("fieldName", field.Name),
+		zap.Int64("fieldID", field.FieldID))
+
+	ts := t.GetTs()
+	redoTask := newBaseRedoTask(t.core.stepExecutor)
+	redoTask.AddSyncStep(&AlterCollectionStep{
+		baseStep: baseStep{core: t.core},
+		oldColl:  oldColl,
+		newColl:  newColl,
+		ts:       ts,
+	})
+
+	// datacoord takes the new schema to backfill the field by compaction
+	redoTask.AddSyncStep(&BroadcastAlteredCollectionStep{
+		baseStep: baseStep{core: t.core},
+		req: &milvuspb.AlterCollectionRequest{
+			DbName:         t.Req.GetDbName(),
+			CollectionName: t.Req.GetCollectionName(),
+			CollectionID:   oldColl.CollectionID,
+		},
+		core: t.core,
+	})
+
+	// proxies fill the new field of the following writes once the schema cache is refreshed
+	aliases := t.core.meta.ListAliasesByID(oldColl.CollectionID)
+	redoTask.AddSyncStep(&expireCacheStep{
+		baseStep:        baseStep{core: t.core},
+		dbName:          t.Req.GetDbName(),
+		collectionNames: append(aliases, t.Req.GetCollectionName()),
+		collectionID:    oldColl.CollectionID,
+		opts:            []proxyutil.ExpireCacheOpt{proxyutil.SetMsgType(commonpb.MsgType_AlterCollection)},
+	})
+
+	return redoTask.Execute(ctx)
+}
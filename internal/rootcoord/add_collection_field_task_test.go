@@ -0,0 +1,151 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+)
+
+func Test_addCollectionFieldTask_Prepare(t *testing.T) {
+	task := &addCollectionFieldTask{Req: &internalpb.AddCollectionFieldRequest{}}
+	assert.Error(t, task.Prepare(context.Background()))
+
+	task = &addCollectionFieldTask{Req: &internalpb.AddCollectionFieldRequest{CollectionName: "cn"}}
+	assert.Error(t, task.Prepare(context.Background()))
+
+	task = &addCollectionFieldTask{Req: &internalpb.AddCollectionFieldRequest{
+		CollectionName: "cn",
+		Field:          &schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Int64, Nullable: true},
+	}}
+	assert.NoError(t, task.Prepare(context.Background()))
+}
+
+func Test_checkAddedField(t *testing.T) {
+	coll := &model.Collection{
+		Fields: []*model.Field{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+
+	for name, field := range map[string]*schemapb.FieldSchema{
+		"empty name":         {DataType: schemapb.DataType_Int64, Nullable: true},
+		"duplicated name":    {Name: "vec", DataType: schemapb.DataType_Int64, Nullable: true},
+		"primary key":        {Name: "f", DataType: schemapb.DataType_Int64, Nullable: true, IsPrimaryKey: true},
+		"partition key":      {Name: "f", DataType: schemapb.DataType_Int64, Nullable: true, IsPartitionKey: true},
+		"vector":             {Name: "f", DataType: schemapb.DataType_FloatVector, Nullable: true},
+		"varchar":            {Name: "f", DataType: schemapb.DataType_VarChar, Nullable: true},
+		"no default value":   {Name: "f", DataType: schemapb.DataType_Int64},
+		"mismatched default": {Name: "f", DataType: schemapb.DataType_Int64, DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_BoolData{BoolData: true}}},
+	} {
+		assert.Error(t, checkAddedField(coll, field), name)
+	}
+
+	assert.NoError(t, checkAddedField(coll, &schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Int64, Nullable: true}))
+	assert.NoError(t, checkAddedField(coll, &schemapb.FieldSchema{
+		Name: "f", DataType: schemapb.DataType_Int32, DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 1}},
+	}))
+	assert.EqualValues(t, 102, nextFieldID(coll))
+	assert.EqualValues(t, common.StartOfUserFieldID, nextFieldID(&model.Collection{}))
+	coll.Properties = []*commonpb.KeyValuePair{{Key: common.CollectionDroppedFieldsKey, Value: "102"}}
+	assert.EqualValues(t, 103, nextFieldID(coll))
+}
+
+func Test_addCollectionFieldTask_Execute(t *testing.T) {
+	field := &schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Int64, Nullable: true}
+	newColl := func() *model.Collection {
+		return &model.Collection{
+			CollectionID: 1,
+			Fields:       []*model.Field{{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64}},
+			Properties:   []*commonpb.KeyValuePair{{Key: common.CollectionSchemaVersionKey, Value: "1"}},
+		}
+	}
+
+	t.Run("get collection failed", func(t *testing.T) {
+		core := newTestCore(withInvalidMeta())
+		task := &addCollectionFieldTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &internalpb.AddCollectionFieldRequest{CollectionName: "cn", Field: field},
+		}
+		assert.Error(t, task.Execute(context.Background()))
+	})
+
+	t.Run("invalid field", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(newColl(), nil)
+		core := newTestCore(withMeta(meta))
+		task := &addCollectionFieldTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &internalpb.AddCollectionFieldRequest{CollectionName: "cn", Field: &schemapb.FieldSchema{Name: "pk", DataType: schemapb.DataType_Int64, Nullable: true}},
+		}
+		assert.Error(t, task.Execute(context.Background()))
+	})
+
+	t.Run("alter step failed", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(newColl(), nil)
+		meta.EXPECT().AlterCollection(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("mock"))
+		meta.EXPECT().ListAliasesByID(mock.Anything).Return([]string{})
+		core := newTestCore(withValidProxyManager(), withMeta(meta))
+		task := &addCollectionFieldTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &internalpb.AddCollectionFieldRequest{CollectionName: "cn", Field: field},
+		}
+		assert.Error(t, task.Execute(context.Background()))
+	})
+
+	t.Run("add successfully", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(newColl(), nil)
+		meta.EXPECT().AlterCollection(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts uint64) error {
+				assert.Len(t, oldColl.Fields, 1)
+				assert.Len(t, newColl.Fields, 2)
+				assert.EqualValues(t, 101, newColl.Fields[1].FieldID)
+				assert.Equal(t, "f", newColl.Fields[1].Name)
+				assert.Equal(t, "2", funcutil.KeyValuePair2Map(newColl.Properties)[common.CollectionSchemaVersionKey])
+				return nil
+			})
+		meta.EXPECT().ListAliasesByID(mock.Anything).Return([]string{})
+
+		broker := newMockBroker()
+		broker.BroadcastAlteredCollectionFunc = func(ctx context.Context, req *milvuspb.AlterCollectionRequest) error {
+			assert.EqualValues(t, 1, req.GetCollectionID())
+			return nil
+		}
+		core := newTestCore(withValidProxyManager(), withMeta(meta), withBroker(broker))
+		task := &addCollectionFieldTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &internalpb.AddCollectionFieldRequest{CollectionName: "cn", Field: field},
+		}
+		assert.NoError(t, task.Execute(context.Background()))
+	})
+}
@@ -29,6 +29,10 @@ type alterAliasTask struct {
 	Req *milvuspb.AlterAliasRequest
 }
 
+func (t *alterAliasTask) jobTarget() (string, string, string) {
+	return "AlterAlias", t.Req.GetDbName(), t.Req.GetCollectionName()
+}
+
 func (t *alterAliasTask) Prepare(ctx context.Context) error {
 	if err := CheckMsgType(t.Req.GetBase().GetMsgType(), commonpb.MsgType_AlterAlias); err != nil {
 		return err
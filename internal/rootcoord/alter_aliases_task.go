@@ -0,0 +1,69 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/proxyutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// alterAliasesTask creates, re-points and drops several aliases of a database in one meta transaction,
+// so that no reader observes a state in which only part of the changes is applied.
+type alterAliasesTask struct {
+	baseTask
+	Req *internalpb.AlterAliasesRequest
+}
+
+func (t *alterAliasesTask) jobTarget() (string, string, string) {
+	return "AlterAliases", t.Req.GetDbName(), ""
+}
+
+func (t *alterAliasesTask) Prepare(ctx context.Context) error {
+	if err := CheckMsgType(t.Req.GetBase().GetMsgType(), commonpb.MsgType_AlterAlias); err != nil {
+		return err
+	}
+	if len(t.Req.GetChanges()) == 0 {
+		return merr.WrapErrParameterInvalidMsg("no alias change specified")
+	}
+	aliases := typeutil.NewSet[string]()
+	for _, change := range t.Req.GetChanges() {
+		if change.GetAlias() == "" {
+			return merr.WrapErrParameterInvalidMsg("alias name should not be empty")
+		}
+		if aliases.Contain(change.GetAlias()) {
+			return merr.WrapErrParameterInvalidMsg("alias %s is changed more than once", change.GetAlias())
+		}
+		aliases.Insert(change.GetAlias())
+	}
+	return nil
+}
+
+func (t *alterAliasesTask) Execute(ctx context.Context) error {
+	aliases := make([]string, 0, len(t.Req.GetChanges()))
+	for _, change := range t.Req.GetChanges() {
+		aliases = append(aliases, change.GetAlias())
+	}
+	if err := t.core.ExpireMetaCache(ctx, t.Req.GetDbName(), aliases, InvalidCollectionID, "", t.GetTs(), proxyutil.SetMsgType(commonpb.MsgType_AlterAlias)); err != nil {
+		return err
+	}
+	return t.core.meta.AlterAliases(ctx, t.Req.GetDbName(), t.Req.GetChanges(), t.GetTs())
+}
@@ -0,0 +1,101 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+func Test_alterAliasesTask_Prepare(t *testing.T) {
+	newTask := func(msgType commonpb.MsgType, changes ...*internalpb.AliasChange) *alterAliasesTask {
+		return &alterAliasesTask{Req: &internalpb.AlterAliasesRequest{
+			Base:    &commonpb.MsgBase{MsgType: msgType},
+			Changes: changes,
+		}}
+	}
+
+	t.Run("invalid msg type", func(t *testing.T) {
+		task := newTask(commonpb.MsgType_DropCollection, &internalpb.AliasChange{Alias: "a1", CollectionName: "coll"})
+		assert.Error(t, task.Prepare(context.Background()))
+	})
+
+	t.Run("no changes", func(t *testing.T) {
+		task := newTask(commonpb.MsgType_AlterAlias)
+		assert.ErrorIs(t, task.Prepare(context.Background()), merr.ErrParameterInvalid)
+	})
+
+	t.Run("empty alias", func(t *testing.T) {
+		task := newTask(commonpb.MsgType_AlterAlias, &internalpb.AliasChange{CollectionName: "coll"})
+		assert.ErrorIs(t, task.Prepare(context.Background()), merr.ErrParameterInvalid)
+	})
+
+	t.Run("duplicate alias", func(t *testing.T) {
+		task := newTask(commonpb.MsgType_AlterAlias,
+			&internalpb.AliasChange{Alias: "a1", CollectionName: "coll1"},
+			&internalpb.AliasChange{Alias: "a1"},
+		)
+		assert.ErrorIs(t, task.Prepare(context.Background()), merr.ErrParameterInvalid)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		task := newTask(commonpb.MsgType_AlterAlias,
+			&internalpb.AliasChange{Alias: "a1", CollectionName: "coll1"},
+			&internalpb.AliasChange{Alias: "a2"},
+		)
+		assert.NoError(t, task.Prepare(context.Background()))
+	})
+}
+
+func Test_alterAliasesTask_Execute(t *testing.T) {
+	req := &internalpb.AlterAliasesRequest{
+		Base:   &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterAlias},
+		DbName: "db",
+		Changes: []*internalpb.AliasChange{
+			{Alias: "a1", CollectionName: "coll1"},
+			{Alias: "a2"},
+		},
+	}
+
+	t.Run("failed to expire cache", func(t *testing.T) {
+		core := newTestCore(withInvalidProxyManager())
+		task := &alterAliasesTask{baseTask: newBaseTask(context.Background(), core), Req: req}
+		assert.Error(t, task.Execute(context.Background()))
+	})
+
+	t.Run("failed to alter aliases", func(t *testing.T) {
+		core := newTestCore(withValidProxyManager(), withInvalidMeta())
+		task := &alterAliasesTask{baseTask: newBaseTask(context.Background(), core), Req: req}
+		assert.Error(t, task.Execute(context.Background()))
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().AlterAliases(mock.Anything, "db", req.GetChanges(), mock.Anything).Return(nil)
+		core := newTestCore(withValidProxyManager(), withMeta(meta))
+		task := &alterAliasesTask{baseTask: newBaseTask(context.Background(), core), Req: req}
+		assert.NoError(t, task.Execute(context.Background()))
+	})
+}
@@ -19,6 +19,7 @@ package rootcoord
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/cockroachdb/errors"
 	"go.uber.org/zap"
@@ -27,7 +28,10 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/util/proxyutil"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
 type alterCollectionTask struct {
@@ -35,10 +39,33 @@ type alterCollectionTask struct {
 	Req *milvuspb.AlterCollectionRequest
 }
 
+func (a *alterCollectionTask) jobTarget() (string, string, string) {
+	return "AlterCollection", a.Req.GetDbName(), a.Req.GetCollectionName()
+}
+
 func (a *alterCollectionTask) Prepare(ctx context.Context) error {
 	if a.Req.GetCollectionName() == "" {
 		return fmt.Errorf("alter collection failed, collection name does not exists")
 	}
+	for _, prop := range a.Req.GetProperties() {
+		if prop.GetKey() == common.CollectionSchemaVersionKey || prop.GetKey() == common.CollectionDroppedFieldsKey {
+			return fmt.Errorf("alter collection failed, %s is maintained by schema changes", prop.GetKey())
+		}
+		if prop.GetKey() == common.PartitionKeyRepartitionFromKey || prop.GetKey() == common.PartitionKeyRepartitionTsKey {
+			return fmt.Errorf("alter collection failed, %s is maintained by increasing partitions of partition key", prop.GetKey())
+		}
+		if prop.GetKey() == common.PartitionKeyNumPartitionsKey {
+			if num, err := strconv.ParseInt(prop.GetValue(), 10, 64); err != nil || num <= 0 {
+				return merr.WrapErrParameterInvalidMsg("invalid %s: %s", prop.GetKey(), prop.GetValue())
+			}
+			if len(a.Req.GetProperties()) > 1 {
+				return merr.WrapErrParameterInvalidMsg("%s can't be altered with other properties", prop.GetKey())
+			}
+		}
+	}
+	if err := common.ValidatePartitionProperties(funcutil.KeyValuePair2Map(a.Req.GetProperties())); err != nil {
+		return merr.WrapErrParameterInvalidMsg(err.Error())
+	}
 
 	return nil
 }
@@ -56,6 +83,12 @@ func (a *alterCollectionTask) Execute(ctx context.Context) error {
 		return err
 	}
 
+	if val, ok := funcutil.KeyValuePair2Map(a.Req.GetProperties())[common.PartitionKeyNumPartitionsKey]; ok {
+		num, _ := strconv.ParseInt(val, 10, 64)
+		a.Req.CollectionID = oldColl.CollectionID
+		return a.increasePartitionKeyPartitions(ctx, oldColl, num)
+	}
+
 	newColl := oldColl.Clone()
 	updateCollectionProperties(newColl, a.Req.GetProperties())
 
@@ -38,6 +38,73 @@ func Test_alterCollectionTask_Prepare(t *testing.T) {
 		assert.Error(t, err)
 	})
 
+	t.Run("alter schema version", func(t *testing.T) {
+		task := &alterCollectionTask{
+			Req: &milvuspb.AlterCollectionRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection},
+				CollectionName: "cn",
+				Properties:     []*commonpb.KeyValuePair{{Key: common.CollectionSchemaVersionKey, Value: "1"}},
+			},
+		}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid partition lifecycle", func(t *testing.T) {
+		task := &alterCollectionTask{
+			Req: &milvuspb.AlterCollectionRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection},
+				CollectionName: "cn",
+				Properties:     []*commonpb.KeyValuePair{{Key: common.PartitionPropertyKey("p1", common.PartitionAutoDropDaysKey), Value: "0"}},
+			},
+		}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("alter dropped fields", func(t *testing.T) {
+		task := &alterCollectionTask{
+			Req: &milvuspb.AlterCollectionRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection},
+				CollectionName: "cn",
+				Properties:     []*commonpb.KeyValuePair{{Key: common.CollectionDroppedFieldsKey, Value: "101"}},
+			},
+		}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("alter partition key repartition", func(t *testing.T) {
+		task := &alterCollectionTask{
+			Req: &milvuspb.AlterCollectionRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection},
+				CollectionName: "cn",
+				Properties:     []*commonpb.KeyValuePair{{Key: common.PartitionKeyRepartitionFromKey, Value: "1"}},
+			},
+		}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid partition key num partitions", func(t *testing.T) {
+		task := &alterCollectionTask{
+			Req: &milvuspb.AlterCollectionRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection},
+				CollectionName: "cn",
+				Properties:     []*commonpb.KeyValuePair{{Key: common.PartitionKeyNumPartitionsKey, Value: "0"}},
+			},
+		}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+
+		task.Req.Properties = []*commonpb.KeyValuePair{
+			{Key: common.PartitionKeyNumPartitionsKey, Value: "32"},
+			{Key: common.CollectionTTLConfigKey, Value: "3600"},
+		}
+		err = task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
 	t.Run("normal case", func(t *testing.T) {
 		task := &alterCollectionTask{
 			Req: &milvuspb.AlterCollectionRequest{
@@ -19,13 +19,16 @@ package rootcoord
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/cockroachdb/errors"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
 type alterDatabaseTask struct {
@@ -33,12 +36,16 @@ type alterDatabaseTask struct {
 	Req *rootcoordpb.AlterDatabaseRequest
 }
 
+func (a *alterDatabaseTask) jobTarget() (string, string, string) {
+	return "AlterDatabase", a.Req.GetDbName(), ""
+}
+
 func (a *alterDatabaseTask) Prepare(ctx context.Context) error {
 	if a.Req.GetDbName() == "" {
 		return fmt.Errorf("alter database failed, database name does not exists")
 	}
 
-	return nil
+	return checkDatabaseProperties(a.Req.GetProperties())
 }
 
 func (a *alterDatabaseTask) Execute(ctx context.Context) error {
@@ -70,6 +77,28 @@ func (a *alterDatabaseTask) Execute(ctx context.Context) error {
 	return redoTask.Execute(ctx)
 }
 
+// checkDatabaseProperties checks the values of the database level limits and load configs.
+func checkDatabaseProperties(props []*commonpb.KeyValuePair) error {
+	for _, key := range []string{common.DatabaseMaxPartitionsKey, common.DatabaseMaxVectorDimSumKey, common.DatabaseReplicaNumber} {
+		if _, err := common.DatabaseLevelLimit(props, key); err != nil {
+			return merr.WrapErrParameterInvalidMsg(err.Error())
+		}
+	}
+	for _, prop := range props {
+		switch prop.GetKey() {
+		case common.DatabaseMaxCollectionsKey:
+			if num, err := strconv.Atoi(prop.GetValue()); err != nil || num < 0 {
+				return merr.WrapErrParameterInvalidMsg("invalid database property: [key=%s] [value=%s]", prop.GetKey(), prop.GetValue())
+			}
+		case common.DatabaseResourceGroups:
+			if _, err := common.DatabaseLevelResourceGroups(props); err != nil {
+				return merr.WrapErrParameterInvalidMsg(err.Error())
+			}
+		}
+	}
+	return nil
+}
+
 func updateProperties(oldProps []*commonpb.KeyValuePair, updatedProps []*commonpb.KeyValuePair) []*commonpb.KeyValuePair {
 	props := make(map[string]string)
 	for _, prop := range oldProps {
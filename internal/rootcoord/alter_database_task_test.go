@@ -29,6 +29,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
 	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
 func Test_alterDatabaseTask_Prepare(t *testing.T) {
@@ -38,10 +39,36 @@ func Test_alterDatabaseTask_Prepare(t *testing.T) {
 		assert.Error(t, err)
 	})
 
+	t.Run("invalid properties", func(t *testing.T) {
+		for _, prop := range []*commonpb.KeyValuePair{
+			{Key: common.DatabaseMaxCollectionsKey, Value: "-1"},
+			{Key: common.DatabaseMaxPartitionsKey, Value: "-1"},
+			{Key: common.DatabaseMaxVectorDimSumKey, Value: "abc"},
+			{Key: common.DatabaseReplicaNumber, Value: "-1"},
+			{Key: common.DatabaseResourceGroups, Value: ""},
+		} {
+			task := &alterDatabaseTask{
+				Req: &rootcoordpb.AlterDatabaseRequest{
+					DbName:     "cn",
+					Properties: []*commonpb.KeyValuePair{prop},
+				},
+			}
+			err := task.Prepare(context.Background())
+			assert.ErrorIs(t, err, merr.ErrParameterInvalid, prop.GetKey())
+		}
+	})
+
 	t.Run("normal case", func(t *testing.T) {
 		task := &alterDatabaseTask{
 			Req: &rootcoordpb.AlterDatabaseRequest{
 				DbName: "cn",
+				Properties: []*commonpb.KeyValuePair{
+					{Key: common.DatabaseMaxCollectionsKey, Value: "0"},
+					{Key: common.DatabaseMaxPartitionsKey, Value: "100"},
+					{Key: common.DatabaseMaxVectorDimSumKey, Value: "0"},
+					{Key: common.DatabaseReplicaNumber, Value: "2"},
+					{Key: common.DatabaseResourceGroups, Value: "rg1,rg2"},
+				},
 			},
 		}
 		err := task.Prepare(context.Background())
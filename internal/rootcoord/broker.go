@@ -56,8 +56,10 @@ type Broker interface {
 	UnwatchChannels(ctx context.Context, info *watchInfo) error
 	GetSegmentStates(context.Context, *datapb.GetSegmentStatesRequest) (*datapb.GetSegmentStatesResponse, error)
 	GcConfirm(ctx context.Context, collectionID, partitionID UniqueID) bool
+	RepartitionConfirm(ctx context.Context, collectionID UniqueID) bool
 
 	DropCollectionIndex(ctx context.Context, collID UniqueID, partIDs []UniqueID) error
+	DropFieldIndex(ctx context.Context, collID UniqueID, fieldID UniqueID) error
 	// notify observer to clean their meta cache
 	BroadcastAlteredCollection(ctx context.Context, req *milvuspb.AlterCollectionRequest) error
 }
@@ -208,6 +210,36 @@ func (b *ServerBroker) DropCollectionIndex(ctx context.Context, collID UniqueID,
 	return nil
 }
 
+func (b *ServerBroker) DropFieldIndex(ctx context.Context, collID UniqueID, fieldID UniqueID) error {
+	log := log.Ctx(ctx).With(zap.Int64("collection", collID), zap.Int64("field", fieldID))
+
+	resp, err := b.s.dataCoord.DescribeIndex(ctx, &indexpb.DescribeIndexRequest{
+		CollectionID: collID,
+	})
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		if errors.Is(err, merr.ErrIndexNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	for _, index := range resp.GetIndexInfos() {
+		if index.GetFieldID() != fieldID {
+			continue
+		}
+		log.Info("dropping field index", zap.String("indexName", index.GetIndexName()))
+		status, err := b.s.dataCoord.DropIndex(ctx, &indexpb.DropIndexRequest{
+			CollectionID: collID,
+			IndexName:    index.GetIndexName(),
+		})
+		if err = merr.CheckRPCCall(status, err); err != nil {
+			return err
+		}
+	}
+	log.Info("done to drop field index")
+	return nil
+}
+
 func (b *ServerBroker) GetSegmentIndexState(ctx context.Context, collID UniqueID, indexName string, segIDs []UniqueID) ([]*indexpb.SegmentIndexState, error) {
 	resp, err := b.s.dataCoord.GetSegmentIndexState(ctx, &indexpb.GetSegmentIndexStateRequest{
 		CollectionID: collID,
@@ -237,23 +269,27 @@ func (b *ServerBroker) BroadcastAlteredCollection(ctx context.Context, req *milv
 		return err
 	}
 
-	partitionIDs := make([]int64, len(colMeta.Partitions))
+	partitionIDs := make([]int64, 0, len(colMeta.Partitions))
+	partitionNames := make([]string, 0, len(colMeta.Partitions))
 	for _, p := range colMeta.Partitions {
 		partitionIDs = append(partitionIDs, p.PartitionID)
+		partitionNames = append(partitionNames, p.PartitionName)
 	}
 	dcReq := &datapb.AlterCollectionRequest{
 		CollectionID: req.GetCollectionID(),
 		Schema: &schemapb.CollectionSchema{
-			Name:        colMeta.Name,
-			Description: colMeta.Description,
-			AutoID:      colMeta.AutoID,
-			Fields:      model.MarshalFieldModels(colMeta.Fields),
+			Name:               colMeta.Name,
+			Description:        colMeta.Description,
+			AutoID:             colMeta.AutoID,
+			Fields:             model.MarshalFieldModels(colMeta.Fields),
+			EnableDynamicField: colMeta.EnableDynamicField,
 		},
 		PartitionIDs:   partitionIDs,
 		StartPositions: colMeta.StartPositions,
 		Properties:     colMeta.Properties,
 		DbID:           db.ID,
 		VChannels:      colMeta.VirtualChannelNames,
+		PartitionNames: partitionNames,
 	}
 
 	resp, err := b.s.dataCoord.BroadcastAlteredCollection(ctx, dcReq)
@@ -289,3 +325,21 @@ func (b *ServerBroker) GcConfirm(ctx context.Context, collectionID, partitionID
 	log.Info("received gc_confirm response", zap.Bool("finished", resp.GetGcFinished()))
 	return resp.GetGcFinished()
 }
+
+// RepartitionConfirm returns whether the rows of the collection are all rewritten into the partitions of partition key.
+func (b *ServerBroker) RepartitionConfirm(ctx context.Context, collectionID UniqueID) bool {
+	log := log.Ctx(ctx).With(zap.Int64("collection", collectionID))
+
+	resp, err := b.s.dataCoord.GetPartitionKeyStats(ctx, &datapb.GetPartitionKeyStatsRequest{
+		Base:         commonpbutil.NewMsgBase(commonpbutil.WithSourceID(b.s.session.ServerID)),
+		CollectionID: collectionID,
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		log.Warn("repartition is not finished", zap.Error(err))
+		return false
+	}
+
+	log.Info("received partition key stats", zap.Int64("repartitionSegments", resp.GetRepartitionSegments()),
+		zap.Int64("repartitionRows", resp.GetRepartitionRows()))
+	return resp.GetRepartitionSegments() == 0
+}
@@ -154,6 +154,54 @@ func TestServerBroker_DropCollectionIndex(t *testing.T) {
 	})
 }
 
+func TestServerBroker_DropFieldIndex(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("failed to describe", func(t *testing.T) {
+		dc := newMockDataCoord()
+		dc.DescribeIndexFunc = func(ctx context.Context, req *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error) {
+			return nil, errors.New("mock")
+		}
+		b := newServerBroker(newTestCore(withDataCoord(dc)))
+		assert.Error(t, b.DropFieldIndex(ctx, 1, 100))
+	})
+
+	t.Run("no index", func(t *testing.T) {
+		dc := newMockDataCoord()
+		dc.DescribeIndexFunc = func(ctx context.Context, req *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error) {
+			return &indexpb.DescribeIndexResponse{Status: merr.Status(merr.WrapErrIndexNotFound(""))}, nil
+		}
+		b := newServerBroker(newTestCore(withDataCoord(dc)))
+		assert.NoError(t, b.DropFieldIndex(ctx, 1, 100))
+	})
+
+	t.Run("drop the index of field", func(t *testing.T) {
+		dc := newMockDataCoord()
+		dc.DescribeIndexFunc = func(ctx context.Context, req *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error) {
+			return &indexpb.DescribeIndexResponse{
+				Status: merr.Success(),
+				IndexInfos: []*indexpb.IndexInfo{
+					{FieldID: 100, IndexName: "idx1"},
+					{FieldID: 101, IndexName: "idx2"},
+				},
+			}, nil
+		}
+		dropped := make([]string, 0)
+		dc.DropIndexFunc = func(ctx context.Context, req *indexpb.DropIndexRequest) (*commonpb.Status, error) {
+			dropped = append(dropped, req.GetIndexName())
+			return merr.Success(), nil
+		}
+		b := newServerBroker(newTestCore(withDataCoord(dc)))
+		assert.NoError(t, b.DropFieldIndex(ctx, 1, 100))
+		assert.Equal(t, []string{"idx1"}, dropped)
+
+		dc.DropIndexFunc = func(ctx context.Context, req *indexpb.DropIndexRequest) (*commonpb.Status, error) {
+			return nil, errors.New("mock")
+		}
+		assert.Error(t, b.DropFieldIndex(ctx, 1, 100))
+	})
+}
+
 func TestServerBroker_GetSegmentIndexState(t *testing.T) {
 	t.Run("failed to execute", func(t *testing.T) {
 		c := newTestCore(withInvalidDataCoord())
@@ -332,6 +380,34 @@ func TestServerBroker_GcConfirm(t *testing.T) {
 	})
 }
 
+func TestServerBroker_RepartitionConfirm(t *testing.T) {
+	t.Run("invalid datacoord", func(t *testing.T) {
+		dc := mocks.NewMockDataCoordClient(t)
+		dc.EXPECT().GetPartitionKeyStats(mock.Anything, mock.Anything).Return(nil, errors.New("error mock GetPartitionKeyStats"))
+		c := newTestCore(withDataCoord(dc))
+		broker := newServerBroker(c)
+		assert.False(t, broker.RepartitionConfirm(context.Background(), 100))
+	})
+
+	t.Run("not finished", func(t *testing.T) {
+		dc := mocks.NewMockDataCoordClient(t)
+		dc.EXPECT().GetPartitionKeyStats(mock.Anything, mock.Anything).Return(
+			&datapb.GetPartitionKeyStatsResponse{Status: merr.Success(), RepartitionSegments: 2}, nil)
+		c := newTestCore(withDataCoord(dc))
+		broker := newServerBroker(c)
+		assert.False(t, broker.RepartitionConfirm(context.Background(), 100))
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		dc := mocks.NewMockDataCoordClient(t)
+		dc.EXPECT().GetPartitionKeyStats(mock.Anything, mock.Anything).Return(
+			&datapb.GetPartitionKeyStatsResponse{Status: merr.Success()}, nil)
+		c := newTestCore(withDataCoord(dc))
+		broker := newServerBroker(c)
+		assert.True(t, broker.RepartitionConfirm(context.Background(), 100))
+	})
+}
+
 func mockGetDatabase(meta *mockrootcoord.IMetaTable) {
 	db := model.NewDatabase(1, "default", pb.DatabaseState_DatabaseCreated, nil)
 	meta.EXPECT().GetDatabaseByName(mock.Anything, mock.Anything, mock.Anything).
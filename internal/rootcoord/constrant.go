@@ -18,7 +18,11 @@ package rootcoord
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
@@ -69,3 +73,62 @@ func checkGeneralCapacity(ctx context.Context, newColNum int,
 	}
 	return nil
 }
+
+// checkDatabaseCapacity checks the database level limits of the partition number and the sum of the vector dimensions,
+// which are set by the database properties.
+func checkDatabaseCapacity(ctx context.Context, dbName string,
+	newParNum int64,
+	newDimSum int64,
+	core *Core,
+	ts typeutil.Timestamp,
+) error {
+	db, err := core.meta.GetDatabaseByName(ctx, dbName, typeutil.MaxTimestamp)
+	if err != nil {
+		return err
+	}
+	maxPartitions, err := common.DatabaseLevelLimit(db.Properties, common.DatabaseMaxPartitionsKey)
+	if err != nil {
+		return merr.WrapErrParameterInvalidMsg(err.Error())
+	}
+	maxDimSum, err := common.DatabaseLevelLimit(db.Properties, common.DatabaseMaxVectorDimSumKey)
+	if err != nil {
+		return merr.WrapErrParameterInvalidMsg(err.Error())
+	}
+	if maxPartitions <= 0 && maxDimSum <= 0 {
+		return nil
+	}
+
+	partitionNum, dimSum := newParNum, newDimSum
+	for _, collectionID := range core.meta.ListAllAvailCollections(ctx)[db.ID] {
+		collection, err := core.meta.GetCollectionByID(ctx, db.Name, collectionID, ts, true)
+		if err != nil {
+			continue
+		}
+		partitionNum += int64(collection.GetPartitionNum(false))
+		dimSum += vectorDimSum(model.MarshalFieldModels(collection.Fields))
+	}
+	if maxPartitions > 0 && partitionNum > maxPartitions {
+		return merr.WrapErrDatabaseLimitExceeded(dbName, "partitions", maxPartitions,
+			fmt.Sprintf("partition number %d exceeds the database limit", partitionNum))
+	}
+	if maxDimSum > 0 && dimSum > maxDimSum {
+		return merr.WrapErrDatabaseLimitExceeded(dbName, "vector dimensions", maxDimSum,
+			fmt.Sprintf("sum of vector dimensions %d exceeds the database limit", dimSum))
+	}
+	return nil
+}
+
+// vectorDimSum returns the sum of the dimensions of the dense vector fields.
+func vectorDimSum(fields []*schemapb.FieldSchema) int64 {
+	var sum int64
+	for _, field := range fields {
+		if !typeutil.IsVectorType(field.GetDataType()) || typeutil.IsSparseFloatVectorType(field.GetDataType()) {
+			continue
+		}
+		dim, err := typeutil.GetDim(field)
+		if err == nil {
+			sum += dim
+		}
+	}
+	return sum
+}
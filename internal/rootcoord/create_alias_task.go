@@ -28,6 +28,10 @@ type createAliasTask struct {
 	Req *milvuspb.CreateAliasRequest
 }
 
+func (t *createAliasTask) jobTarget() (string, string, string) {
+	return "CreateAlias", t.Req.GetDbName(), t.Req.GetCollectionName()
+}
+
 func (t *createAliasTask) Prepare(ctx context.Context) error {
 	if err := CheckMsgType(t.Req.GetBase().GetMsgType(), commonpb.MsgType_CreateAlias); err != nil {
 		return err
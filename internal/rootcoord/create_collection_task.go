@@ -59,6 +59,10 @@ type createCollectionTask struct {
 	partitionNames []string
 }
 
+func (t *createCollectionTask) jobTarget() (string, string, string) {
+	return "CreateCollection", t.Req.GetDbName(), t.Req.GetCollectionName()
+}
+
 func (t *createCollectionTask) validate() error {
 	if t.Req == nil {
 		return errors.New("empty requests")
@@ -120,27 +124,31 @@ func (t *createCollectionTask) checkMaxCollectionsPerDB(db2CollIDs map[int64][]i
 		return merr.WrapErrDatabaseNotFound(t.Req.GetDbName(), "failed to create collection")
 	}
 
-	check := func(maxColNumPerDB int) error {
-		if len(collIDs) >= maxColNumPerDB {
-			log.Warn("unable to create collection because the number of collection has reached the limit in DB", zap.Int("maxCollectionNumPerDB", maxColNumPerDB))
-			return merr.WrapErrCollectionNumLimitExceeded(t.Req.GetDbName(), maxColNumPerDB)
-		}
-		return nil
+	maxColNumPerDB, err := getMaxCollectionsPerDB(db)
+	if err != nil {
+		return err
+	}
+	if len(collIDs) >= maxColNumPerDB {
+		log.Warn("unable to create collection because the number of collection has reached the limit in DB", zap.Int("maxCollectionNumPerDB", maxColNumPerDB))
+		return merr.WrapErrCollectionNumLimitExceeded(t.Req.GetDbName(), maxColNumPerDB)
 	}
+	return nil
+}
 
+// getMaxCollectionsPerDB returns the max collection number of the database, the DB property takes precedence
+// over the quota configuration.
+func getMaxCollectionsPerDB(db *model.Database) (int, error) {
 	maxColNumPerDBStr := db.GetProperty(common.DatabaseMaxCollectionsKey)
-	if maxColNumPerDBStr != "" {
-		maxColNumPerDB, err := strconv.Atoi(maxColNumPerDBStr)
-		if err != nil {
-			log.Warn("parse value of property fail", zap.String("key", common.DatabaseMaxCollectionsKey),
-				zap.String("value", maxColNumPerDBStr), zap.Error(err))
-			return fmt.Errorf(fmt.Sprintf("parse value of property fail, key:%s, value:%s", common.DatabaseMaxCollectionsKey, maxColNumPerDBStr))
-		}
-		return check(maxColNumPerDB)
+	if maxColNumPerDBStr == "" {
+		return Params.QuotaConfig.MaxCollectionNumPerDB.GetAsInt(), nil
 	}
-
-	maxColNumPerDB := Params.QuotaConfig.MaxCollectionNumPerDB.GetAsInt()
-	return check(maxColNumPerDB)
+	maxColNumPerDB, err := strconv.Atoi(maxColNumPerDBStr)
+	if err != nil {
+		log.Warn("parse value of property fail", zap.String("key", common.DatabaseMaxCollectionsKey),
+			zap.String("value", maxColNumPerDBStr), zap.Error(err))
+		return 0, fmt.Errorf(fmt.Sprintf("parse value of property fail, key:%s, value:%s", common.DatabaseMaxCollectionsKey, maxColNumPerDBStr))
+	}
+	return maxColNumPerDB, nil
 }
 
 func checkDefaultValue(schema *schemapb.CollectionSchema) error {
@@ -383,6 +391,14 @@ func (t *createCollectionTask) Prepare(ctx context.Context) error {
 		return err
 	}
 
+	var newPartNum int64 = 1
+	if _, err := typeutil.GetPartitionKeyFieldSchema(t.schema); err == nil && t.Req.GetNumPartitions() > 0 {
+		newPartNum = t.Req.GetNumPartitions()
+	}
+	if err := checkDatabaseCapacity(ctx, t.Req.GetDbName(), newPartNum, vectorDimSum(t.schema.GetFields()), t.core, t.ts); err != nil {
+		return err
+	}
+
 	t.assignShardsNum()
 
 	if err := t.assignCollectionID(); err != nil {
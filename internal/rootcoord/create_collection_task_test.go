@@ -33,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util"
@@ -709,6 +710,45 @@ func Test_createCollectionTask_Prepare(t *testing.T) {
 		assert.Error(t, err)
 	})
 
+	t.Run("database capacity exceeded", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetDatabaseByName(mock.Anything, mock.Anything, mock.Anything).Return(
+			model.NewDatabase(1, "db", etcdpb.DatabaseState_DatabaseCreated, []*commonpb.KeyValuePair{
+				{Key: common.DatabaseMaxVectorDimSumKey, Value: "128"},
+			}), nil)
+		meta.EXPECT().ListAllAvailCollections(mock.Anything).Return(map[int64][]int64{1: {1}})
+		meta.EXPECT().GetDatabaseByID(mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("mock")).Maybe()
+		meta.EXPECT().GetCollectionByID(mock.Anything, "db", int64(1), mock.Anything, true).Return(&model.Collection{
+			Fields: []*model.Field{
+				{Name: "vec", DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "100"}}},
+			},
+		}, nil)
+		core := newTestCore(withMeta(meta))
+
+		collectionName := funcutil.GenRandomStr()
+		schema := &schemapb.CollectionSchema{
+			Name: collectionName,
+			Fields: []*schemapb.FieldSchema{
+				{Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				{Name: "vec", DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "64"}}},
+			},
+		}
+		marshaledSchema, err := proto.Marshal(schema)
+		assert.NoError(t, err)
+		task := createCollectionTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req: &milvuspb.CreateCollectionRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateCollection},
+				DbName:         "db",
+				CollectionName: collectionName,
+				Schema:         marshaledSchema,
+			},
+			dbID: 1,
+		}
+		err = task.Prepare(context.Background())
+		assert.ErrorIs(t, err, merr.ErrDatabaseLimitExceeded)
+	})
+
 	t.Run("normal case", func(t *testing.T) {
 		defer cleanTestEnv()
 
@@ -31,7 +31,15 @@ type createDatabaseTask struct {
 	dbID UniqueID
 }
 
+func (t *createDatabaseTask) jobTarget() (string, string, string) {
+	return "CreateDatabase", t.Req.GetDbName(), ""
+}
+
 func (t *createDatabaseTask) Prepare(ctx context.Context) error {
+	if err := checkDatabaseProperties(t.Req.GetProperties()); err != nil {
+		return err
+	}
+
 	dbs, err := t.core.meta.ListDatabases(ctx, t.GetTs())
 	if err != nil {
 		return err
@@ -28,6 +28,8 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
@@ -48,6 +50,21 @@ func Test_CreateDBTask_Prepare(t *testing.T) {
 		assert.Error(t, err)
 	})
 
+	t.Run("invalid properties", func(t *testing.T) {
+		task := &createDatabaseTask{
+			baseTask: newBaseTask(context.TODO(), newTestCore()),
+			Req: &milvuspb.CreateDatabaseRequest{
+				Base: &commonpb.MsgBase{
+					MsgType: commonpb.MsgType_CreateDatabase,
+				},
+				DbName:     "db",
+				Properties: []*commonpb.KeyValuePair{{Key: common.DatabaseMaxPartitionsKey, Value: "-1"}},
+			},
+		}
+		err := task.Prepare(context.Background())
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
 	t.Run("check database number fail", func(t *testing.T) {
 		meta := mockrootcoord.NewIMetaTable(t)
 		cfgMaxDatabaseNum := Params.RootCoordCfg.MaxDatabaseNum.GetAsInt()
@@ -26,7 +26,9 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
 )
 
 type createPartitionTask struct {
@@ -35,6 +37,10 @@ type createPartitionTask struct {
 	collMeta *model.Collection
 }
 
+func (t *createPartitionTask) jobTarget() (string, string, string) {
+	return "CreatePartition", t.Req.GetDbName(), t.Req.GetCollectionName()
+}
+
 func (t *createPartitionTask) Prepare(ctx context.Context) error {
 	if err := CheckMsgType(t.Req.GetBase().GetMsgType(), commonpb.MsgType_CreatePartition); err != nil {
 		return err
@@ -44,6 +50,9 @@ func (t *createPartitionTask) Prepare(ctx context.Context) error {
 		return err
 	}
 	t.collMeta = collMeta
+	if err := checkDatabaseCapacity(ctx, t.Req.GetDbName(), 1, 0, t.core, t.ts); err != nil {
+		return err
+	}
 	return checkGeneralCapacity(ctx, 0, 1, 0, t.core, t.ts)
 }
 
@@ -116,5 +125,18 @@ func (t *createPartitionTask) Execute(ctx context.Context) error {
 		ts:           t.GetTs(),
 	}, &nullStep{})
 
+	// the ttl of the partition is applied by datacoord, which knows the name of the partition by the broadcast
+	if ttl, _ := common.PartitionTTL(funcutil.KeyValuePair2Map(t.collMeta.Properties), t.Req.GetPartitionName()); ttl > 0 {
+		undoTask.AddStep(&BroadcastAlteredCollectionStep{
+			baseStep: baseStep{core: t.core},
+			req: &milvuspb.AlterCollectionRequest{
+				DbName:         t.Req.GetDbName(),
+				CollectionName: t.collMeta.Name,
+				CollectionID:   t.collMeta.CollectionID,
+			},
+			core: t.core,
+		}, &nullStep{})
+	}
+
 	return undoTask.Execute(ctx)
 }
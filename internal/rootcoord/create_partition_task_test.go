@@ -29,7 +29,9 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
 func Test_createPartitionTask_Prepare(t *testing.T) {
@@ -70,6 +72,7 @@ func Test_createPartitionTask_Prepare(t *testing.T) {
 		meta.On("GetDatabaseByID",
 			mock.Anything, mock.Anything, mock.Anything,
 		).Return(nil, errors.New("mock"))
+		meta.EXPECT().GetDatabaseByName(mock.Anything, mock.Anything, mock.Anything).Return(model.NewDefaultDatabase(), nil)
 
 		core := newTestCore(withMeta(meta))
 		task := &createPartitionTask{
@@ -80,6 +83,31 @@ func Test_createPartitionTask_Prepare(t *testing.T) {
 		assert.NoError(t, err)
 		assert.True(t, coll.Equal(*task.collMeta))
 	})
+
+	t.Run("database partition number exceeds limit", func(t *testing.T) {
+		coll := &model.Collection{Name: "coll", Partitions: []*model.Partition{{PartitionName: "_default"}}}
+
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(coll.Clone(), nil)
+		meta.EXPECT().GetDatabaseByName(mock.Anything, mock.Anything, mock.Anything).Return(
+			model.NewDatabase(1, "db", etcdpb.DatabaseState_DatabaseCreated, []*commonpb.KeyValuePair{
+				{Key: common.DatabaseMaxPartitionsKey, Value: "1"},
+			}), nil)
+		meta.EXPECT().ListAllAvailCollections(mock.Anything).Return(map[int64][]int64{1: {1}})
+		meta.EXPECT().GetCollectionByID(mock.Anything, "db", int64(1), mock.Anything, true).Return(coll.Clone(), nil)
+
+		core := newTestCore(withMeta(meta))
+		task := &createPartitionTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req: &milvuspb.CreatePartitionRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_CreatePartition},
+				DbName:         "db",
+				CollectionName: "coll",
+			},
+		}
+		err := task.Prepare(context.Background())
+		assert.ErrorIs(t, err, merr.ErrDatabaseLimitExceeded)
+	})
 }
 
 func Test_createPartitionTask_Execute(t *testing.T) {
@@ -178,4 +206,44 @@ func Test_createPartitionTask_Execute(t *testing.T) {
 		err := task.Execute(context.Background())
 		assert.NoError(t, err)
 	})
+
+	t.Run("partition with ttl", func(t *testing.T) {
+		collectionName := funcutil.GenRandomStr()
+		partitionName := funcutil.GenRandomStr()
+		coll := &model.Collection{
+			CollectionID: 1,
+			Name:         collectionName,
+			Partitions:   []*model.Partition{},
+			Properties: []*commonpb.KeyValuePair{
+				{Key: common.PartitionPropertyKey(partitionName, common.PartitionTTLConfigKey), Value: "3600"},
+			},
+		}
+		meta := newMockMetaTable()
+		meta.AddPartitionFunc = func(ctx context.Context, partition *model.Partition) error {
+			return nil
+		}
+		meta.ChangePartitionStateFunc = func(ctx context.Context, collectionID UniqueID, partitionID UniqueID, state etcdpb.PartitionState, ts Timestamp) error {
+			return nil
+		}
+		b := newMockBroker()
+		b.SyncNewCreatedPartitionFunc = func(ctx context.Context, collectionID UniqueID, partitionID UniqueID) error {
+			return nil
+		}
+		// datacoord is notified of the partition name to apply the ttl
+		broadcasted := false
+		b.BroadcastAlteredCollectionFunc = func(ctx context.Context, req *milvuspb.AlterCollectionRequest) error {
+			broadcasted = true
+			assert.Equal(t, int64(1), req.GetCollectionID())
+			return nil
+		}
+		core := newTestCore(withValidIDAllocator(), withValidProxyManager(), withMeta(meta), withBroker(b))
+		task := &createPartitionTask{
+			baseTask: newBaseTask(context.Background(), core),
+			collMeta: coll,
+			Req:      &milvuspb.CreatePartitionRequest{CollectionName: collectionName, PartitionName: partitionName},
+		}
+		err := task.Execute(context.Background())
+		assert.NoError(t, err)
+		assert.True(t, broadcasted)
+	})
 }
@@ -0,0 +1,411 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// ddlJobTask is implemented by the tasks which are tracked as ddl jobs.
+type ddlJobTask interface {
+	task
+	// jobTarget returns the type of the job, and the database and the collection it works on.
+	jobTarget() (jobType string, dbName string, collectionName string)
+}
+
+type ddlJobCtxKey struct{}
+
+func withDDLJob(ctx context.Context, job *ddlJob) context.Context {
+	return context.WithValue(ctx, ddlJobCtxKey{}, job)
+}
+
+// ddlJobFromContext returns the job of the task executing with the context, nil if it's not tracked.
+func ddlJobFromContext(ctx context.Context) *ddlJob {
+	job, _ := ctx.Value(ddlJobCtxKey{}).(*ddlJob)
+	return job
+}
+
+// ddlJob is the state and step progress of a ddl task, the job has the same id as the task.
+// A job is still executing after the task is done if the task leaves steps to the step executor,
+// it finishes when all of them are done. All the methods are no-op on a nil job.
+type ddlJob struct {
+	manager *ddlJobManager
+
+	mu   sync.Mutex
+	info *internalpb.DDLJob
+	// the indexes of the steps in info.Steps
+	steps map[nestedStep]int
+	// whether the task is done
+	executed bool
+	// the number of the step stacks not finished in background
+	pendingStacks int
+}
+
+func (j *ddlJob) start() {
+	if j == nil {
+		return
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.info.State = internalpb.DDLJobState_DDLJobExecuting
+	j.save()
+}
+
+// addSteps records the steps to execute by the job.
+func (j *ddlJob) addSteps(steps ...nestedStep) {
+	if j == nil || len(steps) == 0 {
+		return
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	for _, step := range steps {
+		j.steps[step] = len(j.info.Steps)
+		j.info.Steps = append(j.info.Steps, &internalpb.DDLJobStep{Desc: step.Desc()})
+	}
+	j.updateProgress()
+	j.save()
+}
+
+// stepDone marks the step done, the steps not recorded by addSteps are ignored, e.g. the children steps.
+func (j *ddlJob) stepDone(step nestedStep) {
+	if j == nil {
+		return
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	idx, ok := j.steps[step]
+	if !ok {
+		return
+	}
+	delete(j.steps, step)
+	j.info.Steps[idx].Done = true
+	j.updateProgress()
+	j.save()
+}
+
+// addBackground notes a step stack of the job is handed to the step executor.
+func (j *ddlJob) addBackground() {
+	if j == nil {
+		return
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.pendingStacks++
+}
+
+// backgroundDone notes a step stack of the job is done, err is the unrecoverable error failing the stack.
+func (j *ddlJob) backgroundDone(err error) {
+	if j == nil {
+		return
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.pendingStacks--
+	if err != nil {
+		j.finish(err)
+		return
+	}
+	if j.executed && j.pendingStacks <= 0 {
+		j.finish(nil)
+	}
+}
+
+// taskDone notes the task of the job is done.
+func (j *ddlJob) taskDone(err error) {
+	if j == nil {
+		return
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.executed = true
+	if err != nil || j.pendingStacks <= 0 {
+		j.finish(err)
+	}
+}
+
+func (j *ddlJob) finish(err error) {
+	if isDDLJobFinished(j.info) {
+		return
+	}
+	if err != nil {
+		j.info.State = internalpb.DDLJobState_DDLJobFailed
+		j.info.Reason = err.Error()
+	} else {
+		j.info.State = internalpb.DDLJobState_DDLJobCompleted
+		j.info.Progress = 100
+	}
+	j.info.EndTime = time.Now().UnixMilli()
+	j.save()
+	log.Info("ddl job finished",
+		zap.Int64("jobID", j.info.GetJobID()),
+		zap.String("type", j.info.GetJobType()),
+		zap.String("state", j.info.GetState().String()),
+		zap.String("reason", j.info.GetReason()))
+}
+
+func (j *ddlJob) updateProgress() {
+	if len(j.info.Steps) == 0 {
+		return
+	}
+	done := 0
+	for _, step := range j.info.Steps {
+		if step.GetDone() {
+			done++
+		}
+	}
+	j.info.Progress = int64(done * 100 / len(j.info.Steps))
+}
+
+// save marks the job to persist by the manager in background, so the ddl is not blocked by the writes of the job.
+func (j *ddlJob) save() {
+	j.manager.markDirty(j.info.GetJobID())
+}
+
+func (j *ddlJob) snapshot() *internalpb.DDLJob {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return proto.Clone(j.info).(*internalpb.DDLJob)
+}
+
+func isDDLJobFinished(job *internalpb.DDLJob) bool {
+	switch job.GetState() {
+	case internalpb.DDLJobState_DDLJobCompleted, internalpb.DDLJobState_DDLJobFailed, internalpb.DDLJobState_DDLJobInterrupted:
+		return true
+	default:
+		return false
+	}
+}
+
+var (
+	// the interval to persist the jobs changed, the changes of a job within the interval are saved once
+	ddlJobFlushInterval = time.Second
+	// the interval to remove the jobs finished for the retention time
+	ddlJobExpireInterval = time.Minute
+)
+
+// ddlJobManager keeps the ddl jobs until they're finished for the retention time.
+// The jobs are persisted and expired in background.
+type ddlJobManager struct {
+	catalog metastore.RootCoordCatalog
+
+	mu   sync.RWMutex
+	jobs map[UniqueID]*ddlJob
+
+	dirtyMu sync.Mutex
+	// the jobs changed but not persisted yet
+	dirty typeutil.UniqueSet
+
+	wg       sync.WaitGroup
+	stopChan chan struct{}
+	stopOnce sync.Once
+}
+
+func newDDLJobManager(catalog metastore.RootCoordCatalog) *ddlJobManager {
+	return &ddlJobManager{
+		catalog:  catalog,
+		jobs:     make(map[UniqueID]*ddlJob),
+		dirty:    typeutil.NewUniqueSet(),
+		stopChan: make(chan struct{}),
+	}
+}
+
+func (m *ddlJobManager) Start() {
+	m.wg.Add(1)
+	go m.run()
+}
+
+func (m *ddlJobManager) run() {
+	defer m.wg.Done()
+	flushTicker := time.NewTicker(ddlJobFlushInterval)
+	defer flushTicker.Stop()
+	expireTicker := time.NewTicker(ddlJobExpireInterval)
+	defer expireTicker.Stop()
+	for {
+		select {
+		case <-m.stopChan:
+			m.flush()
+			log.Info("ddl job manager exit")
+			return
+		case <-flushTicker.C:
+			m.flush()
+		case <-expireTicker.C:
+			m.removeExpired(time.Now())
+		}
+	}
+}
+
+func (m *ddlJobManager) stop() {
+	m.stopOnce.Do(func() {
+		close(m.stopChan)
+	})
+	m.wg.Wait()
+}
+
+func (m *ddlJobManager) markDirty(jobID UniqueID) {
+	m.dirtyMu.Lock()
+	defer m.dirtyMu.Unlock()
+	m.dirty.Insert(jobID)
+}
+
+// flush persists the jobs changed, the ones failed to save are retried by the next flush.
+// The ddl goes on even if it fails as the job is only for observation.
+func (m *ddlJobManager) flush() {
+	m.dirtyMu.Lock()
+	dirty := m.dirty
+	m.dirty = typeutil.NewUniqueSet()
+	m.dirtyMu.Unlock()
+
+	for _, jobID := range dirty.Collect() {
+		m.mu.RLock()
+		job, ok := m.jobs[jobID]
+		m.mu.RUnlock()
+		if !ok {
+			continue
+		}
+		if err := m.catalog.SaveDDLJob(context.Background(), job.snapshot()); err != nil {
+			log.Warn("failed to save ddl job", zap.Int64("jobID", jobID), zap.Error(err))
+			m.dirtyMu.Lock()
+			m.dirty.Insert(jobID)
+			m.dirtyMu.Unlock()
+		}
+	}
+}
+
+// load recovers the jobs, the jobs unfinished are marked interrupted rather than failed, as the steps left
+// of them are recovered by the restore of rootcoord, which doesn't report back to the jobs.
+func (m *ddlJobManager) load(ctx context.Context) error {
+	infos, err := m.catalog.ListDDLJobs(ctx)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	for _, info := range infos {
+		job := &ddlJob{
+			manager:  m,
+			info:     info,
+			steps:    make(map[nestedStep]int),
+			executed: true,
+		}
+		if !isDDLJobFinished(info) {
+			job.info.State = internalpb.DDLJobState_DDLJobInterrupted
+			job.info.Reason = "interrupted by the restart of rootcoord, the steps left are recovered in background"
+			job.info.EndTime = time.Now().UnixMilli()
+			job.save()
+		}
+		m.jobs[info.GetJobID()] = job
+	}
+	m.mu.Unlock()
+	m.removeExpired(time.Now())
+	log.Info("ddl jobs loaded", zap.Int("num", len(infos)))
+	return nil
+}
+
+// register creates a pending job for the task, which has been assigned an id.
+func (m *ddlJobManager) register(t ddlJobTask) *ddlJob {
+	jobType, dbName, collectionName := t.jobTarget()
+	if dbName == "" {
+		dbName = util.DefaultDBName
+	}
+	job := &ddlJob{
+		manager: m,
+		info: &internalpb.DDLJob{
+			JobID:          t.GetID(),
+			JobType:        jobType,
+			DbName:         dbName,
+			CollectionName: collectionName,
+			State:          internalpb.DDLJobState_DDLJobPending,
+			StartTime:      time.Now().UnixMilli(),
+		},
+		steps: make(map[nestedStep]int),
+	}
+
+	m.mu.Lock()
+	m.jobs[t.GetID()] = job
+	m.mu.Unlock()
+	job.save()
+	return job
+}
+
+// removeExpired removes the jobs finished for the retention time, the catalog is written without holding the lock.
+func (m *ddlJobManager) removeExpired(now time.Time) {
+	retention := Params.RootCoordCfg.DDLJobRetentionTime.GetAsDuration(time.Second)
+	m.mu.RLock()
+	expired := make([]UniqueID, 0)
+	for id, job := range m.jobs {
+		info := job.snapshot()
+		if isDDLJobFinished(info) && now.Sub(time.UnixMilli(info.GetEndTime())) >= retention {
+			expired = append(expired, id)
+		}
+	}
+	m.mu.RUnlock()
+
+	for _, id := range expired {
+		if err := m.catalog.DropDDLJob(context.Background(), id); err != nil {
+			log.Warn("failed to drop expired ddl job", zap.Int64("jobID", id), zap.Error(err))
+			continue
+		}
+		m.mu.Lock()
+		delete(m.jobs, id)
+		m.mu.Unlock()
+	}
+}
+
+func (m *ddlJobManager) get(jobID UniqueID) (*internalpb.DDLJob, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	job, ok := m.jobs[jobID]
+	if !ok {
+		return nil, false
+	}
+	return job.snapshot(), true
+}
+
+// list returns the jobs ordered by id, the filters are ignored if empty.
+func (m *ddlJobManager) list(dbName string, collectionName string, onlyUnfinished bool) []*internalpb.DDLJob {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	jobs := make([]*internalpb.DDLJob, 0, len(m.jobs))
+	for _, job := range m.jobs {
+		info := job.snapshot()
+		if dbName != "" && info.GetDbName() != dbName {
+			continue
+		}
+		if collectionName != "" && info.GetCollectionName() != collectionName {
+			continue
+		}
+		if onlyUnfinished && isDDLJobFinished(info) {
+			continue
+		}
+		jobs = append(jobs, info)
+	}
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].GetJobID() < jobs[j].GetJobID()
+	})
+	return jobs
+}
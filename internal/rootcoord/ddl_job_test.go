@@ -0,0 +1,325 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type mockDescStep struct {
+	baseStep
+	desc string
+	err  error
+}
+
+func (s *mockDescStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	return nil, s.err
+}
+
+func (s *mockDescStep) Desc() string {
+	return s.desc
+}
+
+func newDDLJobTestCatalog(t *testing.T) *mocks.RootCoordCatalog {
+	catalog := mocks.NewRootCoordCatalog(t)
+	catalog.EXPECT().SaveDDLJob(mock.Anything, mock.Anything).Return(nil).Maybe()
+	return catalog
+}
+
+func newTestDropCollectionJobTask(id UniqueID, dbName string, collectionName string) *dropCollectionTask {
+	task := &dropCollectionTask{
+		baseTask: newBaseTask(context.Background(), nil),
+		Req:      &milvuspb.DropCollectionRequest{DbName: dbName, CollectionName: collectionName},
+	}
+	task.SetID(id)
+	return task
+}
+
+func Test_ddlJob_nil(t *testing.T) {
+	var job *ddlJob
+	assert.NotPanics(t, func() {
+		job.start()
+		job.addSteps(&mockDescStep{desc: "step"})
+		job.stepDone(&mockDescStep{desc: "step"})
+		job.addBackground()
+		job.backgroundDone(nil)
+		job.taskDone(nil)
+	})
+	assert.Nil(t, ddlJobFromContext(context.Background()))
+}
+
+func Test_ddlJob_progress(t *testing.T) {
+	t.Run("finished after background steps", func(t *testing.T) {
+		m := newDDLJobManager(newDDLJobTestCatalog(t))
+		job := m.register(newTestDropCollectionJobTask(100, "", "coll"))
+		assert.Equal(t, internalpb.DDLJobState_DDLJobPending, job.snapshot().GetState())
+		assert.Equal(t, util.DefaultDBName, job.snapshot().GetDbName())
+
+		syncStep := &mockDescStep{desc: "remove channels"}
+		asyncStep := &mockDescStep{desc: "confirm gc"}
+		job.start()
+		job.addSteps(syncStep, asyncStep)
+		job.stepDone(syncStep)
+		job.addBackground()
+		job.taskDone(nil)
+
+		info := job.snapshot()
+		assert.Equal(t, internalpb.DDLJobState_DDLJobExecuting, info.GetState())
+		assert.Equal(t, int64(50), info.GetProgress())
+		assert.True(t, info.GetSteps()[0].GetDone())
+		assert.False(t, info.GetSteps()[1].GetDone())
+
+		job.stepDone(asyncStep)
+		job.backgroundDone(nil)
+		info = job.snapshot()
+		assert.Equal(t, internalpb.DDLJobState_DDLJobCompleted, info.GetState())
+		assert.Equal(t, int64(100), info.GetProgress())
+		assert.NotZero(t, info.GetEndTime())
+	})
+
+	t.Run("task failed", func(t *testing.T) {
+		m := newDDLJobManager(newDDLJobTestCatalog(t))
+		job := m.register(newTestDropCollectionJobTask(100, "db", "coll"))
+		job.start()
+		job.taskDone(errors.New("error mock Execute"))
+		info := job.snapshot()
+		assert.Equal(t, internalpb.DDLJobState_DDLJobFailed, info.GetState())
+		assert.Equal(t, "error mock Execute", info.GetReason())
+	})
+
+	t.Run("background failed", func(t *testing.T) {
+		m := newDDLJobManager(newDDLJobTestCatalog(t))
+		job := m.register(newTestDropCollectionJobTask(100, "db", "coll"))
+		job.start()
+		job.addBackground()
+		job.taskDone(nil)
+		job.backgroundDone(errors.New("error mock step"))
+		job.backgroundDone(nil)
+		info := job.snapshot()
+		assert.Equal(t, internalpb.DDLJobState_DDLJobFailed, info.GetState())
+		assert.Equal(t, "error mock step", info.GetReason())
+	})
+
+	t.Run("failed to save", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.EXPECT().SaveDDLJob(mock.Anything, mock.Anything).Return(errors.New("error mock SaveDDLJob")).Once()
+		catalog.EXPECT().SaveDDLJob(mock.Anything, mock.Anything).Return(nil).Once()
+		m := newDDLJobManager(catalog)
+		job := m.register(newTestDropCollectionJobTask(100, "db", "coll"))
+		job.start()
+		job.taskDone(nil)
+		assert.Equal(t, internalpb.DDLJobState_DDLJobCompleted, job.snapshot().GetState())
+
+		// retried by the next flush
+		m.flush()
+		assert.True(t, m.dirty.Contain(100))
+		m.flush()
+		assert.Equal(t, 0, m.dirty.Len())
+	})
+}
+
+func Test_ddlJobManager_flush(t *testing.T) {
+	defer func(interval time.Duration) { ddlJobFlushInterval = interval }(ddlJobFlushInterval)
+	ddlJobFlushInterval = time.Millisecond * 10
+
+	saved := make(chan *internalpb.DDLJob, 10)
+	catalog := mocks.NewRootCoordCatalog(t)
+	catalog.EXPECT().SaveDDLJob(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, job *internalpb.DDLJob) error {
+		saved <- job
+		return nil
+	})
+	m := newDDLJobManager(catalog)
+	job := m.register(newTestDropCollectionJobTask(100, "db", "coll"))
+	step := &mockDescStep{desc: "step"}
+	job.start()
+	job.addSteps(step)
+	job.stepDone(step)
+	job.taskDone(nil)
+
+	// the changes are saved in background at once
+	m.Start()
+	defer m.stop()
+	info := <-saved
+	assert.Equal(t, internalpb.DDLJobState_DDLJobCompleted, info.GetState())
+	assert.Len(t, info.GetSteps(), 1)
+	assert.Never(t, func() bool {
+		return len(saved) > 0
+	}, time.Millisecond*50, time.Millisecond*10)
+}
+
+func Test_ddlJob_redoTask(t *testing.T) {
+	m := newDDLJobManager(newDDLJobTestCatalog(t))
+	job := m.register(newTestDropCollectionJobTask(100, "db", "coll"))
+	job.start()
+
+	redo := newTestRedoTask()
+	redo.AddSyncStep(&mockDescStep{desc: "remove channels"})
+	redo.AddAsyncStep(&mockDescStep{desc: "confirm gc"})
+	err := redo.Execute(withDDLJob(context.Background(), job))
+	assert.NoError(t, err)
+	job.taskDone(nil)
+
+	assert.Eventually(t, func() bool {
+		return job.snapshot().GetState() == internalpb.DDLJobState_DDLJobCompleted
+	}, time.Second*5, time.Millisecond*10)
+	info := job.snapshot()
+	assert.Len(t, info.GetSteps(), 2)
+	assert.Equal(t, "remove channels", info.GetSteps()[0].GetDesc())
+	assert.Equal(t, "confirm gc", info.GetSteps()[1].GetDesc())
+}
+
+func Test_ddlJobManager_load(t *testing.T) {
+	t.Run("failed to list", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.EXPECT().ListDDLJobs(mock.Anything).Return(nil, errors.New("error mock ListDDLJobs"))
+		m := newDDLJobManager(catalog)
+		assert.Error(t, m.load(context.Background()))
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		catalog := newDDLJobTestCatalog(t)
+		catalog.EXPECT().ListDDLJobs(mock.Anything).Return([]*internalpb.DDLJob{
+			{JobID: 1, State: internalpb.DDLJobState_DDLJobExecuting},
+			{JobID: 2, State: internalpb.DDLJobState_DDLJobCompleted, EndTime: time.Now().UnixMilli()},
+			{JobID: 3, State: internalpb.DDLJobState_DDLJobCompleted, EndTime: time.Now().Add(-time.Hour * 48).UnixMilli()},
+		}, nil)
+		catalog.EXPECT().DropDDLJob(mock.Anything, int64(3)).Return(nil)
+		m := newDDLJobManager(catalog)
+		assert.NoError(t, m.load(context.Background()))
+
+		job, ok := m.get(1)
+		assert.True(t, ok)
+		assert.Equal(t, internalpb.DDLJobState_DDLJobInterrupted, job.GetState())
+		assert.NotZero(t, job.GetEndTime())
+		_, ok = m.get(2)
+		assert.True(t, ok)
+		_, ok = m.get(3)
+		assert.False(t, ok)
+	})
+}
+
+func Test_ddlJobManager_removeExpired(t *testing.T) {
+	paramtable.Get().Save(Params.RootCoordCfg.DDLJobRetentionTime.Key, "60")
+	defer paramtable.Get().Reset(Params.RootCoordCfg.DDLJobRetentionTime.Key)
+
+	t.Run("in background", func(t *testing.T) {
+		defer func(interval time.Duration) { ddlJobExpireInterval = interval }(ddlJobExpireInterval)
+		ddlJobExpireInterval = time.Millisecond * 10
+
+		catalog := newDDLJobTestCatalog(t)
+		catalog.EXPECT().DropDDLJob(mock.Anything, int64(100)).Return(nil).Once()
+		m := newDDLJobManager(catalog)
+		job := m.register(newTestDropCollectionJobTask(100, "db", "coll"))
+		job.start()
+		job.taskDone(nil)
+		job.info.EndTime = time.Now().Add(-time.Minute * 2).UnixMilli()
+
+		m.Start()
+		defer m.stop()
+		assert.Eventually(t, func() bool {
+			_, ok := m.get(100)
+			return !ok
+		}, time.Second*5, time.Millisecond*10)
+	})
+
+	catalog := newDDLJobTestCatalog(t)
+	catalog.EXPECT().DropDDLJob(mock.Anything, int64(100)).Return(errors.New("error mock DropDDLJob")).Once()
+	catalog.EXPECT().DropDDLJob(mock.Anything, int64(100)).Return(nil).Once()
+	m := newDDLJobManager(catalog)
+	job := m.register(newTestDropCollectionJobTask(100, "db", "coll"))
+	job.start()
+	job.taskDone(nil)
+	m.register(newTestDropCollectionJobTask(101, "db", "coll"))
+
+	// keep the job if failed to drop it.
+	m.removeExpired(time.Now().Add(time.Minute * 2))
+	_, ok := m.get(100)
+	assert.True(t, ok)
+
+	m.removeExpired(time.Now().Add(time.Minute * 2))
+	_, ok = m.get(100)
+	assert.False(t, ok)
+	// the unfinished job never expires.
+	_, ok = m.get(101)
+	assert.True(t, ok)
+}
+
+func Test_ddlJobManager_list(t *testing.T) {
+	m := newDDLJobManager(newDDLJobTestCatalog(t))
+	job := m.register(newTestDropCollectionJobTask(102, "db1", "coll1"))
+	job.start()
+	job.taskDone(nil)
+	m.register(newTestDropCollectionJobTask(101, "db1", "coll2"))
+	m.register(newTestDropCollectionJobTask(100, "db2", "coll1"))
+
+	jobs := m.list("", "", false)
+	assert.Len(t, jobs, 3)
+	assert.Equal(t, int64(100), jobs[0].GetJobID())
+	assert.Equal(t, int64(102), jobs[2].GetJobID())
+	assert.Equal(t, "DropCollection", jobs[0].GetJobType())
+
+	assert.Len(t, m.list("db1", "", false), 2)
+	assert.Len(t, m.list("", "coll1", false), 2)
+	assert.Len(t, m.list("db1", "", true), 1)
+	assert.Len(t, m.list("db3", "", false), 0)
+}
+
+func Test_scheduler_registerDDLJob(t *testing.T) {
+	idAlloc := newMockIDAllocator()
+	tsoAlloc := newMockTsoAllocator()
+	idAlloc.AllocOneF = func() (UniqueID, error) {
+		return 100, nil
+	}
+	tsoAlloc.GenerateTSOF = func(count uint32) (uint64, error) {
+		return 101, nil
+	}
+	m := newDDLJobManager(newDDLJobTestCatalog(t))
+	s := newScheduler(context.Background(), idAlloc, tsoAlloc, withDDLJobManager(m))
+	s.Start()
+	defer s.Stop()
+
+	task := &mockDDLJobTask{mockNormalTask: newMockNormalTask()}
+	err := s.AddTask(task)
+	assert.NoError(t, err)
+	assert.NoError(t, task.WaitToFinish())
+	assert.NotNil(t, ddlJobFromContext(task.GetCtx()))
+
+	assert.Eventually(t, func() bool {
+		job, ok := m.get(100)
+		return ok && job.GetState() == internalpb.DDLJobState_DDLJobCompleted
+	}, time.Second*5, time.Millisecond*10)
+}
+
+type mockDDLJobTask struct {
+	*mockNormalTask
+}
+
+func (t *mockDDLJobTask) jobTarget() (string, string, string) {
+	return "Mock", "db", "coll"
+}
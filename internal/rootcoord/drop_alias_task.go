@@ -29,6 +29,10 @@ type dropAliasTask struct {
 	Req *milvuspb.DropAliasRequest
 }
 
+func (t *dropAliasTask) jobTarget() (string, string, string) {
+	return "DropAlias", t.Req.GetDbName(), ""
+}
+
 func (t *dropAliasTask) Prepare(ctx context.Context) error {
 	if err := CheckMsgType(t.Req.GetBase().GetMsgType(), commonpb.MsgType_DropAlias); err != nil {
 		return err
@@ -0,0 +1,124 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// dropCollectionFieldTask removes a field from the collection schema.
+// The id of the dropped field is recorded in the collection properties,
+// the binlogs of the field are removed by compaction and its indexes are dropped.
+type dropCollectionFieldTask struct {
+	baseTask
+	Req *internalpb.DropCollectionFieldRequest
+}
+
+func (t *dropCollectionFieldTask) jobTarget() (string, string, string) {
+	return "DropCollectionField", t.Req.GetDbName(), t.Req.GetCollectionName()
+}
+
+func (t *dropCollectionFieldTask) Prepare(ctx context.Context) error {
+	if t.Req.GetCollectionName() == "" {
+		return merr.WrapErrParameterInvalidMsg("collection name is empty")
+	}
+	if t.Req.GetFieldName() == "" {
+		return merr.WrapErrParameterInvalidMsg("field name is empty")
+	}
+	return nil
+}
+
+func checkDroppedField(coll *model.Collection, field *model.Field) error {
+	if field.IsPrimaryKey || field.IsPartitionKey || field.IsClusteringKey || field.IsDynamic {
+		return merr.WrapErrParameterInvalidMsg("the field %s could not be dropped since it's primary key, partition key, clustering key or dynamic field", field.Name)
+	}
+	if typeutil.IsVectorType(field.DataType) {
+		remaining := lo.CountBy(coll.Fields, func(f *model.Field) bool {
+			return typeutil.IsVectorType(f.DataType)
+		})
+		if remaining <= 1 {
+			return merr.WrapErrParameterInvalidMsg("the last vector field %s could not be dropped", field.Name)
+		}
+	}
+	return nil
+}
+
+func (t *dropCollectionFieldTask) Execute(ctx context.Context) error {
+	oldColl, err := t.core.meta.GetCollectionByName(ctx, t.Req.GetDbName(), t.Req.GetCollectionName(), t.ts)
+	if err != nil {
+		log.Warn("get collection failed during dropping field",
+			zap.String("collectionName", t.Req.GetCollectionName()), zap.Uint64("ts", t.ts))
+		return err
+	}
+
+	field, ok := lo.Find(oldColl.Fields, func(f *model.Field) bool {
+		return f.Name == t.Req.GetFieldName()
+	})
+	if !ok {
+		return merr.WrapErrFieldNotFound(t.Req.GetFieldName())
+	}
+	if err := checkDroppedField(oldColl, field); err != nil {
+		return err
+	}
+
+	dropped, err := common.CollectionDroppedFields(funcutil.KeyValuePair2Map(oldColl.Properties))
+	if err != nil {
+		return err
+	}
+	dropped = append(dropped, field.FieldID)
+
+	newColl := oldColl.Clone()
+	newColl.Fields = lo.Filter(newColl.Fields, func(f *model.Field, _ int) bool {
+		return f.FieldID != field.FieldID
+	})
+	updateCollectionProperties(newColl, []*commonpb.KeyValuePair{
+		{Key: common.CollectionDroppedFieldsKey, Value: strings.Join(lo.Map(dropped, func(id int64, _ int) string {
+			return strconv.FormatInt(id, 10)
+		}), ",")},
+	})
+	if err := increaseSchemaVersion(newColl); err != nil {
+		return err
+	}
+
+	log.Info("drop collection field",
+		zap.String("collectionName", t.Req.GetCollectionName()),
+		zap.Int64("collectionID", oldColl.CollectionID),
+		zap.String("fieldName", field.Name),
+		zap.Int64("fieldID", field.FieldID))
+
+	undoTask := newSchemaChangeTask(t.core, t.Req.GetDbName(), t.Req.GetCollectionName(), oldColl, newColl, t.GetTs())
+	undoTask.AddStep(&dropFieldIndexStep{
+		baseStep: baseStep{core: t.core},
+		collID:   oldColl.CollectionID,
+		fieldID:  field.FieldID,
+	}, &nullStep{})
+	return undoTask.Execute(ctx)
+}
@@ -0,0 +1,134 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+)
+
+func Test_dropCollectionFieldTask_Prepare(t *testing.T) {
+	task := &dropCollectionFieldTask{Req: &internalpb.DropCollectionFieldRequest{}}
+	assert.Error(t, task.Prepare(context.Background()))
+
+	task = &dropCollectionFieldTask{Req: &internalpb.DropCollectionFieldRequest{CollectionName: "cn"}}
+	assert.Error(t, task.Prepare(context.Background()))
+
+	task = &dropCollectionFieldTask{Req: &internalpb.DropCollectionFieldRequest{CollectionName: "cn", FieldName: "a"}}
+	assert.NoError(t, task.Prepare(context.Background()))
+}
+
+func Test_checkDroppedField(t *testing.T) {
+	coll := newFieldTestCollection()
+	assert.Error(t, checkDroppedField(coll, coll.Fields[0]))
+	assert.Error(t, checkDroppedField(coll, coll.Fields[1]))
+	assert.NoError(t, checkDroppedField(coll, coll.Fields[2]))
+	assert.Error(t, checkDroppedField(coll, coll.Fields[3]))
+}
+
+func Test_dropCollectionFieldTask_Execute(t *testing.T) {
+	t.Run("get collection failed", func(t *testing.T) {
+		core := newTestCore(withInvalidMeta())
+		task := &dropCollectionFieldTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &internalpb.DropCollectionFieldRequest{CollectionName: "cn", FieldName: "a"},
+		}
+		assert.Error(t, task.Execute(context.Background()))
+	})
+
+	t.Run("field not found", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(newFieldTestCollection(), nil)
+		core := newTestCore(withMeta(meta))
+		task := &dropCollectionFieldTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &internalpb.DropCollectionFieldRequest{CollectionName: "cn", FieldName: "x"},
+		}
+		assert.Error(t, task.Execute(context.Background()))
+	})
+
+	t.Run("drop index failed", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(newFieldTestCollection(), nil)
+		meta.EXPECT().ListAliasesByID(mock.Anything).Return([]string{})
+		meta.EXPECT().AlterCollection(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+		broker := newMockBroker()
+		broker.BroadcastAlteredCollectionFunc = func(ctx context.Context, req *milvuspb.AlterCollectionRequest) error {
+			return nil
+		}
+		broker.DropFieldIndexFunc = func(ctx context.Context, collID UniqueID, fieldID UniqueID) error {
+			return errors.New("mock")
+		}
+		undo := make(chan *stepStack, 1)
+		executor := newMockStepExecutor()
+		executor.AddStepsFunc = func(s *stepStack) {
+			undo <- s
+		}
+		core := newTestCore(withValidProxyManager(), withMeta(meta), withBroker(broker), withStepExecutor(executor))
+		task := &dropCollectionFieldTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &internalpb.DropCollectionFieldRequest{CollectionName: "cn", FieldName: "a"},
+		}
+		assert.Error(t, task.Execute(context.Background()))
+		assert.Len(t, (<-undo).steps, 5)
+	})
+
+	t.Run("drop successfully", func(t *testing.T) {
+		coll := newFieldTestCollection()
+		coll.Properties = []*commonpb.KeyValuePair{{Key: common.CollectionDroppedFieldsKey, Value: "104"}}
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(coll, nil)
+		meta.EXPECT().ListAliasesByID(mock.Anything).Return([]string{})
+		meta.EXPECT().AlterCollection(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts uint64) error {
+				assert.Len(t, oldColl.Fields, 4)
+				assert.Len(t, newColl.Fields, 3)
+				props := funcutil.KeyValuePair2Map(newColl.Properties)
+				assert.Equal(t, "104,102", props[common.CollectionDroppedFieldsKey])
+				assert.Equal(t, "1", props[common.CollectionSchemaVersionKey])
+				return nil
+			})
+
+		broker := newMockBroker()
+		broker.BroadcastAlteredCollectionFunc = func(ctx context.Context, req *milvuspb.AlterCollectionRequest) error {
+			return nil
+		}
+		broker.DropFieldIndexFunc = func(ctx context.Context, collID UniqueID, fieldID UniqueID) error {
+			assert.EqualValues(t, 102, fieldID)
+			return nil
+		}
+		core := newTestCore(withValidProxyManager(), withMeta(meta), withBroker(broker))
+		task := &dropCollectionFieldTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &internalpb.DropCollectionFieldRequest{CollectionName: "cn", FieldName: "a"},
+		}
+		assert.NoError(t, task.Execute(context.Background()))
+	})
+}
@@ -37,6 +37,10 @@ type dropCollectionTask struct {
 	Req *milvuspb.DropCollectionRequest
 }
 
+func (t *dropCollectionTask) jobTarget() (string, string, string) {
+	return "DropCollection", t.Req.GetDbName(), t.Req.GetCollectionName()
+}
+
 func (t *dropCollectionTask) validate() error {
 	if err := CheckMsgType(t.Req.GetBase().GetMsgType(), commonpb.MsgType_DropCollection); err != nil {
 		return err
@@ -31,6 +31,10 @@ type dropDatabaseTask struct {
 	Req *milvuspb.DropDatabaseRequest
 }
 
+func (t *dropDatabaseTask) jobTarget() (string, string, string) {
+	return "DropDatabase", t.Req.GetDbName(), ""
+}
+
 func (t *dropDatabaseTask) Prepare(ctx context.Context) error {
 	if t.Req.GetDbName() == util.DefaultDBName {
 		return fmt.Errorf("can not drop default database")
@@ -37,6 +37,10 @@ type dropPartitionTask struct {
 	collMeta *model.Collection
 }
 
+func (t *dropPartitionTask) jobTarget() (string, string, string) {
+	return "DropPartition", t.Req.GetDbName(), t.Req.GetCollectionName()
+}
+
 func (t *dropPartitionTask) Prepare(ctx context.Context) error {
 	if err := CheckMsgType(t.Req.GetBase().GetMsgType(), commonpb.MsgType_DropPartition); err != nil {
 		return err
@@ -67,6 +67,7 @@ type IMetaTable interface {
 	CreateAlias(ctx context.Context, dbName string, alias string, collectionName string, ts Timestamp) error
 	DropAlias(ctx context.Context, dbName string, alias string, ts Timestamp) error
 	AlterAlias(ctx context.Context, dbName string, alias string, collectionName string, ts Timestamp) error
+	AlterAliases(ctx context.Context, dbName string, changes []*internalpb.AliasChange, ts Timestamp) error
 	DescribeAlias(ctx context.Context, dbName string, alias string, ts Timestamp) (string, error)
 	ListAliases(ctx context.Context, dbName string, collectionName string, ts Timestamp) ([]string, error)
 	AlterCollection(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts Timestamp) error
@@ -799,21 +800,47 @@ func (mt *MetaTable) RenameCollection(ctx context.Context, dbName string, oldNam
 		return err
 	}
 
-	// unsupported rename collection while the collection has aliases
+	// the aliases are moved into the target database along with the collection
 	aliases := mt.listAliasesByID(oldColl.CollectionID)
-	if len(aliases) > 0 && oldColl.DBID != targetDB.ID {
-		return fmt.Errorf("fail to rename db name, must drop all aliases of this collection before rename")
+	movingDB := oldColl.DBID != targetDB.ID
+	if movingDB {
+		for _, alias := range aliases {
+			if _, ok := mt.names.get(newDBName, alias); ok || alias == newName {
+				return merr.WrapErrAliasCollectionNameConflict(newDBName, alias)
+			}
+			if _, ok := mt.aliases.get(newDBName, alias); ok {
+				return merr.WrapErrAliasAlreadyExist(newDBName, alias)
+			}
+		}
 	}
 
 	newColl = oldColl.Clone()
 	newColl.Name = newName
 	newColl.DBID = targetDB.ID
-	if err := mt.catalog.AlterCollection(ctx, oldColl, newColl, metastore.MODIFY, ts); err != nil {
+	// the old name is kept in the same write, so that the grants are moved after restart if interrupted
+	props := funcutil.KeyValuePair2Map(newColl.Properties)
+	props[common.CollectionRenameFromKey] = funcutil.CombineObjectName(dbName, oldName)
+	newColl.Properties = funcutil.Map2KeyValuePair(props)
+	// the aliases are passed to the catalog to move their keys in the same transaction,
+	// they're kept by mt.aliases rather than the collection meta in memory
+	savedOld, savedNew := oldColl, newColl
+	if movingDB {
+		savedOld, savedNew = oldColl.Clone(), newColl.Clone()
+		savedOld.Aliases = aliases
+		savedNew.Aliases = aliases
+	}
+	if err := mt.catalog.AlterCollection(ctx, savedOld, savedNew, metastore.MODIFY, ts); err != nil {
 		return err
 	}
 
 	mt.names.insert(newDBName, newName, oldColl.CollectionID)
 	mt.names.remove(dbName, oldName)
+	if movingDB {
+		for _, alias := range aliases {
+			mt.aliases.insert(newDBName, alias, oldColl.CollectionID)
+			mt.aliases.remove(dbName, alias)
+		}
+	}
 
 	mt.collID2Meta[oldColl.CollectionID] = newColl
 
@@ -1092,6 +1119,81 @@ func (mt *MetaTable) AlterAlias(ctx context.Context, dbName string, alias string
 	return nil
 }
 
+// AlterAliases creates, alters or drops the aliases of the database all or nothing.
+func (mt *MetaTable) AlterAliases(ctx context.Context, dbName string, changes []*internalpb.AliasChange, ts Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+	// backward compatibility for rolling  upgrade
+	if dbName == "" {
+		log.Warn("db name is empty", zap.Int("changes", len(changes)))
+		dbName = util.DefaultDBName
+	}
+
+	db, err := mt.getDatabaseByNameInternal(ctx, dbName, typeutil.MaxTimestamp)
+	if err != nil {
+		return err
+	}
+
+	// all the changes are validated before any of them is applied
+	aliases := make([]*model.Alias, 0, len(changes))
+	droppedAliases := make([]string, 0)
+	for _, change := range changes {
+		alias := change.GetAlias()
+		if collID, ok := mt.names.get(dbName, alias); ok {
+			coll, ok := mt.collID2Meta[collID]
+			// allow alias with dropping&dropped
+			if ok && coll.State != pb.CollectionState_CollectionDropping && coll.State != pb.CollectionState_CollectionDropped {
+				return merr.WrapErrAliasCollectionNameConflict(dbName, alias)
+			}
+		}
+
+		if change.GetCollectionName() == "" {
+			if _, ok := mt.aliases.get(dbName, alias); !ok {
+				return merr.WrapErrAliasNotFound(dbName, alias)
+			}
+			droppedAliases = append(droppedAliases, alias)
+			continue
+		}
+
+		collectionID, ok := mt.names.get(dbName, change.GetCollectionName())
+		if !ok {
+			// you cannot alias to a non-existent collection.
+			return merr.WrapErrCollectionNotFoundWithDB(dbName, change.GetCollectionName())
+		}
+		coll, ok := mt.collID2Meta[collectionID]
+		if !ok || !coll.Available() {
+			return merr.WrapErrCollectionNotFoundWithDB(dbName, change.GetCollectionName())
+		}
+		aliases = append(aliases, &model.Alias{
+			Name:         alias,
+			CollectionID: collectionID,
+			CreatedTime:  ts,
+			State:        pb.AliasState_AliasCreated,
+			DbID:         db.ID,
+		})
+	}
+
+	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName.GetValue())
+	if err := mt.catalog.AlterAliases(ctx1, db.ID, aliases, droppedAliases, ts); err != nil {
+		return err
+	}
+
+	for _, alias := range aliases {
+		mt.aliases.insert(dbName, alias.Name, alias.CollectionID)
+	}
+	for _, alias := range droppedAliases {
+		mt.aliases.remove(dbName, alias)
+	}
+
+	log.Ctx(ctx).Info("alter aliases",
+		zap.String("db", dbName),
+		zap.Any("changes", changes),
+		zap.Uint64("ts", ts),
+	)
+
+	return nil
+}
+
 func (mt *MetaTable) DescribeAlias(ctx context.Context, dbName string, alias string, ts Timestamp) (string, error) {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
@@ -29,6 +29,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/metastore"
 	"github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
 	"github.com/milvus-io/milvus/internal/metastore/mocks"
 	"github.com/milvus-io/milvus/internal/metastore/model"
@@ -37,6 +38,7 @@ import (
 	mocktso "github.com/milvus-io/milvus/internal/tso/mocks"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
@@ -710,6 +712,92 @@ func TestMetaTable_AlterCollection(t *testing.T) {
 	})
 }
 
+func TestMetaTable_AlterAliases(t *testing.T) {
+	newMeta := func(catalog metastore.RootCoordCatalog) *MetaTable {
+		meta := &MetaTable{
+			dbName2Meta: map[string]*model.Database{
+				"db": {ID: 1, Name: "db"},
+			},
+			collID2Meta: map[typeutil.UniqueID]*model.Collection{
+				100: {CollectionID: 100, Name: "coll1", State: pb.CollectionState_CollectionCreated},
+				101: {CollectionID: 101, Name: "coll2", State: pb.CollectionState_CollectionCreated},
+				102: {CollectionID: 102, Name: "coll3", State: pb.CollectionState_CollectionDropping},
+			},
+			names:   newNameDb(),
+			aliases: newNameDb(),
+			catalog: catalog,
+		}
+		meta.names.insert("db", "coll1", 100)
+		meta.names.insert("db", "coll2", 101)
+		meta.names.insert("db", "coll3", 102)
+		meta.aliases.insert("db", "alias1", 100)
+		meta.aliases.insert("db", "alias2", 101)
+		return meta
+	}
+
+	t.Run("database not found", func(t *testing.T) {
+		meta := newMeta(nil)
+		err := meta.AlterAliases(context.TODO(), "not_exist", []*internalpb.AliasChange{{Alias: "alias1", CollectionName: "coll2"}}, 0)
+		assert.ErrorIs(t, err, merr.ErrDatabaseNotFound)
+	})
+
+	t.Run("invalid changes", func(t *testing.T) {
+		meta := newMeta(nil)
+		ctx := context.TODO()
+		// conflicts with a collection name
+		err := meta.AlterAliases(ctx, "db", []*internalpb.AliasChange{{Alias: "coll1", CollectionName: "coll2"}}, 0)
+		assert.ErrorIs(t, err, merr.ErrAliasCollectionNameConfilct)
+		// drop an alias not exist
+		err = meta.AlterAliases(ctx, "db", []*internalpb.AliasChange{{Alias: "alias1", CollectionName: "coll2"}, {Alias: "alias3"}}, 0)
+		assert.ErrorIs(t, err, merr.ErrAliasNotFound)
+		// point to a collection not exist
+		err = meta.AlterAliases(ctx, "db", []*internalpb.AliasChange{{Alias: "alias1", CollectionName: "coll4"}}, 0)
+		assert.ErrorIs(t, err, merr.ErrCollectionNotFound)
+		// point to a collection being dropped
+		err = meta.AlterAliases(ctx, "db", []*internalpb.AliasChange{{Alias: "alias1", CollectionName: "coll3"}}, 0)
+		assert.ErrorIs(t, err, merr.ErrCollectionNotFound)
+
+		// nothing is applied
+		collID, _ := meta.aliases.get("db", "alias1")
+		assert.EqualValues(t, 100, collID)
+	})
+
+	t.Run("failed to persist", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.EXPECT().AlterAliases(mock.Anything, int64(1), mock.Anything, mock.Anything, mock.Anything).Return(errors.New("mock"))
+		meta := newMeta(catalog)
+		err := meta.AlterAliases(context.TODO(), "db", []*internalpb.AliasChange{{Alias: "alias1", CollectionName: "coll2"}}, 0)
+		assert.Error(t, err)
+		collID, _ := meta.aliases.get("db", "alias1")
+		assert.EqualValues(t, 100, collID)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.EXPECT().AlterAliases(mock.Anything, int64(1), mock.Anything, []string{"alias2"}, uint64(1000)).
+			RunAndReturn(func(ctx context.Context, dbID int64, aliases []*model.Alias, droppedAliases []string, ts uint64) error {
+				assert.Len(t, aliases, 2)
+				return nil
+			})
+		meta := newMeta(catalog)
+		err := meta.AlterAliases(context.TODO(), "db", []*internalpb.AliasChange{
+			{Alias: "alias1", CollectionName: "coll2"},
+			{Alias: "alias2"},
+			{Alias: "alias3", CollectionName: "coll1"},
+		}, 1000)
+		assert.NoError(t, err)
+
+		collID, ok := meta.aliases.get("db", "alias1")
+		assert.True(t, ok)
+		assert.EqualValues(t, 101, collID)
+		_, ok = meta.aliases.get("db", "alias2")
+		assert.False(t, ok)
+		collID, ok = meta.aliases.get("db", "alias3")
+		assert.True(t, ok)
+		assert.EqualValues(t, 100, collID)
+	})
+}
+
 func TestMetaTable_DescribeAlias(t *testing.T) {
 	t.Run("metatable describe alias ok", func(t *testing.T) {
 		var collectionID int64 = 100
@@ -1552,7 +1640,7 @@ func TestMetaTable_RenameCollection(t *testing.T) {
 		assert.Error(t, err)
 	})
 
-	t.Run("rename db name fails if aliases exists", func(t *testing.T) {
+	t.Run("rename db name fails if aliases conflict", func(t *testing.T) {
 		catalog := mocks.NewRootCoordCatalog(t)
 		catalog.On("GetCollectionByName",
 			mock.Anything,
@@ -1577,9 +1665,64 @@ func TestMetaTable_RenameCollection(t *testing.T) {
 		}
 		meta.names.insert(util.DefaultDBName, "old", 1)
 		meta.aliases.insert(util.DefaultDBName, "alias", 1)
+		meta.names.insert("db1", "alias", 2)
 
 		err := meta.RenameCollection(context.TODO(), util.DefaultDBName, "old", "db1", "new", 1000)
-		assert.Error(t, err)
+		assert.ErrorIs(t, err, merr.ErrAliasCollectionNameConfilct)
+
+		meta.names.remove("db1", "alias")
+		meta.aliases.insert("db1", "alias", 2)
+		err = meta.RenameCollection(context.TODO(), util.DefaultDBName, "old", "db1", "new", 1000)
+		assert.ErrorIs(t, err, merr.ErrAliasAlreadyExist)
+	})
+
+	t.Run("rename db name with aliases", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.On("GetCollectionByName",
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+		).Return(nil, merr.WrapErrCollectionNotFound("error"))
+		catalog.EXPECT().AlterCollection(mock.Anything, mock.Anything, mock.Anything, metastore.MODIFY, mock.Anything).
+			RunAndReturn(func(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, alterType metastore.AlterType, ts uint64) error {
+				assert.EqualValues(t, util.DefaultDBID, oldColl.DBID)
+				assert.EqualValues(t, 2, newColl.DBID)
+				assert.Equal(t, []string{"alias"}, newColl.Aliases)
+				assert.Equal(t, "default.old", funcutil.KeyValuePair2Map(newColl.Properties)[common.CollectionRenameFromKey])
+				return nil
+			})
+		meta := &MetaTable{
+			dbName2Meta: map[string]*model.Database{
+				util.DefaultDBName: model.NewDefaultDatabase(),
+				"db1":              model.NewDatabase(2, "db1", pb.DatabaseState_DatabaseCreated, nil),
+			},
+			catalog: catalog,
+			names:   newNameDb(),
+			aliases: newNameDb(),
+			collID2Meta: map[typeutil.UniqueID]*model.Collection{
+				1: {
+					CollectionID: 1,
+					DBID:         util.DefaultDBID,
+					Name:         "old",
+				},
+			},
+		}
+		meta.names.insert(util.DefaultDBName, "old", 1)
+		meta.aliases.insert(util.DefaultDBName, "alias", 1)
+
+		err := meta.RenameCollection(context.TODO(), util.DefaultDBName, "old", "db1", "new", 1000)
+		assert.NoError(t, err)
+
+		id, ok := meta.names.get("db1", "new")
+		assert.True(t, ok)
+		assert.EqualValues(t, 1, id)
+		_, ok = meta.aliases.get(util.DefaultDBName, "alias")
+		assert.False(t, ok)
+		id, ok = meta.aliases.get("db1", "alias")
+		assert.True(t, ok)
+		assert.EqualValues(t, 1, id)
+		assert.Empty(t, meta.collID2Meta[1].Aliases)
 	})
 
 	t.Run("alter collection ok", func(t *testing.T) {
@@ -70,6 +70,7 @@ type mockMetaTable struct {
 	RemovePartitionFunc              func(ctx context.Context, collectionID UniqueID, partitionID UniqueID, ts Timestamp) error
 	CreateAliasFunc                  func(ctx context.Context, dbName string, alias string, collectionName string, ts Timestamp) error
 	AlterAliasFunc                   func(ctx context.Context, dbName string, alias string, collectionName string, ts Timestamp) error
+	AlterAliasesFunc                 func(ctx context.Context, dbName string, changes []*internalpb.AliasChange, ts Timestamp) error
 	DropAliasFunc                    func(ctx context.Context, dbName string, alias string, ts Timestamp) error
 	IsAliasFunc                      func(dbName, name string) bool
 	DescribeAliasFunc                func(ctx context.Context, dbName, alias string, ts Timestamp) (string, error)
@@ -150,6 +151,10 @@ func (m mockMetaTable) AlterAlias(ctx context.Context, dbName, alias string, col
 	return m.AlterAliasFunc(ctx, dbName, alias, collectionName, ts)
 }
 
+func (m mockMetaTable) AlterAliases(ctx context.Context, dbName string, changes []*internalpb.AliasChange, ts Timestamp) error {
+	return m.AlterAliasesFunc(ctx, dbName, changes, ts)
+}
+
 func (m mockMetaTable) DropAlias(ctx context.Context, dbName, alias string, ts Timestamp) error {
 	return m.DropAliasFunc(ctx, dbName, alias, ts)
 }
@@ -262,6 +267,7 @@ type mockDataCoord struct {
 	broadCastAlteredCollectionFunc func(ctx context.Context, req *datapb.AlterCollectionRequest) (*commonpb.Status, error)
 	GetSegmentIndexStateFunc       func(ctx context.Context, req *indexpb.GetSegmentIndexStateRequest) (*indexpb.GetSegmentIndexStateResponse, error)
 	DropIndexFunc                  func(ctx context.Context, req *indexpb.DropIndexRequest) (*commonpb.Status, error)
+	DescribeIndexFunc              func(ctx context.Context, req *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error)
 }
 
 func newMockDataCoord() *mockDataCoord {
@@ -298,6 +304,10 @@ func (m *mockDataCoord) DropIndex(ctx context.Context, req *indexpb.DropIndexReq
 	return m.DropIndexFunc(ctx, req)
 }
 
+func (m *mockDataCoord) DescribeIndex(ctx context.Context, req *indexpb.DescribeIndexRequest, opts ...grpc.CallOption) (*indexpb.DescribeIndexResponse, error) {
+	return m.DescribeIndexFunc(ctx, req)
+}
+
 type mockQueryCoord struct {
 	types.QueryCoordClient
 	GetSegmentInfoFunc     func(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error)
@@ -467,6 +477,9 @@ func withInvalidMeta() Opt {
 	meta.AlterAliasFunc = func(ctx context.Context, dbName string, alias string, collectionName string, ts Timestamp) error {
 		return errors.New("error mock AlterAlias")
 	}
+	meta.AlterAliasesFunc = func(ctx context.Context, dbName string, changes []*internalpb.AliasChange, ts Timestamp) error {
+		return errors.New("error mock AlterAliases")
+	}
 	meta.DropAliasFunc = func(ctx context.Context, dbName string, alias string, ts Timestamp) error {
 		return errors.New("error mock DropAlias")
 	}
@@ -887,11 +900,13 @@ type mockBroker struct {
 	FlushFunc             func(ctx context.Context, cID int64, segIDs []int64) error
 
 	DropCollectionIndexFunc  func(ctx context.Context, collID UniqueID, partIDs []UniqueID) error
+	DropFieldIndexFunc       func(ctx context.Context, collID UniqueID, fieldID UniqueID) error
 	GetSegmentIndexStateFunc func(ctx context.Context, collID UniqueID, indexName string, segIDs []UniqueID) ([]*indexpb.SegmentIndexState, error)
 
 	BroadcastAlteredCollectionFunc func(ctx context.Context, req *milvuspb.AlterCollectionRequest) error
 
-	GCConfirmFunc func(ctx context.Context, collectionID, partitionID UniqueID) bool
+	GCConfirmFunc          func(ctx context.Context, collectionID, partitionID UniqueID) bool
+	RepartitionConfirmFunc func(ctx context.Context, collectionID UniqueID) bool
 }
 
 func newMockBroker() *mockBroker {
@@ -922,6 +937,10 @@ func (b mockBroker) DropCollectionIndex(ctx context.Context, collID UniqueID, pa
 	return b.DropCollectionIndexFunc(ctx, collID, partIDs)
 }
 
+func (b mockBroker) DropFieldIndex(ctx context.Context, collID UniqueID, fieldID UniqueID) error {
+	return b.DropFieldIndexFunc(ctx, collID, fieldID)
+}
+
 func (b mockBroker) GetSegmentIndexState(ctx context.Context, collID UniqueID, indexName string, segIDs []UniqueID) ([]*indexpb.SegmentIndexState, error) {
 	return b.GetSegmentIndexStateFunc(ctx, collID, indexName, segIDs)
 }
@@ -934,6 +953,10 @@ func (b mockBroker) GcConfirm(ctx context.Context, collectionID, partitionID Uni
 	return b.GCConfirmFunc(ctx, collectionID, partitionID)
 }
 
+func (b mockBroker) RepartitionConfirm(ctx context.Context, collectionID UniqueID) bool {
+	return b.RepartitionConfirmFunc(ctx, collectionID)
+}
+
 func withBroker(b Broker) Opt {
 	return func(c *Core) {
 		c.broker = b
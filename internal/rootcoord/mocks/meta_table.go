@@ -202,6 +202,51 @@ func (_c *IMetaTable_AlterAlias_Call) RunAndReturn(run func(context.Context, str
 	return _c
 }
 
+// AlterAliases provides a mock function with given fields: ctx, dbName, changes, ts
+func (_m *IMetaTable) AlterAliases(ctx context.Context, dbName string, changes []*internalpb.AliasChange, ts uint64) error {
+	ret := _m.Called(ctx, dbName, changes, ts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []*internalpb.AliasChange, uint64) error); ok {
+		r0 = rf(ctx, dbName, changes, ts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IMetaTable_AlterAliases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterAliases'
+type IMetaTable_AlterAliases_Call struct {
+	*mock.Call
+}
+
+// AlterAliases is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbName string
+//   - changes []*internalpb.AliasChange
+//   - ts uint64
+func (_e *IMetaTable_Expecter) AlterAliases(ctx interface{}, dbName interface{}, changes interface{}, ts interface{}) *IMetaTable_AlterAliases_Call {
+	return &IMetaTable_AlterAliases_Call{Call: _e.mock.On("AlterAliases", ctx, dbName, changes, ts)}
+}
+
+func (_c *IMetaTable_AlterAliases_Call) Run(run func(ctx context.Context, dbName string, changes []*internalpb.AliasChange, ts uint64)) *IMetaTable_AlterAliases_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]*internalpb.AliasChange), args[3].(uint64))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_AlterAliases_Call) Return(_a0 error) *IMetaTable_AlterAliases_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IMetaTable_AlterAliases_Call) RunAndReturn(run func(context.Context, string, []*internalpb.AliasChange, uint64) error) *IMetaTable_AlterAliases_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AlterCollection provides a mock function with given fields: ctx, oldColl, newColl, ts
 func (_m *IMetaTable) AlterCollection(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts uint64) error {
 	ret := _m.Called(ctx, oldColl, newColl, ts)
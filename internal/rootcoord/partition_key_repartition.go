@@ -0,0 +1,312 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/util/proxyutil"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// The partitions of partition key are increased in phases:
+//  1. prepare, the number of partitions before increasing is marked in the collection properties, so that the proxies
+//     search the rows in the partitions of both the previous and the new number of partitions;
+//  2. the new partitions are created and the proxies route the inserts by the new number of partitions;
+//  3. start, the ts after the proxies refreshed is marked, the segments started before it are rewritten by repartition
+//     compaction of datacoord;
+//  4. finish, the marks are removed after all the segments are rewritten.
+type repartitionPhase int
+
+const (
+	repartitionPrepare repartitionPhase = iota + 1
+	repartitionStart
+	repartitionFinish
+)
+
+func (p repartitionPhase) String() string {
+	switch p {
+	case repartitionPrepare:
+		return "prepare"
+	case repartitionStart:
+		return "start"
+	case repartitionFinish:
+		return "finish"
+	}
+	return "unknown"
+}
+
+const confirmRepartitionInterval = time.Minute
+
+// increasePartitionKeyPartitions increases the partitions of partition key of the collection to num.
+func (a *alterCollectionTask) increasePartitionKeyPartitions(ctx context.Context, coll *model.Collection, num int64) error {
+	if !hasPartitionKey(coll) {
+		return merr.WrapErrParameterInvalidMsg("collection %s has no partition key", coll.Name)
+	}
+	if _, ok := funcutil.KeyValuePair2Map(coll.Properties)[common.PartitionKeyRepartitionFromKey]; ok {
+		return merr.WrapErrParameterInvalidMsg("the partitions of partition key of collection %s are being increased", coll.Name)
+	}
+	oldNum := int64(0)
+	for _, partition := range coll.Partitions {
+		if partition.Available() {
+			oldNum++
+		}
+	}
+	if num <= oldNum {
+		return merr.WrapErrParameterInvalidMsg("the partitions of partition key can only be increased, current: %d, requested: %d", oldNum, num)
+	}
+	if cfgMaxPartitionNum := Params.RootCoordCfg.MaxPartitionNum.GetAsInt64(); num > cfgMaxPartitionNum {
+		return merr.WrapErrParameterInvalidMsg("partition number (%d) exceeds max configuration (%d), collection: %s", num, cfgMaxPartitionNum, coll.Name)
+	}
+	if err := checkDatabaseCapacity(ctx, a.Req.GetDbName(), num-oldNum, 0, a.core, a.ts); err != nil {
+		return err
+	}
+	if err := checkGeneralCapacity(ctx, 0, num-oldNum, 0, a.core, a.ts); err != nil {
+		return err
+	}
+
+	partIDs, _, err := a.core.idAllocator.Alloc(uint32(num - oldNum))
+	if err != nil {
+		return err
+	}
+
+	aliases := a.core.meta.ListAliasesByID(coll.CollectionID)
+	expireCache := &expireCacheStep{
+		baseStep:        baseStep{core: a.core},
+		dbName:          a.Req.GetDbName(),
+		collectionNames: append(aliases, coll.Name),
+		collectionID:    coll.CollectionID,
+		ts:              a.GetTs(),
+		opts:            []proxyutil.ExpireCacheOpt{proxyutil.SetMsgType(commonpb.MsgType_AlterCollection)},
+	}
+
+	undoTask := newBaseUndoTask(a.core.stepExecutor)
+	undoTask.AddStep(newRepartitionPropertiesStep(a.core, a.Req.GetDbName(), coll.CollectionID, repartitionPrepare, oldNum),
+		newRepartitionPropertiesStep(a.core, a.Req.GetDbName(), coll.CollectionID, repartitionFinish, 0))
+
+	defaultPartitionName := Params.CommonCfg.DefaultPartitionName.GetValue()
+	for i := oldNum; i < num; i++ {
+		partition := &model.Partition{
+			PartitionID:               partIDs + i - oldNum,
+			PartitionName:             fmt.Sprintf("%s_%d", defaultPartitionName, i),
+			PartitionCreatedTimestamp: a.GetTs(),
+			CollectionID:              coll.CollectionID,
+			State:                     pb.PartitionState_PartitionCreating,
+		}
+		undoTask.AddStep(&addPartitionMetaStep{
+			baseStep:  baseStep{core: a.core},
+			partition: partition,
+		}, &removePartitionMetaStep{
+			baseStep:     baseStep{core: a.core},
+			dbID:         coll.DBID,
+			collectionID: coll.CollectionID,
+			partitionID:  partition.PartitionID,
+			ts:           a.GetTs(),
+		})
+		undoTask.AddStep(&nullStep{}, &releasePartitionsStep{
+			baseStep:     baseStep{core: a.core},
+			collectionID: coll.CollectionID,
+			partitionIDs: []int64{partition.PartitionID},
+		})
+		undoTask.AddStep(&syncNewCreatedPartitionStep{
+			baseStep:     baseStep{core: a.core},
+			collectionID: coll.CollectionID,
+			partitionID:  partition.PartitionID,
+		}, &nullStep{})
+		undoTask.AddStep(&changePartitionStateStep{
+			baseStep:     baseStep{core: a.core},
+			collectionID: coll.CollectionID,
+			partitionID:  partition.PartitionID,
+			state:        pb.PartitionState_PartitionCreated,
+			ts:           a.GetTs(),
+		}, &nullStep{})
+	}
+	// the proxies route the inserts by the new partitions after the cache expired
+	undoTask.AddStep(expireCache, &nullStep{})
+	if err := undoTask.Execute(ctx); err != nil {
+		return err
+	}
+
+	// the new partitions might be written already, so the increasing is never undone since now
+	a.core.continuePartitionKeyRepartition(a.Req.GetDbName(), coll.CollectionID, true)
+	return nil
+}
+
+// continuePartitionKeyRepartition starts the rewriting of the existing data if not started,
+// and finishes the increasing in background after the rewriting is done.
+func (c *Core) continuePartitionKeyRepartition(dbName string, collectionID UniqueID, start bool) {
+	// the steps are executed from the last one
+	steps := []nestedStep{newConfirmRepartitionStep(c, dbName, collectionID)}
+	if start {
+		steps = append(steps, newRepartitionPropertiesStep(c, dbName, collectionID, repartitionStart, 0))
+	}
+	c.stepExecutor.AddSteps(&stepStack{steps: steps})
+}
+
+// restorePartitionKeyRepartition continues the increasing of the partitions of partition key interrupted by restart.
+func (c *Core) restorePartitionKeyRepartition(dbName string, coll *model.Collection) {
+	fromNum, ts, err := common.PartitionKeyRepartition(funcutil.KeyValuePair2Map(coll.Properties))
+	if err != nil {
+		log.Warn("invalid partition key repartition properties", zap.Int64("collectionID", coll.CollectionID), zap.Error(err))
+		return
+	}
+	if fromNum == 0 {
+		return
+	}
+	// the partitions still creating are removed by the restore, and the ones created are kept
+	log.Info("restore partition key repartition", zap.Int64("collectionID", coll.CollectionID), zap.Uint64("ts", ts))
+	c.continuePartitionKeyRepartition(dbName, coll.CollectionID, ts == 0)
+}
+
+// repartitionPropertiesStep updates the repartition properties of the collection by the phase and notifies
+// datacoord and the proxies. The collection is read when executing, as its partitions are changed by the previous steps.
+type repartitionPropertiesStep struct {
+	baseStep
+	dbName       string
+	collectionID UniqueID
+	phase        repartitionPhase
+	// the number of partitions before increasing, for the prepare phase only
+	fromNum int64
+}
+
+func newRepartitionPropertiesStep(core *Core, dbName string, collectionID UniqueID, phase repartitionPhase, fromNum int64) *repartitionPropertiesStep {
+	return &repartitionPropertiesStep{
+		baseStep:     baseStep{core: core},
+		dbName:       dbName,
+		collectionID: collectionID,
+		phase:        phase,
+		fromNum:      fromNum,
+	}
+}
+
+func (s *repartitionPropertiesStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	coll, err := s.core.meta.GetCollectionByID(ctx, s.dbName, s.collectionID, typeutil.MaxTimestamp, false)
+	if errors.Is(err, merr.ErrCollectionNotFound) {
+		// nothing to do if the collection is dropped
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	aliases := s.core.meta.ListAliasesByID(coll.CollectionID)
+	if s.phase == repartitionStart {
+		// the rows written after the repartition ts are taken as routed by the new partitions, so the ts is
+		// allocated only after the proxies have refreshed the partitions, which is not promised after restart
+		expireTs, err := s.core.tsoAllocator.GenerateTSO(1)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.core.ExpireMetaCache(ctx, s.dbName, append(aliases, coll.Name), coll.CollectionID, "", expireTs,
+			proxyutil.SetMsgType(commonpb.MsgType_AlterCollection)); err != nil {
+			return nil, err
+		}
+	}
+	ts, err := s.core.tsoAllocator.GenerateTSO(1)
+	if err != nil {
+		return nil, err
+	}
+
+	props := funcutil.KeyValuePair2Map(coll.Properties)
+	switch s.phase {
+	case repartitionPrepare:
+		props[common.PartitionKeyRepartitionFromKey] = strconv.FormatInt(s.fromNum, 10)
+		delete(props, common.PartitionKeyRepartitionTsKey)
+	case repartitionStart:
+		// the rows written after ts are routed by the new partitions, as the proxies have refreshed their cache
+		props[common.PartitionKeyRepartitionTsKey] = strconv.FormatUint(ts, 10)
+	case repartitionFinish:
+		delete(props, common.PartitionKeyRepartitionFromKey)
+		delete(props, common.PartitionKeyRepartitionTsKey)
+	}
+	newColl := coll.Clone()
+	newColl.Properties = funcutil.Map2KeyValuePair(props)
+	if err := s.core.meta.AlterCollection(ctx, coll, newColl, ts); err != nil {
+		return nil, err
+	}
+
+	if err := s.core.broker.BroadcastAlteredCollection(ctx, &milvuspb.AlterCollectionRequest{
+		DbName:         s.dbName,
+		CollectionName: coll.Name,
+		CollectionID:   coll.CollectionID,
+	}); err != nil {
+		return nil, err
+	}
+
+	err = s.core.ExpireMetaCache(ctx, s.dbName, append(aliases, coll.Name), coll.CollectionID, "", ts,
+		proxyutil.SetMsgType(commonpb.MsgType_AlterCollection))
+	return nil, err
+}
+
+func (s *repartitionPropertiesStep) Desc() string {
+	return fmt.Sprintf("%s partition key repartition, collectionID: %d", s.phase.String(), s.collectionID)
+}
+
+// confirmRepartitionStep waits for the rows of the collection rewritten into the new partitions of partition key,
+// then finishes the increasing of the partitions.
+type confirmRepartitionStep struct {
+	baseStep
+	dbName            string
+	collectionID      UniqueID
+	lastScheduledTime time.Time
+}
+
+func newConfirmRepartitionStep(core *Core, dbName string, collectionID UniqueID) *confirmRepartitionStep {
+	return &confirmRepartitionStep{
+		baseStep:          baseStep{core: core},
+		dbName:            dbName,
+		collectionID:      collectionID,
+		lastScheduledTime: time.Now(),
+	}
+}
+
+func (s *confirmRepartitionStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	if time.Since(s.lastScheduledTime) < confirmRepartitionInterval {
+		return nil, fmt.Errorf("wait for reschedule to confirm repartition, collection: %d, last scheduled time: %s, now: %s",
+			s.collectionID, s.lastScheduledTime.String(), time.Now().String())
+	}
+
+	if s.core.broker.RepartitionConfirm(ctx, s.collectionID) {
+		return []nestedStep{newRepartitionPropertiesStep(s.core, s.dbName, s.collectionID, repartitionFinish, 0)}, nil
+	}
+
+	s.lastScheduledTime = time.Now()
+	return nil, fmt.Errorf("repartition is not finished, collection: %d, last scheduled time: %s, now: %s",
+		s.collectionID, s.lastScheduledTime.String(), time.Now().String())
+}
+
+func (s *confirmRepartitionStep) Desc() string {
+	return fmt.Sprintf("wait for repartition finished, collection: %d, last scheduled time: %s, now: %s",
+		s.collectionID, s.lastScheduledTime.String(), time.Now().String())
+}
+
+func (s *confirmRepartitionStep) Weight() stepPriority {
+	return stepPriorityLow
+}
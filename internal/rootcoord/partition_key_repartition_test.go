@@ -0,0 +1,227 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func newRepartitionTestCollection(properties ...*commonpb.KeyValuePair) *model.Collection {
+	return &model.Collection{
+		CollectionID: 1,
+		Name:         "coll",
+		Fields:       []*model.Field{{Name: "key", DataType: schemapb.DataType_Int64, IsPartitionKey: true}},
+		Partitions: []*model.Partition{
+			{PartitionID: 10, PartitionName: "_default_0", State: pb.PartitionState_PartitionCreated},
+			{PartitionID: 11, PartitionName: "_default_1", State: pb.PartitionState_PartitionCreated},
+		},
+		Properties: properties,
+	}
+}
+
+func Test_alterCollectionTask_increasePartitionKeyPartitions(t *testing.T) {
+	paramtable.Init()
+	newTask := func() *alterCollectionTask {
+		return &alterCollectionTask{
+			baseTask: newBaseTask(context.Background(), newTestCore()),
+			Req:      &milvuspb.AlterCollectionRequest{CollectionName: "coll"},
+		}
+	}
+
+	t.Run("no partition key", func(t *testing.T) {
+		coll := newRepartitionTestCollection()
+		coll.Fields = nil
+		err := newTask().increasePartitionKeyPartitions(context.Background(), coll, 4)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("being increased", func(t *testing.T) {
+		coll := newRepartitionTestCollection(&commonpb.KeyValuePair{Key: common.PartitionKeyRepartitionFromKey, Value: "1"})
+		err := newTask().increasePartitionKeyPartitions(context.Background(), coll, 4)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("not increased", func(t *testing.T) {
+		err := newTask().increasePartitionKeyPartitions(context.Background(), newRepartitionTestCollection(), 2)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("exceeds max partitions", func(t *testing.T) {
+		num := Params.RootCoordCfg.MaxPartitionNum.GetAsInt64() + 1
+		err := newTask().increasePartitionKeyPartitions(context.Background(), newRepartitionTestCollection(), num)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+}
+
+func Test_repartitionPropertiesStep_Execute(t *testing.T) {
+	newCore := func(coll *model.Collection, altered **model.Collection) *Core {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByID(mock.Anything, "db", int64(1), mock.Anything, false).Return(coll, nil)
+		meta.EXPECT().AlterCollection(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts uint64) error {
+				*altered = newColl
+				return nil
+			})
+		meta.EXPECT().ListAliasesByID(int64(1)).Return([]string{})
+		broker := newMockBroker()
+		broker.BroadcastAlteredCollectionFunc = func(ctx context.Context, req *milvuspb.AlterCollectionRequest) error {
+			return nil
+		}
+		tsoAllocator := newMockTsoAllocator()
+		var nextTs uint64 = 999
+		tsoAllocator.GenerateTSOF = func(count uint32) (uint64, error) {
+			nextTs++
+			return nextTs, nil
+		}
+		return newTestCore(withMeta(meta), withBroker(broker), withTsoAllocator(tsoAllocator), withValidProxyManager())
+	}
+
+	t.Run("prepare", func(t *testing.T) {
+		var altered *model.Collection
+		core := newCore(newRepartitionTestCollection(), &altered)
+		_, err := newRepartitionPropertiesStep(core, "db", 1, repartitionPrepare, 2).Execute(context.Background())
+		require.NoError(t, err)
+		fromNum, ts, err := common.PartitionKeyRepartition(funcutil.KeyValuePair2Map(altered.Properties))
+		assert.NoError(t, err)
+		assert.EqualValues(t, 2, fromNum)
+		assert.EqualValues(t, 0, ts)
+	})
+
+	t.Run("start", func(t *testing.T) {
+		var altered *model.Collection
+		core := newCore(newRepartitionTestCollection(&commonpb.KeyValuePair{Key: common.PartitionKeyRepartitionFromKey, Value: "2"}), &altered)
+		_, err := newRepartitionPropertiesStep(core, "db", 1, repartitionStart, 0).Execute(context.Background())
+		require.NoError(t, err)
+		fromNum, ts, err := common.PartitionKeyRepartition(funcutil.KeyValuePair2Map(altered.Properties))
+		assert.NoError(t, err)
+		assert.EqualValues(t, 2, fromNum)
+		// allocated after the cache of the proxies expired
+		assert.EqualValues(t, 1001, ts)
+	})
+
+	t.Run("start but fail to expire cache", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByID(mock.Anything, "db", int64(1), mock.Anything, false).
+			Return(newRepartitionTestCollection(&commonpb.KeyValuePair{Key: common.PartitionKeyRepartitionFromKey, Value: "2"}), nil)
+		meta.EXPECT().ListAliasesByID(int64(1)).Return([]string{})
+		tsoAllocator := newMockTsoAllocator()
+		tsoAllocator.GenerateTSOF = func(count uint32) (uint64, error) {
+			return 1000, nil
+		}
+		core := newTestCore(withMeta(meta), withTsoAllocator(tsoAllocator), withInvalidProxyManager())
+		_, err := newRepartitionPropertiesStep(core, "db", 1, repartitionStart, 0).Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("finish", func(t *testing.T) {
+		var altered *model.Collection
+		core := newCore(newRepartitionTestCollection(
+			&commonpb.KeyValuePair{Key: common.PartitionKeyRepartitionFromKey, Value: "2"},
+			&commonpb.KeyValuePair{Key: common.PartitionKeyRepartitionTsKey, Value: "1000"},
+		), &altered)
+		_, err := newRepartitionPropertiesStep(core, "db", 1, repartitionFinish, 0).Execute(context.Background())
+		require.NoError(t, err)
+		assert.Empty(t, altered.Properties)
+	})
+
+	t.Run("collection dropped", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByID(mock.Anything, "db", int64(1), mock.Anything, false).Return(nil, merr.WrapErrCollectionNotFound(1))
+		core := newTestCore(withMeta(meta))
+		_, err := newRepartitionPropertiesStep(core, "db", 1, repartitionFinish, 0).Execute(context.Background())
+		assert.NoError(t, err)
+	})
+}
+
+func Test_confirmRepartitionStep_Execute(t *testing.T) {
+	t.Run("wait for reschedule", func(t *testing.T) {
+		s := newConfirmRepartitionStep(newTestCore(), "db", 1)
+		_, err := s.Execute(context.TODO())
+		assert.Error(t, err)
+	})
+
+	t.Run("repartition not finished", func(t *testing.T) {
+		broker := newMockBroker()
+		broker.RepartitionConfirmFunc = func(ctx context.Context, collectionID UniqueID) bool {
+			return false
+		}
+		s := newConfirmRepartitionStep(newTestCore(withBroker(broker)), "db", 1)
+		s.lastScheduledTime = time.Now().Add(-confirmRepartitionInterval)
+		_, err := s.Execute(context.TODO())
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		broker := newMockBroker()
+		broker.RepartitionConfirmFunc = func(ctx context.Context, collectionID UniqueID) bool {
+			return true
+		}
+		s := newConfirmRepartitionStep(newTestCore(withBroker(broker)), "db", 1)
+		s.lastScheduledTime = time.Now().Add(-confirmRepartitionInterval)
+		steps, err := s.Execute(context.TODO())
+		assert.NoError(t, err)
+		require.Len(t, steps, 1)
+		assert.Equal(t, repartitionFinish, steps[0].(*repartitionPropertiesStep).phase)
+	})
+}
+
+func TestCore_restorePartitionKeyRepartition(t *testing.T) {
+	var added *stepStack
+	executor := newMockStepExecutor()
+	executor.AddStepsFunc = func(s *stepStack) {
+		added = s
+	}
+	core := newTestCore(withStepExecutor(executor))
+
+	core.restorePartitionKeyRepartition("db", newRepartitionTestCollection())
+	assert.Nil(t, added)
+
+	// the new partitions are being created
+	core.restorePartitionKeyRepartition("db", newRepartitionTestCollection(
+		&commonpb.KeyValuePair{Key: common.PartitionKeyRepartitionFromKey, Value: "1"},
+	))
+	require.NotNil(t, added)
+	require.Len(t, added.steps, 2)
+	assert.Equal(t, repartitionStart, added.steps[1].(*repartitionPropertiesStep).phase)
+
+	// the existing data is being rewritten
+	added = nil
+	core.restorePartitionKeyRepartition("db", newRepartitionTestCollection(
+		&commonpb.KeyValuePair{Key: common.PartitionKeyRepartitionFromKey, Value: "1"},
+		&commonpb.KeyValuePair{Key: common.PartitionKeyRepartitionTsKey, Value: "1000"},
+	))
+	require.NotNil(t, added)
+	require.Len(t, added.steps, 1)
+	assert.IsType(t, &confirmRepartitionStep{}, added.steps[0])
+}
@@ -0,0 +1,352 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	management "github.com/milvus-io/milvus/internal/http"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/util/auditlog"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	partitionLifecycleRelease = "ReleasePartition"
+	partitionLifecycleDrop    = "DropPartition"
+
+	// the actor of the audit records written by the partition lifecycle manager
+	partitionLifecycleActor = "rootcoord:partition-lifecycle"
+
+	// the max interval to retry the failed action on a partition
+	partitionLifecycleMaxBackoff = 24 * time.Hour
+)
+
+// partitionLifecycleAction is a release or drop action on a partition due by the partition lifecycle properties.
+type partitionLifecycleAction struct {
+	DBName         string    `json:"db_name"`
+	CollectionName string    `json:"collection_name"`
+	CollectionID   int64     `json:"collection_id"`
+	PartitionName  string    `json:"partition_name"`
+	PartitionID    int64     `json:"partition_id"`
+	Action         string    `json:"action"`
+	DueTime        time.Time `json:"due_time"`
+}
+
+// partitionLifecycleFailure is the last failure of the action on a partition.
+type partitionLifecycleFailure struct {
+	action string
+	reason string
+	times  int
+	// the action is not retried until then
+	nextRetry time.Time
+}
+
+// partitionLifecycleManager releases and drops the partitions periodically by their lifecycle properties,
+// the partitions are dropped by the same flow as DropPartition.
+type partitionLifecycleManager struct {
+	core *Core
+
+	mu sync.Mutex
+	// the partitions released by the manager, so that they're not released repeatedly
+	released typeutil.UniqueSet
+	// the partitions whose action failed, the action is retried with backoff
+	failures map[int64]*partitionLifecycleFailure
+
+	stopOnce sync.Once
+	stopChan chan struct{}
+	wg       sync.WaitGroup
+}
+
+func newPartitionLifecycleManager(core *Core) *partitionLifecycleManager {
+	return &partitionLifecycleManager{
+		core:     core,
+		released: typeutil.NewUniqueSet(),
+		failures: make(map[int64]*partitionLifecycleFailure),
+		stopChan: make(chan struct{}),
+	}
+}
+
+// the preview handler is registered once as the http server is shared in process
+var registerPartitionLifecycleOnce sync.Once
+
+func (m *partitionLifecycleManager) Start() {
+	registerPartitionLifecycleOnce.Do(func() {
+		management.Register(&management.Handler{
+			Path:    management.RoutePreviewPartitionLifecycle,
+			Handler: m,
+		})
+	})
+	m.wg.Add(1)
+	go m.run()
+}
+
+func (m *partitionLifecycleManager) run() {
+	defer m.wg.Done()
+
+	interval := Params.RootCoordCfg.PartitionLifecycleCheckInterval.GetAsDuration(time.Second)
+	log.Info("start partition lifecycle manager", zap.Duration("checkInterval", interval))
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.stopChan:
+			log.Info("partition lifecycle manager exit")
+			return
+		case <-ticker.C:
+			if !Params.RootCoordCfg.PartitionLifecycleEnabled.GetAsBool() {
+				continue
+			}
+			m.execute(m.core.ctx, time.Now())
+		}
+	}
+}
+
+func (m *partitionLifecycleManager) stop() {
+	m.stopOnce.Do(func() {
+		close(m.stopChan)
+	})
+	m.wg.Wait()
+}
+
+// plan lists the actions due before the deadline, ordered by the due time.
+func (m *partitionLifecycleManager) plan(ctx context.Context, deadline time.Time) []*partitionLifecycleAction {
+	defaultPartitionName := Params.CommonCfg.DefaultPartitionName.GetValue()
+	actions := make([]*partitionLifecycleAction, 0)
+	for dbID, collectionIDs := range m.core.meta.ListAllAvailCollections(ctx) {
+		db, err := m.core.meta.GetDatabaseByID(ctx, dbID, typeutil.MaxTimestamp)
+		if err != nil {
+			continue
+		}
+		for _, collectionID := range collectionIDs {
+			coll, err := m.core.meta.GetCollectionByID(ctx, db.Name, collectionID, typeutil.MaxTimestamp, false)
+			if err != nil {
+				continue
+			}
+			// the partitions of partition key are managed by milvus
+			if hasPartitionKey(coll) {
+				continue
+			}
+			props := funcutil.KeyValuePair2Map(coll.Properties)
+			for _, partition := range coll.Partitions {
+				if !partition.Available() || partition.PartitionName == defaultPartitionName {
+					continue
+				}
+				releaseAfter, dropAfter, err := common.PartitionLifecycle(props, partition.PartitionName)
+				if err != nil {
+					log.RatedWarn(60, "invalid partition lifecycle properties", zap.Int64("collectionID", collectionID),
+						zap.String("partition", partition.PartitionName), zap.Error(err))
+					continue
+				}
+				if releaseAfter == 0 && dropAfter == 0 {
+					continue
+				}
+				createdAt := tsoutil.PhysicalTime(partition.PartitionCreatedTimestamp)
+				action := &partitionLifecycleAction{
+					DBName:         db.Name,
+					CollectionName: coll.Name,
+					CollectionID:   coll.CollectionID,
+					PartitionName:  partition.PartitionName,
+					PartitionID:    partition.PartitionID,
+				}
+				if dropAfter > 0 && !createdAt.Add(dropAfter).After(deadline) {
+					action.Action, action.DueTime = partitionLifecycleDrop, createdAt.Add(dropAfter)
+				} else if releaseAfter > 0 && !createdAt.Add(releaseAfter).After(deadline) && !m.isReleased(partition.PartitionID) {
+					action.Action, action.DueTime = partitionLifecycleRelease, createdAt.Add(releaseAfter)
+				} else {
+					continue
+				}
+				actions = append(actions, action)
+			}
+		}
+	}
+	sort.Slice(actions, func(i, j int) bool {
+		return actions[i].DueTime.Before(actions[j].DueTime)
+	})
+	return actions
+}
+
+func hasPartitionKey(coll *model.Collection) bool {
+	for _, field := range coll.Fields {
+		if field.IsPartitionKey {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *partitionLifecycleManager) isReleased(partitionID int64) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.released.Contain(partitionID)
+}
+
+// execute releases and drops the partitions due now, the failed ones are retried with backoff.
+func (m *partitionLifecycleManager) execute(ctx context.Context, now time.Time) {
+	actions := m.plan(ctx, now)
+	m.pruneFailures(actions)
+	for _, action := range actions {
+		if m.isBackingOff(action, now) {
+			continue
+		}
+		var err error
+		switch action.Action {
+		case partitionLifecycleRelease:
+			err = m.core.broker.ReleasePartitions(ctx, action.CollectionID, action.PartitionID)
+			if err == nil {
+				m.mu.Lock()
+				m.released.Insert(action.PartitionID)
+				m.mu.Unlock()
+			}
+		case partitionLifecycleDrop:
+			err = merr.CheckRPCCall(m.core.DropPartition(ctx, &milvuspb.DropPartitionRequest{
+				Base:           commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_DropPartition)),
+				DbName:         action.DBName,
+				CollectionName: action.CollectionName,
+				PartitionName:  action.PartitionName,
+			}))
+		}
+		log.Info("partition lifecycle action done", zap.Any("action", action), zap.Error(err))
+		if m.recordResult(action, now, err) {
+			m.audit(action, now, err)
+		}
+	}
+}
+
+func (m *partitionLifecycleManager) isBackingOff(action *partitionLifecycleAction, now time.Time) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	failure, ok := m.failures[action.PartitionID]
+	return ok && failure.action == action.Action && now.Before(failure.nextRetry)
+}
+
+// recordResult updates the failure of the partition by the result of the action,
+// it returns whether the result should be audited, the repeated failures with the same reason are not.
+func (m *partitionLifecycleManager) recordResult(action *partitionLifecycleAction, now time.Time, err error) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err == nil {
+		delete(m.failures, action.PartitionID)
+		return true
+	}
+	failure, ok := m.failures[action.PartitionID]
+	if !ok || failure.action != action.Action {
+		failure = &partitionLifecycleFailure{action: action.Action}
+		m.failures[action.PartitionID] = failure
+	}
+	audit := failure.times == 0 || failure.reason != err.Error()
+	failure.reason = err.Error()
+	failure.times++
+	backoff := Params.RootCoordCfg.PartitionLifecycleCheckInterval.GetAsDuration(time.Second)
+	for i := 0; i < failure.times && backoff < partitionLifecycleMaxBackoff; i++ {
+		backoff *= 2
+	}
+	failure.nextRetry = now.Add(min(backoff, partitionLifecycleMaxBackoff))
+	return audit
+}
+
+// pruneFailures removes the failures of the partitions which are no longer due, e.g. dropped by the users.
+func (m *partitionLifecycleManager) pruneFailures(actions []*partitionLifecycleAction) {
+	due := typeutil.NewUniqueSet()
+	for _, action := range actions {
+		due.Insert(action.PartitionID)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for partitionID := range m.failures {
+		if !due.Contain(partitionID) {
+			delete(m.failures, partitionID)
+		}
+	}
+}
+
+func (m *partitionLifecycleManager) audit(action *partitionLifecycleAction, now time.Time, err error) {
+	record := &auditlog.Record{
+		Time:      now,
+		Actor:     partitionLifecycleActor,
+		Operation: action.Action,
+		Database:  action.DBName,
+		Object:    action.CollectionName,
+		After:     fmt.Sprintf("partition=%s, due=%s", action.PartitionName, action.DueTime.Format(time.RFC3339)),
+		Result:    auditlog.ResultSuccess,
+	}
+	if err != nil {
+		record.Result = auditlog.ResultFailure
+		record.Reason = err.Error()
+	}
+	auditlog.Write(record)
+}
+
+type partitionLifecyclePreviewResponse struct {
+	Status  int                         `json:"status"`
+	Msg     string                      `json:"msg,omitempty"`
+	Actions []*partitionLifecycleAction `json:"actions"`
+}
+
+// ServeHTTP lists the actions due within the duration of query param `within`, e.g. ?within=24h, defaults to the check interval.
+// It's a dry run, nothing is released or dropped.
+func (m *partitionLifecycleManager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	within := Params.RootCoordCfg.PartitionLifecycleCheckInterval.GetAsDuration(time.Second)
+	if v := r.URL.Query().Get("within"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			writePartitionLifecyclePreview(w, http.StatusBadRequest, &partitionLifecyclePreviewResponse{
+				Status: http.StatusBadRequest,
+				Msg:    fmt.Sprintf("invalid within %s", v),
+			})
+			return
+		}
+		within = d
+	}
+	if err := merr.CheckHealthy(m.core.GetStateCode()); err != nil {
+		writePartitionLifecyclePreview(w, http.StatusServiceUnavailable, &partitionLifecyclePreviewResponse{
+			Status: http.StatusServiceUnavailable,
+			Msg:    err.Error(),
+		})
+		return
+	}
+	writePartitionLifecyclePreview(w, http.StatusOK, &partitionLifecyclePreviewResponse{
+		Status:  http.StatusOK,
+		Actions: m.plan(r.Context(), time.Now().Add(within)),
+	})
+}
+
+func writePartitionLifecyclePreview(w http.ResponseWriter, status int, resp *partitionLifecyclePreviewResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	bs, err := json.Marshal(resp)
+	if err != nil {
+		log.Warn("failed to send response", zap.Error(err))
+	}
+	w.WriteHeader(status)
+	w.Write(bs)
+}
@@ -0,0 +1,227 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	management "github.com/milvus-io/milvus/internal/http"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/internal/util/auditlog"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+)
+
+func newPartitionLifecycleTestMeta(t *testing.T, now time.Time) *mockrootcoord.IMetaTable {
+	newPartition := func(id int64, name string, age time.Duration) *model.Partition {
+		return &model.Partition{
+			PartitionID:               id,
+			PartitionName:             name,
+			PartitionCreatedTimestamp: tsoutil.ComposeTSByTime(now.Add(-age), 0),
+			CollectionID:              1,
+			State:                     pb.PartitionState_PartitionCreated,
+		}
+	}
+	properties := make([]*commonpb.KeyValuePair, 0)
+	for _, name := range []string{Params.CommonCfg.DefaultPartitionName.GetValue(), "p_old", "p_mid", "p_new", "p_0"} {
+		properties = append(properties,
+			&commonpb.KeyValuePair{Key: common.PartitionPropertyKey(name, common.PartitionAutoReleaseDaysKey), Value: "1"},
+			&commonpb.KeyValuePair{Key: common.PartitionPropertyKey(name, common.PartitionAutoDropDaysKey), Value: "3"})
+	}
+	// the ttl expires the rows rather than drops the partition
+	properties = append(properties, &commonpb.KeyValuePair{Key: common.PartitionPropertyKey("p_ttl", common.PartitionTTLConfigKey), Value: "3600"})
+
+	meta := mockrootcoord.NewIMetaTable(t)
+	meta.EXPECT().ListAllAvailCollections(mock.Anything).Return(map[int64][]int64{1: {1, 2, 3}})
+	meta.EXPECT().GetDatabaseByID(mock.Anything, int64(1), mock.Anything).Return(model.NewDatabase(1, "db", pb.DatabaseState_DatabaseCreated, nil), nil)
+	meta.EXPECT().GetCollectionByID(mock.Anything, "db", int64(1), mock.Anything, false).Return(&model.Collection{
+		CollectionID: 1,
+		Name:         "coll",
+		Properties:   properties,
+		Partitions: []*model.Partition{
+			newPartition(100, Params.CommonCfg.DefaultPartitionName.GetValue(), 10*24*time.Hour),
+			newPartition(101, "p_old", 4*24*time.Hour),
+			newPartition(102, "p_mid", 36*time.Hour),
+			newPartition(103, "p_new", time.Hour),
+			// no lifecycle properties of the partition
+			newPartition(104, "p_none", 4*24*time.Hour),
+			newPartition(105, "p_ttl", 4*24*time.Hour),
+		},
+	}, nil)
+	// partition key
+	meta.EXPECT().GetCollectionByID(mock.Anything, "db", int64(2), mock.Anything, false).Return(&model.Collection{
+		CollectionID: 2,
+		Name:         "coll_partition_key",
+		Fields:       []*model.Field{{Name: "key", DataType: schemapb.DataType_Int64, IsPartitionKey: true}},
+		Properties:   properties,
+		Partitions:   []*model.Partition{newPartition(200, "p_0", 4*24*time.Hour)},
+	}, nil)
+	// the properties of the partitions with the same names in another collection
+	meta.EXPECT().GetCollectionByID(mock.Anything, "db", int64(3), mock.Anything, false).Return(&model.Collection{
+		CollectionID: 3,
+		Name:         "coll_no_lifecycle",
+		Partitions:   []*model.Partition{newPartition(300, "p_old", 4*24*time.Hour)},
+	}, nil)
+	return meta
+}
+
+func Test_partitionLifecycleManager_plan(t *testing.T) {
+	paramtable.Init()
+	now := time.Now()
+	core := newTestCore(withMeta(newPartitionLifecycleTestMeta(t, now)))
+	m := newPartitionLifecycleManager(core)
+
+	actions := m.plan(context.Background(), now)
+	require.Len(t, actions, 2)
+	assert.Equal(t, "p_old", actions[0].PartitionName)
+	assert.Equal(t, partitionLifecycleDrop, actions[0].Action)
+	assert.Equal(t, "p_mid", actions[1].PartitionName)
+	assert.Equal(t, partitionLifecycleRelease, actions[1].Action)
+
+	actions = m.plan(context.Background(), now.Add(24*time.Hour))
+	require.Len(t, actions, 3)
+	assert.Equal(t, "p_new", actions[2].PartitionName)
+	assert.Equal(t, partitionLifecycleRelease, actions[2].Action)
+
+	m.released.Insert(102)
+	actions = m.plan(context.Background(), now)
+	require.Len(t, actions, 1)
+	assert.Equal(t, "p_old", actions[0].PartitionName)
+}
+
+func Test_partitionLifecycleManager_execute(t *testing.T) {
+	paramtable.Init()
+	require.NoError(t, auditlog.Init(t.TempDir(), 1024*1024, 2))
+	defer auditlog.Close()
+
+	now := time.Now()
+	broker := newMockBroker()
+	released := make([]int64, 0)
+	broker.ReleasePartitionsFunc = func(ctx context.Context, collectionID UniqueID, partitionIDs ...UniqueID) error {
+		released = append(released, collectionID)
+		return nil
+	}
+	sched := newMockScheduler()
+	dropped := make([]string, 0)
+	sched.AddTaskFunc = func(t task) error {
+		dropped = append(dropped, t.(*dropPartitionTask).Req.GetPartitionName())
+		t.NotifyDone(nil)
+		return nil
+	}
+	core := newTestCore(withHealthyCode(), withMeta(newPartitionLifecycleTestMeta(t, now)), withBroker(broker), withScheduler(sched))
+	m := newPartitionLifecycleManager(core)
+
+	m.execute(context.Background(), now)
+	assert.Equal(t, []int64{1}, released)
+	assert.Equal(t, []string{"p_old"}, dropped)
+	assert.True(t, m.isReleased(102))
+
+	records, err := auditlog.Query(auditlog.QueryFilter{Actor: partitionLifecycleActor})
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, partitionLifecycleDrop, records[0].Operation)
+	assert.Equal(t, "coll", records[0].Object)
+	assert.Equal(t, auditlog.ResultSuccess, records[0].Result)
+	assert.Equal(t, partitionLifecycleRelease, records[1].Operation)
+
+	// released partitions are not released again
+	m.execute(context.Background(), now)
+	assert.Equal(t, []int64{1}, released)
+}
+
+func Test_partitionLifecycleManager_executeFailed(t *testing.T) {
+	paramtable.Init()
+	require.NoError(t, auditlog.Init(t.TempDir(), 1024*1024, 2))
+	defer auditlog.Close()
+
+	now := time.Now()
+	broker := newMockBroker()
+	broker.ReleasePartitionsFunc = func(ctx context.Context, collectionID UniqueID, partitionIDs ...UniqueID) error {
+		return nil
+	}
+	sched := newMockScheduler()
+	dropped := 0
+	sched.AddTaskFunc = func(t task) error {
+		dropped++
+		return errors.New("error mock drop partition")
+	}
+	core := newTestCore(withHealthyCode(), withMeta(newPartitionLifecycleTestMeta(t, now)), withBroker(broker), withScheduler(sched))
+	m := newPartitionLifecycleManager(core)
+
+	m.execute(context.Background(), now)
+	assert.Equal(t, 1, dropped)
+	// the failed drop isn't retried until the backoff elapses
+	interval := Params.RootCoordCfg.PartitionLifecycleCheckInterval.GetAsDuration(time.Second)
+	m.execute(context.Background(), now.Add(interval))
+	assert.Equal(t, 1, dropped)
+	m.execute(context.Background(), now.Add(2*interval))
+	assert.Equal(t, 2, dropped)
+
+	// the repeated failures are audited once
+	records, err := auditlog.Query(auditlog.QueryFilter{Actor: partitionLifecycleActor, Operation: partitionLifecycleDrop})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, auditlog.ResultFailure, records[0].Result)
+
+	// the failure is removed once the drop succeeds
+	sched.AddTaskFunc = func(t task) error {
+		dropped++
+		t.NotifyDone(nil)
+		return nil
+	}
+	m.execute(context.Background(), now.Add(partitionLifecycleMaxBackoff))
+	assert.Equal(t, 3, dropped)
+	assert.Empty(t, m.failures)
+}
+
+func Test_partitionLifecycleManager_ServeHTTP(t *testing.T) {
+	paramtable.Init()
+	now := time.Now()
+	core := newTestCore(withHealthyCode(), withMeta(newPartitionLifecycleTestMeta(t, now)))
+	m := newPartitionLifecycleManager(core)
+
+	w := httptest.NewRecorder()
+	m.ServeHTTP(w, httptest.NewRequest(http.MethodGet, management.RoutePreviewPartitionLifecycle+"?within=abc", nil))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	m.ServeHTTP(w, httptest.NewRequest(http.MethodGet, management.RoutePreviewPartitionLifecycle+"?within=24h", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	resp := &partitionLifecyclePreviewResponse{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+	assert.Len(t, resp.Actions, 3)
+
+	core.UpdateStateCode(commonpb.StateCode_Abnormal)
+	w = httptest.NewRecorder()
+	m.ServeHTTP(w, httptest.NewRequest(http.MethodGet, management.RoutePreviewPartitionLifecycle, nil))
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+}
@@ -46,17 +46,20 @@ func (b *baseRedoTask) AddAsyncStep(step nestedStep) {
 	b.asyncTodoStep = append(b.asyncTodoStep, step)
 }
 
-func (b *baseRedoTask) redoAsyncSteps() {
+func (b *baseRedoTask) redoAsyncSteps(job *ddlJob) {
 	l := len(b.asyncTodoStep)
 	steps := make([]nestedStep, 0, l)
 	for i := l - 1; i >= 0; i-- {
 		steps = append(steps, b.asyncTodoStep[i])
 	}
 	b.asyncTodoStep = nil // make baseRedoTask can be collected.
-	b.stepExecutor.AddSteps(&stepStack{steps: steps})
+	b.stepExecutor.AddSteps(&stepStack{steps: steps, job: job})
 }
 
 func (b *baseRedoTask) Execute(ctx context.Context) error {
+	job := ddlJobFromContext(ctx)
+	job.addSteps(b.syncTodoStep...)
+	job.addSteps(b.asyncTodoStep...)
 	for i := 0; i < len(b.syncTodoStep); i++ {
 		todo := b.syncTodoStep[i]
 		// no children step in sync steps.
@@ -64,7 +67,10 @@ func (b *baseRedoTask) Execute(ctx context.Context) error {
 			log.Error("failed to execute step", zap.Error(err), zap.String("desc", todo.Desc()))
 			return err
 		}
+		job.stepDone(todo)
 	}
-	go b.redoAsyncSteps()
+	// the job is finished after the async steps are done.
+	job.addBackground()
+	go b.redoAsyncSteps(job)
 	return nil
 }
@@ -0,0 +1,151 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/proxyutil"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// renameCollectionFieldTask renames a field of the collection,
+// the field id is kept so that the existing binlogs and indexes are still valid.
+type renameCollectionFieldTask struct {
+	baseTask
+	Req *internalpb.RenameCollectionFieldRequest
+}
+
+func (t *renameCollectionFieldTask) jobTarget() (string, string, string) {
+	return "RenameCollectionField", t.Req.GetDbName(), t.Req.GetCollectionName()
+}
+
+func (t *renameCollectionFieldTask) Prepare(ctx context.Context) error {
+	if t.Req.GetCollectionName() == "" {
+		return merr.WrapErrParameterInvalidMsg("collection name is empty")
+	}
+	if t.Req.GetOldName() == "" || t.Req.GetNewName() == "" {
+		return merr.WrapErrParameterInvalidMsg("field name is empty")
+	}
+	if t.Req.GetOldName() == t.Req.GetNewName() {
+		return merr.WrapErrParameterInvalidMsg("the new field name is the same as the old one")
+	}
+	return nil
+}
+
+func (t *renameCollectionFieldTask) Execute(ctx context.Context) error {
+	oldColl, err := t.core.meta.GetCollectionByName(ctx, t.Req.GetDbName(), t.Req.GetCollectionName(), t.ts)
+	if err != nil {
+		log.Warn("get collection failed during renaming field",
+			zap.String("collectionName", t.Req.GetCollectionName()), zap.Uint64("ts", t.ts))
+		return err
+	}
+
+	newColl := oldColl.Clone()
+	var field *model.Field
+	for _, f := range newColl.Fields {
+		switch f.Name {
+		case t.Req.GetOldName():
+			field = f
+		case t.Req.GetNewName():
+			return merr.WrapErrParameterInvalidMsg("duplicated field name %s", t.Req.GetNewName())
+		}
+	}
+	if field == nil {
+		return merr.WrapErrFieldNotFound(t.Req.GetOldName())
+	}
+	if field.IsPrimaryKey || field.IsDynamic {
+		return merr.WrapErrParameterInvalidMsg("primary key or dynamic field %s could not be renamed", field.Name)
+	}
+	field.Name = t.Req.GetNewName()
+
+	if err := increaseSchemaVersion(newColl); err != nil {
+		return err
+	}
+
+	log.Info("rename collection field",
+		zap.String("collectionName", t.Req.GetCollectionName()),
+		zap.Int64("collectionID", oldColl.CollectionID),
+		zap.Int64("fieldID", field.FieldID),
+		zap.String("oldName", t.Req.GetOldName()),
+		zap.String("newName", t.Req.GetNewName()))
+
+	return newSchemaChangeTask(t.core, t.Req.GetDbName(), t.Req.GetCollectionName(), oldColl, newColl, t.GetTs()).Execute(ctx)
+}
+
+// increaseSchemaVersion bumps the schema version of the collection after the fields are changed.
+func increaseSchemaVersion(coll *model.Collection) error {
+	version, err := common.CollectionSchemaVersion(funcutil.KeyValuePair2Map(coll.Properties))
+	if err != nil {
+		return err
+	}
+	updateCollectionProperties(coll, []*commonpb.KeyValuePair{
+		{Key: common.CollectionSchemaVersionKey, Value: strconv.FormatInt(version+1, 10)},
+	})
+	return nil
+}
+
+// newSchemaChangeTask saves the new schema, then notifies datacoord and the proxies.
+// If any step fails, the old schema is restored and notified again.
+func newSchemaChangeTask(core *Core, dbName, collectionName string, oldColl, newColl *model.Collection, ts Timestamp) *baseUndoTask {
+	aliases := core.meta.ListAliasesByID(oldColl.CollectionID)
+	broadcastStep := &BroadcastAlteredCollectionStep{
+		baseStep: baseStep{core: core},
+		req: &milvuspb.AlterCollectionRequest{
+			DbName:         dbName,
+			CollectionName: collectionName,
+			CollectionID:   oldColl.CollectionID,
+		},
+		core: core,
+	}
+	expireStep := &expireCacheStep{
+		baseStep:        baseStep{core: core},
+		dbName:          dbName,
+		collectionNames: append(aliases, collectionName),
+		collectionID:    oldColl.CollectionID,
+		opts:            []proxyutil.ExpireCacheOpt{proxyutil.SetMsgType(commonpb.MsgType_AlterCollection)},
+	}
+
+	// the undo steps are executed in reverse order, the old schema is restored before being notified
+	undoTask := newBaseUndoTask(core.stepExecutor)
+	undoTask.AddStep(&nullStep{}, expireStep)
+	undoTask.AddStep(&nullStep{}, broadcastStep)
+	undoTask.AddStep(&AlterCollectionStep{
+		baseStep: baseStep{core: core},
+		oldColl:  oldColl,
+		newColl:  newColl,
+		ts:       ts,
+	}, &AlterCollectionStep{
+		baseStep: baseStep{core: core},
+		oldColl:  newColl,
+		newColl:  oldColl,
+		ts:       ts,
+	})
+	undoTask.AddStep(broadcastStep, &nullStep{})
+	undoTask.AddStep(expireStep, &nullStep{})
+	return undoTask
+}
@@ -0,0 +1,152 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+)
+
+func newFieldTestCollection() *model.Collection {
+	return &model.Collection{
+		CollectionID: 1,
+		Name:         "cn",
+		Fields: []*model.Field{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+			{FieldID: 102, Name: "a", DataType: schemapb.DataType_Int32},
+			{FieldID: 103, Name: "b", DataType: schemapb.DataType_VarChar, IsPartitionKey: true},
+		},
+	}
+}
+
+func Test_renameCollectionFieldTask_Prepare(t *testing.T) {
+	for _, req := range []*internalpb.RenameCollectionFieldRequest{
+		{},
+		{CollectionName: "cn", OldName: "a"},
+		{CollectionName: "cn", OldName: "a", NewName: "a"},
+	} {
+		task := &renameCollectionFieldTask{Req: req}
+		assert.Error(t, task.Prepare(context.Background()))
+	}
+
+	task := &renameCollectionFieldTask{Req: &internalpb.RenameCollectionFieldRequest{CollectionName: "cn", OldName: "a", NewName: "c"}}
+	assert.NoError(t, task.Prepare(context.Background()))
+}
+
+func Test_renameCollectionFieldTask_Execute(t *testing.T) {
+	t.Run("get collection failed", func(t *testing.T) {
+		core := newTestCore(withInvalidMeta())
+		task := &renameCollectionFieldTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &internalpb.RenameCollectionFieldRequest{CollectionName: "cn", OldName: "a", NewName: "c"},
+		}
+		assert.Error(t, task.Execute(context.Background()))
+	})
+
+	t.Run("invalid field", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(newFieldTestCollection(), nil)
+		core := newTestCore(withMeta(meta))
+		for _, req := range []*internalpb.RenameCollectionFieldRequest{
+			{CollectionName: "cn", OldName: "x", NewName: "c"},
+			{CollectionName: "cn", OldName: "a", NewName: "b"},
+			{CollectionName: "cn", OldName: "pk", NewName: "c"},
+		} {
+			task := &renameCollectionFieldTask{baseTask: newBaseTask(context.Background(), core), Req: req}
+			assert.Error(t, task.Execute(context.Background()))
+		}
+	})
+
+	t.Run("broadcast failed and undo", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(newFieldTestCollection(), nil)
+		meta.EXPECT().ListAliasesByID(mock.Anything).Return([]string{})
+		meta.EXPECT().AlterCollection(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+		broker := newMockBroker()
+		broker.BroadcastAlteredCollectionFunc = func(ctx context.Context, req *milvuspb.AlterCollectionRequest) error {
+			return errors.New("mock")
+		}
+		undo := make(chan *stepStack, 1)
+		executor := newMockStepExecutor()
+		executor.AddStepsFunc = func(s *stepStack) {
+			undo <- s
+		}
+		core := newTestCore(withMeta(meta), withBroker(broker), withStepExecutor(executor))
+		task := &renameCollectionFieldTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &internalpb.RenameCollectionFieldRequest{CollectionName: "cn", OldName: "a", NewName: "c"},
+		}
+		assert.Error(t, task.Execute(context.Background()))
+
+		steps := (<-undo).steps
+		assert.Len(t, steps, 3)
+		assert.IsType(t, &expireCacheStep{}, steps[0])
+		assert.IsType(t, &BroadcastAlteredCollectionStep{}, steps[1])
+		revert, ok := steps[2].(*AlterCollectionStep)
+		assert.True(t, ok)
+		assert.Equal(t, "a", revert.newColl.Fields[2].Name)
+	})
+
+	t.Run("rename successfully", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(newFieldTestCollection(), nil)
+		meta.EXPECT().ListAliasesByID(mock.Anything).Return([]string{})
+		meta.EXPECT().AlterCollection(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts uint64) error {
+				assert.Equal(t, "a", oldColl.Fields[2].Name)
+				assert.Equal(t, "c", newColl.Fields[2].Name)
+				assert.EqualValues(t, 102, newColl.Fields[2].FieldID)
+				assert.Equal(t, "1", funcutil.KeyValuePair2Map(newColl.Properties)[common.CollectionSchemaVersionKey])
+				return nil
+			})
+
+		broker := newMockBroker()
+		broker.BroadcastAlteredCollectionFunc = func(ctx context.Context, req *milvuspb.AlterCollectionRequest) error {
+			return nil
+		}
+		core := newTestCore(withValidProxyManager(), withMeta(meta), withBroker(broker))
+		task := &renameCollectionFieldTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &internalpb.RenameCollectionFieldRequest{CollectionName: "cn", OldName: "a", NewName: "c"},
+		}
+		assert.NoError(t, task.Execute(context.Background()))
+	})
+}
+
+func Test_increaseSchemaVersion(t *testing.T) {
+	coll := &model.Collection{Properties: []*commonpb.KeyValuePair{{Key: common.CollectionSchemaVersionKey, Value: "3"}}}
+	assert.NoError(t, increaseSchemaVersion(coll))
+	assert.Equal(t, "4", funcutil.KeyValuePair2Map(coll.Properties)[common.CollectionSchemaVersionKey])
+
+	coll.Properties = []*commonpb.KeyValuePair{{Key: common.CollectionSchemaVersionKey, Value: "invalid"}}
+	assert.Error(t, increaseSchemaVersion(coll))
+}
@@ -18,10 +18,22 @@ package rootcoord
 
 import (
 	"context"
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/util/proxyutil"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 type renameCollectionTask struct {
@@ -29,16 +41,223 @@ type renameCollectionTask struct {
 	Req *milvuspb.RenameCollectionRequest
 }
 
+func (t *renameCollectionTask) jobTarget() (string, string, string) {
+	return "RenameCollection", t.Req.GetDbName(), t.Req.GetOldName()
+}
+
 func (t *renameCollectionTask) Prepare(ctx context.Context) error {
 	if err := CheckMsgType(t.Req.GetBase().GetMsgType(), commonpb.MsgType_RenameCollection); err != nil {
 		return err
 	}
+	dbName := t.Req.GetDbName()
+	if dbName == "" {
+		dbName = util.DefaultDBName
+	}
+	if newDBName := t.Req.GetNewDBName(); newDBName != "" && newDBName != dbName {
+		return t.checkTargetDatabaseCapacity(ctx, dbName, newDBName)
+	}
 	return nil
 }
 
+// checkTargetDatabaseCapacity checks the limits of the database which the collection is moved into.
+func (t *renameCollectionTask) checkTargetDatabaseCapacity(ctx context.Context, dbName, newDBName string) error {
+	coll, err := t.core.meta.GetCollectionByName(ctx, dbName, t.Req.GetOldName(), typeutil.MaxTimestamp)
+	if err != nil {
+		return err
+	}
+	db, err := t.core.meta.GetDatabaseByName(ctx, newDBName, typeutil.MaxTimestamp)
+	if err != nil {
+		return err
+	}
+	maxColNumPerDB, err := getMaxCollectionsPerDB(db)
+	if err != nil {
+		return err
+	}
+	if len(t.core.meta.ListAllAvailCollections(ctx)[db.ID]) >= maxColNumPerDB {
+		log.Ctx(ctx).Warn("unable to rename collection because the number of collection has reached the limit in DB",
+			zap.String("dbName", newDBName), zap.Int("maxCollectionNumPerDB", maxColNumPerDB))
+		return merr.WrapErrCollectionNumLimitExceeded(newDBName, maxColNumPerDB)
+	}
+	return checkDatabaseCapacity(ctx, newDBName, int64(coll.GetPartitionNum(false)),
+		vectorDimSum(model.MarshalFieldModels(coll.Fields)), t.core, typeutil.MaxTimestamp)
+}
+
 func (t *renameCollectionTask) Execute(ctx context.Context) error {
-	if err := t.core.ExpireMetaCache(ctx, t.Req.GetDbName(), []string{t.Req.GetOldName()}, InvalidCollectionID, "", t.GetTs(), proxyutil.SetMsgType(commonpb.MsgType_RenameCollection)); err != nil {
+	dbName := t.Req.GetDbName()
+	if dbName == "" {
+		dbName = util.DefaultDBName
+	}
+	newDBName := t.Req.GetNewDBName()
+	if newDBName == "" {
+		newDBName = dbName
+	}
+	coll, err := t.core.meta.GetCollectionByName(ctx, dbName, t.Req.GetOldName(), typeutil.MaxTimestamp)
+	if err != nil {
 		return err
 	}
-	return t.core.meta.RenameCollection(ctx, t.Req.GetDbName(), t.Req.GetOldName(), t.Req.GetNewDBName(), t.Req.GetNewName(), t.GetTs())
+	aliases := t.core.meta.ListAliasesByID(coll.CollectionID)
+
+	redoTask := newBaseRedoTask(t.core.stepExecutor)
+	redoTask.AddSyncStep(&expireCacheStep{
+		baseStep:        baseStep{core: t.core},
+		dbName:          dbName,
+		collectionNames: append([]string{t.Req.GetOldName()}, aliases...),
+		collectionID:    coll.CollectionID,
+		ts:              t.GetTs(),
+		opts:            []proxyutil.ExpireCacheOpt{proxyutil.SetMsgType(commonpb.MsgType_RenameCollection)},
+	})
+	// the grants left by the previous renaming are moved first, the collection keeps its name until then
+	if _, ok := funcutil.KeyValuePair2Map(coll.Properties)[common.CollectionRenameFromKey]; ok {
+		redoTask.AddSyncStep(newMigrateGrantsStep(t.core, dbName, coll.CollectionID))
+	}
+	redoTask.AddSyncStep(NewSimpleStep("rename collection meta data", func(ctx context.Context) ([]nestedStep, error) {
+		return nil, t.core.meta.RenameCollection(ctx, dbName, t.Req.GetOldName(), newDBName, t.Req.GetNewName(), t.GetTs())
+	}))
+	// the grants are bound to the collection name, so they're moved along with the collection
+	redoTask.AddSyncStep(newMigrateGrantsStep(t.core, newDBName, coll.CollectionID))
+	if dbName != newDBName {
+		redoTask.AddAsyncStep(&expireCacheStep{
+			baseStep:        baseStep{core: t.core},
+			dbName:          newDBName,
+			collectionNames: append([]string{t.Req.GetNewName()}, aliases...),
+			collectionID:    coll.CollectionID,
+			ts:              t.GetTs(),
+			opts:            []proxyutil.ExpireCacheOpt{proxyutil.SetMsgType(commonpb.MsgType_RenameCollection)},
+		})
+	}
+	return redoTask.Execute(ctx)
+}
+
+// restoreGrantMigration moves the grants of the renamed collection interrupted by restart.
+func (c *Core) restoreGrantMigration(dbName string, coll *model.Collection) {
+	from, ok := funcutil.KeyValuePair2Map(coll.Properties)[common.CollectionRenameFromKey]
+	if !ok {
+		return
+	}
+	log.Info("restore collection grants migration", zap.Int64("collectionID", coll.CollectionID), zap.String("from", from))
+	c.stepExecutor.AddSteps(&stepStack{steps: []nestedStep{newMigrateGrantsStep(c, dbName, coll.CollectionID)}})
+}
+
+// migrateGrantsStep moves the grants on the name recorded before renaming to the current name of the collection,
+// then removes the record. The collection is read when executing, as the step may be restored after restart.
+type migrateGrantsStep struct {
+	baseStep
+	dbName       string
+	collectionID UniqueID
+}
+
+func newMigrateGrantsStep(core *Core, dbName string, collectionID UniqueID) *migrateGrantsStep {
+	return &migrateGrantsStep{
+		baseStep:     baseStep{core: core},
+		dbName:       dbName,
+		collectionID: collectionID,
+	}
+}
+
+func (s *migrateGrantsStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	coll, err := s.core.meta.GetCollectionByID(ctx, s.dbName, s.collectionID, typeutil.MaxTimestamp, false)
+	if errors.Is(err, merr.ErrCollectionNotFound) {
+		// nothing to do if the collection is dropped
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	props := funcutil.KeyValuePair2Map(coll.Properties)
+	from, ok := props[common.CollectionRenameFromKey]
+	if !ok {
+		return nil, nil
+	}
+	oldDBName, oldName := funcutil.SplitObjectName(from)
+	if err := s.migrateGrants(ctx, oldDBName, oldName, s.dbName, coll.Name); err != nil {
+		return nil, err
+	}
+
+	ts, err := s.core.tsoAllocator.GenerateTSO(1)
+	if err != nil {
+		return nil, err
+	}
+	delete(props, common.CollectionRenameFromKey)
+	newColl := coll.Clone()
+	newColl.Properties = funcutil.Map2KeyValuePair(props)
+	return nil, s.core.meta.AlterCollection(ctx, coll, newColl, ts)
+}
+
+func (s *migrateGrantsStep) Desc() string {
+	return fmt.Sprintf("migrate collection grants, collectionID: %d", s.collectionID)
+}
+
+// migrateGrants grants the privileges on the old collection to the new one and then revokes the old ones.
+// It's idempotent so that it could be retried after failure.
+func (s *migrateGrantsStep) migrateGrants(ctx context.Context, dbName, oldName, newDBName, newName string) error {
+	if dbName == newDBName && oldName == newName {
+		return nil
+	}
+	roles, err := s.core.meta.SelectRole(util.DefaultTenant, nil, false)
+	if err != nil {
+		return err
+	}
+	objectType := commonpb.ObjectType_Collection.String()
+	for _, role := range roles {
+		roleName := role.GetRole().GetName()
+		grants, err := s.core.meta.SelectGrant(util.DefaultTenant, &milvuspb.GrantEntity{
+			Role:       &milvuspb.RoleEntity{Name: roleName},
+			Object:     &milvuspb.ObjectEntity{Name: objectType},
+			ObjectName: oldName,
+			DbName:     dbName,
+		})
+		if err != nil && !common.IsIgnorableError(err) {
+			return err
+		}
+		for _, grant := range grants {
+			// the grants on any database are kept as they are
+			if grant.GetDbName() != dbName || grant.GetObjectName() != oldName {
+				continue
+			}
+			privilege := grant.GetGrantor().GetPrivilege().GetName()
+			if !util.IsAnyWord(privilege) {
+				privilege = util.PrivilegeNameForMetastore(privilege)
+			}
+			newGrant := &milvuspb.GrantEntity{
+				Role:       &milvuspb.RoleEntity{Name: roleName},
+				Object:     &milvuspb.ObjectEntity{Name: objectType},
+				ObjectName: newName,
+				DbName:     newDBName,
+				Grantor: &milvuspb.GrantorEntity{
+					User:      &milvuspb.UserEntity{Name: grant.GetGrantor().GetUser().GetName()},
+					Privilege: &milvuspb.PrivilegeEntity{Name: privilege},
+				},
+			}
+			if err := s.operateGrant(ctx, newGrant, milvuspb.OperatePrivilegeType_Grant); err != nil {
+				return err
+			}
+			oldGrant := &milvuspb.GrantEntity{
+				Role:       newGrant.Role,
+				Object:     newGrant.Object,
+				ObjectName: oldName,
+				DbName:     dbName,
+				Grantor:    newGrant.Grantor,
+			}
+			if err := s.operateGrant(ctx, oldGrant, milvuspb.OperatePrivilegeType_Revoke); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *migrateGrantsStep) operateGrant(ctx context.Context, entity *milvuspb.GrantEntity, operateType milvuspb.OperatePrivilegeType) error {
+	if err := s.core.meta.OperatePrivilege(util.DefaultTenant, entity, operateType); err != nil && !common.IsIgnorableError(err) {
+		log.Ctx(ctx).Warn("fail to migrate the collection grant", zap.Any("entity", entity), zap.Error(err))
+		return err
+	}
+	opType := int32(typeutil.CacheGrantPrivilege)
+	if operateType == milvuspb.OperatePrivilegeType_Revoke {
+		opType = int32(typeutil.CacheRevokePrivilege)
+	}
+	return s.core.proxyClientManager.RefreshPolicyInfoCache(ctx, &proxypb.RefreshPolicyInfoCacheRequest{
+		OpType: opType,
+		OpKey: funcutil.PolicyForPrivilege(entity.Role.Name, entity.Object.Name, entity.ObjectName,
+			entity.Grantor.Privilege.Name, entity.DbName),
+	})
 }
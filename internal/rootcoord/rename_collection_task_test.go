@@ -22,9 +22,18 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/internal/util/proxyutil"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
 func Test_renameCollectionTask_Prepare(t *testing.T) {
@@ -51,39 +60,267 @@ func Test_renameCollectionTask_Prepare(t *testing.T) {
 		err := task.Prepare(context.Background())
 		assert.NoError(t, err)
 	})
+
+	newReq := func() *milvuspb.RenameCollectionRequest {
+		return &milvuspb.RenameCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_RenameCollection,
+			},
+			OldName:   "old",
+			NewDBName: "db1",
+			NewName:   "new",
+		}
+	}
+	coll := &model.Collection{
+		CollectionID: 1,
+		Partitions:   []*model.Partition{{PartitionID: 1}, {PartitionID: 2}},
+	}
+
+	t.Run("collection number exceeds the limit of target database", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, util.DefaultDBName, "old", mock.Anything).Return(coll.Clone(), nil)
+		meta.EXPECT().GetDatabaseByName(mock.Anything, "db1", mock.Anything).Return(
+			model.NewDatabase(2, "db1", etcdpb.DatabaseState_DatabaseCreated, []*commonpb.KeyValuePair{
+				{Key: common.DatabaseMaxCollectionsKey, Value: "1"},
+			}), nil)
+		meta.EXPECT().ListAllAvailCollections(mock.Anything).Return(map[int64][]int64{1: {1}, 2: {2}})
+		core := newTestCore(withMeta(meta))
+		task := &renameCollectionTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      newReq(),
+		}
+		err := task.Prepare(context.Background())
+		assert.ErrorIs(t, err, merr.ErrCollectionNumLimitExceeded)
+	})
+
+	t.Run("partition number exceeds the limit of target database", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, util.DefaultDBName, "old", mock.Anything).Return(coll.Clone(), nil)
+		meta.EXPECT().GetDatabaseByName(mock.Anything, "db1", mock.Anything).Return(
+			model.NewDatabase(2, "db1", etcdpb.DatabaseState_DatabaseCreated, []*commonpb.KeyValuePair{
+				{Key: common.DatabaseMaxPartitionsKey, Value: "3"},
+			}), nil)
+		meta.EXPECT().ListAllAvailCollections(mock.Anything).Return(map[int64][]int64{1: {1}, 2: {2}})
+		meta.EXPECT().GetCollectionByID(mock.Anything, "db1", int64(2), mock.Anything, true).Return(&model.Collection{
+			CollectionID: 2,
+			Partitions:   []*model.Partition{{PartitionID: 3}, {PartitionID: 4}},
+		}, nil)
+		core := newTestCore(withMeta(meta))
+		task := &renameCollectionTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      newReq(),
+		}
+		err := task.Prepare(context.Background())
+		assert.ErrorIs(t, err, merr.ErrDatabaseLimitExceeded)
+	})
+
+	t.Run("rename into another database", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, util.DefaultDBName, "old", mock.Anything).Return(coll.Clone(), nil)
+		meta.EXPECT().GetDatabaseByName(mock.Anything, "db1", mock.Anything).Return(
+			model.NewDatabase(2, "db1", etcdpb.DatabaseState_DatabaseCreated, []*commonpb.KeyValuePair{
+				{Key: common.DatabaseMaxPartitionsKey, Value: "4"},
+			}), nil)
+		meta.EXPECT().ListAllAvailCollections(mock.Anything).Return(map[int64][]int64{1: {1}, 2: {2}})
+		meta.EXPECT().GetCollectionByID(mock.Anything, "db1", int64(2), mock.Anything, true).Return(&model.Collection{
+			CollectionID: 2,
+			Partitions:   []*model.Partition{{PartitionID: 3}, {PartitionID: 4}},
+		}, nil)
+		core := newTestCore(withMeta(meta))
+		task := &renameCollectionTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      newReq(),
+		}
+		err := task.Prepare(context.Background())
+		assert.NoError(t, err)
+	})
 }
 
 func Test_renameCollectionTask_Execute(t *testing.T) {
-	t.Run("failed to expire cache", func(t *testing.T) {
-		core := newTestCore(withInvalidProxyManager())
+	newReq := func() *milvuspb.RenameCollectionRequest {
+		return &milvuspb.RenameCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_RenameCollection,
+			},
+			OldName:   "old",
+			NewDBName: "db1",
+			NewName:   "new",
+		}
+	}
+
+	t.Run("collection not found", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("fail"))
+		core := newTestCore(withMeta(meta))
 		task := &renameCollectionTask{
 			baseTask: newBaseTask(context.Background(), core),
-			Req: &milvuspb.RenameCollectionRequest{
-				Base: &commonpb.MsgBase{
-					MsgType: commonpb.MsgType_RenameCollection,
-				},
-			},
+			Req:      newReq(),
 		}
 		err := task.Execute(context.Background())
 		assert.Error(t, err)
 	})
 
-	t.Run("failed to rename collection", func(t *testing.T) {
-		meta := newMockMetaTable()
-		meta.RenameCollectionFunc = func(ctx context.Context, oldName string, newName string, ts Timestamp) error {
-			return errors.New("fail")
+	t.Run("failed to expire cache", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&model.Collection{CollectionID: 1}, nil)
+		meta.EXPECT().ListAliasesByID(mock.Anything).Return([]string{})
+		core := newTestCore(withInvalidProxyManager(), withMeta(meta))
+		task := &renameCollectionTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      newReq(),
 		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
 
+	t.Run("failed to rename collection", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&model.Collection{CollectionID: 1}, nil)
+		meta.EXPECT().ListAliasesByID(mock.Anything).Return([]string{})
+		meta.EXPECT().RenameCollection(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("fail"))
 		core := newTestCore(withValidProxyManager(), withMeta(meta))
 		task := &renameCollectionTask{
 			baseTask: newBaseTask(context.Background(), core),
-			Req: &milvuspb.RenameCollectionRequest{
-				Base: &commonpb.MsgBase{
-					MsgType: commonpb.MsgType_RenameCollection,
-				},
+			Req:      newReq(),
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("rename into another database", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, util.DefaultDBName, "old", mock.Anything).Return(&model.Collection{CollectionID: 1}, nil)
+		meta.EXPECT().ListAliasesByID(int64(1)).Return([]string{"alias"})
+		meta.EXPECT().RenameCollection(mock.Anything, util.DefaultDBName, "old", "db1", "new", mock.Anything).Return(nil)
+		meta.EXPECT().GetCollectionByID(mock.Anything, "db1", int64(1), mock.Anything, false).Return(&model.Collection{
+			CollectionID: 1,
+			Name:         "new",
+			Properties: []*commonpb.KeyValuePair{
+				{Key: common.CollectionRenameFromKey, Value: "default.old"},
+			},
+		}, nil)
+		// the old name is removed after the grants are moved
+		meta.EXPECT().AlterCollection(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts uint64) error {
+				assert.Empty(t, newColl.Properties)
+				return nil
+			})
+		meta.EXPECT().SelectRole(util.DefaultTenant, mock.Anything, false).Return([]*milvuspb.RoleResult{
+			{Role: &milvuspb.RoleEntity{Name: "role"}},
+		}, nil)
+		grantor := &milvuspb.GrantorEntity{
+			User:      &milvuspb.UserEntity{Name: "root"},
+			Privilege: &milvuspb.PrivilegeEntity{Name: "Query"},
+		}
+		meta.EXPECT().SelectGrant(util.DefaultTenant, mock.Anything).Return([]*milvuspb.GrantEntity{
+			{
+				Role:       &milvuspb.RoleEntity{Name: "role"},
+				Object:     &milvuspb.ObjectEntity{Name: commonpb.ObjectType_Collection.String()},
+				ObjectName: "old",
+				DbName:     util.DefaultDBName,
+				Grantor:    grantor,
 			},
+			// the grant on any database is kept
+			{
+				Role:       &milvuspb.RoleEntity{Name: "role"},
+				Object:     &milvuspb.ObjectEntity{Name: commonpb.ObjectType_Collection.String()},
+				ObjectName: "old",
+				DbName:     util.AnyWord,
+				Grantor:    grantor,
+			},
+		}, nil)
+		operated := make(map[milvuspb.OperatePrivilegeType]*milvuspb.GrantEntity)
+		meta.EXPECT().OperatePrivilege(util.DefaultTenant, mock.Anything, mock.Anything).
+			RunAndReturn(func(tenant string, entity *milvuspb.GrantEntity, operateType milvuspb.OperatePrivilegeType) error {
+				operated[operateType] = entity
+				return nil
+			})
+
+		var expired []string
+		var policies []string
+		core := newTestCore(withMeta(meta), withTsoAllocator(newMockTsoAllocator()), func(c *Core) {
+			c.proxyClientManager = proxyutil.NewProxyClientManager(proxyutil.DefaultProxyCreator)
+			p := newMockProxy()
+			p.InvalidateCollectionMetaCacheFunc = func(ctx context.Context, request *proxypb.InvalidateCollMetaCacheRequest) (*commonpb.Status, error) {
+				expired = append(expired, request.GetDbName()+"."+request.GetCollectionName())
+				return merr.Success(), nil
+			}
+			p.RefreshPolicyInfoCacheFunc = func(ctx context.Context, request *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
+				policies = append(policies, request.GetOpKey())
+				return merr.Success(), nil
+			}
+			c.proxyClientManager.GetProxyClients().Insert(TestProxyID, p)
+		})
+		task := &renameCollectionTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      newReq(),
 		}
 		err := task.Execute(context.Background())
+		assert.NoError(t, err)
+
+		assert.ElementsMatch(t, []string{"default.old", "default.alias", "db1.new", "db1.alias"}, expired)
+		assert.Len(t, operated, 2)
+		assert.Equal(t, "new", operated[milvuspb.OperatePrivilegeType_Grant].GetObjectName())
+		assert.Equal(t, "db1", operated[milvuspb.OperatePrivilegeType_Grant].GetDbName())
+		assert.Equal(t, util.PrivilegeNameForMetastore("Query"), operated[milvuspb.OperatePrivilegeType_Grant].GetGrantor().GetPrivilege().GetName())
+		assert.Equal(t, "old", operated[milvuspb.OperatePrivilegeType_Revoke].GetObjectName())
+		assert.Equal(t, util.DefaultDBName, operated[milvuspb.OperatePrivilegeType_Revoke].GetDbName())
+		assert.Len(t, policies, 2)
+	})
+}
+
+func Test_migrateGrantsStep_Execute(t *testing.T) {
+	t.Run("collection dropped", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByID(mock.Anything, "db1", int64(1), mock.Anything, false).Return(nil, merr.WrapErrCollectionNotFound(1))
+		core := newTestCore(withMeta(meta))
+		_, err := newMigrateGrantsStep(core, "db1", 1).Execute(context.Background())
+		assert.NoError(t, err)
+	})
+
+	t.Run("no grants to move", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByID(mock.Anything, "db1", int64(1), mock.Anything, false).Return(&model.Collection{CollectionID: 1, Name: "new"}, nil)
+		core := newTestCore(withMeta(meta))
+		_, err := newMigrateGrantsStep(core, "db1", 1).Execute(context.Background())
+		assert.NoError(t, err)
+	})
+
+	t.Run("failed to move grants", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByID(mock.Anything, "db1", int64(1), mock.Anything, false).Return(&model.Collection{
+			CollectionID: 1,
+			Name:         "new",
+			Properties: []*commonpb.KeyValuePair{
+				{Key: common.CollectionRenameFromKey, Value: "default.old"},
+			},
+		}, nil)
+		meta.EXPECT().SelectRole(util.DefaultTenant, mock.Anything, false).Return(nil, errors.New("fail"))
+		core := newTestCore(withMeta(meta))
+		_, err := newMigrateGrantsStep(core, "db1", 1).Execute(context.Background())
 		assert.Error(t, err)
 	})
 }
+
+func TestCore_restoreGrantMigration(t *testing.T) {
+	var added *stepStack
+	executor := newMockStepExecutor()
+	executor.AddStepsFunc = func(s *stepStack) {
+		added = s
+	}
+	core := newTestCore(withStepExecutor(executor))
+
+	core.restoreGrantMigration("db1", &model.Collection{CollectionID: 1, Name: "new"})
+	assert.Nil(t, added)
+
+	core.restoreGrantMigration("db1", &model.Collection{
+		CollectionID: 1,
+		Name:         "new",
+		Properties: []*commonpb.KeyValuePair{
+			{Key: common.CollectionRenameFromKey, Value: "default.old"},
+		},
+	})
+	assert.NotNil(t, added)
+	assert.Len(t, added.steps, 1)
+	assert.Equal(t, "db1", added.steps[0].(*migrateGrantsStep).dbName)
+}
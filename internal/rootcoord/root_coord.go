@@ -117,6 +117,10 @@ type Core struct {
 
 	quotaCenter *QuotaCenter
 
+	partitionLifecycle *partitionLifecycleManager
+
+	ddlJobs *ddlJobManager
+
 	stateCode atomic.Int32
 	initOnce  sync.Once
 	startOnce sync.Once
@@ -372,6 +376,7 @@ func (c *Core) initMetaTable() error {
 		if c.meta, err = NewMetaTable(c.ctx, catalog, c.tsoAllocator); err != nil {
 			return err
 		}
+		c.ddlJobs = newDDLJobManager(catalog)
 
 		return nil
 	}
@@ -443,7 +448,11 @@ func (c *Core) initInternal() error {
 		return err
 	}
 
-	c.scheduler = newScheduler(c.ctx, c.idAllocator, c.tsoAllocator)
+	if err := c.ddlJobs.load(c.ctx); err != nil {
+		return err
+	}
+
+	c.scheduler = newScheduler(c.ctx, c.idAllocator, c.tsoAllocator, withDDLJobManager(c.ddlJobs))
 
 	c.factory.Init(Params)
 	chanMap := c.meta.ListCollectionPhysicalChannels()
@@ -471,6 +480,8 @@ func (c *Core) initInternal() error {
 	c.quotaCenter = NewQuotaCenter(c.proxyClientManager, c.queryCoord, c.dataCoord, c.tsoAllocator, c.meta)
 	log.Debug("RootCoord init QuotaCenter done")
 
+	c.partitionLifecycle = newPartitionLifecycleManager(c)
+
 	if err := c.initCredentials(); err != nil {
 		return err
 	}
@@ -661,6 +672,8 @@ func (c *Core) restore(ctx context.Context) error {
 					default:
 					}
 				}
+				c.restorePartitionKeyRepartition(db.Name, coll)
+				c.restoreGrantMigration(db.Name, coll)
 			} else {
 				switch coll.State {
 				case pb.CollectionState_CollectionDropping:
@@ -690,8 +703,10 @@ func (c *Core) startInternal() error {
 		c.quotaCenter.Start()
 	}
 
+	c.ddlJobs.Start()
 	c.scheduler.Start()
 	c.stepExecutor.Start()
+	c.partitionLifecycle.Start()
 	go func() {
 		// refresh rbac cache
 		if err := retry.Do(c.ctx, func() error {
@@ -774,6 +789,13 @@ func (c *Core) Stop() error {
 	if c.quotaCenter != nil {
 		c.quotaCenter.stop()
 	}
+	if c.partitionLifecycle != nil {
+		c.partitionLifecycle.stop()
+	}
+	// after the scheduler and the step executor stopped, so the last changes of the jobs are saved
+	if c.ddlJobs != nil {
+		c.ddlJobs.stop()
+	}
 
 	c.revokeSession()
 	c.cancelIfNotNil()
@@ -918,6 +940,48 @@ func (c *Core) DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseReques
 	return merr.Success(), nil
 }
 
+// DropDatabaseAsync drops a database, it responds the job id once the request is accepted.
+func (c *Core) DropDatabaseAsync(ctx context.Context, in *milvuspb.DropDatabaseRequest) (*internalpb.SubmitDDLJobResponse, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return &internalpb.SubmitDDLJobResponse{Status: merr.Status(err)}, nil
+	}
+
+	method := "DropDatabaseAsync"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+
+	log.Ctx(ctx).Info("received request to drop database async", zap.String("role", typeutil.RootCoordRole),
+		zap.String("dbName", in.GetDbName()), zap.Int64("msgID", in.GetBase().GetMsgID()))
+
+	// the task outlives the request.
+	t := &dropDatabaseTask{
+		baseTask: newBaseTask(context.WithoutCancel(ctx), c),
+		Req:      in,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Ctx(ctx).Info("failed to enqueue request to drop database async", zap.String("role", typeutil.RootCoordRole),
+			zap.Error(err),
+			zap.String("dbName", in.GetDbName()), zap.Int64("msgID", in.GetBase().GetMsgID()))
+
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return &internalpb.SubmitDDLJobResponse{Status: merr.Status(err)}, nil
+	}
+
+	go func() {
+		if err := t.WaitToFinish(); err == nil {
+			metrics.CleanupRootCoordDBMetrics(in.GetDbName())
+		}
+	}()
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	log.Ctx(ctx).Info("drop database accepted", zap.String("role", typeutil.RootCoordRole),
+		zap.String("dbName", in.GetDbName()), zap.Int64("jobID", t.GetID()))
+	return &internalpb.SubmitDDLJobResponse{
+		Status: merr.Success(),
+		JobID:  t.GetID(),
+	}, nil
+}
+
 func (c *Core) ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
 	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
 		ret := &milvuspb.ListDatabasesResponse{Status: merr.Status(err)}
@@ -1057,6 +1121,44 @@ func (c *Core) DropCollection(ctx context.Context, in *milvuspb.DropCollectionRe
 	return merr.Success(), nil
 }
 
+// DropCollectionAsync drops a collection, it responds the job id once the request is accepted.
+func (c *Core) DropCollectionAsync(ctx context.Context, in *milvuspb.DropCollectionRequest) (*internalpb.SubmitDDLJobResponse, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return &internalpb.SubmitDDLJobResponse{Status: merr.Status(err)}, nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("DropCollectionAsync", metrics.TotalLabel).Inc()
+
+	log.Ctx(ctx).Info("received request to drop collection async",
+		zap.String("role", typeutil.RootCoordRole),
+		zap.String("dbName", in.GetDbName()),
+		zap.String("name", in.GetCollectionName()))
+
+	// the task outlives the request.
+	t := &dropCollectionTask{
+		baseTask: newBaseTask(context.WithoutCancel(ctx), c),
+		Req:      in,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Ctx(ctx).Info("failed to enqueue request to drop collection async", zap.String("role", typeutil.RootCoordRole),
+			zap.Error(err),
+			zap.String("name", in.GetCollectionName()))
+
+		metrics.RootCoordDDLReqCounter.WithLabelValues("DropCollectionAsync", metrics.FailLabel).Inc()
+		return &internalpb.SubmitDDLJobResponse{Status: merr.Status(err)}, nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("DropCollectionAsync", metrics.SuccessLabel).Inc()
+	log.Ctx(ctx).Info("drop collection accepted", zap.String("role", typeutil.RootCoordRole),
+		zap.String("name", in.GetCollectionName()),
+		zap.Int64("jobID", t.GetID()))
+	return &internalpb.SubmitDDLJobResponse{
+		Status: merr.Success(),
+		JobID:  t.GetID(),
+	}, nil
+}
+
 // HasCollection check collection existence
 func (c *Core) HasCollection(ctx context.Context, in *milvuspb.HasCollectionRequest) (*milvuspb.BoolResponse, error) {
 	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
@@ -1299,6 +1401,133 @@ func (c *Core) AlterCollection(ctx context.Context, in *milvuspb.AlterCollection
 	return merr.Success(), nil
 }
 
+// AddCollectionField appends a nullable or defaulted field to an existing collection.
+func (c *Core) AddCollectionField(ctx context.Context, in *internalpb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	method := "AddCollectionField"
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+
+	log := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole),
+		zap.String("dbName", in.GetDbName()),
+		zap.String("name", in.GetCollectionName()),
+		zap.String("fieldName", in.GetField().GetName()))
+	log.Info("received request to add collection field")
+
+	t := &addCollectionFieldTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      in,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to add collection field", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to add collection field", zap.Error(err), zap.Uint64("ts", t.GetTs()))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	metrics.RootCoordDDLReqLatencyInQueue.WithLabelValues(method).Observe(float64(t.queueDur.Milliseconds()))
+
+	log.Info("done to add collection field", zap.Uint64("ts", t.GetTs()))
+	return merr.Success(), nil
+}
+
+// DropCollectionField drops a field which is neither primary key nor partition key from the collection.
+func (c *Core) DropCollectionField(ctx context.Context, in *internalpb.DropCollectionFieldRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	method := "DropCollectionField"
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+
+	log := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole),
+		zap.String("dbName", in.GetDbName()),
+		zap.String("name", in.GetCollectionName()),
+		zap.String("fieldName", in.GetFieldName()))
+	log.Info("received request to drop collection field")
+
+	t := &dropCollectionFieldTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      in,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to drop collection field", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to drop collection field", zap.Error(err), zap.Uint64("ts", t.GetTs()))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	metrics.RootCoordDDLReqLatencyInQueue.WithLabelValues(method).Observe(float64(t.queueDur.Milliseconds()))
+
+	log.Info("done to drop collection field", zap.Uint64("ts", t.GetTs()))
+	return merr.Success(), nil
+}
+
+// RenameCollectionField renames a field of the collection.
+func (c *Core) RenameCollectionField(ctx context.Context, in *internalpb.RenameCollectionFieldRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	method := "RenameCollectionField"
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+
+	log := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole),
+		zap.String("dbName", in.GetDbName()),
+		zap.String("name", in.GetCollectionName()),
+		zap.String("oldName", in.GetOldName()),
+		zap.String("newName", in.GetNewName()))
+	log.Info("received request to rename collection field")
+
+	t := &renameCollectionFieldTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      in,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to rename collection field", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to rename collection field", zap.Error(err), zap.Uint64("ts", t.GetTs()))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	metrics.RootCoordDDLReqLatencyInQueue.WithLabelValues(method).Observe(float64(t.queueDur.Milliseconds()))
+
+	log.Info("done to rename collection field", zap.Uint64("ts", t.GetTs()))
+	return merr.Success(), nil
+}
+
 func (c *Core) AlterDatabase(ctx context.Context, in *rootcoordpb.AlterDatabaseRequest) (*commonpb.Status, error) {
 	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
 		return merr.Status(err), nil
@@ -1887,6 +2116,88 @@ func (c *Core) AlterAlias(ctx context.Context, in *milvuspb.AlterAliasRequest) (
 	return merr.Success(), nil
 }
 
+// AlterAliases alters several aliases of a database atomically
+func (c *Core) AlterAliases(ctx context.Context, in *internalpb.AlterAliasesRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("AlterAliases", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("AlterAliases")
+
+	log.Ctx(ctx).Info("received request to alter aliases",
+		zap.String("role", typeutil.RootCoordRole),
+		zap.String("db", in.GetDbName()),
+		zap.Any("changes", in.GetChanges()))
+
+	t := &alterAliasesTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      in,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Ctx(ctx).Info("failed to enqueue request to alter aliases",
+			zap.String("role", typeutil.RootCoordRole),
+			zap.Error(err),
+			zap.String("db", in.GetDbName()))
+
+		metrics.RootCoordDDLReqCounter.WithLabelValues("AlterAliases", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Ctx(ctx).Info("failed to alter aliases",
+			zap.String("role", typeutil.RootCoordRole),
+			zap.Error(err),
+			zap.String("db", in.GetDbName()),
+			zap.Uint64("ts", t.GetTs()))
+
+		metrics.RootCoordDDLReqCounter.WithLabelValues("AlterAliases", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("AlterAliases", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("AlterAliases").Observe(float64(tr.ElapseSpan().Milliseconds()))
+	metrics.RootCoordDDLReqLatencyInQueue.WithLabelValues("AlterAliases").Observe(float64(t.queueDur.Milliseconds()))
+
+	log.Ctx(ctx).Info("done to alter aliases",
+		zap.String("role", typeutil.RootCoordRole),
+		zap.String("db", in.GetDbName()),
+		zap.Int("changes", len(in.GetChanges())),
+		zap.Uint64("ts", t.GetTs()))
+	return merr.Success(), nil
+}
+
+// ListDDLJobs lists the ddl jobs which are unfinished or finished within the retention time
+func (c *Core) ListDDLJobs(ctx context.Context, in *internalpb.ListDDLJobsRequest) (*internalpb.ListDDLJobsResponse, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return &internalpb.ListDDLJobsResponse{Status: merr.Status(err)}, nil
+	}
+
+	return &internalpb.ListDDLJobsResponse{
+		Status: merr.Success(),
+		Jobs:   c.ddlJobs.list(in.GetDbName(), in.GetCollectionName(), in.GetOnlyUnfinished()),
+	}, nil
+}
+
+// GetDDLJob gets the state and step progress of a ddl job
+func (c *Core) GetDDLJob(ctx context.Context, in *internalpb.GetDDLJobRequest) (*internalpb.GetDDLJobResponse, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return &internalpb.GetDDLJobResponse{Status: merr.Status(err)}, nil
+	}
+
+	job, ok := c.ddlJobs.get(in.GetJobID())
+	if !ok {
+		return &internalpb.GetDDLJobResponse{
+			Status: merr.Status(merr.WrapErrParameterInvalidMsg("ddl job %d not found", in.GetJobID())),
+		}, nil
+	}
+	return &internalpb.GetDDLJobResponse{
+		Status: merr.Success(),
+		Job:    job,
+	}, nil
+}
+
 // DescribeAlias describe collection alias
 func (c *Core) DescribeAlias(ctx context.Context, in *milvuspb.DescribeAliasRequest) (*milvuspb.DescribeAliasResponse, error) {
 	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
@@ -2493,6 +2804,9 @@ func (c *Core) isValidGrantor(entity *milvuspb.GrantorEntity, object string) err
 	if !ok {
 		return fmt.Errorf("not found the object type[name: %s], supported the object types: %v", object, lo.Keys(commonpb.ObjectType_value))
 	}
+	if util.IsPrivilegeGroup(object, entity.Privilege.Name) {
+		return nil
+	}
 	for _, privilege := range privileges {
 		if privilege == entity.Privilege.Name {
 			return nil
@@ -142,6 +142,31 @@ func TestRootCoord_DropDatabase(t *testing.T) {
 	})
 }
 
+func TestRootCoord_DropDatabaseAsync(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.DropDatabaseAsync(context.Background(), &milvuspb.DropDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_NotReadyServe, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("failed to add task", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withInvalidScheduler())
+		resp, err := c.DropDatabaseAsync(context.Background(), &milvuspb.DropDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withValidScheduler())
+		resp, err := c.DropDatabaseAsync(context.Background(), &milvuspb.DropDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+}
+
 func TestRootCoord_ListDatabases(t *testing.T) {
 	t.Run("not healthy", func(t *testing.T) {
 		c := newTestCore(withAbnormalCode())
@@ -220,6 +245,105 @@ func TestRootCoord_AlterDatabase(t *testing.T) {
 	})
 }
 
+func TestRootCoord_AddCollectionField(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.AddCollectionField(context.Background(), &internalpb.AddCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_NotReadyServe, resp.GetErrorCode())
+	})
+
+	t.Run("failed to add task", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withInvalidScheduler())
+		resp, err := c.AddCollectionField(context.Background(), &internalpb.AddCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("failed to execute", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withTaskFailScheduler())
+		resp, err := c.AddCollectionField(context.Background(), &internalpb.AddCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withValidScheduler())
+		resp, err := c.AddCollectionField(context.Background(), &internalpb.AddCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+}
+
+func TestRootCoord_DropCollectionField(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.DropCollectionField(context.Background(), &internalpb.DropCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_NotReadyServe, resp.GetErrorCode())
+	})
+
+	t.Run("failed to add task", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withInvalidScheduler())
+		resp, err := c.DropCollectionField(context.Background(), &internalpb.DropCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("failed to execute", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withTaskFailScheduler())
+		resp, err := c.DropCollectionField(context.Background(), &internalpb.DropCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withValidScheduler())
+		resp, err := c.DropCollectionField(context.Background(), &internalpb.DropCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+}
+
+func TestRootCoord_RenameCollectionField(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.RenameCollectionField(context.Background(), &internalpb.RenameCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_NotReadyServe, resp.GetErrorCode())
+	})
+
+	t.Run("failed to add task", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withInvalidScheduler())
+		resp, err := c.RenameCollectionField(context.Background(), &internalpb.RenameCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("failed to execute", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withTaskFailScheduler())
+		resp, err := c.RenameCollectionField(context.Background(), &internalpb.RenameCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withValidScheduler())
+		resp, err := c.RenameCollectionField(context.Background(), &internalpb.RenameCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+}
+
 func TestRootCoord_CreateCollection(t *testing.T) {
 	t.Run("not healthy", func(t *testing.T) {
 		c := newTestCore(withAbnormalCode())
@@ -300,6 +424,37 @@ func TestRootCoord_DropCollection(t *testing.T) {
 	})
 }
 
+func TestRootCoord_DropCollectionAsync(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.DropCollectionAsync(context.Background(), &milvuspb.DropCollectionRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("failed to add task", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withInvalidScheduler())
+		resp, err := c.DropCollectionAsync(context.Background(), &milvuspb.DropCollectionRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("normal case, everything is ok", func(t *testing.T) {
+		sched := newMockScheduler()
+		sched.AddTaskFunc = func(t task) error {
+			t.SetID(100)
+			return nil
+		}
+		c := newTestCore(withHealthyCode(),
+			withScheduler(sched))
+		resp, err := c.DropCollectionAsync(context.Background(), &milvuspb.DropCollectionRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, int64(100), resp.GetJobID())
+	})
+}
+
 func TestRootCoord_CreatePartition(t *testing.T) {
 	t.Run("not healthy", func(t *testing.T) {
 		c := newTestCore(withAbnormalCode())
@@ -494,6 +649,88 @@ func TestRootCoord_AlterAlias(t *testing.T) {
 	})
 }
 
+func TestRootCoord_AlterAliases(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.AlterAliases(context.Background(), &internalpb.AlterAliasesRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("failed to add task", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withInvalidScheduler())
+		resp, err := c.AlterAliases(context.Background(), &internalpb.AlterAliasesRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("failed to execute", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withTaskFailScheduler())
+		resp, err := c.AlterAliases(context.Background(), &internalpb.AlterAliasesRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("normal case, everything is ok", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withValidScheduler())
+		resp, err := c.AlterAliases(context.Background(), &internalpb.AlterAliasesRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+}
+
+func TestRootCoord_ListDDLJobs(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.ListDDLJobs(context.Background(), &internalpb.ListDDLJobsRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		c := newTestCore(withHealthyCode())
+		c.ddlJobs = newDDLJobManager(newDDLJobTestCatalog(t))
+		c.ddlJobs.register(newTestDropCollectionJobTask(100, "db", "coll1"))
+		c.ddlJobs.register(newTestDropCollectionJobTask(101, "db", "coll2"))
+		resp, err := c.ListDDLJobs(context.Background(), &internalpb.ListDDLJobsRequest{DbName: "db", CollectionName: "coll2"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Len(t, resp.GetJobs(), 1)
+		assert.Equal(t, int64(101), resp.GetJobs()[0].GetJobID())
+	})
+}
+
+func TestRootCoord_GetDDLJob(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.GetDDLJob(context.Background(), &internalpb.GetDDLJobRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		c := newTestCore(withHealthyCode())
+		c.ddlJobs = newDDLJobManager(newDDLJobTestCatalog(t))
+		resp, err := c.GetDDLJob(context.Background(), &internalpb.GetDDLJobRequest{JobID: 100})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		c := newTestCore(withHealthyCode())
+		c.ddlJobs = newDDLJobManager(newDDLJobTestCatalog(t))
+		c.ddlJobs.register(newTestDropCollectionJobTask(100, "db", "coll"))
+		resp, err := c.GetDDLJob(context.Background(), &internalpb.GetDDLJobRequest{JobID: 100})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, "coll", resp.GetJob().GetCollectionName())
+		assert.Equal(t, internalpb.DDLJobState_DDLJobPending, resp.GetJob().GetState())
+	})
+}
+
 func TestRootCoord_DescribeAlias(t *testing.T) {
 	t.Run("not healthy", func(t *testing.T) {
 		c := newTestCore(withAbnormalCode())
@@ -2071,3 +2308,28 @@ func (s *RootCoordSuite) TestRestore() {
 func TestRootCoordSuite(t *testing.T) {
 	suite.Run(t, new(RootCoordSuite))
 }
+
+func TestCore_isValidGrantor(t *testing.T) {
+	meta := newMockMetaTable()
+	meta.SelectUserFunc = func(tenant string, entity *milvuspb.UserEntity, includeRoleInfo bool) ([]*milvuspb.UserResult, error) {
+		return nil, nil
+	}
+	c := newTestCore(withMeta(meta))
+	grantor := func(privilege string) *milvuspb.GrantorEntity {
+		return &milvuspb.GrantorEntity{
+			User:      &milvuspb.UserEntity{Name: util.UserRoot},
+			Privilege: &milvuspb.PrivilegeEntity{Name: privilege},
+		}
+	}
+
+	assert.NoError(t, c.isValidGrantor(grantor("Insert"), commonpb.ObjectType_Collection.String()))
+	assert.NoError(t, c.isValidGrantor(grantor(util.PrivilegeGroupCollectionReadOnly), commonpb.ObjectType_Collection.String()))
+	assert.NoError(t, c.isValidGrantor(grantor(util.PrivilegeGroupDatabaseAdmin), commonpb.ObjectType_Global.String()))
+	assert.Equal(t, "PrivilegeCollectionReadOnly", util.PrivilegeNameForMetastore(util.PrivilegeGroupCollectionReadOnly))
+	assert.Equal(t, util.PrivilegeGroupCollectionReadOnly, util.PrivilegeNameForAPI("PrivilegeCollectionReadOnly"))
+
+	// the groups of other object types
+	assert.Error(t, c.isValidGrantor(grantor(util.PrivilegeGroupDatabaseReadOnly), commonpb.ObjectType_Collection.String()))
+	assert.Error(t, c.isValidGrantor(grantor(util.PrivilegeGroupCollectionAdmin), commonpb.ObjectType_User.String()))
+	assert.Error(t, c.isValidGrantor(grantor("UnknownGroup"), commonpb.ObjectType_Collection.String()))
+}
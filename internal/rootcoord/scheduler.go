@@ -49,13 +49,24 @@ type scheduler struct {
 	lock sync.Mutex
 
 	minDdlTs atomic.Uint64
+
+	// tracks the ddl tasks as jobs if not nil
+	ddlJobs *ddlJobManager
 }
 
-func newScheduler(ctx context.Context, idAllocator allocator.Interface, tsoAllocator tso.Allocator) *scheduler {
+type schedulerOpt func(*scheduler)
+
+func withDDLJobManager(ddlJobs *ddlJobManager) schedulerOpt {
+	return func(s *scheduler) {
+		s.ddlJobs = ddlJobs
+	}
+}
+
+func newScheduler(ctx context.Context, idAllocator allocator.Interface, tsoAllocator tso.Allocator, opts ...schedulerOpt) *scheduler {
 	ctx1, cancel := context.WithCancel(ctx)
 	// TODO
 	n := 1024 * 10
-	return &scheduler{
+	s := &scheduler{
 		ctx:          ctx1,
 		cancel:       cancel,
 		idAllocator:  idAllocator,
@@ -63,6 +74,10 @@ func newScheduler(ctx context.Context, idAllocator allocator.Interface, tsoAlloc
 		taskChan:     make(chan task, n),
 		minDdlTs:     *atomic.NewUint64(0),
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 func (s *scheduler) Start() {
@@ -81,11 +96,15 @@ func (s *scheduler) Stop() {
 func (s *scheduler) execute(task task) {
 	defer s.setMinDdlTs(task.GetTs()) // we should update ts, whatever task succeeds or not.
 	task.SetInQueueDuration()
+	job := ddlJobFromContext(task.GetCtx())
+	job.start()
 	if err := task.Prepare(task.GetCtx()); err != nil {
+		job.taskDone(err)
 		task.NotifyDone(err)
 		return
 	}
 	err := task.Execute(task.GetCtx())
+	job.taskDone(err)
 	task.NotifyDone(err)
 }
 
@@ -157,6 +176,10 @@ func (s *scheduler) AddTask(task task) error {
 	if err := s.setTs(task); err != nil {
 		return err
 	}
+	if t, ok := task.(ddlJobTask); ok && s.ddlJobs != nil {
+		job := s.ddlJobs.register(t)
+		task.SetCtx(withDDLJob(task.GetCtx(), job))
+	}
 	s.enqueue(task)
 	return nil
 }
@@ -88,8 +88,11 @@ func (t *showCollectionTask) Execute(ctx context.Context) error {
 			}
 			for _, entity := range entities {
 				objectType := entity.GetObject().GetName()
+				privilegeName := entity.GetGrantor().GetPrivilege().GetName()
+				// the database privilege groups allow describing all the collections of the database
 				if objectType == commonpb.ObjectType_Global.String() &&
-					entity.GetGrantor().GetPrivilege().GetName() == util.PrivilegeNameForAPI(commonpb.ObjectPrivilege_PrivilegeAll.String()) {
+					(privilegeName == util.PrivilegeNameForAPI(commonpb.ObjectPrivilege_PrivilegeAll.String()) ||
+						util.IsPrivilegeGroup(objectType, privilegeName)) {
 					privilegeColls.Insert(util.AnyWord)
 					return privilegeColls, nil
 				}
@@ -359,6 +359,25 @@ func (s *dropIndexStep) Weight() stepPriority {
 	return stepPriorityNormal
 }
 
+type dropFieldIndexStep struct {
+	baseStep
+	collID  UniqueID
+	fieldID UniqueID
+}
+
+func (s *dropFieldIndexStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	err := s.core.broker.DropFieldIndex(ctx, s.collID, s.fieldID)
+	return nil, err
+}
+
+func (s *dropFieldIndexStep) Desc() string {
+	return fmt.Sprintf("drop field index, collectionID: %d, fieldID: %d", s.collID, s.fieldID)
+}
+
+func (s *dropFieldIndexStep) Weight() stepPriority {
+	return stepPriorityNormal
+}
+
 type addPartitionMetaStep struct {
 	baseStep
 	partition *model.Partition
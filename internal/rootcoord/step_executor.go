@@ -41,6 +41,8 @@ type StepExecutor interface {
 
 type stepStack struct {
 	steps []nestedStep
+	// the job the steps belong to, nil if the steps aren't tracked
+	job *ddlJob
 }
 
 func (s *stepStack) totalPriority() int {
@@ -61,12 +63,14 @@ func (s *stepStack) Execute(ctx context.Context) *stepStack {
 		// TODO: maybe a interface `step.LogOnError` is better.
 		_, isWaitForTsSyncedStep := todo.(*waitForTsSyncedStep)
 		_, isConfirmGCStep := todo.(*confirmGCStep)
-		skipLog := isWaitForTsSyncedStep || isConfirmGCStep
+		_, isConfirmRepartitionStep := todo.(*confirmRepartitionStep)
+		skipLog := isWaitForTsSyncedStep || isConfirmGCStep || isConfirmRepartitionStep
 
 		if !retry.IsRecoverable(err) {
 			if !skipLog {
 				log.Warn("failed to execute step, not able to reschedule", zap.Error(err), zap.String("step", todo.Desc()))
 			}
+			s.job.backgroundDone(err)
 			return nil
 		}
 		if err != nil {
@@ -74,13 +78,15 @@ func (s *stepStack) Execute(ctx context.Context) *stepStack {
 			if !skipLog {
 				log.Warn("failed to execute step, wait for reschedule", zap.Error(err), zap.String("step", todo.Desc()))
 			}
-			return &stepStack{steps: steps}
+			return &stepStack{steps: steps, job: s.job}
 		}
 		// this step is done.
+		s.job.stepDone(todo)
 		steps = steps[:l-1]
 		steps = append(steps, childSteps...)
 	}
 	// everything is done.
+	s.job.backgroundDone(nil)
 	return nil
 }
 
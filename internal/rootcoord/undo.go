@@ -48,6 +48,8 @@ func (b *baseUndoTask) Execute(ctx context.Context) error {
 	if len(b.todoStep) != len(b.undoStep) {
 		return fmt.Errorf("todo step and undo step length not equal")
 	}
+	job := ddlJobFromContext(ctx)
+	job.addSteps(b.todoStep...)
 	for i := 0; i < len(b.todoStep); i++ {
 		todoStep := b.todoStep[i]
 		// no children step in normal case.
@@ -55,9 +57,10 @@ func (b *baseUndoTask) Execute(ctx context.Context) error {
 			log.Warn("failed to execute step, trying to undo", zap.Error(err), zap.String("desc", todoStep.Desc()))
 			undoSteps := b.undoStep[:i]
 			b.undoStep = nil // let baseUndoTask can be collected.
-			go b.stepExecutor.AddSteps(&stepStack{undoSteps})
+			go b.stepExecutor.AddSteps(&stepStack{steps: undoSteps})
 			return err
 		}
+		job.stepDone(todoStep)
 	}
 	return nil
 }
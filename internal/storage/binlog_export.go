@@ -0,0 +1,349 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/apache/arrow/go/v12/arrow"
+	"github.com/apache/arrow/go/v12/arrow/array"
+	"github.com/apache/arrow/go/v12/arrow/memory"
+	"github.com/apache/arrow/go/v12/parquet"
+	"github.com/apache/arrow/go/v12/parquet/compress"
+	"github.com/apache/arrow/go/v12/parquet/pqarrow"
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// ExportFormat is the file format of the exported rows.
+type ExportFormat string
+
+const (
+	ExportFormatParquet ExportFormat = "parquet"
+	ExportFormatJSONL   ExportFormat = "jsonl"
+
+	exportBatchSize = 4096
+)
+
+// ExportWriter writes the rows read from binlogs to a file.
+type ExportWriter interface {
+	// Write writes a row, the value may be reused by the caller after Write returns.
+	Write(v *Value) error
+	Close() error
+}
+
+// NewExportWriter creates an ExportWriter of @format writing to @w.
+func NewExportWriter(format ExportFormat, schema *schemapb.CollectionSchema, w io.Writer) (ExportWriter, error) {
+	fields := exportFields(schema)
+	switch format {
+	case ExportFormatParquet:
+		return newParquetExportWriter(fields, w)
+	case ExportFormatJSONL:
+		return newJSONLExportWriter(fields, w), nil
+	default:
+		return nil, merr.WrapErrParameterInvalidMsg("unsupported export format %s", format)
+	}
+}
+
+// exportFields returns the fields to export sorted by field id, the system fields are included.
+func exportFields(schema *schemapb.CollectionSchema) []*schemapb.FieldSchema {
+	fields := make([]*schemapb.FieldSchema, 0, len(schema.GetFields())+2)
+	fields = append(fields, schema.GetFields()...)
+	hasField := func(fieldID int64) bool {
+		return lo.ContainsBy(fields, func(field *schemapb.FieldSchema) bool {
+			return field.GetFieldID() == fieldID
+		})
+	}
+	if !hasField(common.RowIDField) {
+		fields = append(fields, &schemapb.FieldSchema{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64})
+	}
+	if !hasField(common.TimeStampField) {
+		fields = append(fields, &schemapb.FieldSchema{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64})
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].GetFieldID() < fields[j].GetFieldID()
+	})
+	return fields
+}
+
+// ExportSegment reads the insert binlogs and deltalogs of a segment, and writes the rows not deleted to @writer.
+// @binlogPaths are grouped by batch, each batch contains the binlogs of all fields.
+// @deltalogPaths should include the deltalogs of the L0 segments of the same channel and partition, and the
+// collection-level ones, whose deletes are not compacted into the deltalogs of the segment yet.
+// Returns the number of exported rows and deleted rows.
+func ExportSegment(ctx context.Context, cm ChunkManager, schema *schemapb.CollectionSchema,
+	binlogPaths [][]string, deltalogPaths []string, writer ExportWriter,
+) (int64, int64, error) {
+	pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil {
+		return 0, 0, err
+	}
+	deltas, err := loadDeletes(ctx, cm, deltalogPaths)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var exported, deleted int64
+	for _, paths := range binlogPaths {
+		values, err := cm.MultiRead(ctx, paths)
+		if err != nil {
+			return 0, 0, err
+		}
+		blobs := lo.Map(values, func(v []byte, i int) *Blob {
+			return &Blob{Key: paths[i], Value: v}
+		})
+		reader, err := NewBinlogDeserializeReader(blobs, pkField.GetFieldID())
+		if err != nil {
+			return 0, 0, err
+		}
+		for {
+			err := reader.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				reader.Close()
+				return 0, 0, err
+			}
+			v := reader.Value()
+			// the upserted row has the same ts with the delete, it's not deleted
+			if ts, ok := deltas[v.PK.GetValue()]; ok && uint64(v.Timestamp) < ts {
+				deleted++
+				continue
+			}
+			if err := writer.Write(v); err != nil {
+				reader.Close()
+				return 0, 0, err
+			}
+			exported++
+		}
+		reader.Close()
+	}
+	return exported, deleted, nil
+}
+
+// loadDeletes returns the max delete ts of each primary key in the deltalogs, the same key might be deleted
+// by both the deltalogs of the segment and the ones of the L0 segments.
+func loadDeletes(ctx context.Context, cm ChunkManager, deltalogPaths []string) (map[any]uint64, error) {
+	deltas := make(map[any]uint64)
+	for _, path := range deltalogPaths {
+		value, err := cm.Read(ctx, path)
+		if err != nil {
+			return nil, err
+		}
+		reader, err := NewDeltalogDeserializeReader([]*Blob{{Key: path, Value: value}})
+		if err != nil {
+			return nil, err
+		}
+		for {
+			err := reader.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				reader.Close()
+				return nil, err
+			}
+			dl := reader.Value()
+			if ts, ok := deltas[dl.Pk.GetValue()]; !ok || ts < dl.Ts {
+				deltas[dl.Pk.GetValue()] = dl.Ts
+			}
+		}
+		reader.Close()
+	}
+	return deltas, nil
+}
+
+var _ ExportWriter = (*parquetExportWriter)(nil)
+
+// parquetExportWriter writes the rows to a parquet file, the columns are named by field names.
+type parquetExportWriter struct {
+	fields   []*schemapb.FieldSchema
+	schema   *arrow.Schema
+	fw       *pqarrow.FileWriter
+	builders []array.Builder
+	rows     int
+}
+
+func newParquetExportWriter(fields []*schemapb.FieldSchema, w io.Writer) (*parquetExportWriter, error) {
+	arrowFields := make([]arrow.Field, 0, len(fields))
+	builders := make([]array.Builder, 0, len(fields))
+	for _, field := range fields {
+		entry, ok := serdeMap[field.GetDataType()]
+		if !ok {
+			return nil, merr.WrapErrServiceInternal(fmt.Sprintf("unexpected type %s", field.GetDataType()))
+		}
+		dim, _ := typeutil.GetDim(field)
+		dataType := entry.arrowType(int(dim))
+		arrowFields = append(arrowFields, arrow.Field{
+			Name:     field.GetName(),
+			Type:     dataType,
+			Nullable: true,
+		})
+		builders = append(builders, array.NewBuilder(memory.DefaultAllocator, dataType))
+	}
+	schema := arrow.NewSchema(arrowFields, nil)
+	fw, err := pqarrow.NewFileWriter(schema, w,
+		parquet.NewWriterProperties(parquet.WithCompression(compress.Codecs.Zstd)),
+		pqarrow.DefaultWriterProps())
+	if err != nil {
+		return nil, err
+	}
+	return &parquetExportWriter{
+		fields:   fields,
+		schema:   schema,
+		fw:       fw,
+		builders: builders,
+	}, nil
+}
+
+func (pw *parquetExportWriter) Write(v *Value) error {
+	m := v.Value.(map[FieldID]any)
+	for i, field := range pw.fields {
+		if !serdeMap[field.GetDataType()].serialize(pw.builders[i], m[field.GetFieldID()]) {
+			return merr.WrapErrServiceInternal(fmt.Sprintf("serialize error on type %s", field.GetDataType()))
+		}
+	}
+	pw.rows++
+	if pw.rows >= exportBatchSize {
+		return pw.flush()
+	}
+	return nil
+}
+
+func (pw *parquetExportWriter) flush() error {
+	if pw.rows == 0 {
+		return nil
+	}
+	arrays := make([]arrow.Array, len(pw.builders))
+	for i, builder := range pw.builders {
+		arrays[i] = builder.NewArray()
+	}
+	rec := array.NewRecord(pw.schema, arrays, int64(pw.rows))
+	defer rec.Release()
+	for _, a := range arrays {
+		a.Release()
+	}
+	pw.rows = 0
+	return pw.fw.WriteBuffered(rec)
+}
+
+func (pw *parquetExportWriter) Close() error {
+	err := pw.flush()
+	for _, builder := range pw.builders {
+		builder.Release()
+	}
+	return merr.Combine(err, pw.fw.Close())
+}
+
+var _ ExportWriter = (*jsonlExportWriter)(nil)
+
+// jsonlExportWriter writes the rows as JSON Lines, each line is an object keyed by field names.
+type jsonlExportWriter struct {
+	fields []*schemapb.FieldSchema
+	w      *bufio.Writer
+}
+
+func newJSONLExportWriter(fields []*schemapb.FieldSchema, w io.Writer) *jsonlExportWriter {
+	return &jsonlExportWriter{
+		fields: fields,
+		w:      bufio.NewWriter(w),
+	}
+}
+
+func (jw *jsonlExportWriter) Write(v *Value) error {
+	m := v.Value.(map[FieldID]any)
+	row := make(map[string]any, len(jw.fields))
+	for _, field := range jw.fields {
+		row[field.GetName()] = exportJSONValue(field.GetDataType(), m[field.GetFieldID()])
+	}
+	data, err := json.Marshal(row)
+	if err != nil {
+		return err
+	}
+	if _, err := jw.w.Write(data); err != nil {
+		return err
+	}
+	return jw.w.WriteByte('\n')
+}
+
+func (jw *jsonlExportWriter) Close() error {
+	return jw.w.Flush()
+}
+
+// exportJSONValue converts the deserialized binlog value to the value to marshal in JSON.
+func exportJSONValue(dataType schemapb.DataType, v any) any {
+	if v == nil {
+		return nil
+	}
+	switch dataType {
+	case schemapb.DataType_JSON:
+		data := v.([]byte)
+		// keep the broken json as string, so that it could still be inspected
+		if !json.Valid(data) {
+			return string(data)
+		}
+		return json.RawMessage(data)
+	case schemapb.DataType_Array:
+		return exportArrayValue(v.(*schemapb.ScalarField))
+	case schemapb.DataType_BinaryVector:
+		// marshal as a list of bytes instead of a base64 string
+		return lo.Map(v.([]byte), func(b byte, _ int) int { return int(b) })
+	case schemapb.DataType_Float16Vector:
+		return typeutil.Float16BytesToFloat32Vector(v.([]byte))
+	case schemapb.DataType_BFloat16Vector:
+		return typeutil.BFloat16BytesToFloat32Vector(v.([]byte))
+	case schemapb.DataType_SparseFloatVector:
+		row := v.([]byte)
+		// keyed by string since the indices are not continuous
+		sparse := make(map[string]float32, typeutil.SparseFloatRowElementCount(row))
+		for i := 0; i < typeutil.SparseFloatRowElementCount(row); i++ {
+			sparse[fmt.Sprint(typeutil.SparseFloatRowIndexAt(row, i))] = typeutil.SparseFloatRowValueAt(row, i)
+		}
+		return sparse
+	default:
+		return v
+	}
+}
+
+func exportArrayValue(field *schemapb.ScalarField) any {
+	switch data := field.GetData().(type) {
+	case *schemapb.ScalarField_BoolData:
+		return data.BoolData.GetData()
+	case *schemapb.ScalarField_IntData:
+		return data.IntData.GetData()
+	case *schemapb.ScalarField_LongData:
+		return data.LongData.GetData()
+	case *schemapb.ScalarField_FloatData:
+		return data.FloatData.GetData()
+	case *schemapb.ScalarField_DoubleData:
+		return data.DoubleData.GetData()
+	case *schemapb.ScalarField_StringData:
+		return data.StringData.GetData()
+	default:
+		return nil
+	}
+}
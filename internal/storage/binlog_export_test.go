@@ -0,0 +1,147 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/apache/arrow/go/v12/arrow/array"
+	"github.com/apache/arrow/go/v12/arrow/memory"
+	"github.com/apache/arrow/go/v12/parquet/pqarrow"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
+)
+
+func TestExportSegment(t *testing.T) {
+	ctx := context.Background()
+	rootPath := t.TempDir()
+	cm := NewLocalChunkManager(RootPath(rootPath))
+
+	schema := proto.Clone(generateTestSchema()).(*schemapb.CollectionSchema)
+	for _, field := range schema.Fields {
+		switch field.FieldID {
+		case 13:
+			field.IsPrimaryKey = true
+		case 19:
+			field.Name = "json"
+		}
+	}
+
+	// pk 1 and 2 are deleted after insert, pk 3 remains
+	size := 3
+	blobs, err := generateTestData(size)
+	require.NoError(t, err)
+	binlogPaths := make([]string, 0, len(blobs))
+	for _, blob := range blobs {
+		fieldID, err := strconv.ParseInt(blob.Key, 10, 64)
+		require.NoError(t, err)
+		binlogPath := metautil.BuildInsertLogPath(rootPath, 1, 1, 1, fieldID, 1)
+		require.NoError(t, cm.Write(ctx, binlogPath, blob.Value))
+		binlogPaths = append(binlogPaths, binlogPath)
+	}
+	deltaBlob, err := generateTestDeltalogData(size)
+	require.NoError(t, err)
+	deltalogPath := metautil.BuildDeltaLogPath(rootPath, 1, 1, 1, 2)
+	require.NoError(t, cm.Write(ctx, deltalogPath, deltaBlob.Value))
+
+	t.Run("jsonl", func(t *testing.T) {
+		var buf bytes.Buffer
+		writer, err := NewExportWriter(ExportFormatJSONL, schema, &buf)
+		require.NoError(t, err)
+		exported, deleted, err := ExportSegment(ctx, cm, schema, [][]string{binlogPaths}, []string{deltalogPath}, writer)
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+		assert.EqualValues(t, 1, exported)
+		assert.EqualValues(t, 2, deleted)
+
+		scanner := bufio.NewScanner(&buf)
+		require.True(t, scanner.Scan())
+		row := make(map[string]any)
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &row))
+		assert.EqualValues(t, 3, row["int64"])
+		assert.EqualValues(t, 3, row["rowid"])
+		assert.Equal(t, "3", row["varchar"])
+		assert.Equal(t, []any{3.0, 3.0, 3.0}, row["array"])
+		assert.Equal(t, "\x03", row["json"])
+		assert.Len(t, row["floatVector"], 8)
+		assert.Equal(t, []any{255.0}, row["binaryVector"])
+		assert.Len(t, row["float16Vector"], 8)
+		assert.Len(t, row["sparseFloatVector"], 3)
+		assert.False(t, scanner.Scan())
+	})
+
+	t.Run("parquet", func(t *testing.T) {
+		var buf bytes.Buffer
+		writer, err := NewExportWriter(ExportFormatParquet, schema, &buf)
+		require.NoError(t, err)
+		exported, _, err := ExportSegment(ctx, cm, schema, [][]string{binlogPaths}, nil, writer)
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+		assert.EqualValues(t, size, exported)
+
+		table, err := pqarrow.ReadTable(ctx, bytes.NewReader(buf.Bytes()), nil, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+		require.NoError(t, err)
+		defer table.Release()
+		assert.EqualValues(t, size, table.NumRows())
+		assert.EqualValues(t, len(schema.Fields), table.NumCols())
+		indices := table.Schema().FieldIndices("int64")
+		require.Len(t, indices, 1)
+		column := table.Column(indices[0]).Data().Chunk(0).(*array.Int64)
+		assert.Equal(t, []int64{1, 2, 3}, column.Int64Values())
+	})
+
+	t.Run("with L0 deletes", func(t *testing.T) {
+		// the L0 segment deletes pk 3 which remains by the deltalogs of the segment
+		deleteData := &DeleteData{}
+		deleteData.Append(NewInt64PrimaryKey(3), math.MaxUint64)
+		l0Blob, err := NewDeleteCodec().Serialize(1, common.AllPartitionsID, 2, deleteData)
+		require.NoError(t, err)
+		l0DeltalogPath := metautil.BuildDeltaLogPath(rootPath, 1, common.AllPartitionsID, 2, 3)
+		require.NoError(t, cm.Write(ctx, l0DeltalogPath, l0Blob.Value))
+
+		writer, err := NewExportWriter(ExportFormatJSONL, schema, &bytes.Buffer{})
+		require.NoError(t, err)
+		exported, deleted, err := ExportSegment(ctx, cm, schema, [][]string{binlogPaths}, []string{deltalogPath, l0DeltalogPath}, writer)
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+		assert.EqualValues(t, 0, exported)
+		assert.EqualValues(t, size, deleted)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := NewExportWriter("csv", schema, &bytes.Buffer{})
+		assert.Error(t, err)
+
+		writer, err := NewExportWriter(ExportFormatJSONL, schema, &bytes.Buffer{})
+		require.NoError(t, err)
+		_, _, err = ExportSegment(ctx, cm, generateTestSchema(), [][]string{binlogPaths}, nil, writer)
+		assert.Error(t, err)
+		_, _, err = ExportSegment(ctx, cm, schema, [][]string{{metautil.BuildInsertLogPath(rootPath, 1, 1, 1, 100, 100)}}, nil, writer)
+		assert.Error(t, err)
+	})
+}
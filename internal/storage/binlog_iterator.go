@@ -23,6 +23,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
 var (
@@ -69,12 +70,25 @@ func NewInsertBinlogIterator(blobs []*Blob, PKfieldID UniqueID, pkType schemapb.
 
 	_, _, serData, err := reader.Deserialize(blobs)
 	if err != nil {
-		return nil, err
+		return nil, locateCorruptedBinlog(blobs, err)
 	}
 
 	return &InsertBinlogIterator{data: serData, PKfieldID: PKfieldID, PkType: pkType}, nil
 }
 
+// locateCorruptedBinlog finds the corrupted binlog in @blobs if @err is caused by checksum mismatch.
+func locateCorruptedBinlog(blobs []*Blob, err error) error {
+	if !errors.Is(err, merr.ErrIoChecksumMismatch) {
+		return err
+	}
+	for _, blob := range blobs {
+		if verifyErr := VerifyBinlog(blob.GetValue()); verifyErr != nil {
+			return errors.Wrapf(verifyErr, "binlog %s is corrupted", blob.GetKey())
+		}
+	}
+	return err
+}
+
 // HasNext returns true if the iterator have unread record
 func (itr *InsertBinlogIterator) HasNext() bool {
 	return !itr.isDisposed() && itr.hasNext()
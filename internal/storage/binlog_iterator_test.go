@@ -17,6 +17,7 @@
 package storage
 
 import (
+	"bytes"
 	"fmt"
 	"testing"
 
@@ -26,6 +27,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -257,6 +259,16 @@ func TestInsertlogIterator(t *testing.T) {
 		_, err = itr.Next()
 		assert.Equal(t, ErrNoMoreRecord, err)
 	})
+
+	t.Run("corrupted binlog", func(t *testing.T) {
+		blobs, err := generateTestData(3)
+		assert.NoError(t, err)
+		blobs[1].Value = bytes.Clone(blobs[1].Value)
+		blobs[1].Value[len(blobs[1].Value)-1] ^= 0xff
+		_, err = NewInsertBinlogIterator(blobs, common.RowIDField, schemapb.DataType_Int64)
+		assert.ErrorIs(t, err, merr.ErrIoChecksumMismatch)
+		assert.ErrorContains(t, err, blobs[1].Key)
+	})
 }
 
 func TestMergeIterator(t *testing.T) {
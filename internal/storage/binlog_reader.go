@@ -25,6 +25,7 @@ import (
 	"github.com/cockroachdb/errors"
 
 	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
 // BinlogReader is an object to read binlog file. Binlog file's format can be
@@ -35,6 +36,9 @@ type BinlogReader struct {
 	buffer      *bytes.Buffer
 	eventReader *EventReader
 	isClose     bool
+	// checksums of events recorded in descriptor, nil for the binlogs written without checksums
+	checksums  []uint32
+	eventIndex int
 }
 
 // NextEventReader iters all events reader to read the binlog file.
@@ -53,13 +57,72 @@ func (reader *BinlogReader) NextEventReader() (*EventReader, error) {
 	if err != nil {
 		return nil, err
 	}
-	reader.eventReader, err = newEventReader(reader.descriptorEvent.PayloadDataType, reader.buffer, nullable)
+	checksum, err := reader.nextChecksum()
 	if err != nil {
 		return nil, err
 	}
+	reader.eventReader, err = newEventReader(reader.descriptorEvent.PayloadDataType, reader.buffer, nullable, checksum)
+	if err != nil {
+		return nil, reader.wrapEventError(err)
+	}
+	reader.eventIndex++
 	return reader.eventReader, nil
 }
 
+// nextChecksum returns the checksum of next event, nil if the binlog has no checksums.
+func (reader *BinlogReader) nextChecksum() (*uint32, error) {
+	if reader.checksums == nil {
+		return nil, nil
+	}
+	if reader.eventIndex >= len(reader.checksums) {
+		return nil, reader.wrapEventError(merr.WrapErrIoFailedReason(
+			fmt.Sprintf("binlog has more events than the %d checksums recorded", len(reader.checksums))))
+	}
+	return &reader.checksums[reader.eventIndex], nil
+}
+
+// wrapEventError adds the position of current event to @err, so that the corrupted binlog could be located.
+func (reader *BinlogReader) wrapEventError(err error) error {
+	return errors.Wrapf(err, "failed to read event %d of binlog, collectionID=%d, partitionID=%d, segmentID=%d, fieldID=%d",
+		reader.eventIndex, reader.CollectionID, reader.PartitionID, reader.SegmentID, reader.FieldID)
+}
+
+// VerifyBinlog checks the checksums of all events in binlog without decoding the payloads,
+// the binlogs written without checksums are only checked for the integrity of event lengths.
+func VerifyBinlog(data []byte) error {
+	reader, err := NewBinlogReader(data)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	for ; reader.buffer.Len() > 0; reader.eventIndex++ {
+		header, err := readEventHeader(reader.buffer)
+		if err != nil {
+			return reader.wrapEventError(err)
+		}
+		checksum, err := reader.nextChecksum()
+		if err != nil {
+			return err
+		}
+		if checksum != nil {
+			if err := verifyEventChecksum(header, reader.buffer, *checksum); err != nil {
+				return reader.wrapEventError(err)
+			}
+		}
+		size := int(header.EventLength - header.GetMemoryUsageInBytes())
+		if size < 0 || size > reader.buffer.Len() {
+			return reader.wrapEventError(merr.WrapErrIoUnexpectEOF(header.TypeCode.String(),
+				fmt.Errorf("event length %d exceeds the remaining %d bytes", header.EventLength, reader.buffer.Len())))
+		}
+		reader.buffer.Next(size)
+	}
+	if reader.checksums != nil && reader.eventIndex != len(reader.checksums) {
+		return merr.WrapErrIoFailedReason(fmt.Sprintf("binlog has %d events, but %d checksums recorded", reader.eventIndex, len(reader.checksums)))
+	}
+	return nil
+}
+
 func (reader *BinlogReader) readMagicNumber() (int32, error) {
 	var err error
 	reader.magicNumber, err = readMagicNumber(reader.buffer)
@@ -128,5 +191,10 @@ func NewBinlogReader(data []byte) (*BinlogReader, error) {
 	if _, err := reader.readDescriptorEvent(); err != nil {
 		return nil, err
 	}
+	checksums, err := reader.descriptorEvent.GetEventChecksums()
+	if err != nil {
+		return nil, err
+	}
+	reader.checksums = checksums
 	return reader, nil
 }
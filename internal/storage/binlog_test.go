@@ -21,6 +21,7 @@ import (
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
+	"math"
 	"testing"
 	"time"
 	"unsafe"
@@ -30,6 +31,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 	"github.com/milvus-io/milvus/pkg/util/uniquegenerator"
@@ -1512,6 +1514,10 @@ func (e *testEvent) ReleasePayloadWriter() {
 func (e *testEvent) SetOffset(offset int32) {
 }
 
+func (e *testEvent) Checksum() (uint32, error) {
+	return 0, nil
+}
+
 var _ EventWriter = (*testEvent)(nil)
 
 func TestWriterListError(t *testing.T) {
@@ -1538,3 +1544,95 @@ func TestWriterListError(t *testing.T) {
 	err = insertWriter.Finish()
 	assert.Error(t, err)
 }
+
+func TestBinlogChecksum(t *testing.T) {
+	w := NewInsertBinlogWriter(schemapb.DataType_Int64, 10, 20, 30, 40, false)
+	for i := 0; i < 2; i++ {
+		e, err := w.NextInsertEventWriter(false)
+		assert.NoError(t, err)
+		err = e.AddDataToPayload([]int64{1, 2, 3}, nil)
+		assert.NoError(t, err)
+		e.SetEventTimestamp(100, 200)
+	}
+	w.SetEventTimeStamp(1000, 2000)
+	w.AddExtra(originalSizeKey, "100")
+	err := w.Finish()
+	assert.NoError(t, err)
+	buf, err := w.GetBuffer()
+	assert.NoError(t, err)
+	defer w.Close()
+
+	readEvents := func(data []byte) error {
+		r, err := NewBinlogReader(data)
+		if err != nil {
+			return err
+		}
+		defer r.Close()
+		for {
+			event, err := r.NextEventReader()
+			if err != nil {
+				return err
+			}
+			if event == nil {
+				return nil
+			}
+		}
+	}
+
+	t.Run("verified", func(t *testing.T) {
+		r, err := NewBinlogReader(buf)
+		assert.NoError(t, err)
+		assert.Len(t, r.checksums, 2)
+		r.Close()
+		assert.NoError(t, readEvents(buf))
+		assert.NoError(t, VerifyBinlog(buf))
+	})
+
+	t.Run("corrupted", func(t *testing.T) {
+		corrupted := bytes.Clone(buf)
+		corrupted[len(corrupted)-1] ^= 0xff
+		err := readEvents(corrupted)
+		assert.ErrorIs(t, err, merr.ErrIoChecksumMismatch)
+		assert.ErrorContains(t, err, "event 1")
+		assert.ErrorContains(t, err, "segmentID=30")
+		assert.ErrorIs(t, VerifyBinlog(corrupted), merr.ErrIoChecksumMismatch)
+
+		// truncated binlog
+		assert.Error(t, VerifyBinlog(buf[:len(buf)-1]))
+	})
+
+	t.Run("without checksums", func(t *testing.T) {
+		// rewrite the descriptor event without checksums, as the binlogs written by previous versions
+		r, err := NewBinlogReader(buf)
+		assert.NoError(t, err)
+		events := bytes.Clone(r.buffer.Bytes())
+		delete(r.descriptorEvent.Extras, eventChecksumsKey)
+		legacy := new(bytes.Buffer)
+		assert.NoError(t, binary.Write(legacy, common.Endian, MagicNumber))
+		assert.NoError(t, r.descriptorEvent.Write(legacy))
+		legacy.Write(events)
+		r.Close()
+
+		r, err = NewBinlogReader(legacy.Bytes())
+		assert.NoError(t, err)
+		assert.Nil(t, r.checksums)
+		r.Close()
+		assert.NoError(t, readEvents(legacy.Bytes()))
+		assert.NoError(t, VerifyBinlog(legacy.Bytes()))
+	})
+
+	t.Run("invalid checksums", func(t *testing.T) {
+		data := newDescriptorEventData()
+		data.AddExtra(originalSizeKey, "100")
+		data.AddExtra(eventChecksumsKey, "invalid")
+		assert.Error(t, data.FinishExtra())
+
+		data.Extras[eventChecksumsKey] = []interface{}{float64(1), float64(-1)}
+		_, err := data.GetEventChecksums()
+		assert.Error(t, err)
+		data.Extras[eventChecksumsKey] = []interface{}{float64(1), float64(math.MaxUint32)}
+		checksums, err := data.GetEventChecksums()
+		assert.NoError(t, err)
+		assert.Equal(t, []uint32{1, math.MaxUint32}, checksums)
+	})
+}
@@ -106,6 +106,21 @@ func (writer *baseBinlogWriter) Finish() error {
 
 	var offset int32
 	writer.buffer = new(bytes.Buffer)
+
+	// finish the events first, since their checksums are recorded in the descriptor event
+	checksums := make([]uint32, 0, len(writer.eventWriters))
+	for _, w := range writer.eventWriters {
+		if err := w.Finish(); err != nil {
+			return err
+		}
+		checksum, err := w.Checksum()
+		if err != nil {
+			return err
+		}
+		checksums = append(checksums, checksum)
+	}
+	writer.descriptorEventData.AddExtra(eventChecksumsKey, checksums)
+
 	if err := binary.Write(writer.buffer, common.Endian, MagicNumber); err != nil {
 		return err
 	}
@@ -118,9 +133,6 @@ func (writer *baseBinlogWriter) Finish() error {
 	writer.length = 0
 	for _, w := range writer.eventWriters {
 		w.SetOffset(offset)
-		if err := w.Finish(); err != nil {
-			return err
-		}
 		if err := w.Write(writer.buffer); err != nil {
 			return err
 		}
@@ -0,0 +1,517 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"container/list"
+	"context"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/lock"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+const (
+	// cacheFileHeaderSize is the size of the header of cached file, which is the crc32 checksum
+	// followed by the size of the object.
+	cacheFileHeaderSize = 4 + 8
+	cacheTmpFileSuffix  = ".tmp"
+)
+
+// cacheEntry is an object cached on local disk.
+type cacheEntry struct {
+	key      string
+	size     int64
+	checksum uint32
+	// verified is false for the entries found on disk on startup, the content is checked against
+	// the checksum on first reuse. The entries filled by the process are verified when written.
+	verified bool
+}
+
+// CachedChunkManager is a read-through cache of ChunkManager, it caches the immutable objects
+// (binlogs and index files) on local disk, so the objects won't be downloaded again after restart.
+// The cache is bounded by capacity in bytes, and the least recently used objects are evicted first.
+type CachedChunkManager struct {
+	ChunkManager
+
+	cachePath string
+	capacity  int64
+
+	mu         sync.Mutex
+	entries    map[string]*list.Element
+	accessList *list.List
+	size       int64
+
+	loadLocks *lock.KeyLock[string]
+}
+
+var _ ChunkManager = (*CachedChunkManager)(nil)
+
+// NewCachedChunkManager creates a CachedChunkManager on top of @cm, the objects found in @cachePath are reused.
+func NewCachedChunkManager(cm ChunkManager, cachePath string, capacity int64) (*CachedChunkManager, error) {
+	if err := os.MkdirAll(cachePath, os.ModePerm); err != nil {
+		return nil, merr.WrapErrIoFailed(cachePath, err)
+	}
+	ccm := &CachedChunkManager{
+		ChunkManager: cm,
+		cachePath:    cachePath,
+		capacity:     capacity,
+		entries:      make(map[string]*list.Element),
+		accessList:   list.New(),
+		loadLocks:    lock.NewKeyLock[string](),
+	}
+	if err := ccm.recover(); err != nil {
+		return nil, err
+	}
+	log.Info("cached chunk manager init success.",
+		zap.String("cachePath", cachePath),
+		zap.Int64("capacity", capacity),
+		zap.Int("recoveredNum", len(ccm.entries)),
+		zap.Int64("recoveredSize", ccm.size))
+	return ccm, nil
+}
+
+type sharedCachedChunkManager struct {
+	ccm *CachedChunkManager
+	// the object storage cached, the objects of different storages can't share the cache path
+	// as the files are named by the object paths
+	storage string
+}
+
+var (
+	sharedCachesMu sync.Mutex
+	// the cached chunk managers of the process by cache path
+	sharedCaches = make(map[string]*sharedCachedChunkManager)
+)
+
+// getSharedCachedChunkManager returns the CachedChunkManager of the process caching the objects of @storage
+// in @cachePath, it's created on top of the chunk manager created by @newChunkManager for the first call.
+// The chunk managers caching in the same path must share one instance, otherwise they evict the files of
+// each other and exceed the capacity together.
+func getSharedCachedChunkManager(storage string, cachePath string, capacity int64,
+	newChunkManager func() (ChunkManager, error),
+) (*CachedChunkManager, error) {
+	sharedCachesMu.Lock()
+	defer sharedCachesMu.Unlock()
+	if shared, ok := sharedCaches[cachePath]; ok {
+		if shared.storage != storage {
+			return nil, merr.WrapErrParameterInvalidMsg("read cache path %s is used by storage %s, can't cache storage %s",
+				cachePath, shared.storage, storage)
+		}
+		return shared.ccm, nil
+	}
+	cm, err := newChunkManager()
+	if err != nil {
+		return nil, err
+	}
+	ccm, err := NewCachedChunkManager(cm, cachePath, capacity)
+	if err != nil {
+		return nil, err
+	}
+	sharedCaches[cachePath] = &sharedCachedChunkManager{ccm: ccm, storage: storage}
+	return ccm, nil
+}
+
+// recover loads the cached objects from local disk, the older ones are evicted if exceeding capacity.
+func (ccm *CachedChunkManager) recover() error {
+	type recovered struct {
+		entry   *cacheEntry
+		modTime time.Time
+	}
+	files := make([]recovered, 0)
+	err := filepath.WalkDir(ccm.cachePath, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		if strings.HasSuffix(filePath, cacheTmpFileSuffix) {
+			// the file was being written when crashed
+			os.Remove(filePath)
+			return nil
+		}
+		key, err := filepath.Rel(ccm.cachePath, filePath)
+		if err != nil {
+			return err
+		}
+		key = filepath.ToSlash(key)
+		entry, err := readCacheFileHeader(filePath)
+		if err != nil {
+			log.Warn("remove broken cache file", zap.String("path", filePath), zap.Error(err))
+			os.Remove(filePath)
+			return nil
+		}
+		entry.key = key
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		files = append(files, recovered{entry: entry, modTime: info.ModTime()})
+		return nil
+	})
+	if err != nil {
+		return merr.WrapErrIoFailed(ccm.cachePath, err)
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].modTime.Before(files[j].modTime)
+	})
+	ccm.mu.Lock()
+	defer ccm.mu.Unlock()
+	for _, file := range files {
+		ccm.addLocked(file.entry)
+	}
+	return nil
+}
+
+// Write writes the object to underlying chunk manager, the stale cache is dropped.
+func (ccm *CachedChunkManager) Write(ctx context.Context, filePath string, content []byte) error {
+	defer ccm.evict(filePath)
+	return ccm.ChunkManager.Write(ctx, filePath, content)
+}
+
+// MultiWrite writes the objects to underlying chunk manager, the stale caches are dropped.
+func (ccm *CachedChunkManager) MultiWrite(ctx context.Context, contents map[string][]byte) error {
+	defer func() {
+		for filePath := range contents {
+			ccm.evict(filePath)
+		}
+	}()
+	return ccm.ChunkManager.MultiWrite(ctx, contents)
+}
+
+// Read reads the object from local cache, and downloads it on cache miss.
+func (ccm *CachedChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
+	if !isImmutableObject(filePath) {
+		return ccm.ChunkManager.Read(ctx, filePath)
+	}
+
+	if data, ok := ccm.readCache(filePath); ok {
+		metrics.PersistentDataCacheAccessCounter.WithLabelValues(metrics.CacheHitLabel).Inc()
+		return data, nil
+	}
+
+	// avoid downloading the same object concurrently
+	ccm.loadLocks.Lock(filePath)
+	defer ccm.loadLocks.Unlock(filePath)
+	if data, ok := ccm.readCache(filePath); ok {
+		metrics.PersistentDataCacheAccessCounter.WithLabelValues(metrics.CacheHitLabel).Inc()
+		return data, nil
+	}
+
+	metrics.PersistentDataCacheAccessCounter.WithLabelValues(metrics.CacheMissLabel).Inc()
+	data, err := ccm.ChunkManager.Read(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	if err := ccm.writeCache(filePath, data); err != nil {
+		// failed to cache won't fail the read
+		log.Warn("failed to write local cache", zap.String("path", filePath), zap.Error(err))
+	}
+	return data, nil
+}
+
+// MultiRead reads the objects through local cache.
+func (ccm *CachedChunkManager) MultiRead(ctx context.Context, filePaths []string) ([][]byte, error) {
+	var el error
+	var objectsValues [][]byte
+	for _, filePath := range filePaths {
+		objectValue, err := ccm.Read(ctx, filePath)
+		if err != nil {
+			el = merr.Combine(el, errors.Wrapf(err, "failed to read %s", filePath))
+		}
+		objectsValues = append(objectsValues, objectValue)
+	}
+	return objectsValues, el
+}
+
+// ReadAt reads specific position of the object from local cache if it's cached,
+// partial reads won't fill the cache since the object may be very large.
+func (ccm *CachedChunkManager) ReadAt(ctx context.Context, filePath string, off int64, length int64) ([]byte, error) {
+	if off < 0 || length < 0 {
+		return nil, io.EOF
+	}
+	if isImmutableObject(filePath) {
+		if data, ok := ccm.readCacheAt(filePath, off, length); ok {
+			metrics.PersistentDataCacheAccessCounter.WithLabelValues(metrics.CacheHitLabel).Inc()
+			return data, nil
+		}
+		metrics.PersistentDataCacheAccessCounter.WithLabelValues(metrics.CacheMissLabel).Inc()
+	}
+	return ccm.ChunkManager.ReadAt(ctx, filePath, off, length)
+}
+
+// Remove deletes the object and its local cache.
+func (ccm *CachedChunkManager) Remove(ctx context.Context, filePath string) error {
+	ccm.evict(filePath)
+	return ccm.ChunkManager.Remove(ctx, filePath)
+}
+
+// MultiRemove deletes the objects and their local caches.
+func (ccm *CachedChunkManager) MultiRemove(ctx context.Context, filePaths []string) error {
+	for _, filePath := range filePaths {
+		ccm.evict(filePath)
+	}
+	return ccm.ChunkManager.MultiRemove(ctx, filePaths)
+}
+
+// RemoveWithPrefix deletes the objects with @prefix and their local caches.
+func (ccm *CachedChunkManager) RemoveWithPrefix(ctx context.Context, prefix string) error {
+	ccm.mu.Lock()
+	keys := make([]string, 0)
+	for key := range ccm.entries {
+		if strings.HasPrefix(key, cacheKey(prefix)) {
+			keys = append(keys, key)
+		}
+	}
+	ccm.mu.Unlock()
+	for _, key := range keys {
+		ccm.evict(key)
+	}
+	return ccm.ChunkManager.RemoveWithPrefix(ctx, prefix)
+}
+
+// readCache reads the whole object from local cache, the broken cache file is evicted.
+// The checksum is computed only for the entries not verified yet.
+func (ccm *CachedChunkManager) readCache(filePath string) ([]byte, bool) {
+	entry, ok := ccm.get(filePath)
+	if !ok {
+		return nil, false
+	}
+	verified := ccm.isVerified(entry)
+	content, err := ReadFile(ccm.localPath(filePath))
+	if err == nil {
+		err = verifyCacheFile(entry, content, !verified)
+	}
+	if err != nil {
+		log.Warn("local cache is broken, evict it", zap.String("path", filePath), zap.Error(err))
+		ccm.evict(filePath)
+		return nil, false
+	}
+	if !verified {
+		ccm.markVerified(entry)
+	}
+	return content[cacheFileHeaderSize:], true
+}
+
+// readCacheAt reads specific position of the object from local cache.
+func (ccm *CachedChunkManager) readCacheAt(filePath string, off int64, length int64) ([]byte, bool) {
+	entry, ok := ccm.get(filePath)
+	if !ok {
+		return nil, false
+	}
+	if !ccm.isVerified(entry) {
+		// verify the whole file on first reuse
+		_, ok := ccm.readCache(filePath)
+		if !ok {
+			return nil, false
+		}
+	}
+	if off+length > entry.size {
+		return nil, false
+	}
+
+	file, err := Open(ccm.localPath(filePath))
+	if err != nil {
+		ccm.evict(filePath)
+		return nil, false
+	}
+	defer file.Close()
+	data := make([]byte, length)
+	if _, err := file.ReadAt(data, cacheFileHeaderSize+off); err != nil {
+		log.Warn("failed to read local cache, evict it", zap.String("path", filePath), zap.Error(err))
+		ccm.evict(filePath)
+		return nil, false
+	}
+	return data, true
+}
+
+// writeCache writes the object to local cache, the object larger than capacity is skipped.
+func (ccm *CachedChunkManager) writeCache(filePath string, data []byte) error {
+	if int64(len(data)) > ccm.capacity {
+		return nil
+	}
+	entry := &cacheEntry{
+		key:      cacheKey(filePath),
+		size:     int64(len(data)),
+		checksum: crc32.Checksum(data, castagnoliTable),
+		verified: true,
+	}
+	localPath := ccm.localPath(filePath)
+	if err := os.MkdirAll(path.Dir(localPath), os.ModePerm); err != nil {
+		return err
+	}
+	// write to a temp file and rename it, so that a crash won't leave a partial file.
+	tmpPath := localPath + cacheTmpFileSuffix
+	content := make([]byte, cacheFileHeaderSize+len(data))
+	binary.LittleEndian.PutUint32(content, entry.checksum)
+	binary.LittleEndian.PutUint64(content[4:], uint64(entry.size))
+	copy(content[cacheFileHeaderSize:], data)
+	if err := WriteFile(tmpPath, content, 0o600); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := os.Rename(tmpPath, localPath); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+
+	ccm.mu.Lock()
+	defer ccm.mu.Unlock()
+	ccm.addLocked(entry)
+	return nil
+}
+
+func (ccm *CachedChunkManager) get(filePath string) (*cacheEntry, bool) {
+	ccm.mu.Lock()
+	defer ccm.mu.Unlock()
+	e, ok := ccm.entries[cacheKey(filePath)]
+	if !ok {
+		return nil, false
+	}
+	ccm.accessList.MoveToFront(e)
+	return e.Value.(*cacheEntry), true
+}
+
+func (ccm *CachedChunkManager) isVerified(entry *cacheEntry) bool {
+	ccm.mu.Lock()
+	defer ccm.mu.Unlock()
+	return entry.verified
+}
+
+func (ccm *CachedChunkManager) markVerified(entry *cacheEntry) {
+	ccm.mu.Lock()
+	defer ccm.mu.Unlock()
+	entry.verified = true
+}
+
+// addLocked adds the entry as the most recently used one, and evicts the least recently used
+// entries until the cache size is within capacity.
+func (ccm *CachedChunkManager) addLocked(entry *cacheEntry) {
+	if e, ok := ccm.entries[entry.key]; ok {
+		ccm.size -= e.Value.(*cacheEntry).size
+		ccm.accessList.Remove(e)
+	}
+	ccm.entries[entry.key] = ccm.accessList.PushFront(entry)
+	ccm.size += entry.size
+
+	for ccm.size > ccm.capacity {
+		e := ccm.accessList.Back()
+		if e == nil || e.Value.(*cacheEntry) == entry {
+			break
+		}
+		ccm.evictLocked(e.Value.(*cacheEntry).key)
+	}
+	metrics.PersistentDataCacheSize.Set(float64(ccm.size))
+}
+
+func (ccm *CachedChunkManager) evict(filePath string) {
+	ccm.mu.Lock()
+	defer ccm.mu.Unlock()
+	ccm.evictLocked(filePath)
+	metrics.PersistentDataCacheSize.Set(float64(ccm.size))
+}
+
+func (ccm *CachedChunkManager) evictLocked(filePath string) {
+	key := cacheKey(filePath)
+	e, ok := ccm.entries[key]
+	if !ok {
+		return
+	}
+	delete(ccm.entries, key)
+	ccm.accessList.Remove(e)
+	ccm.size -= e.Value.(*cacheEntry).size
+	if err := os.Remove(ccm.localPath(filePath)); err != nil && !os.IsNotExist(err) {
+		log.Warn("failed to remove local cache file", zap.String("path", filePath), zap.Error(err))
+	}
+	metrics.PersistentDataCacheEvictCounter.Inc()
+}
+
+func (ccm *CachedChunkManager) localPath(filePath string) string {
+	return path.Join(ccm.cachePath, filePath)
+}
+
+// cacheKey returns the key of object in cache, which is the relative path under cache root.
+func cacheKey(filePath string) string {
+	return strings.TrimPrefix(filePath, "/")
+}
+
+// isImmutableObject returns true if the object is binlog or index file, which is never modified after written.
+func isImmutableObject(filePath string) bool {
+	for _, dir := range strings.Split(path.Dir(filePath), "/") {
+		switch dir {
+		case common.SegmentInsertLogPath, common.SegmentDeltaLogPath, common.SegmentStatslogPath, common.SegmentIndexPath:
+			return true
+		}
+	}
+	return false
+}
+
+// readCacheFileHeader reads the checksum and size of cached file, and checks whether the file is complete.
+func readCacheFileHeader(filePath string) (*cacheEntry, error) {
+	file, err := Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	header := make([]byte, cacheFileHeaderSize)
+	if _, err := io.ReadFull(file, header); err != nil {
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	entry := &cacheEntry{
+		checksum: binary.LittleEndian.Uint32(header),
+		size:     int64(binary.LittleEndian.Uint64(header[4:])),
+	}
+	if info.Size() != cacheFileHeaderSize+entry.size {
+		return nil, errors.Newf("cache file size mismatch, expected %d, actual %d", cacheFileHeaderSize+entry.size, info.Size())
+	}
+	return entry, nil
+}
+
+// verifyCacheFile checks the content of cached file with the size and checksum recorded,
+// the checksum of the content is computed only if @checksum is true.
+func verifyCacheFile(entry *cacheEntry, content []byte, checksum bool) error {
+	if int64(len(content)) != cacheFileHeaderSize+entry.size {
+		return errors.Newf("cache file size mismatch, expected %d, actual %d", cacheFileHeaderSize+entry.size, len(content))
+	}
+	if binary.LittleEndian.Uint32(content) != entry.checksum ||
+		(checksum && crc32.Checksum(content[cacheFileHeaderSize:], castagnoliTable) != entry.checksum) {
+		return errors.New("cache file checksum mismatch")
+	}
+	return nil
+}
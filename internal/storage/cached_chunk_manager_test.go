@@ -0,0 +1,190 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// countingChunkManager counts the reads which reach the underlying chunk manager.
+type countingChunkManager struct {
+	ChunkManager
+	readCount   atomic.Int32
+	readAtCount atomic.Int32
+}
+
+func (cm *countingChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
+	cm.readCount.Inc()
+	return cm.ChunkManager.Read(ctx, filePath)
+}
+
+func (cm *countingChunkManager) ReadAt(ctx context.Context, filePath string, off int64, length int64) ([]byte, error) {
+	cm.readAtCount.Inc()
+	return cm.ChunkManager.ReadAt(ctx, filePath, off, length)
+}
+
+func TestCachedChunkManager(t *testing.T) {
+	ctx := context.Background()
+	remotePath := t.TempDir()
+	cachePath := t.TempDir()
+
+	underlying := &countingChunkManager{ChunkManager: NewLocalChunkManager(RootPath(remotePath))}
+	insertLog := path.Join(remotePath, "insert_log", "1", "2", "3", "100", "1")
+	indexFile := path.Join(remotePath, "index_files", "1", "1", "2", "3", "HNSW_1")
+	metaFile := path.Join(remotePath, "meta", "1")
+	require.NoError(t, underlying.Write(ctx, insertLog, []byte("insert_log_data")))
+	require.NoError(t, underlying.Write(ctx, indexFile, []byte("index_file_data")))
+	require.NoError(t, underlying.Write(ctx, metaFile, []byte("meta_data")))
+
+	ccm, err := NewCachedChunkManager(underlying, cachePath, 20)
+	require.NoError(t, err)
+
+	t.Run("read through", func(t *testing.T) {
+		underlying.readCount.Store(0)
+		data, err := ccm.Read(ctx, insertLog)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("insert_log_data"), data)
+		assert.EqualValues(t, 1, underlying.readCount.Load())
+
+		// hit local cache, the entry filled is verified already
+		entry, ok := ccm.get(insertLog)
+		require.True(t, ok)
+		assert.True(t, ccm.isVerified(entry))
+		data, err = ccm.Read(ctx, insertLog)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("insert_log_data"), data)
+		assert.EqualValues(t, 1, underlying.readCount.Load())
+
+		data, err = ccm.ReadAt(ctx, insertLog, 7, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("log"), data)
+		assert.EqualValues(t, 0, underlying.readAtCount.Load())
+
+		// mutable objects are not cached
+		for i := 0; i < 2; i++ {
+			data, err = ccm.Read(ctx, metaFile)
+			assert.NoError(t, err)
+			assert.Equal(t, []byte("meta_data"), data)
+		}
+		assert.EqualValues(t, 3, underlying.readCount.Load())
+	})
+
+	t.Run("lru eviction", func(t *testing.T) {
+		underlying.readCount.Store(0)
+		values, err := ccm.MultiRead(ctx, []string{indexFile})
+		assert.NoError(t, err)
+		assert.Equal(t, [][]byte{[]byte("index_file_data")}, values)
+		assert.EqualValues(t, 1, underlying.readCount.Load())
+
+		// capacity is 20 bytes, the insert log is evicted since it's least recently used
+		_, ok := ccm.get(insertLog)
+		assert.False(t, ok)
+		_, err = os.Stat(ccm.localPath(insertLog))
+		assert.True(t, os.IsNotExist(err))
+		assert.EqualValues(t, len("index_file_data"), ccm.size)
+	})
+
+	t.Run("recover and verify", func(t *testing.T) {
+		underlying.readCount.Store(0)
+		recovered, err := NewCachedChunkManager(underlying, cachePath, 20)
+		require.NoError(t, err)
+		data, err := recovered.Read(ctx, indexFile)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("index_file_data"), data)
+		assert.EqualValues(t, 0, underlying.readCount.Load())
+
+		// corrupt the cached file, the broken cache should be dropped and downloaded again
+		content, err := os.ReadFile(recovered.localPath(indexFile))
+		require.NoError(t, err)
+		content[len(content)-1] = 'x'
+		require.NoError(t, os.WriteFile(recovered.localPath(indexFile), content, 0o600))
+		recovered, err = NewCachedChunkManager(underlying, cachePath, 20)
+		require.NoError(t, err)
+		data, err = recovered.ReadAt(ctx, indexFile, 0, 5)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("index"), data)
+		assert.EqualValues(t, 1, underlying.readAtCount.Load())
+
+		// broken file which is too short
+		require.NoError(t, os.WriteFile(recovered.localPath(insertLog), []byte("short"), 0o600))
+		recovered, err = NewCachedChunkManager(underlying, cachePath, 20)
+		require.NoError(t, err)
+		_, ok := recovered.get(insertLog)
+		assert.False(t, ok)
+	})
+
+	t.Run("invalidate", func(t *testing.T) {
+		_, err := ccm.Read(ctx, insertLog)
+		assert.NoError(t, err)
+		assert.NoError(t, ccm.Write(ctx, insertLog, []byte("new_data")))
+		_, ok := ccm.get(insertLog)
+		assert.False(t, ok)
+		data, err := ccm.Read(ctx, insertLog)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("new_data"), data)
+
+		assert.NoError(t, ccm.Remove(ctx, insertLog))
+		_, ok = ccm.get(insertLog)
+		assert.False(t, ok)
+		_, err = ccm.Read(ctx, insertLog)
+		assert.Error(t, err)
+
+		_, err = ccm.Read(ctx, indexFile)
+		assert.NoError(t, err)
+		assert.NoError(t, ccm.RemoveWithPrefix(ctx, path.Join(remotePath, "index_files")))
+		_, ok = ccm.get(indexFile)
+		assert.False(t, ok)
+	})
+}
+
+func TestGetSharedCachedChunkManager(t *testing.T) {
+	cachePath := t.TempDir()
+	cm := NewLocalChunkManager(RootPath(t.TempDir()))
+
+	var created int
+	newChunkManager := func() (ChunkManager, error) {
+		created++
+		return cm, nil
+	}
+
+	ccm1, err := getSharedCachedChunkManager("minio/a-bucket/files", cachePath, 20, newChunkManager)
+	require.NoError(t, err)
+	ccm2, err := getSharedCachedChunkManager("minio/a-bucket/files", cachePath, 20, newChunkManager)
+	require.NoError(t, err)
+	assert.Same(t, ccm1, ccm2)
+	// the chunk manager is not created again for the shared cache
+	assert.Equal(t, 1, created)
+
+	ccm3, err := getSharedCachedChunkManager("minio/a-bucket/files", path.Join(cachePath, "querynode"), 20, newChunkManager)
+	require.NoError(t, err)
+	assert.NotSame(t, ccm1, ccm3)
+	assert.Equal(t, 2, created)
+
+	// the cache path can't be shared by different storages
+	_, err = getSharedCachedChunkManager("minio/b-bucket/files", cachePath, 20, newChunkManager)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	assert.Equal(t, 2, created)
+}
@@ -17,10 +17,13 @@
 package storage
 
 import (
+	"bytes"
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
+	"hash/crc32"
 	"io"
+	"math"
 	"strconv"
 
 	"github.com/cockroachdb/errors"
@@ -34,6 +37,9 @@ import (
 const (
 	originalSizeKey = "original_size"
 	nullableKey     = "nullable"
+	// eventChecksumsKey stores the CRC32C checksums of events in order,
+	// the binlogs written by previous versions don't have it.
+	eventChecksumsKey = "event_checksums"
 )
 
 const version = "version"
@@ -85,6 +91,31 @@ func (data *descriptorEventData) GetNullable() (bool, error) {
 	return nullable, nil
 }
 
+// GetEventChecksums returns the checksums of events, nil if the binlog is written without checksums.
+func (data *descriptorEventData) GetEventChecksums() ([]uint32, error) {
+	stored, ok := data.Extras[eventChecksumsKey]
+	if !ok {
+		return nil, nil
+	}
+	switch values := stored.(type) {
+	case []uint32:
+		return values, nil
+	case []interface{}:
+		// the numbers are unmarshalled as float64 from json
+		checksums := make([]uint32, 0, len(values))
+		for _, v := range values {
+			f, ok := v.(float64)
+			if !ok || f < 0 || f > math.MaxUint32 || f != math.Trunc(f) {
+				return nil, merr.WrapErrParameterInvalidMsg(fmt.Sprintf("invalid event checksum %v", v))
+			}
+			checksums = append(checksums, uint32(f))
+		}
+		return checksums, nil
+	default:
+		return nil, merr.WrapErrParameterInvalidMsg(fmt.Sprintf("value of %v must be a list of checksums", eventChecksumsKey))
+	}
+}
+
 // GetMemoryUsageInBytes returns the memory size of DescriptorEventDataFixPart.
 func (data *descriptorEventData) GetMemoryUsageInBytes() int32 {
 	return data.GetEventDataFixPartSize() + int32(binary.Size(data.PostHeaderLengths)) + int32(binary.Size(data.ExtraLength)) + data.ExtraLength
@@ -124,6 +155,10 @@ func (data *descriptorEventData) FinishExtra() error {
 		}
 	}
 
+	if _, err := data.GetEventChecksums(); err != nil {
+		return err
+	}
+
 	data.ExtraBytes, err = json.Marshal(data.Extras)
 	if err != nil {
 		return err
@@ -178,6 +213,19 @@ type eventData interface {
 	WriteEventData(buffer io.Writer) error
 }
 
+var castagnoliTable = crc32.MakeTable(crc32.Castagnoli)
+
+// eventDataChecksum returns the CRC32C checksum of event data fix part and payload,
+// the event header is excluded since the position in it is unknown until the descriptor event is written.
+func eventDataChecksum(writeEventData func(buffer io.Writer) error, payload []byte) (uint32, error) {
+	buffer := new(bytes.Buffer)
+	if err := writeEventData(buffer); err != nil {
+		return 0, err
+	}
+	checksum := crc32.Checksum(buffer.Bytes(), castagnoliTable)
+	return crc32.Update(checksum, castagnoliTable, payload), nil
+}
+
 // all event types' fixed part only have start Timestamp and end Timestamp yet, but maybe different events will
 // have different fields later, so we just create an event data struct per event type.
 type insertEventData struct {
@@ -19,8 +19,11 @@ package storage
 import (
 	"bytes"
 	"fmt"
+	"hash/crc32"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
 // EventReader is used to parse the events contained in the Binlog file.
@@ -85,7 +88,24 @@ func (reader *EventReader) Close() {
 	}
 }
 
-func newEventReader(datatype schemapb.DataType, buffer *bytes.Buffer, nullable bool) (*EventReader, error) {
+// verifyEventChecksum checks the event data and payload following @header in @buffer against @expected,
+// the buffer is not consumed.
+func verifyEventChecksum(header *eventHeader, buffer *bytes.Buffer, expected uint32) error {
+	size := int(header.EventLength - header.GetMemoryUsageInBytes())
+	if size < 0 || size > buffer.Len() {
+		return merr.WrapErrIoUnexpectEOF(header.TypeCode.String(),
+			fmt.Errorf("event length %d exceeds the remaining %d bytes", header.EventLength, buffer.Len()))
+	}
+	actual := crc32.Checksum(buffer.Bytes()[:size], castagnoliTable)
+	if actual != expected {
+		metrics.PersistentDataChecksumMismatchCounter.WithLabelValues(header.TypeCode.String()).Inc()
+		return merr.WrapErrIoChecksumMismatch(header.TypeCode.String(), expected, actual)
+	}
+	return nil
+}
+
+// newEventReader reads the next event from @buffer, the event is verified if @checksum is not nil.
+func newEventReader(datatype schemapb.DataType, buffer *bytes.Buffer, nullable bool, checksum *uint32) (*EventReader, error) {
 	reader := &EventReader{
 		eventHeader: eventHeader{
 			baseEventHeader{},
@@ -97,6 +117,11 @@ func newEventReader(datatype schemapb.DataType, buffer *bytes.Buffer, nullable b
 	if err := reader.readHeader(); err != nil {
 		return nil, err
 	}
+	if checksum != nil {
+		if err := verifyEventChecksum(&reader.eventHeader, buffer, *checksum); err != nil {
+			return nil, err
+		}
+	}
 	if err := reader.readData(); err != nil {
 		return nil, err
 	}
@@ -184,7 +184,7 @@ func TestInsertEvent(t *testing.T) {
 		assert.Equal(t, values, ev)
 		pR.Close()
 
-		r, err := newEventReader(dt, bytes.NewBuffer(wBuf), false)
+		r, err := newEventReader(dt, bytes.NewBuffer(wBuf), false, nil)
 		assert.NoError(t, err)
 		payload, nulls, _, err := r.GetDataFromPayload()
 		assert.NoError(t, err)
@@ -376,7 +376,7 @@ func TestInsertEvent(t *testing.T) {
 		assert.Equal(t, s[2], "abcdefg")
 		pR.Close()
 
-		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf), false)
+		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf), false, nil)
 		assert.NoError(t, err)
 
 		s, _, err = pR.GetStringFromPayload()
@@ -431,7 +431,7 @@ func TestDeleteEvent(t *testing.T) {
 
 		pR.Close()
 
-		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf), false)
+		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf), false, nil)
 		assert.NoError(t, err)
 
 		s, _, err = pR.GetStringFromPayload()
@@ -485,7 +485,7 @@ func TestCreateCollectionEvent(t *testing.T) {
 		assert.Equal(t, values, []int64{1, 2, 3, 4, 5, 6})
 		pR.Close()
 
-		r, err := newEventReader(schemapb.DataType_Int64, bytes.NewBuffer(wBuf), false)
+		r, err := newEventReader(schemapb.DataType_Int64, bytes.NewBuffer(wBuf), false, nil)
 		assert.NoError(t, err)
 		payload, _, _, err := r.GetDataFromPayload()
 		assert.NoError(t, err)
@@ -533,7 +533,7 @@ func TestCreateCollectionEvent(t *testing.T) {
 
 		pR.Close()
 
-		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf), true)
+		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf), true, nil)
 		assert.NoError(t, err)
 
 		s, _, err = pR.GetStringFromPayload()
@@ -587,7 +587,7 @@ func TestDropCollectionEvent(t *testing.T) {
 		assert.Equal(t, values, []int64{1, 2, 3, 4, 5, 6})
 		pR.Close()
 
-		r, err := newEventReader(schemapb.DataType_Int64, bytes.NewBuffer(wBuf), false)
+		r, err := newEventReader(schemapb.DataType_Int64, bytes.NewBuffer(wBuf), false, nil)
 		assert.NoError(t, err)
 		payload, _, _, err := r.GetDataFromPayload()
 		assert.NoError(t, err)
@@ -635,7 +635,7 @@ func TestDropCollectionEvent(t *testing.T) {
 
 		pR.Close()
 
-		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf), false)
+		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf), false, nil)
 		assert.NoError(t, err)
 
 		s, _, err = r.GetStringFromPayload()
@@ -689,7 +689,7 @@ func TestCreatePartitionEvent(t *testing.T) {
 		assert.Equal(t, values, []int64{1, 2, 3, 4, 5, 6})
 		pR.Close()
 
-		r, err := newEventReader(schemapb.DataType_Int64, bytes.NewBuffer(wBuf), false)
+		r, err := newEventReader(schemapb.DataType_Int64, bytes.NewBuffer(wBuf), false, nil)
 		assert.NoError(t, err)
 		payload, _, _, err := r.GetDataFromPayload()
 		assert.NoError(t, err)
@@ -737,7 +737,7 @@ func TestCreatePartitionEvent(t *testing.T) {
 
 		pR.Close()
 
-		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf), false)
+		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf), false, nil)
 		assert.NoError(t, err)
 
 		s, _, err = pR.GetStringFromPayload()
@@ -791,7 +791,7 @@ func TestDropPartitionEvent(t *testing.T) {
 		assert.Equal(t, values, []int64{1, 2, 3, 4, 5, 6})
 		pR.Close()
 
-		r, err := newEventReader(schemapb.DataType_Int64, bytes.NewBuffer(wBuf), false)
+		r, err := newEventReader(schemapb.DataType_Int64, bytes.NewBuffer(wBuf), false, nil)
 		assert.NoError(t, err)
 		payload, _, _, err := r.GetDataFromPayload()
 		assert.NoError(t, err)
@@ -839,7 +839,7 @@ func TestDropPartitionEvent(t *testing.T) {
 
 		pR.Close()
 
-		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf), false)
+		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf), false, nil)
 		assert.NoError(t, err)
 
 		s, _, err = pR.GetStringFromPayload()
@@ -1061,7 +1061,7 @@ func TestReadFixPartError(t *testing.T) {
 
 func TestEventReaderError(t *testing.T) {
 	buf := new(bytes.Buffer)
-	r, err := newEventReader(schemapb.DataType_Int64, buf, false)
+	r, err := newEventReader(schemapb.DataType_Int64, buf, false, nil)
 	assert.Nil(t, r)
 	assert.Error(t, err)
 
@@ -1069,7 +1069,7 @@ func TestEventReaderError(t *testing.T) {
 	err = header.Write(buf)
 	assert.NoError(t, err)
 
-	r, err = newEventReader(schemapb.DataType_Int64, buf, false)
+	r, err = newEventReader(schemapb.DataType_Int64, buf, false, nil)
 	assert.Nil(t, r)
 	assert.Error(t, err)
 
@@ -1078,7 +1078,7 @@ func TestEventReaderError(t *testing.T) {
 	err = header.Write(buf)
 	assert.NoError(t, err)
 
-	r, err = newEventReader(schemapb.DataType_Int64, buf, false)
+	r, err = newEventReader(schemapb.DataType_Int64, buf, false, nil)
 	assert.Nil(t, r)
 	assert.Error(t, err)
 
@@ -1095,7 +1095,7 @@ func TestEventReaderError(t *testing.T) {
 	err = binary.Write(buf, common.Endian, insertData)
 	assert.NoError(t, err)
 
-	r, err = newEventReader(schemapb.DataType_Int64, buf, false)
+	r, err = newEventReader(schemapb.DataType_Int64, buf, false, nil)
 	assert.Nil(t, r)
 	assert.Error(t, err)
 }
@@ -1115,7 +1115,7 @@ func TestEventClose(t *testing.T) {
 	w.Close()
 
 	wBuf := buf.Bytes()
-	r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf), false)
+	r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf), false, nil)
 	assert.NoError(t, err)
 
 	r.Close()
@@ -102,6 +102,8 @@ type EventWriter interface {
 	Write(buffer *bytes.Buffer) error
 	GetMemoryUsageInBytes() (int32, error)
 	SetOffset(offset int32)
+	// Checksum returns the checksum of event data and payload, should call Finish first
+	Checksum() (uint32, error)
 }
 
 type baseEventWriter struct {
@@ -124,6 +126,7 @@ func (writer *baseEventWriter) GetMemoryUsageInBytes() (int32, error) {
 }
 
 func (writer *baseEventWriter) Write(buffer *bytes.Buffer) error {
+	writer.NextPosition = writer.EventLength + writer.offset
 	if err := writer.eventHeader.Write(buffer); err != nil {
 		return err
 	}
@@ -151,11 +154,18 @@ func (writer *baseEventWriter) Finish() error {
 			return err
 		}
 		writer.EventLength = eventLength
-		writer.NextPosition = eventLength + writer.offset
 	}
 	return nil
 }
 
+func (writer *baseEventWriter) Checksum() (uint32, error) {
+	data, err := writer.GetPayloadBufferFromWriter()
+	if err != nil {
+		return 0, err
+	}
+	return eventDataChecksum(writer.writeEventData, data)
+}
+
 func (writer *baseEventWriter) Close() {
 	if !writer.isClosed {
 		writer.isFinish = true
@@ -2,6 +2,8 @@ package storage
 
 import (
 	"context"
+	"fmt"
+	"path"
 
 	"github.com/cockroachdb/errors"
 
@@ -31,6 +33,10 @@ func NewChunkManagerFactoryWithParam(params *paramtable.ComponentParam) *ChunkMa
 		UseVirtualHost(params.MinioCfg.UseVirtualHost.GetAsBool()),
 		Region(params.MinioCfg.Region.GetValue()),
 		RequestTimeout(params.MinioCfg.RequestTimeoutMs.GetAsInt64()),
+		// each role caches in its own directory, so the cache is reused by the same role after restart.
+		ReadCache(params.MinioCfg.ReadCacheEnabled.GetAsBool(),
+			path.Join(params.MinioCfg.ReadCacheRootPath.GetValue(), paramtable.GetRole()),
+			params.MinioCfg.ReadCacheCapacity.GetAsInt64()*1024*1024),
 		CreateBucket(true))
 }
 
@@ -46,14 +52,28 @@ func NewChunkManagerFactory(persistentStorage string, opts ...Option) *ChunkMana
 }
 
 func (f *ChunkManagerFactory) newChunkManager(ctx context.Context, engine string) (ChunkManager, error) {
+	var cm ChunkManager
 	switch engine {
 	case "local":
-		return NewLocalChunkManager(RootPath(f.config.rootPath)), nil
+		cm = NewLocalChunkManager(RootPath(f.config.rootPath))
 	case "remote", "minio", "opendal":
-		return NewRemoteChunkManager(ctx, f.config)
+		newRemote := func() (ChunkManager, error) {
+			return NewRemoteChunkManager(ctx, f.config)
+		}
+		if !f.config.readCacheEnabled {
+			return newRemote()
+		}
+		// the remote chunk manager is created only if the shared cache is not created yet
+		storage := fmt.Sprintf("%s/%s/%s", f.config.address, f.config.bucketName, f.config.rootPath)
+		ccm, err := getSharedCachedChunkManager(storage, f.config.readCachePath, f.config.readCacheCapacity, newRemote)
+		if err != nil {
+			return nil, err
+		}
+		cm = ccm
 	default:
 		return nil, errors.New("no chunk manager implemented with engine: " + engine)
 	}
+	return cm, nil
 }
 
 func (f *ChunkManagerFactory) NewPersistentStorageChunkManager(ctx context.Context) (ChunkManager, error) {
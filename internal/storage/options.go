@@ -16,6 +16,9 @@ type config struct {
 	useVirtualHost    bool
 	region            string
 	requestTimeoutMs  int64
+	readCacheEnabled  bool
+	readCachePath     string
+	readCacheCapacity int64
 }
 
 func newDefaultConfig() *config {
@@ -108,3 +111,12 @@ func RequestTimeout(requestTimeoutMs int64) Option {
 		c.requestTimeoutMs = requestTimeoutMs
 	}
 }
+
+// ReadCache enables the local disk cache of remote objects, @capacity is in bytes.
+func ReadCache(enabled bool, cachePath string, capacity int64) Option {
+	return func(c *config) {
+		c.readCacheEnabled = enabled
+		c.readCachePath = cachePath
+		c.readCacheCapacity = capacity
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/apache/arrow/go/v12/arrow"
 	"github.com/apache/arrow/go/v12/arrow/array"
 	"github.com/apache/arrow/go/v12/arrow/memory"
+	"github.com/cockroachdb/errors"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/pkg/common"
@@ -73,7 +74,7 @@ func (crr *compositeBinlogRecordReader) iterateNextBatch() error {
 		crr.r.schema[reader.FieldID] = reader.PayloadDataType
 		er, err := reader.NextEventReader()
 		if err != nil {
-			return err
+			return errors.Wrapf(err, "failed to read binlog %s", b[crr.blobPos].GetKey())
 		}
 		rr, err := er.GetArrowRecordReader()
 		if err != nil {
@@ -318,15 +319,20 @@ func (bsw *BinlogStreamWriter) writeBinlogHeaders(w io.Writer) error {
 	de.PayloadDataType = bsw.fieldSchema.DataType
 	de.FieldID = bsw.fieldSchema.FieldID
 	de.descriptorEventData.AddExtra(originalSizeKey, strconv.Itoa(bsw.memorySize))
+	ev := newInsertEventData()
+	ev.StartTimestamp = 1
+	ev.EndTimestamp = 1
+	checksum, err := eventDataChecksum(ev.WriteEventData, bsw.buf.Bytes())
+	if err != nil {
+		return err
+	}
+	de.descriptorEventData.AddExtra(eventChecksumsKey, []uint32{checksum})
 	if err := de.Write(w); err != nil {
 		return err
 	}
 	// Write event header
 	eh := newEventHeader(InsertEventType)
 	// Write event data
-	ev := newInsertEventData()
-	ev.StartTimestamp = 1
-	ev.EndTimestamp = 1
 	eh.EventLength = int32(bsw.buf.Len()) + eh.GetMemoryUsageInBytes() + int32(binary.Size(ev))
 	// eh.NextPosition = eh.EventLength + w.Offset()
 	if err := eh.Write(w); err != nil {
@@ -468,15 +474,20 @@ func (dsw *DeltalogStreamWriter) writeDeltalogHeaders(w io.Writer) error {
 	de := NewBaseDescriptorEvent(dsw.collectionID, dsw.partitionID, dsw.segmentID)
 	de.PayloadDataType = dsw.fieldSchema.DataType
 	de.descriptorEventData.AddExtra(originalSizeKey, strconv.Itoa(dsw.memorySize))
+	ev := newDeleteEventData()
+	ev.StartTimestamp = 1
+	ev.EndTimestamp = 1
+	checksum, err := eventDataChecksum(ev.WriteEventData, dsw.buf.Bytes())
+	if err != nil {
+		return err
+	}
+	de.descriptorEventData.AddExtra(eventChecksumsKey, []uint32{checksum})
 	if err := de.Write(w); err != nil {
 		return err
 	}
 	// Write event header
 	eh := newEventHeader(DeleteEventType)
 	// Write event data
-	ev := newDeleteEventData()
-	ev.StartTimestamp = 1
-	ev.EndTimestamp = 1
 	eh.EventLength = int32(dsw.buf.Len()) + eh.GetMemoryUsageInBytes() + int32(binary.Size(ev))
 	// eh.NextPosition = eh.EventLength + w.Offset()
 	if err := eh.Write(w); err != nil {
@@ -714,15 +725,20 @@ func (dsw *MultiFieldDeltalogStreamWriter) writeDeltalogHeaders(w io.Writer) err
 	de.PayloadDataType = schemapb.DataType_Int64
 	de.descriptorEventData.AddExtra(originalSizeKey, strconv.Itoa(dsw.memorySize))
 	de.descriptorEventData.AddExtra(version, MultiField)
+	ev := newDeleteEventData()
+	ev.StartTimestamp = 1
+	ev.EndTimestamp = 1
+	checksum, err := eventDataChecksum(ev.WriteEventData, dsw.buf.Bytes())
+	if err != nil {
+		return err
+	}
+	de.descriptorEventData.AddExtra(eventChecksumsKey, []uint32{checksum})
 	if err := de.Write(w); err != nil {
 		return err
 	}
 	// Write event header
 	eh := newEventHeader(DeleteEventType)
 	// Write event data
-	ev := newDeleteEventData()
-	ev.StartTimestamp = 1
-	ev.EndTimestamp = 1
 	eh.EventLength = int32(dsw.buf.Len()) + eh.GetMemoryUsageInBytes() + int32(binary.Size(ev))
 	// eh.NextPosition = eh.EventLength + w.Offset()
 	if err := eh.Write(w); err != nil {
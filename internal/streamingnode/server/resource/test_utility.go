@@ -13,5 +13,6 @@ func InitForTest(opts ...optResourceInit) {
 	}
 	if r.rootCoordClient != nil {
 		r.timestampAllocator = idalloc.NewTSOAllocator(r.rootCoordClient)
+		r.idAllocator = idalloc.NewIDAllocator(r.rootCoordClient)
 	}
 }
@@ -21,12 +21,14 @@ func newScannerAdaptor(
 	readOption wal.ReadOption,
 	cleanup func(),
 ) wal.Scanner {
+	logger := log.With(zap.String("name", name), zap.String("channel", l.Channel().Name))
 	s := &scannerAdaptorImpl{
-		logger:        log.With(zap.String("name", name), zap.String("channel", l.Channel().Name)),
+		logger:        logger,
 		innerWAL:      l,
 		readOption:    readOption,
 		sendingCh:     make(chan message.ImmutableMessage, 1),
 		reorderBuffer: utility.NewReOrderBuffer(),
+		txnBuffer:     utility.NewTxnBuffer(logger),
 		pendingQueue:  utility.NewImmutableMessageQueue(),
 		cleanup:       cleanup,
 		ScannerHelper: helper.NewScannerHelper(name),
@@ -43,6 +45,7 @@ type scannerAdaptorImpl struct {
 	readOption    wal.ReadOption
 	sendingCh     chan message.ImmutableMessage
 	reorderBuffer *utility.ReOrderByTimeTickBuffer // only support time tick reorder now.
+	txnBuffer     *utility.TxnBuffer               // txn body is held until the commit message is seen.
 	pendingQueue  *utility.ImmutableMessageQueue   //
 	cleanup       func()
 }
@@ -119,11 +122,14 @@ func (s *scannerAdaptorImpl) handleUpstream(msg message.ImmutableMessage) {
 	if msg.MessageType() == message.MessageTypeTimeTick {
 		// If the time tick message incoming,
 		// the reorder buffer can be consumed into a pending queue with latest timetick.
-		s.pendingQueue.Add(s.reorderBuffer.PopUtilTimeTick(msg.TimeTick()))
+		// The uncommitted txn messages are held by txn buffer until the commit message is seen.
+		msgs := s.reorderBuffer.PopUtilTimeTick(msg.TimeTick())
+		s.pendingQueue.Add(s.txnBuffer.HandleImmutableMessages(msgs))
 		return
 	}
 	// Filtering the message if needed.
-	if s.readOption.MessageFilter != nil && !s.readOption.MessageFilter(msg) {
+	// Txn control message is never filtered, otherwise the txn body can not be released.
+	if !msg.MessageType().IsTxnControl() && s.readOption.MessageFilter != nil && !s.readOption.MessageFilter(msg) {
 		return
 	}
 	// otherwise add message into reorder buffer directly.
@@ -0,0 +1,37 @@
+package txn
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/interceptors"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+var _ interceptors.InterceptorBuilder = (*interceptorBuilder)(nil)
+
+// NewInterceptorBuilder creates a new txn interceptor builder.
+// 1. Assign txn id to the begin message.
+// 2. Promise the commit message is appended after all body messages of the txn.
+// 3. Rollback the txn if no message of it comes within the keepalive.
+func NewInterceptorBuilder() interceptors.InterceptorBuilder {
+	return &interceptorBuilder{}
+}
+
+// interceptorBuilder is a builder to build txnAppendInterceptor.
+type interceptorBuilder struct{}
+
+// Build implements Builder.
+func (b *interceptorBuilder) Build(param interceptors.InterceptorBuildParam) interceptors.BasicInterceptor {
+	ctx, cancel := context.WithCancel(context.Background())
+	interceptor := &txnAppendInterceptor{
+		ctx:        ctx,
+		cancel:     cancel,
+		done:       make(chan struct{}),
+		txnManager: newTxnManager(),
+	}
+	go interceptor.executeExpireTxn(
+		paramtable.Get().StreamingNodeCfg.TxnExpireCheckInterval.GetAsDurationByParse(),
+		param,
+	)
+	return interceptor
+}
@@ -0,0 +1,156 @@
+package txn
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/interceptors"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/streaming/util/message"
+)
+
+var _ interceptors.AppendInterceptor = (*txnAppendInterceptor)(nil)
+
+// txnAppendInterceptor is a append interceptor to manage the transaction of wal.
+type txnAppendInterceptor struct {
+	ctx        context.Context
+	cancel     context.CancelFunc
+	done       chan struct{}
+	txnManager *txnManager
+}
+
+// DoAppend implements AppendInterceptor.
+func (impl *txnAppendInterceptor) DoAppend(ctx context.Context, msg message.MutableMessage, append interceptors.Append) (message.MessageID, error) {
+	txnCtx := msg.TxnContext()
+	if txnCtx == nil {
+		// Not a txn message, append it directly.
+		return append(ctx, msg)
+	}
+
+	switch msg.MessageType() {
+	case message.MessageTypeBeginTxn:
+		return impl.handleBegin(ctx, msg, append)
+	case message.MessageTypeCommitTxn:
+		return impl.handleCommit(ctx, msg, append)
+	case message.MessageTypeRollbackTxn:
+		return impl.handleRollback(ctx, msg, append)
+	default:
+		return impl.handleBody(ctx, msg, append)
+	}
+}
+
+// handleBegin assigns a new txn id to the begin message and starts a new txn session.
+func (impl *txnAppendInterceptor) handleBegin(ctx context.Context, msg message.MutableMessage, append interceptors.Append) (message.MessageID, error) {
+	session, err := impl.txnManager.BeginNewTxn(ctx, msg.TxnContext().Keepalive)
+	if err != nil {
+		return nil, err
+	}
+	// The txn context is written back into message, so the caller can get the txn id after append.
+	msg.WithTxnContext(session.TxnContext())
+	msgID, err := append(ctx, msg)
+	if err != nil {
+		impl.txnManager.RemoveSession(session.TxnContext().TxnID)
+		return nil, err
+	}
+	return msgID, nil
+}
+
+// handleBody appends the body message of a on-going txn.
+func (impl *txnAppendInterceptor) handleBody(ctx context.Context, msg message.MutableMessage, append interceptors.Append) (message.MessageID, error) {
+	session, err := impl.txnManager.GetSessionOfTxn(msg.TxnContext().TxnID)
+	if err != nil {
+		return nil, err
+	}
+	if err := session.AddNewMessage(); err != nil {
+		return nil, err
+	}
+	defer session.DoneMessage()
+
+	// Keep the txn context consistent with the begin message.
+	msg.WithTxnContext(session.TxnContext())
+	return append(ctx, msg)
+}
+
+// handleCommit appends the commit message after all body messages of the txn are appended.
+func (impl *txnAppendInterceptor) handleCommit(ctx context.Context, msg message.MutableMessage, append interceptors.Append) (message.MessageID, error) {
+	session, err := impl.txnManager.GetSessionOfTxn(msg.TxnContext().TxnID)
+	if err != nil {
+		return nil, err
+	}
+	if err := session.RequestCommit(ctx); err != nil {
+		return nil, err
+	}
+	msg.WithTxnContext(session.TxnContext())
+	msgID, err := append(ctx, msg)
+	if err != nil {
+		// The commit can be retried if the commit message is not appended.
+		session.ResetState()
+		return nil, err
+	}
+	impl.txnManager.RemoveSession(session.TxnContext().TxnID)
+	return msgID, nil
+}
+
+// handleRollback appends the rollback message of the txn.
+func (impl *txnAppendInterceptor) handleRollback(ctx context.Context, msg message.MutableMessage, append interceptors.Append) (message.MessageID, error) {
+	session, err := impl.txnManager.GetSessionOfTxn(msg.TxnContext().TxnID)
+	if err != nil {
+		return nil, err
+	}
+	if err := session.RequestRollback(); err != nil {
+		return nil, err
+	}
+	msg.WithTxnContext(session.TxnContext())
+	msgID, err := append(ctx, msg)
+	if err != nil {
+		session.ResetState()
+		return nil, err
+	}
+	impl.txnManager.RemoveSession(session.TxnContext().TxnID)
+	return msgID, nil
+}
+
+// Close implements AppendInterceptor.
+func (impl *txnAppendInterceptor) Close() {
+	impl.cancel()
+	<-impl.done
+}
+
+// executeExpireTxn rollbacks the expired txn at background.
+// A rollback message is appended into wal, so the scanner can release the uncommitted messages of the txn as soon as possible.
+func (impl *txnAppendInterceptor) executeExpireTxn(interval time.Duration, param interceptors.InterceptorBuildParam) {
+	defer close(impl.done)
+
+	logger := log.With(zap.Any("channel", param.WALImpls.Channel()))
+	logger.Info("start to expire txn...")
+	defer logger.Info("expire txn stopped")
+
+	// wait for the final wal object is ready to use.
+	var appender interceptors.Append
+	select {
+	case <-impl.ctx.Done():
+		return
+	case <-param.WAL.Done():
+		appender = param.WAL.Get().Append
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-impl.ctx.Done():
+			return
+		case <-ticker.C:
+			for _, session := range impl.txnManager.GetExpiredSessions(time.Now()) {
+				txnCtx := session.TxnContext()
+				if _, err := appender(impl.ctx, message.NewRollbackTxnMessage(txnCtx)); err != nil {
+					logger.Warn("rollback expired txn failed", zap.Int64("txnID", int64(txnCtx.TxnID)), zap.Error(err))
+					continue
+				}
+				logger.Info("expired txn is rollback", zap.Int64("txnID", int64(txnCtx.TxnID)), zap.Duration("keepalive", txnCtx.Keepalive))
+			}
+		}
+	}
+}
@@ -0,0 +1,208 @@
+package txn
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/internal/mocks/streamingnode/server/mock_wal"
+	"github.com/milvus-io/milvus/internal/proto/streamingpb"
+	"github.com/milvus-io/milvus/internal/streamingnode/server/resource"
+	"github.com/milvus-io/milvus/internal/streamingnode/server/resource/idalloc"
+	"github.com/milvus-io/milvus/internal/streamingnode/server/wal"
+	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/interceptors"
+	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
+	"github.com/milvus-io/milvus/pkg/mocks/streaming/mock_walimpls"
+	"github.com/milvus-io/milvus/pkg/mocks/streaming/util/mock_message"
+	"github.com/milvus-io/milvus/pkg/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/syncutil"
+)
+
+func TestTxnInterceptor(t *testing.T) {
+	paramtable.Init()
+	resource.InitForTest(resource.OptRootCoordClient(idalloc.NewMockRootCoordClient(t)))
+
+	w := mock_walimpls.NewMockWALImpls(t)
+	w.EXPECT().Channel().Return(types.PChannelInfo{Name: "test"}).Maybe()
+	interceptor := NewInterceptorBuilder().Build(interceptors.InterceptorBuildParam{
+		WALImpls: w,
+		WAL:      syncutil.NewFuture[wal.WAL](),
+	}).(*txnAppendInterceptor)
+	defer interceptor.Close()
+
+	ctx := context.Background()
+	appended := make([]message.MutableMessage, 0)
+	appender := func(ctx context.Context, msg message.MutableMessage) (message.MessageID, error) {
+		appended = append(appended, msg)
+		return mock_message.NewMockMessageID(t), nil
+	}
+
+	// non-txn message is appended directly.
+	_, err := interceptor.DoAppend(ctx, newInsertMessage(nil), appender)
+	assert.NoError(t, err)
+	assert.Len(t, appended, 1)
+
+	// begin a new txn, txn id is assigned by interceptor.
+	begin := message.NewBeginTxnMessage(0)
+	_, err = interceptor.DoAppend(ctx, begin, appender)
+	assert.NoError(t, err)
+	txnCtx := *begin.TxnContext()
+	assert.NotEqual(t, message.NonTxnID, txnCtx.TxnID)
+	assert.Equal(t, paramtable.Get().StreamingNodeCfg.TxnDefaultKeepaliveTimeout.GetAsDurationByParse(), txnCtx.Keepalive)
+	assert.Equal(t, 1, interceptor.txnManager.Len())
+
+	// append body and commit.
+	_, err = interceptor.DoAppend(ctx, newInsertMessage(&txnCtx), appender)
+	assert.NoError(t, err)
+	_, err = interceptor.DoAppend(ctx, message.NewCommitTxnMessage(txnCtx), appender)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, interceptor.txnManager.Len())
+	assert.Len(t, appended, 4)
+
+	// txn is not found after commit.
+	_, err = interceptor.DoAppend(ctx, newInsertMessage(&txnCtx), appender)
+	assert.Equal(t, streamingpb.StreamingCode_STREAMING_CODE_TRANSACTION_EXPIRED, status.AsStreamingError(err).Code)
+	_, err = interceptor.DoAppend(ctx, message.NewCommitTxnMessage(txnCtx), appender)
+	assert.Equal(t, streamingpb.StreamingCode_STREAMING_CODE_TRANSACTION_EXPIRED, status.AsStreamingError(err).Code)
+	_, err = interceptor.DoAppend(ctx, message.NewRollbackTxnMessage(txnCtx), appender)
+	assert.Equal(t, streamingpb.StreamingCode_STREAMING_CODE_TRANSACTION_EXPIRED, status.AsStreamingError(err).Code)
+
+	// rollback a txn.
+	begin = message.NewBeginTxnMessage(time.Minute)
+	_, err = interceptor.DoAppend(ctx, begin, appender)
+	assert.NoError(t, err)
+	txnCtx = *begin.TxnContext()
+	assert.Equal(t, time.Minute, txnCtx.Keepalive)
+	_, err = interceptor.DoAppend(ctx, message.NewRollbackTxnMessage(txnCtx), appender)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, interceptor.txnManager.Len())
+
+	// failed begin will not create a session.
+	appendErr := errors.New("append failed")
+	failedAppender := func(ctx context.Context, msg message.MutableMessage) (message.MessageID, error) {
+		return nil, appendErr
+	}
+	_, err = interceptor.DoAppend(ctx, message.NewBeginTxnMessage(0), failedAppender)
+	assert.ErrorIs(t, err, appendErr)
+	assert.Equal(t, 0, interceptor.txnManager.Len())
+
+	// failed commit can be retried.
+	begin = message.NewBeginTxnMessage(time.Minute)
+	_, err = interceptor.DoAppend(ctx, begin, appender)
+	assert.NoError(t, err)
+	txnCtx = *begin.TxnContext()
+	_, err = interceptor.DoAppend(ctx, message.NewCommitTxnMessage(txnCtx), failedAppender)
+	assert.ErrorIs(t, err, appendErr)
+	_, err = interceptor.DoAppend(ctx, message.NewCommitTxnMessage(txnCtx), appender)
+	assert.NoError(t, err)
+}
+
+func TestTxnInterceptorExpire(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().Save(paramtable.Get().StreamingNodeCfg.TxnExpireCheckInterval.Key, "10ms")
+	defer paramtable.Get().Reset(paramtable.Get().StreamingNodeCfg.TxnExpireCheckInterval.Key)
+	resource.InitForTest(resource.OptRootCoordClient(idalloc.NewMockRootCoordClient(t)))
+
+	mu := sync.Mutex{}
+	rollbacks := make([]message.TxnID, 0)
+	var interceptor *txnAppendInterceptor
+	l := mock_wal.NewMockWAL(t)
+	l.EXPECT().Append(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, msg message.MutableMessage) (message.MessageID, error) {
+		return interceptor.DoAppend(ctx, msg, func(ctx context.Context, msg message.MutableMessage) (message.MessageID, error) {
+			if msg.MessageType() == message.MessageTypeRollbackTxn {
+				mu.Lock()
+				rollbacks = append(rollbacks, msg.TxnContext().TxnID)
+				mu.Unlock()
+			}
+			return mock_message.NewMockMessageID(t), nil
+		})
+	})
+	w := mock_walimpls.NewMockWALImpls(t)
+	w.EXPECT().Channel().Return(types.PChannelInfo{Name: "test"}).Maybe()
+	future := syncutil.NewFuture[wal.WAL]()
+	interceptor = NewInterceptorBuilder().Build(interceptors.InterceptorBuildParam{
+		WALImpls: w,
+		WAL:      future,
+	}).(*txnAppendInterceptor)
+	defer interceptor.Close()
+	future.Set(l)
+
+	ctx := context.Background()
+	begin := message.NewBeginTxnMessage(50 * time.Millisecond)
+	_, err := l.Append(ctx, begin)
+	assert.NoError(t, err)
+	txnCtx := *begin.TxnContext()
+
+	// keepalive is refreshed by body message.
+	for i := 0; i < 5; i++ {
+		time.Sleep(20 * time.Millisecond)
+		_, err = l.Append(ctx, newInsertMessage(&txnCtx))
+		assert.NoError(t, err)
+	}
+
+	// txn is rollback after keepalive.
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(rollbacks) == 1 && rollbacks[0] == txnCtx.TxnID
+	}, 5*time.Second, 10*time.Millisecond)
+	_, err = l.Append(ctx, message.NewCommitTxnMessage(txnCtx))
+	assert.Equal(t, streamingpb.StreamingCode_STREAMING_CODE_TRANSACTION_EXPIRED, status.AsStreamingError(err).Code)
+}
+
+func TestTxnSession(t *testing.T) {
+	session := newTxnSession(message.TxnContext{TxnID: 1, Keepalive: 20 * time.Millisecond})
+	assert.NoError(t, session.AddNewMessage())
+
+	// txn is never expired if there's any message on appending.
+	time.Sleep(40 * time.Millisecond)
+	assert.False(t, session.IsExpired(time.Now()))
+
+	// commit will wait for all body messages appended.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, session.RequestCommit(ctx), context.DeadlineExceeded)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		assert.NoError(t, session.RequestCommit(context.Background()))
+	}()
+	time.Sleep(10 * time.Millisecond)
+	session.DoneMessage()
+	<-done
+
+	// no more message can be appended after commit requested.
+	err := session.AddNewMessage()
+	assert.Equal(t, streamingpb.StreamingCode_STREAMING_CODE_INVALID_TRANSACTION_STATE, status.AsStreamingError(err).Code)
+	err = session.RequestRollback()
+	assert.Equal(t, streamingpb.StreamingCode_STREAMING_CODE_INVALID_TRANSACTION_STATE, status.AsStreamingError(err).Code)
+
+	// expired txn can not be committed but can be rollback.
+	session.ResetState()
+	time.Sleep(40 * time.Millisecond)
+	assert.True(t, session.IsExpired(time.Now()))
+	err = session.AddNewMessage()
+	assert.Equal(t, streamingpb.StreamingCode_STREAMING_CODE_TRANSACTION_EXPIRED, status.AsStreamingError(err).Code)
+	err = session.RequestCommit(context.Background())
+	assert.Equal(t, streamingpb.StreamingCode_STREAMING_CODE_TRANSACTION_EXPIRED, status.AsStreamingError(err).Code)
+	assert.NoError(t, session.RequestRollback())
+}
+
+func newInsertMessage(txnCtx *message.TxnContext) message.MutableMessage {
+	msg := message.NewMutableMessageBuilder().
+		WithMessageType(message.MessageTypeInsert).
+		WithPayload([]byte("payload")).
+		BuildMutable()
+	if txnCtx != nil {
+		msg.WithTxnContext(*txnCtx)
+	}
+	return msg
+}
@@ -0,0 +1,89 @@
+package txn
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/streamingnode/server/resource"
+	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
+	"github.com/milvus-io/milvus/pkg/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// newTxnManager creates a new txn manager.
+func newTxnManager() *txnManager {
+	return &txnManager{
+		mu:       sync.Mutex{},
+		sessions: make(map[message.TxnID]*txnSession),
+	}
+}
+
+// txnManager is the manager of all on-going txn sessions of a wal.
+type txnManager struct {
+	mu       sync.Mutex
+	sessions map[message.TxnID]*txnSession
+}
+
+// BeginNewTxn allocates a new txn id and starts a new txn session.
+// The default keepalive is used if the keepalive is not set.
+func (m *txnManager) BeginNewTxn(ctx context.Context, keepalive time.Duration) (*txnSession, error) {
+	id, err := resource.Resource().IDAllocator().Allocate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if keepalive <= 0 {
+		keepalive = paramtable.Get().StreamingNodeCfg.TxnDefaultKeepaliveTimeout.GetAsDurationByParse()
+	}
+	session := newTxnSession(message.TxnContext{
+		TxnID:     message.TxnID(id),
+		Keepalive: keepalive,
+	})
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sessions[session.TxnContext().TxnID] = session
+	return session, nil
+}
+
+// GetSessionOfTxn returns the session of the txn.
+func (m *txnManager) GetSessionOfTxn(id message.TxnID) (*txnSession, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	session, ok := m.sessions[id]
+	if !ok {
+		return nil, status.NewTransactionExpired("txn %d not found, may be expired or done", id)
+	}
+	return session, nil
+}
+
+// RemoveSession removes the session of the txn.
+func (m *txnManager) RemoveSession(id message.TxnID) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.sessions, id)
+}
+
+// GetExpiredSessions returns the sessions which are expired at given time.
+func (m *txnManager) GetExpiredSessions(now time.Time) []*txnSession {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	expired := make([]*txnSession, 0)
+	for _, session := range m.sessions {
+		if session.IsExpired(now) {
+			expired = append(expired, session)
+		}
+	}
+	return expired
+}
+
+// Len returns the count of on-going txn sessions.
+func (m *txnManager) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return len(m.sessions)
+}
@@ -0,0 +1,142 @@
+package txn
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
+	"github.com/milvus-io/milvus/pkg/streaming/util/message"
+)
+
+type txnState int
+
+const (
+	txnStateInFlight   txnState = iota // the txn is on-going, body message can be appended.
+	txnStateOnCommit                   // the commit message of txn is on appending.
+	txnStateOnRollback                 // the rollback message of txn is on appending.
+)
+
+// newTxnSession creates a new txn session.
+func newTxnSession(txnCtx message.TxnContext) *txnSession {
+	return &txnSession{
+		txnCtx:     txnCtx,
+		state:      txnStateInFlight,
+		lastActive: time.Now(),
+	}
+}
+
+// txnSession is the session of an on-going transaction at wal side.
+type txnSession struct {
+	mu         sync.Mutex
+	txnCtx     message.TxnContext
+	state      txnState
+	lastActive time.Time // the last time a message of the txn is appended.
+	inflight   int       // the count of body messages on appending.
+	drained    chan struct{}
+}
+
+// TxnContext returns the txn context of the session.
+func (s *txnSession) TxnContext() message.TxnContext {
+	return s.txnCtx
+}
+
+// IsExpired checks if the txn is expired at given time.
+func (s *txnSession) IsExpired(now time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.isExpired(now)
+}
+
+// isExpired checks if the txn is expired without lock.
+// A txn is never expired if there's any message on appending.
+func (s *txnSession) isExpired(now time.Time) bool {
+	return s.inflight == 0 && now.Sub(s.lastActive) > s.txnCtx.Keepalive
+}
+
+// AddNewMessage marks a new body message of the txn is on appending.
+// DoneMessage should be called after the message append operation is done.
+func (s *txnSession) AddNewMessage() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.state != txnStateInFlight {
+		return status.NewInvalidTransactionState("txn %d is not in flight, can not append new message", s.txnCtx.TxnID)
+	}
+	if s.isExpired(time.Now()) {
+		return status.NewTransactionExpired("txn %d is expired", s.txnCtx.TxnID)
+	}
+	s.inflight++
+	s.lastActive = time.Now()
+	return nil
+}
+
+// DoneMessage marks a body message of the txn is appended.
+func (s *txnSession) DoneMessage() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.inflight--
+	s.lastActive = time.Now()
+	if s.inflight == 0 && s.drained != nil {
+		close(s.drained)
+		s.drained = nil
+	}
+}
+
+// RequestCommit requests to commit the txn.
+// It blocks until all body messages of the txn are appended,
+// so the commit message is always appended after all body messages.
+func (s *txnSession) RequestCommit(ctx context.Context) error {
+	drained, err := s.transitState(txnStateOnCommit, true)
+	if err != nil {
+		return err
+	}
+	select {
+	case <-ctx.Done():
+		// The commit can be retried later.
+		s.ResetState()
+		return ctx.Err()
+	case <-drained:
+		return nil
+	}
+}
+
+// RequestRollback requests to rollback the txn.
+// An expired txn can still be rollback.
+func (s *txnSession) RequestRollback() error {
+	_, err := s.transitState(txnStateOnRollback, false)
+	return err
+}
+
+// transitState transits the txn state from in-flight to the target state,
+// and returns a channel which will be closed when all body messages are appended.
+func (s *txnSession) transitState(target txnState, checkExpired bool) (<-chan struct{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.state != txnStateInFlight {
+		return nil, status.NewInvalidTransactionState("txn %d is not in flight, can not be committed or rollback", s.txnCtx.TxnID)
+	}
+	if checkExpired && s.isExpired(time.Now()) {
+		return nil, status.NewTransactionExpired("txn %d is expired", s.txnCtx.TxnID)
+	}
+	s.state = target
+	drained := make(chan struct{})
+	if s.inflight == 0 {
+		close(drained)
+	} else {
+		s.drained = drained
+	}
+	return drained, nil
+}
+
+// ResetState resets the txn state into in-flight if the commit or rollback message is not appended.
+func (s *txnSession) ResetState() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.state = txnStateInFlight
+	s.lastActive = time.Now()
+}
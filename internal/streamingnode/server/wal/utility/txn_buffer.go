@@ -0,0 +1,119 @@
+package utility
+
+import (
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/streaming/util/message"
+)
+
+// NewTxnBuffer creates a new txn buffer.
+func NewTxnBuffer(logger *log.MLogger) *TxnBuffer {
+	return &TxnBuffer{
+		logger:   logger,
+		builders: make(map[message.TxnID]*txnMessages),
+	}
+}
+
+// TxnBuffer is a buffer that holds the messages of uncommitted transactions.
+// The body of a transaction is released only when its commit message is seen,
+// so the consumer always see a transaction atomically.
+// The buffer never expires a transaction by itself, the expired transaction is rollback by the txn interceptor of wal,
+// which is the only one to judge the expiration, so a transaction accepted to commit by wal is never discarded here.
+type TxnBuffer struct {
+	logger   *log.MLogger
+	builders map[message.TxnID]*txnMessages
+}
+
+// txnMessages is the messages of a uncommitted transaction.
+type txnMessages struct {
+	begin message.ImmutableMessage
+	body  []message.ImmutableMessage
+}
+
+// Len returns the count of uncommitted transactions in the buffer.
+func (b *TxnBuffer) Len() int {
+	return len(b.builders)
+}
+
+// HandleImmutableMessages handles the messages which are sorted by time tick.
+// The messages of committed transactions and the messages not in a transaction are returned in order.
+// The rollback transactions will be discarded.
+func (b *TxnBuffer) HandleImmutableMessages(msgs []message.ImmutableMessage) []message.ImmutableMessage {
+	result := make([]message.ImmutableMessage, 0, len(msgs))
+	for _, msg := range msgs {
+		txnCtx := msg.TxnContext()
+		if txnCtx == nil {
+			result = append(result, msg)
+			continue
+		}
+		switch msg.MessageType() {
+		case message.MessageTypeBeginTxn:
+			b.handleBeginTxn(msg)
+		case message.MessageTypeCommitTxn:
+			result = append(result, b.handleCommitTxn(msg)...)
+		case message.MessageTypeRollbackTxn:
+			b.handleRollbackTxn(msg)
+		default:
+			b.handleTxnBodyMessage(msg)
+		}
+	}
+	return result
+}
+
+// handleBeginTxn handles the begin transaction message.
+func (b *TxnBuffer) handleBeginTxn(msg message.ImmutableMessage) {
+	txnID := msg.TxnContext().TxnID
+	if _, ok := b.builders[txnID]; ok {
+		b.logger.Warn("duplicated begin txn message, drop the previous one",
+			zap.Int64("txnID", int64(txnID)),
+			zap.Any("messageID", msg.MessageID()))
+	}
+	b.builders[txnID] = &txnMessages{
+		begin: msg,
+	}
+}
+
+// handleCommitTxn handles the commit transaction message.
+// The whole transaction is returned if the transaction is found.
+func (b *TxnBuffer) handleCommitTxn(msg message.ImmutableMessage) []message.ImmutableMessage {
+	txnID := msg.TxnContext().TxnID
+	builder, ok := b.builders[txnID]
+	if !ok {
+		b.logger.Warn("txn is not found when commit, drop it",
+			zap.Int64("txnID", int64(txnID)),
+			zap.Any("messageID", msg.MessageID()))
+		return nil
+	}
+	delete(b.builders, txnID)
+	if len(builder.body) == 0 {
+		// Nothing need to be seen by consumer if the body is empty or filtered.
+		return nil
+	}
+	result := make([]message.ImmutableMessage, 0, len(builder.body)+2)
+	result = append(result, builder.begin)
+	result = append(result, builder.body...)
+	return append(result, msg)
+}
+
+// handleRollbackTxn handles the rollback transaction message.
+func (b *TxnBuffer) handleRollbackTxn(msg message.ImmutableMessage) {
+	txnID := msg.TxnContext().TxnID
+	if _, ok := b.builders[txnID]; ok {
+		delete(b.builders, txnID)
+		b.logger.Debug("txn is rollback", zap.Int64("txnID", int64(txnID)))
+	}
+}
+
+// handleTxnBodyMessage handles the body message of transaction.
+func (b *TxnBuffer) handleTxnBodyMessage(msg message.ImmutableMessage) {
+	txnID := msg.TxnContext().TxnID
+	builder, ok := b.builders[txnID]
+	if !ok {
+		b.logger.Warn("txn is not found when handling body message, drop it",
+			zap.Int64("txnID", int64(txnID)),
+			zap.Any("messageID", msg.MessageID()))
+		return
+	}
+	builder.body = append(builder.body, msg)
+}
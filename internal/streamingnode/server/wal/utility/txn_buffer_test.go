@@ -0,0 +1,95 @@
+package utility
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/mocks/streaming/util/mock_message"
+	"github.com/milvus-io/milvus/pkg/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+)
+
+func TestTxnBuffer(t *testing.T) {
+	b := NewTxnBuffer(log.With())
+	baseTs := tsoutil.ComposeTSByTime(time.Now(), 0)
+	tsAfter := func(d time.Duration) uint64 {
+		return tsoutil.AddPhysicalDurationOnTs(baseTs, d)
+	}
+	txn1 := message.TxnContext{TxnID: 1, Keepalive: time.Second}
+	txn2 := message.TxnContext{TxnID: 2, Keepalive: time.Second}
+	txn3 := message.TxnContext{TxnID: 3, Keepalive: time.Second}
+
+	// message not in txn is returned directly.
+	msgs := b.HandleImmutableMessages([]message.ImmutableMessage{
+		newInsertMessage(t, tsAfter(0), nil),
+		newTxnControlMessage(t, message.NewBeginTxnMessage(time.Second), tsAfter(1*time.Millisecond), txn1),
+		newTxnControlMessage(t, message.NewBeginTxnMessage(time.Second), tsAfter(2*time.Millisecond), txn2),
+		newTxnControlMessage(t, message.NewBeginTxnMessage(time.Second), tsAfter(3*time.Millisecond), txn3),
+		newInsertMessage(t, tsAfter(4*time.Millisecond), &txn1),
+		newInsertMessage(t, tsAfter(5*time.Millisecond), &txn2),
+		newInsertMessage(t, tsAfter(6*time.Millisecond), nil),
+		newInsertMessage(t, tsAfter(7*time.Millisecond), &txn3),
+	})
+	assert.Len(t, msgs, 2)
+	assert.Nil(t, msgs[0].TxnContext())
+	assert.Nil(t, msgs[1].TxnContext())
+	assert.Equal(t, 3, b.Len())
+
+	// committed txn is released with begin and commit message, rollback txn is discarded.
+	msgs = b.HandleImmutableMessages([]message.ImmutableMessage{
+		newInsertMessage(t, tsAfter(11*time.Millisecond), &txn1),
+		newTxnControlMessage(t, message.NewCommitTxnMessage(txn1), tsAfter(12*time.Millisecond), txn1),
+		newTxnControlMessage(t, message.NewRollbackTxnMessage(txn2), tsAfter(13*time.Millisecond), txn2),
+		// body or commit of unknown txn is dropped.
+		newInsertMessage(t, tsAfter(14*time.Millisecond), &txn2),
+		newTxnControlMessage(t, message.NewCommitTxnMessage(txn2), tsAfter(15*time.Millisecond), txn2),
+	})
+	assert.Len(t, msgs, 4)
+	assert.Equal(t, message.MessageTypeBeginTxn, msgs[0].MessageType())
+	assert.Equal(t, message.MessageTypeInsert, msgs[1].MessageType())
+	assert.Equal(t, message.MessageTypeInsert, msgs[2].MessageType())
+	assert.Equal(t, message.MessageTypeCommitTxn, msgs[3].MessageType())
+	for _, msg := range msgs {
+		assert.Equal(t, txn1.TxnID, msg.TxnContext().TxnID)
+	}
+	assert.Equal(t, 1, b.Len())
+
+	// txn is never expired by the buffer, the commit accepted by wal after the keepalive window is still released.
+	msgs = b.HandleImmutableMessages([]message.ImmutableMessage{
+		newTxnControlMessage(t, message.NewCommitTxnMessage(txn3), tsAfter(2*time.Second), txn3),
+	})
+	assert.Len(t, msgs, 3)
+	assert.Equal(t, message.MessageTypeBeginTxn, msgs[0].MessageType())
+	assert.Equal(t, message.MessageTypeInsert, msgs[1].MessageType())
+	assert.Equal(t, message.MessageTypeCommitTxn, msgs[2].MessageType())
+	assert.Equal(t, 0, b.Len())
+
+	// empty txn is not released.
+	msgs = b.HandleImmutableMessages([]message.ImmutableMessage{
+		newTxnControlMessage(t, message.NewBeginTxnMessage(time.Second), tsAfter(3*time.Second), txn1),
+		newTxnControlMessage(t, message.NewCommitTxnMessage(txn1), tsAfter(3*time.Second), txn1),
+	})
+	assert.Len(t, msgs, 0)
+	assert.Equal(t, 0, b.Len())
+}
+
+func newInsertMessage(t *testing.T, ts uint64, txnCtx *message.TxnContext) message.ImmutableMessage {
+	msg := message.NewMutableMessageBuilder().
+		WithMessageType(message.MessageTypeInsert).
+		WithPayload([]byte("payload")).
+		BuildMutable().
+		WithTimeTick(ts)
+	if txnCtx != nil {
+		msg.WithTxnContext(*txnCtx)
+	}
+	return msg.IntoImmutableMessage(mock_message.NewMockMessageID(t))
+}
+
+func newTxnControlMessage(t *testing.T, msg message.MutableMessage, ts uint64, txnCtx message.TxnContext) message.ImmutableMessage {
+	return msg.WithTimeTick(ts).
+		WithTxnContext(txnCtx).
+		IntoImmutableMessage(mock_message.NewMockMessageID(t))
+}
@@ -0,0 +1,215 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package auditlog records the DDL, RBAC and credential changes into a dedicated rotating file on local disk.
+// The proxy forwards the actor and the client address of the audited requests to the coordinators,
+// which write one record for each request after it's done.
+package auditlog
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus/pkg/eventlog"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/conc"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/requestutil"
+)
+
+const (
+	// ActorKey is the metadata key of the user issuing the audited request.
+	ActorKey = "audit-actor"
+	// SourceAddrKey is the metadata key of the client address of the audited request.
+	SourceAddrKey = "audit-source-addr"
+
+	ResultSuccess = "success"
+	ResultFailure = "failure"
+)
+
+var (
+	auditLog   atomic.Pointer[eventlog.DiskLogger]
+	sfAuditLog conc.Singleflight[*eventlog.DiskLogger]
+
+	// the methods audited, which are named the same in the proxy, rootcoord and datacoord
+	auditedMethods = map[string]struct{}{
+		"CreateCollection":      {},
+		"DropCollection":        {},
+		"DropCollectionAsync":   {},
+		"AlterCollection":       {},
+		"RenameCollection":      {},
+		"AddCollectionField":    {},
+		"DropCollectionField":   {},
+		"RenameCollectionField": {},
+		"CreatePartition":       {},
+		"DropPartition":         {},
+		"CreateIndex":           {},
+		"AlterIndex":            {},
+		"DropIndex":             {},
+		"CreateAlias":           {},
+		"DropAlias":             {},
+		"AlterAlias":            {},
+		"AlterAliases":          {},
+		"CreateDatabase":        {},
+		"DropDatabase":          {},
+		"DropDatabaseAsync":     {},
+		"AlterDatabase":         {},
+		"CreateCredential":      {},
+		"UpdateCredential":      {},
+		"DeleteCredential":      {},
+		"CreateRole":            {},
+		"DropRole":              {},
+		"OperateUserRole":       {},
+		"OperatePrivilege":      {},
+	}
+)
+
+// Record is an audit record of a DDL, RBAC or credential change.
+type Record struct {
+	Time       time.Time `json:"time"`
+	Actor      string    `json:"actor"`
+	SourceAddr string    `json:"source_addr"`
+	Operation  string    `json:"operation"`
+	Database   string    `json:"database,omitempty"`
+	Object     string    `json:"object,omitempty"`
+	// the summary of the object before the change, empty for creation
+	Before string `json:"before,omitempty"`
+	// the summary of the requested change, empty for dropping
+	After  string `json:"after,omitempty"`
+	Result string `json:"result"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// IsAudited returns whether the method is audited, the method could be the full grpc method name.
+func IsAudited(method string) bool {
+	_, ok := auditedMethods[methodName(method)]
+	return ok
+}
+
+func methodName(fullMethod string) string {
+	return fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+}
+
+// AppendToOutgoingContext forwards the actor and the client address of the request to the coordinators.
+func AppendToOutgoingContext(ctx context.Context, actor string, sourceAddr string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, ActorKey, actor, SourceAddrKey, sourceAddr)
+}
+
+func getFromIncomingContext(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// Init starts the audit logger writing into the rotating files under dir.
+func Init(dir string, maxFileSize int64, maxFiles int) error {
+	if auditLog.Load() != nil {
+		return nil
+	}
+	_, err, _ := sfAuditLog.Do("audit_log", func() (*eventlog.DiskLogger, error) {
+		if l := auditLog.Load(); l != nil {
+			return l, nil
+		}
+		l, err := eventlog.NewDiskLogger(dir, maxFileSize, maxFiles)
+		if err != nil {
+			return nil, err
+		}
+		auditLog.Store(l)
+		log.Info("audit logger started", zap.String("dir", dir),
+			zap.Int64("maxFileSize", maxFileSize), zap.Int("maxFiles", maxFiles))
+		return l, nil
+	})
+	return err
+}
+
+// Enabled returns whether the audit logger is started.
+func Enabled() bool {
+	return auditLog.Load() != nil
+}
+
+// Write appends the record to the audit log, it's a no-op if the audit logger is not started.
+func Write(record *Record) {
+	l := auditLog.Load()
+	if l == nil {
+		return
+	}
+	bs, err := json.Marshal(record)
+	if err != nil {
+		log.RatedWarn(60, "failed to marshal audit record", zap.Error(err))
+		return
+	}
+	l.Record(eventlog.NewRawEvt(eventlog.Level_Info, string(bs)))
+}
+
+// Close stops the audit logger, the records written afterwards are dropped.
+func Close() error {
+	l := auditLog.Swap(nil)
+	if l == nil {
+		return nil
+	}
+	return l.Close()
+}
+
+// BeforeFunc returns the summary of the object before it's changed by the request.
+type BeforeFunc func(ctx context.Context, req any) string
+
+// UnaryServerInterceptor writes an audit record for each audited request handled by the coordinator.
+// The before function is optional, it's called before the request is handled.
+func UnaryServerInterceptor(before BeforeFunc) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !Enabled() || !IsAudited(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		record := &Record{
+			Actor:      getFromIncomingContext(ctx, ActorKey),
+			SourceAddr: getFromIncomingContext(ctx, SourceAddrKey),
+			Operation:  methodName(info.FullMethod),
+		}
+		record.Database, record.Object, record.After = summarize(req)
+		if before != nil {
+			record.Before = before(ctx, req)
+		}
+
+		resp, err := handler(ctx, req)
+		result := err
+		if result == nil {
+			if status, ok := requestutil.GetStatusFromResponse(resp); ok {
+				result = merr.Error(status)
+			}
+		}
+		record.Time = time.Now()
+		record.Result = ResultSuccess
+		if result != nil {
+			record.Result = ResultFailure
+			record.Reason = result.Error()
+		}
+		Write(record)
+		return resp, err
+	}
+}
@@ -0,0 +1,182 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auditlog
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+func TestIsAudited(t *testing.T) {
+	assert.True(t, IsAudited("/milvus.proto.rootcoord.RootCoord/CreateCollection"))
+	assert.True(t, IsAudited("/milvus.proto.milvus.MilvusService/OperatePrivilege"))
+	assert.True(t, IsAudited("DropIndex"))
+	assert.True(t, IsAudited("/milvus.proto.rootcoord.RootCoord/DropCollectionAsync"))
+	assert.False(t, IsAudited("/milvus.proto.milvus.MilvusService/Search"))
+	assert.False(t, IsAudited("DescribeCollection"))
+}
+
+func TestAppendToOutgoingContext(t *testing.T) {
+	ctx := AppendToOutgoingContext(context.Background(), "root", "127.0.0.1:1234")
+	md, ok := metadata.FromOutgoingContext(ctx)
+	require.True(t, ok)
+	assert.Equal(t, []string{"root"}, md.Get(ActorKey))
+	assert.Equal(t, []string{"127.0.0.1:1234"}, md.Get(SourceAddrKey))
+}
+
+func TestSummarize(t *testing.T) {
+	db, object, after := summarize(&milvuspb.AlterCollectionRequest{
+		DbName:         "db",
+		CollectionName: "coll",
+		Properties:     []*commonpb.KeyValuePair{{Key: "k", Value: "v"}},
+	})
+	assert.Equal(t, "db", db)
+	assert.Equal(t, "coll", object)
+	assert.Equal(t, "properties=[k=v]", after)
+
+	_, object, after = summarize(&internalpb.CredentialInfo{Username: "user", EncryptedPassword: "secret", Sha256Password: "secret"})
+	assert.Equal(t, "user", object)
+	assert.NotContains(t, after, "secret")
+
+	_, object, after = summarize(&milvuspb.OperatePrivilegeRequest{
+		Type: milvuspb.OperatePrivilegeType_Grant,
+		Entity: &milvuspb.GrantEntity{
+			Role:       &milvuspb.RoleEntity{Name: "role"},
+			Object:     &milvuspb.ObjectEntity{Name: commonpb.ObjectType_Collection.String()},
+			ObjectName: "coll",
+			Grantor:    &milvuspb.GrantorEntity{Privilege: &milvuspb.PrivilegeEntity{Name: "Query"}},
+		},
+	})
+	assert.Equal(t, "role", object)
+	assert.Equal(t, "Grant privilege=Query, object=Collection:coll", after)
+
+	_, object, after = summarize(&internalpb.AlterAliasesRequest{
+		DbName: "db",
+		Changes: []*internalpb.AliasChange{
+			{Alias: "a1", CollectionName: "coll_v2"},
+			{Alias: "a2"},
+		},
+	})
+	assert.Equal(t, "a1,a2", object)
+	assert.Equal(t, "aliases=[a1=coll_v2,a2=]", after)
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	interceptor := UnaryServerInterceptor(func(ctx context.Context, req any) string {
+		return "properties=[k=old]"
+	})
+	req := &milvuspb.AlterCollectionRequest{
+		DbName:         "db",
+		CollectionName: "coll",
+		Properties:     []*commonpb.KeyValuePair{{Key: "k", Value: "new"}},
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.rootcoord.RootCoord/AlterCollection"}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return merr.Success(), nil
+	}
+
+	// not enabled
+	_, err := interceptor(context.Background(), req, info, handler)
+	assert.NoError(t, err)
+	_, err = Query(QueryFilter{})
+	assert.Error(t, err)
+
+	require.NoError(t, Init(t.TempDir(), 1024*1024, 2))
+	defer Close()
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(ActorKey, "root", SourceAddrKey, "127.0.0.1:1234"))
+	_, err = interceptor(ctx, req, info, handler)
+	assert.NoError(t, err)
+	_, err = interceptor(ctx, req, info, func(ctx context.Context, req any) (any, error) {
+		return merr.Status(merr.WrapErrCollectionNotFound("coll")), nil
+	})
+	assert.NoError(t, err)
+	_, err = interceptor(ctx, &milvuspb.DropCollectionRequest{DbName: "db", CollectionName: "coll"},
+		&grpc.UnaryServerInfo{FullMethod: "/milvus.proto.rootcoord.RootCoord/DropCollection"},
+		func(ctx context.Context, req any) (any, error) {
+			return nil, errors.New("mock")
+		})
+	assert.Error(t, err)
+	// not audited
+	_, err = interceptor(ctx, &milvuspb.DescribeCollectionRequest{}, &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.rootcoord.RootCoord/DescribeCollection"}, handler)
+	assert.NoError(t, err)
+
+	records, err := Query(QueryFilter{})
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	assert.Equal(t, "root", records[0].Actor)
+	assert.Equal(t, "127.0.0.1:1234", records[0].SourceAddr)
+	assert.Equal(t, "AlterCollection", records[0].Operation)
+	assert.Equal(t, "db", records[0].Database)
+	assert.Equal(t, "coll", records[0].Object)
+	assert.Equal(t, "properties=[k=old]", records[0].Before)
+	assert.Equal(t, "properties=[k=new]", records[0].After)
+	assert.Equal(t, ResultSuccess, records[0].Result)
+	assert.Equal(t, ResultFailure, records[1].Result)
+	assert.NotEmpty(t, records[1].Reason)
+	assert.Equal(t, ResultFailure, records[2].Result)
+
+	records, err = Query(QueryFilter{Operation: "DropCollection"})
+	require.NoError(t, err)
+	assert.Len(t, records, 1)
+	records, err = Query(QueryFilter{Limit: 2})
+	require.NoError(t, err)
+	assert.Len(t, records, 2)
+	assert.Equal(t, "DropCollection", records[1].Operation)
+	records, err = Query(QueryFilter{End: time.Now().Add(-time.Hour)})
+	require.NoError(t, err)
+	assert.Len(t, records, 0)
+}
+
+func TestQueryHandler(t *testing.T) {
+	handler := QueryHandler()
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/auditlog/query", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+
+	require.NoError(t, Init(t.TempDir(), 1024*1024, 2))
+	defer Close()
+	Write(&Record{Actor: "root", Operation: "CreateRole", Object: "role", Result: ResultSuccess})
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/auditlog/query?limit=abc", nil))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/auditlog/query?actor=root&start=2024-01-01T00:00:00Z", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	resp := &queryResponse{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+	require.Len(t, resp.Records, 1)
+	assert.Equal(t, "CreateRole", resp.Records[0].Operation)
+}
@@ -0,0 +1,147 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auditlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/eventlog"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+const defaultQueryLimit = 1000
+
+// QueryFilter filters the audit records, the zero value fields match all records.
+type QueryFilter struct {
+	Start     time.Time
+	End       time.Time
+	Actor     string
+	Operation string
+	// only the latest records are returned if the limit is exceeded
+	Limit int
+}
+
+func (f *QueryFilter) match(record *Record) bool {
+	if f.Actor != "" && record.Actor != f.Actor {
+		return false
+	}
+	if f.Operation != "" && record.Operation != f.Operation {
+		return false
+	}
+	return true
+}
+
+// Query returns the audit records matching the filter, oldest first.
+func Query(filter QueryFilter) ([]*Record, error) {
+	l := auditLog.Load()
+	if l == nil {
+		return nil, fmt.Errorf("audit log not enabled")
+	}
+	entries, err := l.Query(eventlog.QueryFilter{Start: filter.Start, End: filter.End})
+	if err != nil {
+		return nil, err
+	}
+	records := make([]*Record, 0, len(entries))
+	for _, entry := range entries {
+		record := &Record{}
+		if err := json.Unmarshal([]byte(entry.Data), record); err != nil {
+			continue
+		}
+		if filter.match(record) {
+			records = append(records, record)
+		}
+	}
+	if filter.Limit > 0 && len(records) > filter.Limit {
+		records = records[len(records)-filter.Limit:]
+	}
+	return records, nil
+}
+
+type queryHandler struct{}
+
+// QueryHandler returns the handler to query the audit records.
+func QueryHandler() http.Handler {
+	return &queryHandler{}
+}
+
+type queryResponse struct {
+	Status  int       `json:"status"`
+	Msg     string    `json:"msg,omitempty"`
+	Records []*Record `json:"records"`
+}
+
+func (h *queryHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !Enabled() {
+		writeJSON(w, http.StatusServiceUnavailable, &queryResponse{Status: http.StatusServiceUnavailable, Msg: "audit log not enabled"})
+		return
+	}
+	filter, err := parseQueryFilter(r)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, &queryResponse{Status: http.StatusBadRequest, Msg: err.Error()})
+		return
+	}
+	records, err := Query(filter)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, &queryResponse{Status: http.StatusInternalServerError, Msg: err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusOK, &queryResponse{Status: http.StatusOK, Records: records})
+}
+
+// parseQueryFilter parses the filter from url query, e.g.
+// ?start=2024-01-01T00:00:00Z&end=2024-01-02T00:00:00Z&actor=root&operation=DropCollection&limit=100
+func parseQueryFilter(r *http.Request) (QueryFilter, error) {
+	query := r.URL.Query()
+	filter := QueryFilter{
+		Actor:     query.Get("actor"),
+		Operation: query.Get("operation"),
+		Limit:     defaultQueryLimit,
+	}
+	var err error
+	if v := query.Get("start"); v != "" {
+		if filter.Start, err = time.Parse(time.RFC3339, v); err != nil {
+			return filter, fmt.Errorf("invalid start %s, RFC3339 time expected", v)
+		}
+	}
+	if v := query.Get("end"); v != "" {
+		if filter.End, err = time.Parse(time.RFC3339, v); err != nil {
+			return filter, fmt.Errorf("invalid end %s, RFC3339 time expected", v)
+		}
+	}
+	if v := query.Get("limit"); v != "" {
+		if filter.Limit, err = strconv.Atoi(v); err != nil || filter.Limit <= 0 {
+			return filter, fmt.Errorf("invalid limit %s", v)
+		}
+	}
+	return filter, nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, resp *queryResponse) {
+	w.Header().Set(eventlog.ContentTypeHeader, eventlog.ContentTypeJSON)
+	bs, err := json.Marshal(resp)
+	if err != nil {
+		log.Warn("failed to send response", zap.Error(err))
+	}
+	w.WriteHeader(status)
+	w.Write(bs)
+}
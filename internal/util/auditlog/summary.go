@@ -0,0 +1,121 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auditlog
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+)
+
+// PropertiesSummary formats the key-value pairs as `k1=v1,k2=v2`.
+func PropertiesSummary(pairs []*commonpb.KeyValuePair) string {
+	kvs := make([]string, 0, len(pairs))
+	for _, pair := range pairs {
+		kvs = append(kvs, pair.GetKey()+"="+pair.GetValue())
+	}
+	return strings.Join(kvs, ",")
+}
+
+func schemaSummary(bs []byte) string {
+	schema := &schemapb.CollectionSchema{}
+	if err := proto.Unmarshal(bs, schema); err != nil {
+		return ""
+	}
+	fields := make([]string, 0, len(schema.GetFields()))
+	for _, field := range schema.GetFields() {
+		fields = append(fields, field.GetName()+":"+field.GetDataType().String())
+	}
+	return strings.Join(fields, ",")
+}
+
+// summarize returns the database, the object and the summary of the change of the audited request.
+// The secrets such as passwords are never included.
+func summarize(req any) (database string, object string, after string) {
+	switch r := req.(type) {
+	case *milvuspb.CreateCollectionRequest:
+		return r.GetDbName(), r.GetCollectionName(), fmt.Sprintf("fields=[%s], shards=%d, properties=[%s]",
+			schemaSummary(r.GetSchema()), r.GetShardsNum(), PropertiesSummary(r.GetProperties()))
+	case *milvuspb.DropCollectionRequest:
+		return r.GetDbName(), r.GetCollectionName(), ""
+	case *milvuspb.AlterCollectionRequest:
+		return r.GetDbName(), r.GetCollectionName(), fmt.Sprintf("properties=[%s]", PropertiesSummary(r.GetProperties()))
+	case *milvuspb.RenameCollectionRequest:
+		return r.GetDbName(), r.GetOldName(), fmt.Sprintf("database=%s, name=%s", r.GetNewDBName(), r.GetNewName())
+	case *internalpb.AddCollectionFieldRequest:
+		return r.GetDbName(), r.GetCollectionName(), fmt.Sprintf("add field=%s:%s", r.GetField().GetName(), r.GetField().GetDataType())
+	case *internalpb.DropCollectionFieldRequest:
+		return r.GetDbName(), r.GetCollectionName(), fmt.Sprintf("drop field=%s", r.GetFieldName())
+	case *internalpb.RenameCollectionFieldRequest:
+		return r.GetDbName(), r.GetCollectionName(), fmt.Sprintf("rename field %s to %s", r.GetOldName(), r.GetNewName())
+	case *milvuspb.CreatePartitionRequest:
+		return r.GetDbName(), r.GetCollectionName(), fmt.Sprintf("create partition=%s", r.GetPartitionName())
+	case *milvuspb.DropPartitionRequest:
+		return r.GetDbName(), r.GetCollectionName(), fmt.Sprintf("drop partition=%s", r.GetPartitionName())
+	case *indexpb.CreateIndexRequest:
+		return "", strconv.FormatInt(r.GetCollectionID(), 10), fmt.Sprintf("index=%s, field=%d, params=[%s]",
+			r.GetIndexName(), r.GetFieldID(), PropertiesSummary(r.GetUserIndexParams()))
+	case *indexpb.AlterIndexRequest:
+		return "", strconv.FormatInt(r.GetCollectionID(), 10), fmt.Sprintf("index=%s, params=[%s]", r.GetIndexName(), PropertiesSummary(r.GetParams()))
+	case *indexpb.DropIndexRequest:
+		return "", strconv.FormatInt(r.GetCollectionID(), 10), fmt.Sprintf("drop index=%s", r.GetIndexName())
+	case *milvuspb.CreateAliasRequest:
+		return r.GetDbName(), r.GetAlias(), fmt.Sprintf("collection=%s", r.GetCollectionName())
+	case *milvuspb.DropAliasRequest:
+		return r.GetDbName(), r.GetAlias(), ""
+	case *milvuspb.AlterAliasRequest:
+		return r.GetDbName(), r.GetAlias(), fmt.Sprintf("collection=%s", r.GetCollectionName())
+	case *internalpb.AlterAliasesRequest:
+		aliases := make([]string, 0, len(r.GetChanges()))
+		changes := make([]string, 0, len(r.GetChanges()))
+		for _, change := range r.GetChanges() {
+			aliases = append(aliases, change.GetAlias())
+			changes = append(changes, change.GetAlias()+"="+change.GetCollectionName())
+		}
+		return r.GetDbName(), strings.Join(aliases, ","), fmt.Sprintf("aliases=[%s]", strings.Join(changes, ","))
+	case *milvuspb.CreateDatabaseRequest:
+		return r.GetDbName(), r.GetDbName(), fmt.Sprintf("properties=[%s]", PropertiesSummary(r.GetProperties()))
+	case *milvuspb.DropDatabaseRequest:
+		return r.GetDbName(), r.GetDbName(), ""
+	case *rootcoordpb.AlterDatabaseRequest:
+		return r.GetDbName(), r.GetDbName(), fmt.Sprintf("properties=[%s]", PropertiesSummary(r.GetProperties()))
+	case *internalpb.CredentialInfo:
+		return "", r.GetUsername(), fmt.Sprintf("credential of user=%s", r.GetUsername())
+	case *milvuspb.DeleteCredentialRequest:
+		return "", r.GetUsername(), ""
+	case *milvuspb.CreateRoleRequest:
+		return "", r.GetEntity().GetName(), ""
+	case *milvuspb.DropRoleRequest:
+		return "", r.GetRoleName(), ""
+	case *milvuspb.OperateUserRoleRequest:
+		return "", r.GetUsername(), fmt.Sprintf("%s role=%s", r.GetType(), r.GetRoleName())
+	case *milvuspb.OperatePrivilegeRequest:
+		entity := r.GetEntity()
+		return entity.GetDbName(), entity.GetRole().GetName(), fmt.Sprintf("%s privilege=%s, object=%s:%s",
+			r.GetType(), entity.GetGrantor().GetPrivilege().GetName(), entity.GetObject().GetName(), entity.GetObjectName())
+	}
+	return "", "", ""
+}
@@ -181,3 +181,23 @@ func (m *GrpcQueryCoordClient) TransferChannel(ctx context.Context, req *querypb
 func (m *GrpcQueryCoordClient) CheckQueryNodeDistribution(ctx context.Context, req *querypb.CheckQueryNodeDistributionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, m.Err
 }
+
+func (m *GrpcQueryCoordClient) GetResourceGroupRecommendations(ctx context.Context, req *querypb.GetResourceGroupRecommendationsRequest, opts ...grpc.CallOption) (*querypb.GetResourceGroupRecommendationsResponse, error) {
+	return &querypb.GetResourceGroupRecommendationsResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) PreviewBalance(ctx context.Context, req *querypb.PreviewBalanceRequest, opts ...grpc.CallOption) (*querypb.PreviewBalanceResponse, error) {
+	return &querypb.PreviewBalanceResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) DrainNode(ctx context.Context, req *querypb.DrainNodeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) CancelDrainNode(ctx context.Context, req *querypb.CancelDrainNodeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) GetDrainNodeProgress(ctx context.Context, req *querypb.GetDrainNodeProgressRequest, opts ...grpc.CallOption) (*querypb.GetDrainNodeProgressResponse, error) {
+	return &querypb.GetDrainNodeProgressResponse{}, m.Err
+}
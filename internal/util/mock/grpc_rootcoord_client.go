@@ -57,6 +57,38 @@ func (m *GrpcRootCoordClient) RenameCollection(ctx context.Context, in *milvuspb
 	return merr.Success(), nil
 }
 
+func (m *GrpcRootCoordClient) AddCollectionField(ctx context.Context, in *internalpb.AddCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
+func (m *GrpcRootCoordClient) DropCollectionField(ctx context.Context, in *internalpb.DropCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
+func (m *GrpcRootCoordClient) RenameCollectionField(ctx context.Context, in *internalpb.RenameCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
+func (m *GrpcRootCoordClient) AlterAliases(ctx context.Context, in *internalpb.AlterAliasesRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
+func (m *GrpcRootCoordClient) DropCollectionAsync(ctx context.Context, in *milvuspb.DropCollectionRequest, opts ...grpc.CallOption) (*internalpb.SubmitDDLJobResponse, error) {
+	return &internalpb.SubmitDDLJobResponse{Status: merr.Success()}, nil
+}
+
+func (m *GrpcRootCoordClient) DropDatabaseAsync(ctx context.Context, in *milvuspb.DropDatabaseRequest, opts ...grpc.CallOption) (*internalpb.SubmitDDLJobResponse, error) {
+	return &internalpb.SubmitDDLJobResponse{Status: merr.Success()}, nil
+}
+
+func (m *GrpcRootCoordClient) ListDDLJobs(ctx context.Context, in *internalpb.ListDDLJobsRequest, opts ...grpc.CallOption) (*internalpb.ListDDLJobsResponse, error) {
+	return &internalpb.ListDDLJobsResponse{Status: merr.Success()}, nil
+}
+
+func (m *GrpcRootCoordClient) GetDDLJob(ctx context.Context, in *internalpb.GetDDLJobRequest, opts ...grpc.CallOption) (*internalpb.GetDDLJobResponse, error) {
+	return &internalpb.GetDDLJobResponse{Status: merr.Success()}, nil
+}
+
 func (m *GrpcRootCoordClient) CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error) {
 	return &milvuspb.CheckHealthResponse{}, m.Err
 }
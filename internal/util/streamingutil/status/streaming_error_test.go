@@ -56,4 +56,16 @@ func TestStreamingError(t *testing.T) {
 	assert.False(t, streamingErr.IsWrongStreamingNode())
 	pbErr = streamingErr.AsPBError()
 	assert.Equal(t, streamingpb.StreamingCode_STREAMING_CODE_ON_SHUTDOWN, pbErr.Code)
+
+	streamingErr = NewTransactionExpired("test, %d", 1)
+	assert.Contains(t, streamingErr.Error(), "code: STREAMING_CODE_TRANSACTION_EXPIRED, cause: test, 1")
+	assert.False(t, streamingErr.IsWrongStreamingNode())
+	pbErr = streamingErr.AsPBError()
+	assert.Equal(t, streamingpb.StreamingCode_STREAMING_CODE_TRANSACTION_EXPIRED, pbErr.Code)
+
+	streamingErr = NewInvalidTransactionState("test, %d", 1)
+	assert.Contains(t, streamingErr.Error(), "code: STREAMING_CODE_INVALID_TRANSACTION_STATE, cause: test, 1")
+	assert.False(t, streamingErr.IsWrongStreamingNode())
+	pbErr = streamingErr.AsPBError()
+	assert.Equal(t, streamingpb.StreamingCode_STREAMING_CODE_INVALID_TRANSACTION_STATE, pbErr.Code)
 }
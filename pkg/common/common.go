@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cockroachdb/errors"
 
@@ -131,6 +132,14 @@ const (
 const (
 	CollectionTTLConfigKey      = "collection.ttl.seconds"
 	CollectionAutoCompactionKey = "collection.autocompaction.enabled"
+	// the deleted and overwritten rows are kept within the retention, so that they could be read by time travel
+	CollectionHistoryRetentionKey = "collection.history.retention.seconds"
+	// the schema version is increased by every online schema change, maintained by rootcoord
+	CollectionSchemaVersionKey = "collection.schema.version"
+	// the comma separated ids of the dropped fields, whose data is removed by compaction, maintained by rootcoord
+	CollectionDroppedFieldsKey = "collection.schema.dropped_fields"
+	// the database and name of the collection before renaming, maintained by rootcoord until the grants are moved
+	CollectionRenameFromKey = "collection.rename.from"
 
 	// rate limit
 	CollectionInsertRateMaxKey   = "collection.insertRate.max.mb"
@@ -149,11 +158,20 @@ const (
 
 	PartitionDiskQuotaKey = "partition.diskProtection.diskQuota.mb"
 
+	// the properties of each partition, kept in the collection properties with the keys returned by
+	// PartitionPropertyKey, e.g. partition.p20240101.autoDrop.days. The rows of the partition expire after
+	// the ttl like the collection ttl, and the partition is released and dropped by its age since creation.
+	PartitionTTLConfigKey       = "ttl.seconds"
+	PartitionAutoReleaseDaysKey = "autoRelease.days"
+	PartitionAutoDropDaysKey    = "autoDrop.days"
+
 	// database level properties
 	DatabaseReplicaNumber       = "database.replica.number"
 	DatabaseResourceGroups      = "database.resource_groups"
 	DatabaseDiskQuotaKey        = "database.diskQuota.mb"
 	DatabaseMaxCollectionsKey   = "database.max.collections"
+	DatabaseMaxPartitionsKey    = "database.max.partitions"
+	DatabaseMaxVectorDimSumKey  = "database.max.vector.dim.sum"
 	DatabaseForceDenyWritingKey = "database.force.deny.writing"
 
 	// collection level load properties
@@ -166,6 +184,14 @@ const (
 	MmapEnabledKey           = "mmap.enabled"
 	LazyLoadEnableKey        = "lazyload.enabled"
 	PartitionKeyIsolationKey = "partitionkey.isolation"
+
+	// PartitionKeyNumPartitionsKey increases the number of partitions of partition key by altering collection,
+	// the existing data is rewritten into the new partitions by compaction.
+	PartitionKeyNumPartitionsKey = "partitionkey.num_partitions"
+	// the number of partitions before increasing and the ts of increasing, maintained by rootcoord until
+	// all the existing data is rewritten
+	PartitionKeyRepartitionFromKey = "partitionkey.repartition.from"
+	PartitionKeyRepartitionTsKey   = "partitionkey.repartition.ts"
 )
 
 const (
@@ -252,6 +278,125 @@ func IsPartitionKeyIsolationPropEnabled(props map[string]string) (bool, error) {
 	return iso, nil
 }
 
+// CollectionHistoryRetention returns the history retention of collection, zero if it's not set.
+func CollectionHistoryRetention(props map[string]string) (time.Duration, error) {
+	val, ok := props[CollectionHistoryRetentionKey]
+	if !ok {
+		return 0, nil
+	}
+	seconds, err := strconv.ParseInt(val, 10, 64)
+	if err != nil || seconds < 0 {
+		return 0, fmt.Errorf("invalid collection property: [key=%s] [value=%s]", CollectionHistoryRetentionKey, val)
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
+
+// PartitionPropertyKey returns the key of the collection property keeping the property of the partition.
+func PartitionPropertyKey(partitionName string, key string) string {
+	return fmt.Sprintf("partition.%s.%s", partitionName, key)
+}
+
+func partitionPropertyDuration(props map[string]string, partitionName string, key string, unit time.Duration) (time.Duration, error) {
+	val, ok := props[PartitionPropertyKey(partitionName, key)]
+	if !ok {
+		return 0, nil
+	}
+	num, err := strconv.ParseInt(val, 10, 64)
+	if err != nil || num <= 0 {
+		return 0, fmt.Errorf("invalid collection property: [key=%s] [value=%s]", PartitionPropertyKey(partitionName, key), val)
+	}
+	return time.Duration(num) * unit, nil
+}
+
+// PartitionTTL returns the ttl of the rows in the partition, zero if not specified.
+func PartitionTTL(props map[string]string, partitionName string) (time.Duration, error) {
+	return partitionPropertyDuration(props, partitionName, PartitionTTLConfigKey, time.Second)
+}
+
+// PartitionLifecycle returns the ages after which the partition is released and dropped, zero if not specified.
+func PartitionLifecycle(props map[string]string, partitionName string) (releaseAfter time.Duration, dropAfter time.Duration, err error) {
+	if releaseAfter, err = partitionPropertyDuration(props, partitionName, PartitionAutoReleaseDaysKey, 24*time.Hour); err != nil {
+		return 0, 0, err
+	}
+	if dropAfter, err = partitionPropertyDuration(props, partitionName, PartitionAutoDropDaysKey, 24*time.Hour); err != nil {
+		return 0, 0, err
+	}
+	return releaseAfter, dropAfter, nil
+}
+
+// ValidatePartitionProperties checks the values of the partition properties in the collection properties.
+func ValidatePartitionProperties(props map[string]string) error {
+	for key := range props {
+		name, ok := strings.CutPrefix(key, "partition.")
+		if !ok {
+			continue
+		}
+		for _, suffix := range []string{PartitionTTLConfigKey, PartitionAutoReleaseDaysKey, PartitionAutoDropDaysKey} {
+			partitionName, ok := strings.CutSuffix(name, "."+suffix)
+			if !ok || partitionName == "" {
+				continue
+			}
+			if _, err := partitionPropertyDuration(props, partitionName, suffix, time.Second); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// PartitionKeyRepartition returns the number of partitions before the partitions of partition key are increased
+// and the ts since which the rows are routed by the increased partitions. The number is zero if the partitions are
+// not being increased, and the ts is zero if the new partitions are still being created.
+func PartitionKeyRepartition(props map[string]string) (fromNum int64, ts uint64, err error) {
+	fromVal, ok := props[PartitionKeyRepartitionFromKey]
+	if !ok {
+		return 0, 0, nil
+	}
+	fromNum, err = strconv.ParseInt(fromVal, 10, 64)
+	if err != nil || fromNum <= 0 {
+		return 0, 0, fmt.Errorf("invalid collection property: [key=%s] [value=%s]", PartitionKeyRepartitionFromKey, fromVal)
+	}
+	tsVal, ok := props[PartitionKeyRepartitionTsKey]
+	if !ok {
+		return fromNum, 0, nil
+	}
+	ts, err = strconv.ParseUint(tsVal, 10, 64)
+	if err != nil || ts == 0 {
+		return 0, 0, fmt.Errorf("invalid collection property: [key=%s] [value=%s]", PartitionKeyRepartitionTsKey, tsVal)
+	}
+	return fromNum, ts, nil
+}
+
+// CollectionSchemaVersion returns the schema version of collection, zero if the schema is never changed.
+func CollectionSchemaVersion(props map[string]string) (int64, error) {
+	val, ok := props[CollectionSchemaVersionKey]
+	if !ok {
+		return 0, nil
+	}
+	version, err := strconv.ParseInt(val, 10, 64)
+	if err != nil || version < 0 {
+		return 0, fmt.Errorf("invalid collection property: [key=%s] [value=%s]", CollectionSchemaVersionKey, val)
+	}
+	return version, nil
+}
+
+// CollectionDroppedFields returns the ids of the fields dropped from the collection schema.
+func CollectionDroppedFields(props map[string]string) ([]int64, error) {
+	val, ok := props[CollectionDroppedFieldsKey]
+	if !ok || val == "" {
+		return nil, nil
+	}
+	fieldIDs := make([]int64, 0)
+	for _, s := range strings.Split(val, ",") {
+		fieldID, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+		if err != nil || fieldID < StartOfUserFieldID {
+			return nil, fmt.Errorf("invalid collection property: [key=%s] [value=%s]", CollectionDroppedFieldsKey, val)
+		}
+		fieldIDs = append(fieldIDs, fieldID)
+	}
+	return fieldIDs, nil
+}
+
 const (
 	// LatestVerision is the magic number for watch latest revision
 	LatestRevision = int64(-1)
@@ -292,6 +437,21 @@ func DatabaseLevelResourceGroups(kvs []*commonpb.KeyValuePair) ([]string, error)
 	return nil, fmt.Errorf("database property not found: %s", DatabaseResourceGroups)
 }
 
+// DatabaseLevelLimit returns the limit set by the database property,
+// 0 means the property is not set or set to 0, both mean unlimited.
+func DatabaseLevelLimit(kvs []*commonpb.KeyValuePair, key string) (int64, error) {
+	for _, kv := range kvs {
+		if kv.Key == key {
+			limit, err := strconv.ParseInt(kv.Value, 10, 64)
+			if err != nil || limit < 0 {
+				return 0, fmt.Errorf("invalid database property: [key=%s] [value=%s]", kv.Key, kv.Value)
+			}
+			return limit, nil
+		}
+	}
+	return 0, nil
+}
+
 func CollectionLevelReplicaNumber(kvs []*commonpb.KeyValuePair) (int64, error) {
 	for _, kv := range kvs {
 		if kv.Key == CollectionReplicaNumber {
@@ -3,6 +3,7 @@ package common
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -89,6 +90,80 @@ func TestDatabaseProperties(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestDatabaseLevelLimit(t *testing.T) {
+	props := []*commonpb.KeyValuePair{
+		{Key: DatabaseMaxPartitionsKey, Value: "100"},
+		{Key: DatabaseMaxVectorDimSumKey, Value: "xxxx"},
+		{Key: DatabaseMaxCollectionsKey, Value: "-1"},
+		{Key: DatabaseReplicaNumber, Value: "0"},
+	}
+
+	limit, err := DatabaseLevelLimit(props, DatabaseMaxPartitionsKey)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), limit)
+
+	_, err = DatabaseLevelLimit(props, DatabaseMaxVectorDimSumKey)
+	assert.Error(t, err)
+	_, err = DatabaseLevelLimit(props, DatabaseMaxCollectionsKey)
+	assert.Error(t, err)
+
+	// 0 is the same as not set
+	limit, err = DatabaseLevelLimit(props, DatabaseReplicaNumber)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), limit)
+
+	// not set
+	limit, err = DatabaseLevelLimit(props, DatabaseDiskQuotaKey)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), limit)
+}
+
+func TestPartitionLifecycle(t *testing.T) {
+	releaseAfter, dropAfter, err := PartitionLifecycle(map[string]string{}, "p1")
+	assert.NoError(t, err)
+	assert.Zero(t, releaseAfter)
+	assert.Zero(t, dropAfter)
+
+	props := map[string]string{
+		PartitionPropertyKey("p1", PartitionAutoReleaseDaysKey): "7",
+		PartitionPropertyKey("p1", PartitionAutoDropDaysKey):    "30",
+		PartitionPropertyKey("p1", PartitionTTLConfigKey):       "3600",
+	}
+	assert.Equal(t, "partition.p1.autoDrop.days", PartitionPropertyKey("p1", PartitionAutoDropDaysKey))
+	releaseAfter, dropAfter, err = PartitionLifecycle(props, "p1")
+	assert.NoError(t, err)
+	assert.Equal(t, 7*24*time.Hour, releaseAfter)
+	// the ttl doesn't drop the partition
+	assert.Equal(t, 30*24*time.Hour, dropAfter)
+	ttl, err := PartitionTTL(props, "p1")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Hour, ttl)
+
+	// the properties are set for each partition
+	releaseAfter, dropAfter, err = PartitionLifecycle(props, "p2")
+	assert.NoError(t, err)
+	assert.Zero(t, releaseAfter)
+	assert.Zero(t, dropAfter)
+	ttl, err = PartitionTTL(props, "p2")
+	assert.NoError(t, err)
+	assert.Zero(t, ttl)
+	assert.NoError(t, ValidatePartitionProperties(props))
+
+	for _, key := range []string{PartitionTTLConfigKey, PartitionAutoReleaseDaysKey, PartitionAutoDropDaysKey} {
+		for _, val := range []string{"0", "abc"} {
+			props := map[string]string{PartitionPropertyKey("p1", key): val}
+			_, _, err = PartitionLifecycle(props, "p1")
+			if key != PartitionTTLConfigKey {
+				assert.Error(t, err, key)
+			}
+			assert.Error(t, ValidatePartitionProperties(props), key)
+		}
+	}
+	_, err = PartitionTTL(map[string]string{PartitionPropertyKey("p1", PartitionTTLConfigKey): "abc"}, "p1")
+	assert.Error(t, err)
+	assert.NoError(t, ValidatePartitionProperties(map[string]string{PartitionDiskQuotaKey: "abc"}))
+}
+
 func TestCommonPartitionKeyIsolation(t *testing.T) {
 	getProto := func(val string) []*commonpb.KeyValuePair {
 		return []*commonpb.KeyValuePair{
@@ -149,3 +224,48 @@ func TestCommonPartitionKeyIsolation(t *testing.T) {
 		assert.False(t, res)
 	})
 }
+
+func TestCollectionHistoryRetention(t *testing.T) {
+	retention, err := CollectionHistoryRetention(map[string]string{})
+	assert.NoError(t, err)
+	assert.Zero(t, retention)
+
+	retention, err = CollectionHistoryRetention(map[string]string{CollectionHistoryRetentionKey: "86400"})
+	assert.NoError(t, err)
+	assert.Equal(t, 24*time.Hour, retention)
+
+	for _, val := range []string{"", "-1", "1d"} {
+		_, err = CollectionHistoryRetention(map[string]string{CollectionHistoryRetentionKey: val})
+		assert.Error(t, err, val)
+	}
+}
+
+func TestCollectionSchemaVersion(t *testing.T) {
+	version, err := CollectionSchemaVersion(map[string]string{})
+	assert.NoError(t, err)
+	assert.Zero(t, version)
+
+	version, err = CollectionSchemaVersion(map[string]string{CollectionSchemaVersionKey: "2"})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, version)
+
+	for _, val := range []string{"", "-1", "v1"} {
+		_, err = CollectionSchemaVersion(map[string]string{CollectionSchemaVersionKey: val})
+		assert.Error(t, err, val)
+	}
+}
+
+func TestCollectionDroppedFields(t *testing.T) {
+	fieldIDs, err := CollectionDroppedFields(map[string]string{})
+	assert.NoError(t, err)
+	assert.Empty(t, fieldIDs)
+
+	fieldIDs, err = CollectionDroppedFields(map[string]string{CollectionDroppedFieldsKey: "101,103"})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{101, 103}, fieldIDs)
+
+	for _, val := range []string{"101,", "1", "f"} {
+		_, err = CollectionDroppedFields(map[string]string{CollectionDroppedFieldsKey: val})
+		assert.Error(t, err, val)
+	}
+}
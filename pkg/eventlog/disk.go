@@ -0,0 +1,348 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventlog
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/conc"
+)
+
+const (
+	diskLogFilePrefix = "events-"
+	diskLogFileSuffix = ".log"
+
+	// max length of a persisted event line
+	diskLogMaxLineSize = 1024 * 1024
+)
+
+var (
+	diskLog   atomic.Pointer[DiskLogger]
+	sfDiskLog conc.Singleflight[*DiskLogger]
+)
+
+// Entry is the persisted form of an event.
+type Entry struct {
+	Ts           int64  `json:"ts"`
+	Level        Level  `json:"level"`
+	Type         int32  `json:"type"`
+	CollectionID int64  `json:"collection_id,omitempty"`
+	Data         string `json:"data"`
+}
+
+// QueryFilter filters the persisted events, the zero value fields match all events.
+type QueryFilter struct {
+	// the minimal level of events
+	Level        Level
+	Types        []int32
+	CollectionID int64
+	Start        time.Time
+	End          time.Time
+	// only the latest events are returned if the limit is exceeded
+	Limit int
+}
+
+func (f *QueryFilter) match(entry *Entry) bool {
+	if entry.Level < f.Level {
+		return false
+	}
+	if len(f.Types) > 0 && !containsType(f.Types, entry.Type) {
+		return false
+	}
+	if f.CollectionID != 0 && entry.CollectionID != f.CollectionID {
+		return false
+	}
+	if !f.Start.IsZero() && entry.Ts < f.Start.UnixNano() {
+		return false
+	}
+	if !f.End.IsZero() && entry.Ts > f.End.UnixNano() {
+		return false
+	}
+	return true
+}
+
+func containsType(types []int32, tp int32) bool {
+	for _, t := range types {
+		if t == tp {
+			return true
+		}
+	}
+	return false
+}
+
+// DiskLogger is a Logger persisting events into a ring of files on local disk.
+// A new file is started once the current one exceeds maxFileSize, and the oldest file
+// is removed when there are more than maxFiles files, so the recent events could still
+// be queried after nobody listened to them.
+type DiskLogger struct {
+	level       atomic.Int32
+	dir         string
+	maxFileSize int64
+	maxFiles    int
+
+	mu   sync.Mutex
+	seqs []int64 // sequence numbers of files, oldest first
+	file *os.File
+	size int64
+}
+
+// NewDiskLogger creates a DiskLogger under dir, the events persisted before are kept.
+func NewDiskLogger(dir string, maxFileSize int64, maxFiles int) (*DiskLogger, error) {
+	if maxFileSize <= 0 || maxFiles <= 0 {
+		return nil, fmt.Errorf("invalid disk event log size, maxFileSize: %d, maxFiles: %d", maxFileSize, maxFiles)
+	}
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	seqs := make([]int64, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, diskLogFilePrefix) || !strings.HasSuffix(name, diskLogFileSuffix) {
+			continue
+		}
+		seq, err := strconv.ParseInt(strings.TrimSuffix(strings.TrimPrefix(name, diskLogFilePrefix), diskLogFileSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		seqs = append(seqs, seq)
+	}
+	sort.Slice(seqs, func(i, j int) bool { return seqs[i] < seqs[j] })
+
+	l := &DiskLogger{
+		dir:         dir,
+		maxFileSize: maxFileSize,
+		maxFiles:    maxFiles,
+		seqs:        seqs,
+	}
+	l.SetLevel(Level_Info)
+	if len(seqs) == 0 {
+		l.seqs = append(l.seqs, 0)
+	}
+	if err := l.openFile(l.seqs[len(l.seqs)-1]); err != nil {
+		return nil, err
+	}
+	l.truncateFiles()
+	return l, nil
+}
+
+func (l *DiskLogger) filePath(seq int64) string {
+	return filepath.Join(l.dir, fmt.Sprintf("%s%d%s", diskLogFilePrefix, seq, diskLogFileSuffix))
+}
+
+func (l *DiskLogger) openFile(seq int64) error {
+	file, err := os.OpenFile(l.filePath(seq), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	l.file = file
+	l.size = info.Size()
+	return nil
+}
+
+// rotate starts a new file and removes the oldest files exceeding the limit.
+func (l *DiskLogger) rotate() error {
+	if err := l.file.Close(); err != nil {
+		return err
+	}
+	seq := l.seqs[len(l.seqs)-1] + 1
+	if err := l.openFile(seq); err != nil {
+		return err
+	}
+	l.seqs = append(l.seqs, seq)
+	l.truncateFiles()
+	return nil
+}
+
+func (l *DiskLogger) truncateFiles() {
+	for len(l.seqs) > l.maxFiles {
+		if err := os.Remove(l.filePath(l.seqs[0])); err != nil && !os.IsNotExist(err) {
+			log.Warn("failed to remove event log file", zap.String("file", l.filePath(l.seqs[0])), zap.Error(err))
+		}
+		l.seqs = l.seqs[1:]
+	}
+}
+
+func (l *DiskLogger) SetLevel(lvl Level) {
+	l.level.Store(int32(lvl))
+}
+
+func (l *DiskLogger) GetLevel() Level {
+	return Level(l.level.Load())
+}
+
+// Record implements `Logger`, appends the event to the current file without buffering,
+// so that the events are kept even if the process crashes.
+func (l *DiskLogger) Record(evt Evt) {
+	if evt.Level() < l.GetLevel() {
+		return
+	}
+	entry := &Entry{
+		Ts:    time.Now().UnixNano(),
+		Level: evt.Level(),
+		Type:  evt.Type(),
+		Data:  string(evt.Raw()),
+	}
+	if collEvt, ok := evt.(CollectionEvt); ok {
+		entry.CollectionID = collEvt.CollectionID()
+	}
+	bs, err := json.Marshal(entry)
+	if err != nil {
+		log.RatedWarn(60, "failed to marshal event", zap.Error(err))
+		return
+	}
+	bs = append(bs, '\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.file == nil {
+		return
+	}
+	if l.size > 0 && l.size+int64(len(bs)) > l.maxFileSize {
+		if err := l.rotate(); err != nil {
+			log.RatedWarn(60, "failed to rotate event log file", zap.String("dir", l.dir), zap.Error(err))
+			return
+		}
+	}
+	n, err := l.file.Write(bs)
+	l.size += int64(n)
+	if err != nil {
+		log.RatedWarn(60, "failed to write event log", zap.String("dir", l.dir), zap.Error(err))
+	}
+}
+
+// RecordFunc implements `Logger`.
+func (l *DiskLogger) RecordFunc(lvl Level, fn func() Evt) {
+	if lvl < l.GetLevel() {
+		return
+	}
+	l.Record(fn())
+}
+
+// Flush implements `Logger`, syncs the current file to disk.
+func (l *DiskLogger) Flush() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.file == nil {
+		return nil
+	}
+	return l.file.Sync()
+}
+
+// Close closes the current file, the events recorded afterwards are dropped.
+func (l *DiskLogger) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.file == nil {
+		return nil
+	}
+	err := l.file.Close()
+	l.file = nil
+	return err
+}
+
+// Query returns the persisted events matching the filter, oldest first.
+// The files are scanned without holding the lock, so that recording is not blocked by the query,
+// a file removed by rotation meanwhile is skipped and the line being written is dropped as broken.
+func (l *DiskLogger) Query(filter QueryFilter) ([]*Entry, error) {
+	l.mu.Lock()
+	seqs := slices.Clone(l.seqs)
+	l.mu.Unlock()
+
+	result := make([]*Entry, 0)
+	for _, seq := range seqs {
+		entries, err := l.readFile(seq, &filter)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, entries...)
+		if filter.Limit > 0 && len(result) > filter.Limit {
+			result = result[len(result)-filter.Limit:]
+		}
+	}
+	return result, nil
+}
+
+func (l *DiskLogger) readFile(seq int64, filter *QueryFilter) ([]*Entry, error) {
+	file, err := os.OpenFile(l.filePath(seq), os.O_RDONLY, 0)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer file.Close()
+
+	entries := make([]*Entry, 0)
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), diskLogMaxLineSize)
+	for scanner.Scan() {
+		entry := &Entry{}
+		// skip the broken line, which may be left by a crash
+		if err := json.Unmarshal(scanner.Bytes(), entry); err != nil {
+			continue
+		}
+		if filter.match(entry) {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, scanner.Err()
+}
+
+// InitDiskLogger starts the singleton DiskLogger under dir and registers it to the global logger.
+func InitDiskLogger(dir string, maxFileSize int64, maxFiles int) error {
+	if diskLog.Load() != nil {
+		return nil
+	}
+	_, err, _ := sfDiskLog.Do("disk_evt_log", func() (*DiskLogger, error) {
+		if l := diskLog.Load(); l != nil {
+			return l, nil
+		}
+		l, err := NewDiskLogger(dir, maxFileSize, maxFiles)
+		if err != nil {
+			return nil, err
+		}
+		diskLog.Store(l)
+		getGlobalLogger().Register("disk_logger", l)
+		log.Info("disk event logger started", zap.String("dir", dir),
+			zap.Int64("maxFileSize", maxFileSize), zap.Int("maxFiles", maxFiles))
+		return l, nil
+	})
+	return err
+}
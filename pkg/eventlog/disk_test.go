@@ -0,0 +1,186 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type DiskLoggerSuite struct {
+	suite.Suite
+
+	dir string
+}
+
+func (s *DiskLoggerSuite) SetupTest() {
+	s.dir = s.T().TempDir()
+}
+
+func (s *DiskLoggerSuite) TestRecordAndQuery() {
+	l, err := NewDiskLogger(s.dir, 1024*1024, 2)
+	s.Require().NoError(err)
+	defer l.Close()
+
+	l.Record(NewRawEvt(Level_Debug, "debug"))
+	l.Record(NewRawEvt(Level_Info, "info"))
+	l.Record(NewCollectionRawEvt(Level_Warn, 100, "warn"))
+	l.RecordFunc(Level_Error, func() Evt { return &rawEvt{level: Level_Error, tp: 1, collectionID: 101, data: []byte("error")} })
+	s.NoError(l.Flush())
+
+	entries, err := l.Query(QueryFilter{})
+	s.Require().NoError(err)
+	s.Require().Len(entries, 3)
+	s.Equal("info", entries[0].Data)
+	s.EqualValues(100, entries[1].CollectionID)
+
+	entries, err = l.Query(QueryFilter{Level: Level_Warn})
+	s.Require().NoError(err)
+	s.Len(entries, 2)
+
+	entries, err = l.Query(QueryFilter{Types: []int32{1}})
+	s.Require().NoError(err)
+	s.Require().Len(entries, 1)
+	s.Equal("error", entries[0].Data)
+
+	entries, err = l.Query(QueryFilter{CollectionID: 100})
+	s.Require().NoError(err)
+	s.Require().Len(entries, 1)
+	s.Equal("warn", entries[0].Data)
+
+	entries, err = l.Query(QueryFilter{Start: time.Now().Add(time.Hour)})
+	s.Require().NoError(err)
+	s.Empty(entries)
+
+	entries, err = l.Query(QueryFilter{End: time.Now()})
+	s.Require().NoError(err)
+	s.Len(entries, 3)
+
+	entries, err = l.Query(QueryFilter{Limit: 1})
+	s.Require().NoError(err)
+	s.Require().Len(entries, 1)
+	s.Equal("error", entries[0].Data)
+}
+
+func (s *DiskLoggerSuite) TestRotate() {
+	l, err := NewDiskLogger(s.dir, 128, 3)
+	s.Require().NoError(err)
+
+	for i := 0; i < 20; i++ {
+		l.Record(NewRawEvt(Level_Info, fmt.Sprintf("event-%02d", i)))
+	}
+	files, err := os.ReadDir(s.dir)
+	s.Require().NoError(err)
+	s.Len(files, 3)
+
+	entries, err := l.Query(QueryFilter{})
+	s.Require().NoError(err)
+	s.NotEmpty(entries)
+	s.Less(len(entries), 20)
+	s.Equal("event-19", entries[len(entries)-1].Data)
+	s.NoError(l.Close())
+
+	// events are kept after reopen
+	l, err = NewDiskLogger(s.dir, 128, 3)
+	s.Require().NoError(err)
+	defer l.Close()
+	reopened, err := l.Query(QueryFilter{})
+	s.Require().NoError(err)
+	s.Equal(entries, reopened)
+
+	l.Record(NewRawEvt(Level_Info, "event-20"))
+	entries, err = l.Query(QueryFilter{Limit: 1})
+	s.Require().NoError(err)
+	s.Equal("event-20", entries[0].Data)
+}
+
+func (s *DiskLoggerSuite) TestQueryWhileRecording() {
+	l, err := NewDiskLogger(s.dir, 128, 3)
+	s.Require().NoError(err)
+	defer l.Close()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 200; i++ {
+			l.Record(NewRawEvt(Level_Info, fmt.Sprintf("event-%03d", i)))
+		}
+	}()
+	for i := 0; i < 20; i++ {
+		_, err := l.Query(QueryFilter{})
+		s.NoError(err)
+	}
+	<-done
+
+	entries, err := l.Query(QueryFilter{Limit: 1})
+	s.Require().NoError(err)
+	s.Equal("event-199", entries[0].Data)
+}
+
+func (s *DiskLoggerSuite) TestInvalidParam() {
+	_, err := NewDiskLogger(s.dir, 0, 1)
+	s.Error(err)
+	_, err = NewDiskLogger(s.dir, 1024, 0)
+	s.Error(err)
+}
+
+func (s *DiskLoggerSuite) TestQueryHandler() {
+	diskLog.Store(nil)
+	handler := QueryHandler()
+
+	req := httptest.NewRequest(http.MethodGet, "/eventlog/query", nil)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	s.Equal(http.StatusServiceUnavailable, w.Code)
+
+	s.Require().NoError(InitDiskLogger(s.dir, 1024*1024, 2))
+	defer func() {
+		diskLog.Load().Close()
+		diskLog.Store(nil)
+		getGlobalLogger().loggers.Remove("disk_logger")
+	}()
+	Record(NewCollectionRawEvt(Level_Info, 100, "info"))
+	Record(NewCollectionRawEvt(Level_Warn, 101, "warn"))
+
+	req = httptest.NewRequest(http.MethodGet, "/eventlog/query?level=WARN&collection_id=101", nil)
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	s.Equal(http.StatusOK, w.Code)
+	resp := &eventLogQueryResponse{}
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), resp))
+	s.Require().Len(resp.Events, 1)
+	s.Equal("Warn", resp.Events[0].Level)
+	s.Equal("warn", resp.Events[0].Data)
+
+	for _, query := range []string{"level=fatal", "type=a", "collection_id=a", "start=yesterday", "end=1", "limit=0"} {
+		req = httptest.NewRequest(http.MethodGet, "/eventlog/query?"+query, nil)
+		w = httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		s.Equal(http.StatusBadRequest, w.Code, query)
+	}
+}
+
+func TestDiskLogger(t *testing.T) {
+	suite.Run(t, new(DiskLoggerSuite))
+}
@@ -18,9 +18,10 @@ package eventlog
 
 // rawEvt implement `Evt` interface with plain event msg.
 type rawEvt struct {
-	level Level
-	tp    int32
-	data  []byte
+	level        Level
+	tp           int32
+	collectionID int64
+	data         []byte
 }
 
 func (l *rawEvt) Level() Level {
@@ -35,9 +36,22 @@ func (l *rawEvt) Raw() []byte {
 	return l.data
 }
 
+func (l *rawEvt) CollectionID() int64 {
+	return l.collectionID
+}
+
 func NewRawEvt(level Level, data string) Evt {
 	return &rawEvt{
 		level: level,
 		data:  []byte(data),
 	}
 }
+
+// NewCollectionRawEvt creates a raw event related to the collection.
+func NewCollectionRawEvt(level Level, collectionID int64, data string) Evt {
+	return &rawEvt{
+		level:        level,
+		collectionID: collectionID,
+		data:         []byte(data),
+	}
+}
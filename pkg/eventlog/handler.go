@@ -18,7 +18,11 @@ package eventlog
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -72,3 +76,124 @@ func writeJSON(w http.ResponseWriter, r *http.Request, resp *eventLogResponse) {
 	}
 	w.Write(bs)
 }
+
+const defaultQueryLimit = 1000
+
+type eventLogQueryHandler struct{}
+
+// QueryHandler returns the handler to query the events persisted by disk logger.
+func QueryHandler() http.Handler {
+	return &eventLogQueryHandler{}
+}
+
+type eventLogQueryResponse struct {
+	Status int           `json:"status"`
+	Msg    string        `json:"msg,omitempty"`
+	Events []*queryEvent `json:"events"`
+}
+
+type queryEvent struct {
+	Time         string `json:"time"`
+	Level        string `json:"level"`
+	Type         int32  `json:"type"`
+	CollectionID int64  `json:"collection_id,omitempty"`
+	Data         string `json:"data"`
+}
+
+func (h *eventLogQueryHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	l := diskLog.Load()
+	if l == nil {
+		writeQueryJSON(w, http.StatusServiceUnavailable, &eventLogQueryResponse{Status: http.StatusServiceUnavailable, Msg: "disk event log not enabled"})
+		return
+	}
+	filter, err := parseQueryFilter(r)
+	if err != nil {
+		writeQueryJSON(w, http.StatusBadRequest, &eventLogQueryResponse{Status: http.StatusBadRequest, Msg: err.Error()})
+		return
+	}
+	entries, err := l.Query(filter)
+	if err != nil {
+		writeQueryJSON(w, http.StatusInternalServerError, &eventLogQueryResponse{Status: http.StatusInternalServerError, Msg: err.Error()})
+		return
+	}
+	resp := &eventLogQueryResponse{
+		Status: http.StatusOK,
+		Events: make([]*queryEvent, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		resp.Events = append(resp.Events, &queryEvent{
+			Time:         time.Unix(0, entry.Ts).Format(time.RFC3339Nano),
+			Level:        entry.Level.String(),
+			Type:         entry.Type,
+			CollectionID: entry.CollectionID,
+			Data:         entry.Data,
+		})
+	}
+	writeQueryJSON(w, http.StatusOK, resp)
+}
+
+// parseQueryFilter parses the filter from url query, e.g.
+// ?level=warn&type=0,1&collection_id=100&start=2024-01-01T00:00:00Z&end=2024-01-02T00:00:00Z&limit=100
+func parseQueryFilter(r *http.Request) (QueryFilter, error) {
+	query := r.URL.Query()
+	filter := QueryFilter{Limit: defaultQueryLimit}
+	if v := query.Get("level"); v != "" {
+		level, ok := parseLevel(v)
+		if !ok {
+			return filter, fmt.Errorf("invalid level %s", v)
+		}
+		filter.Level = level
+	}
+	if v := query.Get("type"); v != "" {
+		for _, s := range strings.Split(v, ",") {
+			tp, err := strconv.ParseInt(strings.TrimSpace(s), 10, 32)
+			if err != nil {
+				return filter, fmt.Errorf("invalid type %s", s)
+			}
+			filter.Types = append(filter.Types, int32(tp))
+		}
+	}
+	if v := query.Get("collection_id"); v != "" {
+		collectionID, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return filter, fmt.Errorf("invalid collection_id %s", v)
+		}
+		filter.CollectionID = collectionID
+	}
+	var err error
+	if v := query.Get("start"); v != "" {
+		if filter.Start, err = time.Parse(time.RFC3339, v); err != nil {
+			return filter, fmt.Errorf("invalid start %s, RFC3339 time expected", v)
+		}
+	}
+	if v := query.Get("end"); v != "" {
+		if filter.End, err = time.Parse(time.RFC3339, v); err != nil {
+			return filter, fmt.Errorf("invalid end %s, RFC3339 time expected", v)
+		}
+	}
+	if v := query.Get("limit"); v != "" {
+		if filter.Limit, err = strconv.Atoi(v); err != nil || filter.Limit <= 0 {
+			return filter, fmt.Errorf("invalid limit %s", v)
+		}
+	}
+	return filter, nil
+}
+
+func parseLevel(v string) (Level, bool) {
+	for value, name := range Level_name {
+		if strings.EqualFold(name, v) {
+			return Level(value), true
+		}
+	}
+	return Level_Undefined, false
+}
+
+func writeQueryJSON(w http.ResponseWriter, status int, resp *eventLogQueryResponse) {
+	w.Header().Set(ContentTypeHeader, ContentTypeJSON)
+	bs, err := json.Marshal(resp)
+	if err != nil {
+		log.Warn("faild to send response", zap.Error(err))
+	}
+	w.WriteHeader(status)
+	w.Write(bs)
+}
@@ -34,6 +34,11 @@ type Evt interface {
 	Raw() []byte
 }
 
+// CollectionEvt is implemented by the events related to a collection.
+type CollectionEvt interface {
+	CollectionID() int64
+}
+
 // Record is the global helper function to `globalLogger.Record`.
 func Record(evt Evt) {
 	getGlobalLogger().Record(evt)
@@ -26,6 +26,7 @@ const (
 	DataStatLabel   = "stat"
 
 	persistentDataOpType = "persistent_data_op_type"
+	binlogEventType      = "binlog_event_type"
 )
 
 var (
@@ -54,6 +55,38 @@ var (
 			Name:      "op_count",
 			Help:      "count of persistent data operation",
 		}, []string{persistentDataOpType, statusLabelName})
+
+	PersistentDataCacheAccessCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "storage",
+			Name:      "local_cache_access_count",
+			Help:      "count of persistent data read through local disk cache, labeled by hit or miss",
+		}, []string{cacheStateLabelName})
+
+	PersistentDataCacheSize = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "storage",
+			Name:      "local_cache_size",
+			Help:      "size in bytes of persistent data cached on local disk",
+		})
+
+	PersistentDataCacheEvictCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "storage",
+			Name:      "local_cache_evict_count",
+			Help:      "count of persistent data evicted from local disk cache",
+		})
+
+	PersistentDataChecksumMismatchCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "storage",
+			Name:      "binlog_checksum_mismatch_count",
+			Help:      "count of binlog events failed the checksum verification on read",
+		}, []string{binlogEventType})
 )
 
 // RegisterStorageMetrics registers storage metrics
@@ -61,4 +94,8 @@ func RegisterStorageMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(PersistentDataKvSize)
 	registry.MustRegister(PersistentDataRequestLatency)
 	registry.MustRegister(PersistentDataOpCounter)
+	registry.MustRegister(PersistentDataCacheAccessCounter)
+	registry.MustRegister(PersistentDataCacheSize)
+	registry.MustRegister(PersistentDataCacheEvictCounter)
+	registry.MustRegister(PersistentDataChecksumMismatchCounter)
 }
@@ -232,6 +232,49 @@ func (_c *MockMutableMessage_Properties_Call) RunAndReturn(run func() message.RP
 	return _c
 }
 
+// TxnContext provides a mock function with given fields:
+func (_m *MockMutableMessage) TxnContext() *message.TxnContext {
+	ret := _m.Called()
+
+	var r0 *message.TxnContext
+	if rf, ok := ret.Get(0).(func() *message.TxnContext); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*message.TxnContext)
+		}
+	}
+
+	return r0
+}
+
+// MockMutableMessage_TxnContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TxnContext'
+type MockMutableMessage_TxnContext_Call struct {
+	*mock.Call
+}
+
+// TxnContext is a helper method to define mock.On call
+func (_e *MockMutableMessage_Expecter) TxnContext() *MockMutableMessage_TxnContext_Call {
+	return &MockMutableMessage_TxnContext_Call{Call: _e.mock.On("TxnContext")}
+}
+
+func (_c *MockMutableMessage_TxnContext_Call) Run(run func()) *MockMutableMessage_TxnContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockMutableMessage_TxnContext_Call) Return(_a0 *message.TxnContext) *MockMutableMessage_TxnContext_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMutableMessage_TxnContext_Call) RunAndReturn(run func() *message.TxnContext) *MockMutableMessage_TxnContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Version provides a mock function with given fields:
 func (_m *MockMutableMessage) Version() message.Version {
 	ret := _m.Called()
@@ -361,6 +404,50 @@ func (_c *MockMutableMessage_WithTimeTick_Call) RunAndReturn(run func(uint64) me
 	return _c
 }
 
+// WithTxnContext provides a mock function with given fields: txnCtx
+func (_m *MockMutableMessage) WithTxnContext(txnCtx message.TxnContext) message.MutableMessage {
+	ret := _m.Called(txnCtx)
+
+	var r0 message.MutableMessage
+	if rf, ok := ret.Get(0).(func(message.TxnContext) message.MutableMessage); ok {
+		r0 = rf(txnCtx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(message.MutableMessage)
+		}
+	}
+
+	return r0
+}
+
+// MockMutableMessage_WithTxnContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithTxnContext'
+type MockMutableMessage_WithTxnContext_Call struct {
+	*mock.Call
+}
+
+// WithTxnContext is a helper method to define mock.On call
+//   - txnCtx message.TxnContext
+func (_e *MockMutableMessage_Expecter) WithTxnContext(txnCtx interface{}) *MockMutableMessage_WithTxnContext_Call {
+	return &MockMutableMessage_WithTxnContext_Call{Call: _e.mock.On("WithTxnContext", txnCtx)}
+}
+
+func (_c *MockMutableMessage_WithTxnContext_Call) Run(run func(txnCtx message.TxnContext)) *MockMutableMessage_WithTxnContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(message.TxnContext))
+	})
+	return _c
+}
+
+func (_c *MockMutableMessage_WithTxnContext_Call) Return(_a0 message.MutableMessage) *MockMutableMessage_WithTxnContext_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMutableMessage_WithTxnContext_Call) RunAndReturn(run func(message.TxnContext) message.MutableMessage) *MockMutableMessage_WithTxnContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WithVChannel provides a mock function with given fields: vChannel
 func (_m *MockMutableMessage) WithVChannel(vChannel string) message.MutableMessage {
 	ret := _m.Called(vChannel)
@@ -25,6 +25,10 @@ type BasicMessage interface {
 	// Properties returns the message properties.
 	// Should be used with read-only promise.
 	Properties() RProperties
+
+	// TxnContext returns the transaction context of current message.
+	// Return nil if the message is not in a transaction.
+	TxnContext() *TxnContext
 }
 
 // MutableMessage is the mutable message interface.
@@ -44,6 +48,11 @@ type MutableMessage interface {
 	// !!! preserved for streaming system internal usage, don't call it outside of log system.
 	WithVChannel(vChannel string) MutableMessage
 
+	// WithTxnContext sets the transaction context of current message.
+	// Client should use it to attach a message into an ongoing transaction,
+	// the txn id is assigned by wal when the begin message is appended.
+	WithTxnContext(txnCtx TxnContext) MutableMessage
+
 	// IntoImmutableMessage converts the mutable message to immutable message.
 	IntoImmutableMessage(msgID MessageID) ImmutableMessage
 }
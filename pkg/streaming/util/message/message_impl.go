@@ -43,6 +43,25 @@ func (m *messageImpl) EstimateSize() int {
 	return len(m.payload) + m.properties.EstimateSize()
 }
 
+// TxnContext returns the transaction context of current message.
+func (m *messageImpl) TxnContext() *TxnContext {
+	value, ok := m.properties.Get(messageTxnContext)
+	if !ok {
+		return nil
+	}
+	txnCtx, err := unmarshalTxnContext(value)
+	if err != nil {
+		panic(fmt.Sprintf("there's a bug in the message codes, dirty txn context %s in properties of message", value))
+	}
+	return txnCtx
+}
+
+// WithTxnContext sets the transaction context of current message.
+func (m *messageImpl) WithTxnContext(txnCtx TxnContext) MutableMessage {
+	m.properties.Set(messageTxnContext, txnCtx.marshal())
+	return m
+}
+
 // WithVChannel sets the virtual channel of current message.
 func (m *messageImpl) WithVChannel(vChannel string) MutableMessage {
 	m.properties.Set(messageVChannel, vChannel)
@@ -20,6 +20,12 @@ func TestMessageType(t *testing.T) {
 	typ = unmarshalMessageType(s)
 	assert.Equal(t, MessageTypeTimeTick, typ)
 	assert.True(t, MessageTypeTimeTick.Valid())
+
+	for _, typ := range []MessageType{MessageTypeBeginTxn, MessageTypeCommitTxn, MessageTypeRollbackTxn} {
+		assert.True(t, typ.Valid())
+		assert.NotEmpty(t, typ.String())
+		assert.Equal(t, typ, unmarshalMessageType(typ.marshal()))
+	}
 }
 
 func TestVersion(t *testing.T) {
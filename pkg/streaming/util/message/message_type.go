@@ -18,6 +18,12 @@ const (
 	MessageTypeDropCollection   MessageType = MessageType(commonpb.MsgType_DropCollection)
 	MessageTypeCreatePartition  MessageType = MessageType(commonpb.MsgType_CreatePartition)
 	MessageTypeDropPartition    MessageType = MessageType(commonpb.MsgType_DropPartition)
+
+	// Transaction control messages are only used by streaming system,
+	// so they are not defined in commonpb.MsgType.
+	MessageTypeBeginTxn    MessageType = 900
+	MessageTypeCommitTxn   MessageType = 901
+	MessageTypeRollbackTxn MessageType = 902
 )
 
 var messageTypeName = map[MessageType]string{
@@ -30,6 +36,9 @@ var messageTypeName = map[MessageType]string{
 	MessageTypeDropCollection:   "DROP_COLLECTION",
 	MessageTypeCreatePartition:  "CREATE_PARTITION",
 	MessageTypeDropPartition:    "DROP_PARTITION",
+	MessageTypeBeginTxn:         "BEGIN_TXN",
+	MessageTypeCommitTxn:        "COMMIT_TXN",
+	MessageTypeRollbackTxn:      "ROLLBACK_TXN",
 }
 
 // String implements fmt.Stringer interface.
@@ -48,6 +57,12 @@ func (t MessageType) Valid() bool {
 	return t != MessageTypeUnknown && ok
 }
 
+// IsTxnControl checks if the MessageType is a transaction control message type.
+// Begin, commit and rollback message are transaction control message.
+func (t MessageType) IsTxnControl() bool {
+	return t == MessageTypeBeginTxn || t == MessageTypeCommitTxn || t == MessageTypeRollbackTxn
+}
+
 // unmarshalMessageType unmarshal MessageType from string.
 func unmarshalMessageType(s string) MessageType {
 	i, err := strconv.ParseInt(s, 10, 32)
@@ -7,6 +7,7 @@ const (
 	messageTimeTick      = "_tt" // message time tick.
 	messageLastConfirmed = "_lc" // message last confirmed message id.
 	messageVChannel      = "_vc" // message virtual channel.
+	messageTxnContext    = "_tx" // message transaction context.
 )
 
 var (
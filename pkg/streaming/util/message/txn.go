@@ -0,0 +1,86 @@
+package message
+
+import (
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	// NonTxnID is the txn id of the message which is not in a transaction.
+	NonTxnID TxnID = -1
+
+	txnContextSeparator = "/"
+)
+
+// TxnID is the unique identifier of a transaction on one wal.
+type TxnID int64
+
+// TxnContext is the transaction context of a message.
+// All messages of one transaction (begin, body and commit/rollback) carry the same TxnContext.
+type TxnContext struct {
+	// TxnID is assigned by the wal when the begin message is appended.
+	TxnID TxnID
+	// Keepalive is the max idle duration between two messages of the transaction.
+	// The transaction will be expired and rolled back if no message comes within the keepalive.
+	Keepalive time.Duration
+}
+
+// marshal marshal TxnContext to string.
+func (t TxnContext) marshal() string {
+	return EncodeInt64(int64(t.TxnID)) + txnContextSeparator + EncodeInt64(t.Keepalive.Milliseconds())
+}
+
+// unmarshalTxnContext unmarshal TxnContext from string.
+func unmarshalTxnContext(s string) (*TxnContext, error) {
+	parts := strings.Split(s, txnContextSeparator)
+	if len(parts) != 2 {
+		return nil, errors.Errorf("malformed txn context: %s", s)
+	}
+	txnID, err := DecodeInt64(parts[0])
+	if err != nil {
+		return nil, errors.Wrapf(err, "malformed txn id of txn context: %s", s)
+	}
+	keepalive, err := DecodeInt64(parts[1])
+	if err != nil {
+		return nil, errors.Wrapf(err, "malformed keepalive of txn context: %s", s)
+	}
+	return &TxnContext{
+		TxnID:     TxnID(txnID),
+		Keepalive: time.Duration(keepalive) * time.Millisecond,
+	}, nil
+}
+
+// NewBeginTxnMessage creates a new begin transaction message.
+// The txn id will be assigned by wal when the message is appended,
+// and can be read from `TxnContext` of the message after append operation returns.
+// A zero keepalive means using the default keepalive of wal.
+func NewBeginTxnMessage(keepalive time.Duration) MutableMessage {
+	return NewMutableMessageBuilder().
+		WithMessageType(MessageTypeBeginTxn).
+		WithPayload([]byte{}).
+		BuildMutable().
+		WithTxnContext(TxnContext{
+			TxnID:     NonTxnID,
+			Keepalive: keepalive,
+		})
+}
+
+// NewCommitTxnMessage creates a new commit transaction message for the given transaction.
+func NewCommitTxnMessage(txnCtx TxnContext) MutableMessage {
+	return NewMutableMessageBuilder().
+		WithMessageType(MessageTypeCommitTxn).
+		WithPayload([]byte{}).
+		BuildMutable().
+		WithTxnContext(txnCtx)
+}
+
+// NewRollbackTxnMessage creates a new rollback transaction message for the given transaction.
+func NewRollbackTxnMessage(txnCtx TxnContext) MutableMessage {
+	return NewMutableMessageBuilder().
+		WithMessageType(MessageTypeRollbackTxn).
+		WithPayload([]byte{}).
+		BuildMutable().
+		WithTxnContext(txnCtx)
+}
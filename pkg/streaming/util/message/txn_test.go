@@ -0,0 +1,64 @@
+package message
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTxnContext(t *testing.T) {
+	txnCtx := TxnContext{
+		TxnID:     123,
+		Keepalive: 10 * time.Second,
+	}
+	txnCtx2, err := unmarshalTxnContext(txnCtx.marshal())
+	assert.NoError(t, err)
+	assert.Equal(t, txnCtx, *txnCtx2)
+
+	txnCtx2, err = unmarshalTxnContext(TxnContext{TxnID: NonTxnID}.marshal())
+	assert.NoError(t, err)
+	assert.Equal(t, NonTxnID, txnCtx2.TxnID)
+	assert.Zero(t, txnCtx2.Keepalive)
+
+	for _, s := range []string{"", "1", "1/2/3", "#/1", "1/#"} {
+		_, err = unmarshalTxnContext(s)
+		assert.Error(t, err)
+	}
+}
+
+func TestTxnMessage(t *testing.T) {
+	msg := NewMutableMessageBuilder().
+		WithMessageType(MessageTypeInsert).
+		WithPayload([]byte("payload")).
+		BuildMutable()
+	assert.Nil(t, msg.TxnContext())
+	msg.WithTxnContext(TxnContext{TxnID: 1, Keepalive: time.Second})
+	assert.Equal(t, TxnID(1), msg.TxnContext().TxnID)
+	assert.Equal(t, time.Second, msg.TxnContext().Keepalive)
+
+	begin := NewBeginTxnMessage(time.Second)
+	assert.Equal(t, MessageTypeBeginTxn, begin.MessageType())
+	assert.True(t, begin.MessageType().IsTxnControl())
+	assert.Equal(t, NonTxnID, begin.TxnContext().TxnID)
+	assert.Equal(t, time.Second, begin.TxnContext().Keepalive)
+
+	commit := NewCommitTxnMessage(TxnContext{TxnID: 2, Keepalive: time.Second})
+	assert.Equal(t, MessageTypeCommitTxn, commit.MessageType())
+	assert.True(t, commit.MessageType().IsTxnControl())
+	assert.Equal(t, TxnID(2), commit.TxnContext().TxnID)
+
+	rollback := NewRollbackTxnMessage(TxnContext{TxnID: 3, Keepalive: time.Second})
+	assert.Equal(t, MessageTypeRollbackTxn, rollback.MessageType())
+	assert.True(t, rollback.MessageType().IsTxnControl())
+	assert.Equal(t, TxnID(3), rollback.TxnContext().TxnID)
+	assert.False(t, MessageTypeInsert.IsTxnControl())
+
+	immutableMsg := NewImmutableMesasge(nil, []byte{}, map[string]string{
+		messageTypeKey:    MessageTypeInsert.marshal(),
+		messageTxnContext: "dirty",
+	})
+	assert.Panics(t, func() {
+		immutableMsg.TxnContext()
+	})
+}
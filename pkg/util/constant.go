@@ -61,6 +61,13 @@ const (
 	PrivilegeWord = "Privilege"
 	AnyWord       = "*"
 
+	PrivilegeGroupCollectionReadOnly  = "CollectionReadOnly"
+	PrivilegeGroupCollectionReadWrite = "CollectionReadWrite"
+	PrivilegeGroupCollectionAdmin     = "CollectionAdmin"
+	PrivilegeGroupDatabaseReadOnly    = "DatabaseReadOnly"
+	PrivilegeGroupDatabaseReadWrite   = "DatabaseReadWrite"
+	PrivilegeGroupDatabaseAdmin       = "DatabaseAdmin"
+
 	IdentifierKey = "identifier"
 
 	HeaderUserAgent = "user-agent"
@@ -152,6 +159,82 @@ var (
 		},
 	}
 
+	// PrivilegeGroups are the built-in privilege groups of each object type, keyed by the api name of the group,
+	// the privileges in the groups are the metastore names.
+	// Granting a group grants all the privileges in it on the object, e.g. granting CollectionReadOnly
+	// on the collection `*` of a database makes the role read-only on all the collections of the database.
+	// The groups are matched by the casbin model, so they are not expanded into the policies of each privilege.
+	PrivilegeGroups = map[string]map[string][]string{
+		commonpb.ObjectType_Collection.String(): {
+			PrivilegeGroupCollectionReadOnly:  collectionReadOnlyPrivileges,
+			PrivilegeGroupCollectionReadWrite: collectionReadWritePrivileges,
+			PrivilegeGroupCollectionAdmin:     collectionAdminPrivileges,
+		},
+		commonpb.ObjectType_Global.String(): {
+			PrivilegeGroupDatabaseReadOnly:  databaseReadOnlyPrivileges,
+			PrivilegeGroupDatabaseReadWrite: databaseReadWritePrivileges,
+			PrivilegeGroupDatabaseAdmin:     databaseAdminPrivileges,
+		},
+	}
+
+	collectionReadOnlyPrivileges = []string{
+		commonpb.ObjectPrivilege_PrivilegeQuery.String(),
+		commonpb.ObjectPrivilege_PrivilegeSearch.String(),
+		commonpb.ObjectPrivilege_PrivilegeIndexDetail.String(),
+		commonpb.ObjectPrivilege_PrivilegeGetFlushState.String(),
+		commonpb.ObjectPrivilege_PrivilegeGetLoadState.String(),
+		commonpb.ObjectPrivilege_PrivilegeGetLoadingProgress.String(),
+		commonpb.ObjectPrivilege_PrivilegeHasPartition.String(),
+		commonpb.ObjectPrivilege_PrivilegeShowPartitions.String(),
+		commonpb.ObjectPrivilege_PrivilegeGetStatistics.String(),
+	}
+	collectionReadWritePrivileges = appendPrivileges(collectionReadOnlyPrivileges,
+		commonpb.ObjectPrivilege_PrivilegeInsert.String(),
+		commonpb.ObjectPrivilege_PrivilegeDelete.String(),
+		commonpb.ObjectPrivilege_PrivilegeUpsert.String(),
+		commonpb.ObjectPrivilege_PrivilegeImport.String(),
+		commonpb.ObjectPrivilege_PrivilegeFlush.String(),
+		commonpb.ObjectPrivilege_PrivilegeCompaction.String(),
+	)
+	collectionAdminPrivileges = appendPrivileges(collectionReadWritePrivileges,
+		commonpb.ObjectPrivilege_PrivilegeLoad.String(),
+		commonpb.ObjectPrivilege_PrivilegeRelease.String(),
+		commonpb.ObjectPrivilege_PrivilegeCreateIndex.String(),
+		commonpb.ObjectPrivilege_PrivilegeDropIndex.String(),
+		commonpb.ObjectPrivilege_PrivilegeCreatePartition.String(),
+		commonpb.ObjectPrivilege_PrivilegeDropPartition.String(),
+		commonpb.ObjectPrivilege_PrivilegeLoadBalance.String(),
+	)
+
+	databaseReadOnlyPrivileges = []string{
+		commonpb.ObjectPrivilege_PrivilegeShowCollections.String(),
+		commonpb.ObjectPrivilege_PrivilegeDescribeCollection.String(),
+		commonpb.ObjectPrivilege_PrivilegeDescribeDatabase.String(),
+		commonpb.ObjectPrivilege_PrivilegeDescribeAlias.String(),
+		commonpb.ObjectPrivilege_PrivilegeListAliases.String(),
+	}
+	databaseReadWritePrivileges = appendPrivileges(databaseReadOnlyPrivileges,
+		commonpb.ObjectPrivilege_PrivilegeCreateCollection.String(),
+		commonpb.ObjectPrivilege_PrivilegeDropCollection.String(),
+		commonpb.ObjectPrivilege_PrivilegeRenameCollection.String(),
+		commonpb.ObjectPrivilege_PrivilegeCreateAlias.String(),
+		commonpb.ObjectPrivilege_PrivilegeDropAlias.String(),
+	)
+	databaseAdminPrivileges = appendPrivileges(databaseReadWritePrivileges,
+		commonpb.ObjectPrivilege_PrivilegeAlterDatabase.String(),
+	)
+
+	// the privileges of each group keyed by the metastore name of the group
+	privilegeGroupMembers = func() map[string]map[string]struct{} {
+		members := make(map[string]map[string]struct{})
+		for _, groups := range PrivilegeGroups {
+			for group, privileges := range groups {
+				members[PrivilegeWord+group] = StringSet(privileges)
+			}
+		}
+		return members
+	}()
+
 	RelatedPrivileges = map[string][]string{
 		commonpb.ObjectPrivilege_PrivilegeLoad.String(): {
 			commonpb.ObjectPrivilege_PrivilegeGetLoadState.String(),
@@ -188,7 +271,8 @@ func MetaStore2API(name string) string {
 
 func PrivilegeNameForAPI(name string) string {
 	_, ok := commonpb.ObjectPrivilege_value[name]
-	if !ok {
+	_, isGroup := privilegeGroupMembers[name]
+	if !ok && !isGroup {
 		return ""
 	}
 	return MetaStore2API(name)
@@ -197,12 +281,29 @@ func PrivilegeNameForAPI(name string) string {
 func PrivilegeNameForMetastore(name string) string {
 	dbPrivilege := PrivilegeWord + name
 	_, ok := commonpb.ObjectPrivilege_value[dbPrivilege]
-	if !ok {
+	_, isGroup := privilegeGroupMembers[dbPrivilege]
+	if !ok && !isGroup {
 		return ""
 	}
 	return dbPrivilege
 }
 
+// IsPrivilegeGroup returns whether the api name is a built-in privilege group of the object type.
+func IsPrivilegeGroup(objectType string, name string) bool {
+	_, ok := PrivilegeGroups[objectType][name]
+	return ok
+}
+
+// PrivilegeGroupContains returns whether the privilege group contains the privilege, both are metastore names.
+func PrivilegeGroupContains(group string, privilege string) bool {
+	_, ok := privilegeGroupMembers[group][privilege]
+	return ok
+}
+
+func appendPrivileges(privileges []string, more ...string) []string {
+	return append(append(make([]string, 0, len(privileges)+len(more)), privileges...), more...)
+}
+
 func IsAnyWord(word string) bool {
 	return word == AnyWord
 }
@@ -115,6 +115,7 @@ var (
 	ErrDatabaseNotFound         = newMilvusError("database not found", 800, false)
 	ErrDatabaseNumLimitExceeded = newMilvusError("exceeded the limit number of database", 801, false)
 	ErrDatabaseInvalidName      = newMilvusError("invalid database name", 802, false)
+	ErrDatabaseLimitExceeded    = newMilvusError("exceeded the limit of database", 803, false)
 
 	// Node related
 	ErrNodeNotFound        = newMilvusError("node not found", 901, false)
@@ -125,9 +126,10 @@ var (
 	ErrNodeStateUnexpected = newMilvusError("node state unexpected", 906, false)
 
 	// IO related
-	ErrIoKeyNotFound = newMilvusError("key not found", 1000, false)
-	ErrIoFailed      = newMilvusError("IO failed", 1001, false)
-	ErrIoUnexpectEOF = newMilvusError("unexpected EOF", 1002, true)
+	ErrIoKeyNotFound      = newMilvusError("key not found", 1000, false)
+	ErrIoFailed           = newMilvusError("IO failed", 1001, false)
+	ErrIoUnexpectEOF      = newMilvusError("unexpected EOF", 1002, true)
+	ErrIoChecksumMismatch = newMilvusError("checksum mismatch", 1003, false)
 
 	// Parameter related
 	ErrParameterInvalid  = newMilvusError("invalid parameter", 1100, false)
@@ -447,6 +447,14 @@ func WrapErrDatabaseNumLimitExceeded(limit int, msg ...string) error {
 	return err
 }
 
+func WrapErrDatabaseLimitExceeded(database string, resource string, limit int64, msg ...string) error {
+	err := wrapFields(ErrDatabaseLimitExceeded, value("database", database), value("resource", resource), value("limit", limit))
+	if len(msg) > 0 {
+		err = errors.Wrap(err, strings.Join(msg, "->"))
+	}
+	return err
+}
+
 func WrapErrDatabaseNameInvalid(database any, msg ...string) error {
 	err := wrapFields(ErrDatabaseInvalidName, value("database", database))
 	if len(msg) > 0 {
@@ -898,6 +906,20 @@ func WrapErrIoUnexpectEOF(key string, err error) error {
 	return wrapFieldsWithDesc(ErrIoUnexpectEOF, err.Error(), value("key", key))
 }
 
+// WrapErrIoChecksumMismatch wraps ErrIoChecksumMismatch with the key of corrupted data,
+// @key shall identify the corrupted part precisely, like the binlog path and event index.
+func WrapErrIoChecksumMismatch(key string, expected, actual uint32, msg ...string) error {
+	err := wrapFields(ErrIoChecksumMismatch,
+		value("key", key),
+		value("expected", expected),
+		value("actual", actual),
+	)
+	if len(msg) > 0 {
+		err = errors.Wrap(err, strings.Join(msg, "->"))
+	}
+	return err
+}
+
 // Parameter related
 func WrapErrParameterInvalid[T any](expected, actual T, msg ...string) error {
 	err := wrapFields(ErrParameterInvalid,
@@ -262,6 +262,16 @@ type commonConfig struct {
 	UsePartitionKeyAsClusteringKey ParamItem `refreshable:"true"`
 	UseVectorAsClusteringKey       ParamItem `refreshable:"true"`
 	EnableVectorClusteringKey      ParamItem `refreshable:"true"`
+
+	EventLogEnabled     ParamItem `refreshable:"false"`
+	EventLogRootPath    ParamItem `refreshable:"false"`
+	EventLogMaxFileSize ParamItem `refreshable:"false"`
+	EventLogMaxFiles    ParamItem `refreshable:"false"`
+
+	AuditLogEnabled     ParamItem `refreshable:"false"`
+	AuditLogRootPath    ParamItem `refreshable:"false"`
+	AuditLogMaxFileSize ParamItem `refreshable:"false"`
+	AuditLogMaxFiles    ParamItem `refreshable:"false"`
 }
 
 func (p *commonConfig) init(base *BaseTable) {
@@ -808,6 +818,78 @@ like the old password verification when updating the credential`,
 		DefaultValue: "false",
 	}
 	p.EnableVectorClusteringKey.Init(base.mgr)
+
+	p.EventLogEnabled = ParamItem{
+		Key:          "common.eventLog.enabled",
+		Version:      "2.5.0",
+		DefaultValue: "false",
+		Doc:          "Whether to persist the event logs of each component on local disk, which could be queried from /eventlog/query",
+		Export:       true,
+	}
+	p.EventLogEnabled.Init(base.mgr)
+
+	p.EventLogRootPath = ParamItem{
+		Key:          "common.eventLog.rootPath",
+		Version:      "2.5.0",
+		DefaultValue: "/var/lib/milvus/data/eventlog",
+		Doc:          "The local path to persist the event logs, each component writes into its own sub directory",
+		Export:       true,
+	}
+	p.EventLogRootPath.Init(base.mgr)
+
+	p.EventLogMaxFileSize = ParamItem{
+		Key:          "common.eventLog.maxFileSize",
+		Version:      "2.5.0",
+		DefaultValue: "16",
+		Doc:          "The max size of each event log file in MB",
+		Export:       true,
+	}
+	p.EventLogMaxFileSize.Init(base.mgr)
+
+	p.EventLogMaxFiles = ParamItem{
+		Key:          "common.eventLog.maxFiles",
+		Version:      "2.5.0",
+		DefaultValue: "8",
+		Doc:          "The max number of event log files of each component, the oldest file is removed when exceeded",
+		Export:       true,
+	}
+	p.EventLogMaxFiles.Init(base.mgr)
+
+	p.AuditLogEnabled = ParamItem{
+		Key:          "common.auditLog.enabled",
+		Version:      "2.5.0",
+		DefaultValue: "false",
+		Doc:          "Whether the coordinators record the DDL, RBAC and credential changes into the audit log, which could be queried from /auditlog/query",
+		Export:       true,
+	}
+	p.AuditLogEnabled.Init(base.mgr)
+
+	p.AuditLogRootPath = ParamItem{
+		Key:          "common.auditLog.rootPath",
+		Version:      "2.5.0",
+		DefaultValue: "/var/lib/milvus/data/auditlog",
+		Doc:          "The local path to persist the audit log",
+		Export:       true,
+	}
+	p.AuditLogRootPath.Init(base.mgr)
+
+	p.AuditLogMaxFileSize = ParamItem{
+		Key:          "common.auditLog.maxFileSize",
+		Version:      "2.5.0",
+		DefaultValue: "64",
+		Doc:          "The max size of each audit log file in MB",
+		Export:       true,
+	}
+	p.AuditLogMaxFileSize.Init(base.mgr)
+
+	p.AuditLogMaxFiles = ParamItem{
+		Key:          "common.auditLog.maxFiles",
+		Version:      "2.5.0",
+		DefaultValue: "32",
+		Doc:          "The max number of audit log files, the oldest file is removed when exceeded",
+		Export:       true,
+	}
+	p.AuditLogMaxFiles.Init(base.mgr)
 }
 
 type gpuConfig struct {
@@ -990,6 +1072,11 @@ type rootCoordConfig struct {
 	MaxDatabaseNum              ParamItem `refreshable:"false"`
 	MaxGeneralCapacity          ParamItem `refreshable:"true"`
 	GracefulStopTimeout         ParamItem `refreshable:"true"`
+
+	PartitionLifecycleEnabled       ParamItem `refreshable:"true"`
+	PartitionLifecycleCheckInterval ParamItem `refreshable:"false"`
+
+	DDLJobRetentionTime ParamItem `refreshable:"true"`
 }
 
 func (p *rootCoordConfig) init(base *BaseTable) {
@@ -1061,6 +1148,33 @@ func (p *rootCoordConfig) init(base *BaseTable) {
 		Export:       true,
 	}
 	p.GracefulStopTimeout.Init(base.mgr)
+
+	p.PartitionLifecycleEnabled = ParamItem{
+		Key:          "rootCoord.partitionLifecycle.enabled",
+		Version:      "2.5.0",
+		DefaultValue: "true",
+		Doc:          "whether to release and drop the partitions by the partition lifecycle properties of the collections",
+		Export:       true,
+	}
+	p.PartitionLifecycleEnabled.Init(base.mgr)
+
+	p.PartitionLifecycleCheckInterval = ParamItem{
+		Key:          "rootCoord.partitionLifecycle.checkInterval",
+		Version:      "2.5.0",
+		DefaultValue: "600",
+		Doc:          "seconds, the interval to check the partition lifecycle properties",
+		Export:       true,
+	}
+	p.PartitionLifecycleCheckInterval.Init(base.mgr)
+
+	p.DDLJobRetentionTime = ParamItem{
+		Key:          "rootCoord.ddlJob.retentionTime",
+		Version:      "2.5.0",
+		DefaultValue: "86400",
+		Doc:          "seconds, the finished ddl jobs are removed after the retention time",
+		Export:       true,
+	}
+	p.DDLJobRetentionTime.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -1123,6 +1237,8 @@ type proxyConfig struct {
 	GracefulStopTimeout ParamItem `refreshable:"true"`
 
 	SlowQuerySpanInSeconds ParamItem `refreshable:"true"`
+
+	DDLJobWaitTimeout ParamItem `refreshable:"true"`
 }
 
 func (p *proxyConfig) init(base *BaseTable) {
@@ -1524,6 +1640,15 @@ please adjust in embedded Milvus: false`,
 		Export:       true,
 	}
 	p.SlowQuerySpanInSeconds.Init(base.mgr)
+
+	p.DDLJobWaitTimeout = ParamItem{
+		Key:          "proxy.ddlJobWaitTimeout",
+		Version:      "2.5.0",
+		DefaultValue: "3600",
+		Doc:          "seconds, the max time to wait for the ddl job of an async drop to complete, the cache and the replication of the drop are skipped after timeout",
+		Export:       true,
+	}
+	p.DDLJobWaitTimeout.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -1561,6 +1686,8 @@ type queryCoordConfig struct {
 	GrowingRowCountWeight               ParamItem `refreshable:"true"`
 	DelegatorMemoryOverloadFactor       ParamItem `refreshable:"true`
 	BalanceCostThreshold                ParamItem `refreshable:"true"`
+	BalanceMemoryWatermark              ParamItem `refreshable:"true"`
+	BalanceMmapResidentRatio            ParamItem `refreshable:"true"`
 
 	SegmentCheckInterval       ParamItem `refreshable:"true"`
 	ChannelCheckInterval       ParamItem `refreshable:"true"`
@@ -1601,6 +1728,13 @@ type queryCoordConfig struct {
 
 	CollectionObserverInterval ParamItem `refreshable:"false"`
 	CheckExecutedFlagInterval  ParamItem `refreshable:"false"`
+
+	// ---- Resource Group Recommendation ---
+	RGRecommendMemoryHighWatermark ParamItem `refreshable:"true"`
+	RGRecommendMemoryLowWatermark  ParamItem `refreshable:"true"`
+	RGRecommendCPUHighWatermark    ParamItem `refreshable:"true"`
+	RGRecommendCPULowWatermark     ParamItem `refreshable:"true"`
+	RGRecommendNQPerSecondPerNode  ParamItem `refreshable:"true"`
 }
 
 func (p *queryCoordConfig) init(base *BaseTable) {
@@ -1670,7 +1804,7 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 		Version:      "2.0.0",
 		DefaultValue: "ScoreBasedBalancer",
 		PanicIfEmpty: false,
-		Doc:          "auto balancer used for segments on queryNodes",
+		Doc:          "auto balancer used for segments on queryNodes, options: RoundRobinBalancer, RowCountBasedBalancer, ScoreBasedBalancer, MultipleTargetBalancer, ChannelLevelScoreBalancer, MemoryAwareBalancer",
 		Export:       true,
 	}
 	p.Balancer.Init(base.mgr)
@@ -1814,6 +1948,26 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 	}
 	p.BalanceCostThreshold.Init(base.mgr)
 
+	p.BalanceMemoryWatermark = ParamItem{
+		Key:          "queryCoord.balanceMemoryWatermark",
+		Version:      "2.5.0",
+		DefaultValue: "0.85",
+		PanicIfEmpty: true,
+		Doc:          "the ratio of node memory capacity, MemoryAwareBalancer won't assign segment to node if the estimated memory exceeds it",
+		Export:       true,
+	}
+	p.BalanceMemoryWatermark.Init(base.mgr)
+
+	p.BalanceMmapResidentRatio = ParamItem{
+		Key:          "queryCoord.balanceMmapResidentRatio",
+		Version:      "2.5.0",
+		DefaultValue: "0.1",
+		PanicIfEmpty: true,
+		Doc:          "the estimated ratio of mmap data resident in memory, used by MemoryAwareBalancer",
+		Export:       true,
+	}
+	p.BalanceMmapResidentRatio.Init(base.mgr)
+
 	p.MemoryUsageMaxDifferencePercentage = ParamItem{
 		Key:          "queryCoord.memoryUsageMaxDifferencePercentage",
 		Version:      "2.0.0",
@@ -2114,6 +2268,51 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 		Export:       false,
 	}
 	p.CheckExecutedFlagInterval.Init(base.mgr)
+
+	p.RGRecommendMemoryHighWatermark = ParamItem{
+		Key:          "queryCoord.resourceGroupRecommend.memoryHighWatermark",
+		Version:      "2.5.0",
+		DefaultValue: "0.8",
+		Doc:          "the memory usage ratio of resource group, above which more nodes are recommended",
+		Export:       true,
+	}
+	p.RGRecommendMemoryHighWatermark.Init(base.mgr)
+
+	p.RGRecommendMemoryLowWatermark = ParamItem{
+		Key:          "queryCoord.resourceGroupRecommend.memoryLowWatermark",
+		Version:      "2.5.0",
+		DefaultValue: "0.3",
+		Doc:          "the memory usage ratio of resource group, below which less nodes are recommended",
+		Export:       true,
+	}
+	p.RGRecommendMemoryLowWatermark.Init(base.mgr)
+
+	p.RGRecommendCPUHighWatermark = ParamItem{
+		Key:          "queryCoord.resourceGroupRecommend.cpuHighWatermark",
+		Version:      "2.5.0",
+		DefaultValue: "0.8",
+		Doc:          "the cpu usage ratio of resource group, above which more nodes are recommended",
+		Export:       true,
+	}
+	p.RGRecommendCPUHighWatermark.Init(base.mgr)
+
+	p.RGRecommendCPULowWatermark = ParamItem{
+		Key:          "queryCoord.resourceGroupRecommend.cpuLowWatermark",
+		Version:      "2.5.0",
+		DefaultValue: "0.2",
+		Doc:          "the cpu usage ratio of resource group, below which less nodes are recommended",
+		Export:       true,
+	}
+	p.RGRecommendCPULowWatermark.Init(base.mgr)
+
+	p.RGRecommendNQPerSecondPerNode = ParamItem{
+		Key:          "queryCoord.resourceGroupRecommend.nqPerSecondPerNode",
+		Version:      "2.5.0",
+		DefaultValue: "0",
+		Doc:          "the search nq per second that a query node can serve, 0 means qps is not considered when recommending",
+		Export:       true,
+	}
+	p.RGRecommendNQPerSecondPerNode.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -2918,6 +3117,11 @@ type dataCoordConfig struct {
 	ClusteringCompactionMaxClusterSizeRatio  ParamItem `refreshable:"true"`
 	ClusteringCompactionMaxClusterSize       ParamItem `refreshable:"true"`
 
+	// Repartition Compaction
+	RepartitionCompactionEnable     ParamItem `refreshable:"true"`
+	PartitionKeyStatsSampleSegments ParamItem `refreshable:"true"`
+	BackfillCompactionMaxSegments   ParamItem `refreshable:"true"`
+
 	// LevelZero Segment
 	EnableLevelZeroSegment                   ParamItem `refreshable:"false"`
 	LevelZeroCompactionTriggerMinSize        ParamItem `refreshable:"true"`
@@ -3468,6 +3672,34 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 	}
 	p.ClusteringCompactionMaxClusterSize.Init(base.mgr)
 
+	p.RepartitionCompactionEnable = ParamItem{
+		Key:          "dataCoord.compaction.repartition.enable",
+		Version:      "2.5.0",
+		DefaultValue: "true",
+		Doc:          "Enable rewriting the existing data into the partitions after the partitions of partition key are increased",
+		Export:       true,
+	}
+	p.RepartitionCompactionEnable.Init(base.mgr)
+
+	p.BackfillCompactionMaxSegments = ParamItem{
+		Key:          "dataCoord.compaction.backfill.maxSegments",
+		Version:      "2.5.0",
+		DefaultValue: "4",
+		Doc: `The maximum number of segments of each collection compacted to backfill the added fields in each global compaction round,
+the other segments are backfilled in the following rounds or by the compactions triggered for other reasons`,
+		Export: true,
+	}
+	p.BackfillCompactionMaxSegments.Init(base.mgr)
+
+	p.PartitionKeyStatsSampleSegments = ParamItem{
+		Key:          "dataCoord.partitionKeyStats.sampleSegments",
+		Version:      "2.5.0",
+		DefaultValue: "4",
+		Doc:          "The max number of flushed segments of each partition sampled to estimate the key cardinality and the hot keys of partition key",
+		Export:       true,
+	}
+	p.PartitionKeyStatsSampleSegments.Init(base.mgr)
+
 	p.EnableGarbageCollection = ParamItem{
 		Key:          "dataCoord.enableGarbageCollection",
 		Version:      "2.0.0",
@@ -4243,9 +4475,31 @@ It's ok to set it into duration string, such as 30s or 1m30s, see time.ParseDura
 	p.AutoBalanceBackoffMultiplier.Init(base.mgr)
 }
 
-type streamingNodeConfig struct{}
+type streamingNodeConfig struct {
+	TxnDefaultKeepaliveTimeout ParamItem `refreshable:"true"`
+	TxnExpireCheckInterval     ParamItem `refreshable:"false"`
+}
 
 func (p *streamingNodeConfig) init(base *BaseTable) {
+	p.TxnDefaultKeepaliveTimeout = ParamItem{
+		Key:     "streamingNode.txn.defaultKeepaliveTimeout",
+		Version: "2.5.0",
+		Doc: `The default keepalive timeout of a wal transaction, 10s by default.
+A transaction will be expired and rolled back if no message of it is appended within the timeout.
+It's ok to set it into duration string, such as 30s or 1m30s, see time.ParseDuration`,
+		DefaultValue: "10s",
+		Export:       true,
+	}
+	p.TxnDefaultKeepaliveTimeout.Init(base.mgr)
+	p.TxnExpireCheckInterval = ParamItem{
+		Key:     "streamingNode.txn.expireCheckInterval",
+		Version: "2.5.0",
+		Doc: `The interval of checking expired wal transactions at background, 1s by default.
+It's ok to set it into duration string, such as 30s or 1m30s, see time.ParseDuration`,
+		DefaultValue: "1s",
+		Export:       true,
+	}
+	p.TxnExpireCheckInterval.Init(base.mgr)
 }
 
 type runtimeConfig struct {
@@ -130,6 +130,10 @@ func TestComponentParam(t *testing.T) {
 		params.Save("rootCoord.gracefulStopTimeout", "100")
 		assert.Equal(t, 100*time.Second, Params.GracefulStopTimeout.GetAsDuration(time.Second))
 
+		assert.True(t, Params.PartitionLifecycleEnabled.GetAsBool())
+		assert.Equal(t, 600*time.Second, Params.PartitionLifecycleCheckInterval.GetAsDuration(time.Second))
+		assert.Equal(t, 24*time.Hour, Params.DDLJobRetentionTime.GetAsDuration(time.Second))
+
 		SetCreateTime(time.Now())
 		SetUpdateTime(time.Now())
 	})
@@ -189,6 +193,8 @@ func TestComponentParam(t *testing.T) {
 		assert.False(t, Params.SkipPartitionKeyCheck.GetAsBool())
 		params.Save("proxy.skipPartitionKeyCheck", "true")
 		assert.True(t, Params.SkipPartitionKeyCheck.GetAsBool())
+
+		assert.Equal(t, time.Hour, Params.DDLJobWaitTimeout.GetAsDuration(time.Second))
 	})
 
 	// t.Run("test proxyConfig panic", func(t *testing.T) {
@@ -326,6 +332,17 @@ func TestComponentParam(t *testing.T) {
 		params.Reset("queryCoord.checkExecutedFlagInterval")
 
 		assert.Equal(t, 0.3, Params.DelegatorMemoryOverloadFactor.GetAsFloat())
+		assert.Equal(t, 0.85, Params.BalanceMemoryWatermark.GetAsFloat())
+		assert.Equal(t, 0.1, Params.BalanceMmapResidentRatio.GetAsFloat())
+
+		assert.Equal(t, 0.8, Params.RGRecommendMemoryHighWatermark.GetAsFloat())
+		assert.Equal(t, 0.3, Params.RGRecommendMemoryLowWatermark.GetAsFloat())
+		assert.Equal(t, 0.8, Params.RGRecommendCPUHighWatermark.GetAsFloat())
+		assert.Equal(t, 0.2, Params.RGRecommendCPULowWatermark.GetAsFloat())
+		assert.Equal(t, 0.0, Params.RGRecommendNQPerSecondPerNode.GetAsFloat())
+		params.Save("queryCoord.resourceGroupRecommend.nqPerSecondPerNode", "1000")
+		assert.Equal(t, 1000.0, Params.RGRecommendNQPerSecondPerNode.GetAsFloat())
+		params.Reset("queryCoord.resourceGroupRecommend.nqPerSecondPerNode")
 	})
 
 	t.Run("test queryNodeConfig", func(t *testing.T) {
@@ -467,6 +484,10 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, int64(100*1024*1024), Params.ClusteringCompactionMaxSegmentSize.GetAsSize())
 		params.Save("dataCoord.compaction.clustering.preferSegmentSize", "10m")
 		assert.Equal(t, int64(10*1024*1024), Params.ClusteringCompactionPreferSegmentSize.GetAsSize())
+
+		assert.True(t, Params.RepartitionCompactionEnable.GetAsBool())
+		assert.Equal(t, 4, Params.BackfillCompactionMaxSegments.GetAsInt())
+		assert.Equal(t, 4, Params.PartitionKeyStatsSampleSegments.GetAsInt())
 	})
 
 	t.Run("test dataNodeConfig", func(t *testing.T) {
@@ -548,6 +569,15 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, 3.5, params.StreamingCoordCfg.AutoBalanceBackoffMultiplier.GetAsFloat())
 	})
 
+	t.Run("test streamingNodeConfig", func(t *testing.T) {
+		assert.Equal(t, 10*time.Second, params.StreamingNodeCfg.TxnDefaultKeepaliveTimeout.GetAsDurationByParse())
+		assert.Equal(t, 1*time.Second, params.StreamingNodeCfg.TxnExpireCheckInterval.GetAsDurationByParse())
+		params.Save(params.StreamingNodeCfg.TxnDefaultKeepaliveTimeout.Key, "1m")
+		params.Save(params.StreamingNodeCfg.TxnExpireCheckInterval.Key, "100ms")
+		assert.Equal(t, 1*time.Minute, params.StreamingNodeCfg.TxnDefaultKeepaliveTimeout.GetAsDurationByParse())
+		assert.Equal(t, 100*time.Millisecond, params.StreamingNodeCfg.TxnExpireCheckInterval.GetAsDurationByParse())
+	})
+
 	t.Run("channel config priority", func(t *testing.T) {
 		Params := &params.CommonCfg
 		params.Save(Params.RootCoordDml.Key, "dml1")
@@ -565,6 +595,22 @@ func TestComponentParam(t *testing.T) {
 		params.Save("common.enableVectorClusteringKey", "true")
 		assert.Equal(t, true, Params.EnableVectorClusteringKey.GetAsBool())
 	})
+
+	t.Run("event log config", func(t *testing.T) {
+		Params := &params.CommonCfg
+		assert.False(t, Params.EventLogEnabled.GetAsBool())
+		assert.Equal(t, "/var/lib/milvus/data/eventlog", Params.EventLogRootPath.GetValue())
+		assert.Equal(t, int64(16), Params.EventLogMaxFileSize.GetAsInt64())
+		assert.Equal(t, 8, Params.EventLogMaxFiles.GetAsInt())
+	})
+
+	t.Run("audit log config", func(t *testing.T) {
+		Params := &params.CommonCfg
+		assert.False(t, Params.AuditLogEnabled.GetAsBool())
+		assert.Equal(t, "/var/lib/milvus/data/auditlog", Params.AuditLogRootPath.GetValue())
+		assert.Equal(t, int64(64), Params.AuditLogMaxFileSize.GetAsInt64())
+		assert.Equal(t, 32, Params.AuditLogMaxFiles.GetAsInt())
+	})
 }
 
 func TestForbiddenItem(t *testing.T) {
@@ -1089,6 +1089,9 @@ type MinioConfig struct {
 	UseVirtualHost     ParamItem `refreshable:"false"`
 	RequestTimeoutMs   ParamItem `refreshable:"false"`
 	ListObjectsMaxKeys ParamItem `refreshable:"true"`
+	ReadCacheEnabled   ParamItem `refreshable:"false"`
+	ReadCacheRootPath  ParamItem `refreshable:"false"`
+	ReadCacheCapacity  ParamItem `refreshable:"false"`
 }
 
 func (p *MinioConfig) Init(base *BaseTable) {
@@ -1266,4 +1269,32 @@ Leave it empty if you want to use AWS default endpoint`,
 		Export: true,
 	}
 	p.ListObjectsMaxKeys.Init(base.mgr)
+
+	p.ReadCacheEnabled = ParamItem{
+		Key:          "minio.readCache.enabled",
+		Version:      "2.5.0",
+		DefaultValue: "false",
+		Doc:          "Whether to cache the binlogs and index files read from MinIO/S3 on local disk",
+		Export:       true,
+	}
+	p.ReadCacheEnabled.Init(base.mgr)
+
+	p.ReadCacheRootPath = ParamItem{
+		Key:          "minio.readCache.rootPath",
+		Version:      "2.5.0",
+		DefaultValue: "/var/lib/milvus/data/remote_cache",
+		Doc: `The local root path to cache the objects read from MinIO/S3, each role caches in its own sub directory.
+The processes of the same role on one host must use different paths`,
+		Export: true,
+	}
+	p.ReadCacheRootPath.Init(base.mgr)
+
+	p.ReadCacheCapacity = ParamItem{
+		Key:          "minio.readCache.capacity",
+		Version:      "2.5.0",
+		DefaultValue: "10240",
+		Doc:          "The capacity of local read cache in MB, the least recently used objects are evicted when exceeded",
+		Export:       true,
+	}
+	p.ReadCacheCapacity.Init(base.mgr)
 }
@@ -207,5 +207,9 @@ func TestServiceParam(t *testing.T) {
 		t.Logf("Minio BucketName = %s", Params.BucketName.GetValue())
 
 		t.Logf("Minio rootpath = %s", Params.RootPath.GetValue())
+
+		assert.False(t, Params.ReadCacheEnabled.GetAsBool())
+		assert.Equal(t, "/var/lib/milvus/data/remote_cache", Params.ReadCacheRootPath.GetValue())
+		assert.Equal(t, int64(10240), Params.ReadCacheCapacity.GetAsInt64())
 	})
 }
@@ -260,3 +260,60 @@ func GenEmptyFieldData(field *schemapb.FieldSchema) (*schemapb.FieldData, error)
 		return nil, fmt.Errorf("unsupported data type: %s", dataType.String())
 	}
 }
+
+// GenZeroFieldData generates the field data of numRows rows filled with the zero value of the field type,
+// vectors are filled with zeros of the field dimension, JSON with empty objects and arrays with empty elements.
+func GenZeroFieldData(field *schemapb.FieldSchema, numRows int) (*schemapb.FieldData, error) {
+	fieldData, err := GenEmptyFieldData(field)
+	if err != nil {
+		return nil, err
+	}
+	scalars := fieldData.GetScalars()
+	vectors := fieldData.GetVectors()
+	dim := int(vectors.GetDim())
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		scalars.GetBoolData().Data = make([]bool, numRows)
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		scalars.GetIntData().Data = make([]int32, numRows)
+	case schemapb.DataType_Int64:
+		scalars.GetLongData().Data = make([]int64, numRows)
+	case schemapb.DataType_Float:
+		scalars.GetFloatData().Data = make([]float32, numRows)
+	case schemapb.DataType_Double:
+		scalars.GetDoubleData().Data = make([]float64, numRows)
+	case schemapb.DataType_VarChar:
+		scalars.GetStringData().Data = make([]string, numRows)
+	case schemapb.DataType_Array:
+		data := make([]*schemapb.ScalarField, 0, numRows)
+		for i := 0; i < numRows; i++ {
+			element, err := GenEmptyFieldData(&schemapb.FieldSchema{DataType: field.GetElementType()})
+			if err != nil {
+				return nil, err
+			}
+			data = append(data, element.GetScalars())
+		}
+		scalars.GetArrayData().Data = data
+	case schemapb.DataType_JSON:
+		data := make([][]byte, 0, numRows)
+		for i := 0; i < numRows; i++ {
+			data = append(data, []byte("{}"))
+		}
+		scalars.GetJsonData().Data = data
+	case schemapb.DataType_BinaryVector:
+		vectors.Data = &schemapb.VectorField_BinaryVector{BinaryVector: make([]byte, numRows*dim/8)}
+	case schemapb.DataType_FloatVector:
+		vectors.GetFloatVector().Data = make([]float32, numRows*dim)
+	case schemapb.DataType_Float16Vector:
+		vectors.Data = &schemapb.VectorField_Float16Vector{Float16Vector: make([]byte, numRows*dim*2)}
+	case schemapb.DataType_BFloat16Vector:
+		vectors.Data = &schemapb.VectorField_Bfloat16Vector{Bfloat16Vector: make([]byte, numRows*dim*2)}
+	case schemapb.DataType_SparseFloatVector:
+		contents := make([][]byte, 0, numRows)
+		for i := 0; i < numRows; i++ {
+			contents = append(contents, []byte{})
+		}
+		vectors.GetSparseFloatVector().Contents = contents
+	}
+	return fieldData, nil
+}
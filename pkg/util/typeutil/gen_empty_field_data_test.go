@@ -0,0 +1,73 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+func TestGenZeroFieldData(t *testing.T) {
+	dimParams := []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "16"}}
+	fields := []*schemapb.FieldSchema{
+		{FieldID: 100, DataType: schemapb.DataType_Bool},
+		{FieldID: 101, DataType: schemapb.DataType_Int8},
+		{FieldID: 102, DataType: schemapb.DataType_Int64},
+		{FieldID: 103, DataType: schemapb.DataType_Float},
+		{FieldID: 104, DataType: schemapb.DataType_Double},
+		{FieldID: 105, DataType: schemapb.DataType_VarChar},
+		{FieldID: 106, DataType: schemapb.DataType_Array, ElementType: schemapb.DataType_Int32},
+		{FieldID: 107, DataType: schemapb.DataType_JSON},
+		{FieldID: 108, DataType: schemapb.DataType_BinaryVector, TypeParams: dimParams},
+		{FieldID: 109, DataType: schemapb.DataType_FloatVector, TypeParams: dimParams},
+		{FieldID: 110, DataType: schemapb.DataType_Float16Vector, TypeParams: dimParams},
+		{FieldID: 111, DataType: schemapb.DataType_BFloat16Vector, TypeParams: dimParams},
+		{FieldID: 112, DataType: schemapb.DataType_SparseFloatVector},
+	}
+	datas := make([]*schemapb.FieldData, 0, len(fields))
+	for _, field := range fields {
+		fieldData, err := GenZeroFieldData(field, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, field.GetFieldID(), fieldData.GetFieldId())
+		datas = append(datas, fieldData)
+	}
+
+	assert.Len(t, datas[0].GetScalars().GetBoolData().GetData(), 3)
+	assert.Len(t, datas[1].GetScalars().GetIntData().GetData(), 3)
+	assert.Len(t, datas[2].GetScalars().GetLongData().GetData(), 3)
+	assert.Len(t, datas[3].GetScalars().GetFloatData().GetData(), 3)
+	assert.Len(t, datas[4].GetScalars().GetDoubleData().GetData(), 3)
+	assert.Len(t, datas[5].GetScalars().GetStringData().GetData(), 3)
+	assert.Len(t, datas[6].GetScalars().GetArrayData().GetData(), 3)
+	assert.NotNil(t, datas[6].GetScalars().GetArrayData().GetData()[0].GetIntData())
+	assert.Equal(t, [][]byte{[]byte("{}"), []byte("{}"), []byte("{}")}, datas[7].GetScalars().GetJsonData().GetData())
+	assert.Len(t, datas[8].GetVectors().GetBinaryVector(), 6)
+	assert.Len(t, datas[9].GetVectors().GetFloatVector().GetData(), 48)
+	assert.Len(t, datas[10].GetVectors().GetFloat16Vector(), 96)
+	assert.Len(t, datas[11].GetVectors().GetBfloat16Vector(), 96)
+	assert.Len(t, datas[12].GetVectors().GetSparseFloatVector().GetContents(), 3)
+
+	_, err := GenZeroFieldData(&schemapb.FieldSchema{DataType: schemapb.DataType_None}, 1)
+	assert.Error(t, err)
+	_, err = GenZeroFieldData(&schemapb.FieldSchema{DataType: schemapb.DataType_FloatVector}, 1)
+	assert.Error(t, err)
+}